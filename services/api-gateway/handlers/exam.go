@@ -138,7 +138,7 @@ func (h *ExamHandler) GetSections(c *gin.Context) {
 }
 
 func (h *ExamHandler) ImportQuestions(c *gin.Context) {
-	file, _, err := c.Request.FormFile("file")
+	file, header, err := c.Request.FormFile("file")
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
 		return
@@ -158,8 +158,12 @@ func (h *ExamHandler) ImportQuestions(c *gin.Context) {
 	}
 
 	resp, err := h.examClient.ImportQuestions(c.Request.Context(), &pb.ImportQuestionsRequest{
-		CreatorId:   userID,
-		FileContent: fileBytes,
+		CreatorId:      userID,
+		FileContent:    fileBytes,
+		Format:         c.PostForm("format"),
+		FileName:       header.Filename,
+		DefaultTopic:   c.PostForm("default_topic"),
+		DefaultSection: c.PostForm("default_section"),
 	})
 
 	if err != nil {
@@ -331,10 +335,17 @@ func (h *ExamHandler) CreateExam(c *gin.Context) {
 			StartTime             string `json:"start_time"`
 			EndTime               string `json:"end_time"`
 			ShuffleQuestions      bool   `json:"shuffle_questions"`
+			ShuffleChoices        bool   `json:"shuffle_choices"`
 			ShowResultImmediately bool   `json:"show_result_immediately"`
 			RequiresApproval      bool   `json:"requires_approval"`
 			IsDynamic             bool   `json:"is_dynamic"`
 			DynamicConfig         string `json:"dynamic_config"`
+			ScoringPolicy         string `json:"scoring_policy"`
+			NegativeMarking       float32 `json:"negative_marking"`
+			ScoreScale            string `json:"score_scale"`
+			IsAdaptive            bool   `json:"is_adaptive"`
+			AdaptiveConfig        string `json:"adaptive_config"`
+			IsPractice            bool   `json:"is_practice"`
 		} `json:"settings"`
 		Status string `json:"status"`
 	}
@@ -370,10 +381,17 @@ func (h *ExamHandler) CreateExam(c *gin.Context) {
 			StartTime:             req.Settings.StartTime,
 			EndTime:               req.Settings.EndTime,
 			ShuffleQuestions:      req.Settings.ShuffleQuestions,
+			ShuffleChoices:        req.Settings.ShuffleChoices,
 			ShowResultImmediately: req.Settings.ShowResultImmediately,
 			RequiresApproval:      req.Settings.RequiresApproval,
 			IsDynamic:             req.Settings.IsDynamic,
 			DynamicConfig:         req.Settings.DynamicConfig,
+			ScoringPolicy:         req.Settings.ScoringPolicy,
+			NegativeMarking:       req.Settings.NegativeMarking,
+			ScoreScale:            req.Settings.ScoreScale,
+			IsAdaptive:            req.Settings.IsAdaptive,
+			AdaptiveConfig:        req.Settings.AdaptiveConfig,
+			IsPractice:            req.Settings.IsPractice,
 		},
 		Status: req.Status,
 	})
@@ -595,7 +613,7 @@ func (h *ExamHandler) UpdateQuestion(c *gin.Context) {
 		return
 	}
 
-	_, err = getUserIDFromContext(c)
+	userID, err := getUserIDFromContext(c)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
@@ -605,14 +623,19 @@ func (h *ExamHandler) UpdateQuestion(c *gin.Context) {
 		Content       string `json:"content"`
 		IsCorrect     bool   `json:"is_correct"`
 		AttachmentUrl string `json:"attachment_url"`
+		MatchTarget   string `json:"match_target"`
+		Position      int32  `json:"position"`
+		PinLast       bool   `json:"pin_last"`
 	}
 	var req struct {
-		Content       string      `json:"content" binding:"required"`
-		QuestionType  string      `json:"question_type" binding:"required"`
-		Difficulty    string      `json:"difficulty" binding:"required"`
-		Explanation   string      `json:"explanation"`
-		AttachmentUrl string      `json:"attachment_url"`
-		Choices       []ChoiceReq `json:"choices" binding:"required,min=2"`
+		Content       string                  `json:"content" binding:"required"`
+		QuestionType  string                  `json:"question_type" binding:"required"`
+		Difficulty    string                  `json:"difficulty" binding:"required"`
+		Explanation   string                  `json:"explanation"`
+		AttachmentUrl string                  `json:"attachment_url"`
+		Choices       []ChoiceReq             `json:"choices"`
+		Numeric       *pb.NumericAnswerConfig `json:"numeric"`
+		Blanks        []*pb.ClozeBlank        `json:"blanks"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -626,6 +649,9 @@ func (h *ExamHandler) UpdateQuestion(c *gin.Context) {
 			Content:       ch.Content,
 			IsCorrect:     ch.IsCorrect,
 			AttachmentUrl: ch.AttachmentUrl,
+			MatchTarget:   ch.MatchTarget,
+			Position:      ch.Position,
+			PinLast:       ch.PinLast,
 		})
 	}
 
@@ -637,6 +663,9 @@ func (h *ExamHandler) UpdateQuestion(c *gin.Context) {
 		Explanation:   req.Explanation,
 		AttachmentUrl: req.AttachmentUrl,
 		Choices:       pbChoices,
+		Numeric:       req.Numeric,
+		Blanks:        req.Blanks,
+		EditorId:      userID,
 	})
 
 	if err != nil {
@@ -706,10 +735,17 @@ func (h *ExamHandler) UpdateExam(c *gin.Context) {
 			StartTime             string  `json:"start_time"`
 			EndTime               string  `json:"end_time"`
 			ShuffleQuestions      bool    `json:"shuffle_questions"`
+			ShuffleChoices        bool    `json:"shuffle_choices"`
 			ShowResultImmediately bool    `json:"show_result_immediately"`
 			RequiresApproval      bool    `json:"requires_approval"`
 			IsDynamic             bool    `json:"is_dynamic"`
 			DynamicConfig         string  `json:"dynamic_config"`
+			ScoringPolicy         string  `json:"scoring_policy"`
+			NegativeMarking       float32 `json:"negative_marking"`
+			ScoreScale            string  `json:"score_scale"`
+			IsAdaptive            bool    `json:"is_adaptive"`
+			AdaptiveConfig        string  `json:"adaptive_config"`
+			IsPractice            bool    `json:"is_practice"`
 		} `json:"settings"`
 		Status string `json:"status"`
 	}
@@ -741,10 +777,17 @@ func (h *ExamHandler) UpdateExam(c *gin.Context) {
 			StartTime:             req.Settings.StartTime,
 			EndTime:               req.Settings.EndTime,
 			ShuffleQuestions:      req.Settings.ShuffleQuestions,
+			ShuffleChoices:        req.Settings.ShuffleChoices,
 			ShowResultImmediately: req.Settings.ShowResultImmediately,
 			RequiresApproval:      req.Settings.RequiresApproval,
 			IsDynamic:             req.Settings.IsDynamic,
 			DynamicConfig:         req.Settings.DynamicConfig,
+			ScoringPolicy:         req.Settings.ScoringPolicy,
+			NegativeMarking:       req.Settings.NegativeMarking,
+			ScoreScale:            req.Settings.ScoreScale,
+			IsAdaptive:            req.Settings.IsAdaptive,
+			AdaptiveConfig:        req.Settings.AdaptiveConfig,
+			IsPractice:            req.Settings.IsPractice,
 		},
 		Status: req.Status,
 	})
@@ -790,10 +833,13 @@ func (h *ExamHandler) GetMyExamStats(c *gin.Context) {
 
 func (h *ExamHandler) SaveAnswer(c *gin.Context) {
 	var req struct {
-		ExamId         int64  `json:"exam_id"`
-		QuestionId     int64  `json:"question_id"`
-		ChosenChoiceId int64  `json:"chosen_choice_id"`
-		TextAnswer     string `json:"text_answer"`
+		ExamId           int64             `json:"exam_id"`
+		QuestionId       int64             `json:"question_id"`
+		ChosenChoiceId   int64             `json:"chosen_choice_id"`
+		TextAnswer       string            `json:"text_answer"`
+		OrderedChoiceIds []int64           `json:"ordered_choice_ids"`
+		Matches          []*pb.MatchAnswer `json:"matches"`
+		Blanks           []string          `json:"blanks"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -807,13 +853,16 @@ func (h *ExamHandler) SaveAnswer(c *gin.Context) {
 	}
 
 	resp, err := h.examClient.SaveAnswer(c.Request.Context(), &pb.SaveAnswerRequest{
-		UserId:         userID,
-		ExamId:         req.ExamId,
-		QuestionId:     req.QuestionId,
-		ChosenChoiceId: req.ChosenChoiceId,
-		TextAnswer:     req.TextAnswer,
-		IpAddress:      c.ClientIP(),
-		UserAgent:      c.GetHeader("User-Agent"),
+		UserId:           userID,
+		ExamId:           req.ExamId,
+		QuestionId:       req.QuestionId,
+		ChosenChoiceId:   req.ChosenChoiceId,
+		TextAnswer:       req.TextAnswer,
+		OrderedChoiceIds: req.OrderedChoiceIds,
+		Matches:          req.Matches,
+		Blanks:           req.Blanks,
+		IpAddress:        c.ClientIP(),
+		UserAgent:        c.GetHeader("User-Agent"),
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -855,6 +904,234 @@ func (h *ExamHandler) GetExamStats(c *gin.Context) {
 	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
 }
 
+func (h *ExamHandler) GetItemAnalysis(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := h.examClient.GetItemAnalysis(c.Request.Context(), &pb.GetItemAnalysisRequest{ExamId: examID, InstructorId: userID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) ImportQTIPackage(c *gin.Context) {
+	file, _, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.ImportQTIPackage(c.Request.Context(), &pb.ImportQTIPackageRequest{
+		CreatorId:      userID,
+		FileContent:    fileBytes,
+		DefaultTopic:   c.PostForm("default_topic"),
+		DefaultSection: c.PostForm("default_section"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) ExportQTIPackage(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.ExportQTIPackage(c.Request.Context(), &pb.ExportQTIPackageRequest{
+		ExamId:    examID,
+		CreatorId: userID,
+		Version:   c.Query("version"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) RegradeExam(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		QuestionID        int64    `json:"question_id"`
+		Policy            string   `json:"policy" binding:"required"`
+		AcceptedChoiceIDs []int64  `json:"accepted_choice_ids"`
+		AcceptedAnswers   []string `json:"accepted_answers"`
+		Reason            string   `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.RegradeExam(c.Request.Context(), &pb.RegradeExamRequest{
+		ExamId:            examID,
+		QuestionId:        req.QuestionID,
+		Policy:            req.Policy,
+		AcceptedChoiceIds: req.AcceptedChoiceIDs,
+		AcceptedAnswers:   req.AcceptedAnswers,
+		InstructorId:      userID,
+		Reason:            req.Reason,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) GetRegradeHistory(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetRegradeHistory(c.Request.Context(), &pb.GetRegradeHistoryRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Regrades})
+}
+
+func (h *ExamHandler) CreateAppeal(c *gin.Context) {
+	submissionID, _ := strconv.ParseInt(c.Param("submission_id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		QuestionID int64  `json:"question_id" binding:"required"`
+		Reason     string `json:"reason" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.CreateAppeal(c.Request.Context(), &pb.CreateAppealRequest{
+		SubmissionId: submissionID,
+		QuestionId:   req.QuestionID,
+		UserId:       userID,
+		Reason:       req.Reason,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Appeal})
+}
+
+func (h *ExamHandler) GetMyAppeals(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	submissionID, _ := strconv.ParseInt(c.Query("submission_id"), 10, 64)
+
+	resp, err := h.examClient.GetMyAppeals(c.Request.Context(), &pb.GetMyAppealsRequest{
+		UserId:       userID,
+		SubmissionId: submissionID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Appeals})
+}
+
+func (h *ExamHandler) GetAppealQueue(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	examID, _ := strconv.ParseInt(c.Query("exam_id"), 10, 64)
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	resp, err := h.examClient.GetAppealQueue(c.Request.Context(), &pb.GetAppealQueueRequest{
+		InstructorId: userID,
+		ExamId:       examID,
+		Status:       c.Query("status"),
+		Page:         int32(page),
+		Limit:        int32(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) ResolveAppeal(c *gin.Context) {
+	appealID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Status        string   `json:"status" binding:"required"`
+		Response      string   `json:"response"`
+		AwardedPoints *float32 `json:"awarded_points"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.ResolveAppeal(c.Request.Context(), &pb.ResolveAppealRequest{
+		AppealId:      appealID,
+		InstructorId:  userID,
+		Status:        req.Status,
+		Response:      req.Response,
+		AwardedPoints: req.AwardedPoints,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Appeal})
+}
+
 func (h *ExamHandler) ExportExamResults(c *gin.Context) {
 	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
 	userID, _ := getUserIDFromContext(c)
@@ -966,6 +1243,69 @@ func (h *ExamHandler) GetQuestion(c *gin.Context) {
 	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Question})
 }
 
+func (h *ExamHandler) GetQuestionHistory(c *gin.Context) {
+	questionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID"})
+		return
+	}
+
+	resp, err := h.examClient.GetQuestionHistory(c.Request.Context(), &pb.GetQuestionHistoryRequest{QuestionId: questionID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) GetQuestionVersion(c *gin.Context) {
+	questionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID"})
+		return
+	}
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid version"})
+		return
+	}
+
+	resp, err := h.examClient.GetQuestionVersion(c.Request.Context(), &pb.GetQuestionVersionRequest{
+		QuestionId: questionID,
+		Version:    int32(version),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) DiffQuestionVersions(c *gin.Context) {
+	questionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID"})
+		return
+	}
+	from, errFrom := strconv.Atoi(c.Query("from"))
+	to, errTo := strconv.Atoi(c.Query("to"))
+	if errFrom != nil || errTo != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Query from và to phải là số phiên bản"})
+		return
+	}
+
+	resp, err := h.examClient.DiffQuestionVersions(c.Request.Context(), &pb.DiffQuestionVersionsRequest{
+		QuestionId:  questionID,
+		FromVersion: int32(from),
+		ToVersion:   int32(to),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
 func (h *ExamHandler) GetExamViolations(c *gin.Context) {
 	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
 
@@ -1016,6 +1356,7 @@ func (h *ExamHandler) ExportQuestions(c *gin.Context) {
 	topicID, _ := strconv.ParseInt(c.Query("topic_id"), 10, 64)
 	difficulty := c.Query("difficulty")
 	search := c.Query("search")
+	format := c.Query("format")
 
 	resp, err := h.examClient.ExportQuestions(c.Request.Context(), &pb.ExportQuestionsRequest{
 		CreatorId:  userID,
@@ -1023,6 +1364,7 @@ func (h *ExamHandler) ExportQuestions(c *gin.Context) {
 		TopicId:    topicID,
 		Difficulty: difficulty,
 		Search:     search,
+		Format:     format,
 	})
 
 	if err != nil {
@@ -1047,10 +1389,11 @@ func (h *ExamHandler) StartExam(c *gin.Context) {
 	}
 
 	resp, err := h.examClient.StartExam(c.Request.Context(), &pb.StartExamRequest{
-		ExamId:    examID,
-		UserId:    userID,
-		IpAddress: c.ClientIP(),
-		UserAgent: c.GetHeader("User-Agent"),
+		ExamId:     examID,
+		UserId:     userID,
+		IpAddress:  c.ClientIP(),
+		UserAgent:  c.GetHeader("User-Agent"),
+		RetryWrong: c.Query("retry_wrong") == "true",
 	})
 
 	if err != nil {
@@ -1061,6 +1404,52 @@ func (h *ExamHandler) StartExam(c *gin.Context) {
 	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
 }
 
+func (h *ExamHandler) GetNextAdaptiveQuestion(c *gin.Context) {
+	examID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid exam id"})
+		return
+	}
+
+	var req struct {
+		QuestionId       int64             `json:"question_id"`
+		ChoiceIds        []int64           `json:"choice_ids"`
+		TextAnswer       string            `json:"text_answer"`
+		OrderedChoiceIds []int64           `json:"ordered_choice_ids"`
+		Matches          []*pb.MatchAnswer `json:"matches"`
+		Blanks           []string          `json:"blanks"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetNextAdaptiveQuestion(c.Request.Context(), &pb.GetNextAdaptiveQuestionRequest{
+		ExamId:           examID,
+		UserId:           userID,
+		IpAddress:        c.ClientIP(),
+		UserAgent:        c.GetHeader("User-Agent"),
+		QuestionId:       req.QuestionId,
+		ChoiceIds:        req.ChoiceIds,
+		TextAnswer:       req.TextAnswer,
+		OrderedChoiceIds: req.OrderedChoiceIds,
+		Matches:          req.Matches,
+		Blanks:           req.Blanks,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
 func (h *ExamHandler) GetAccessRequests(c *gin.Context) {
 	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
 	userID, _ := getUserIDFromContext(c)
@@ -1440,21 +1829,27 @@ func (h *ExamHandler) GradeEssay(c *gin.Context) {
 	submissionID, _ := strconv.ParseInt(submissionIDStr, 10, 64)
 
 	var body struct {
-		QuestionID int64   `json:"question_id"`
-		IsCorrect  bool    `json:"is_correct"`
-		ScoreRatio float32 `json:"score_ratio"`
+		QuestionID   int64                 `json:"question_id"`
+		IsCorrect    bool                  `json:"is_correct"`
+		ScoreRatio   float32               `json:"score_ratio"`
+		RubricScores []*pb.RubricSelection `json:"rubric_scores"`
+		Feedback     string                `json:"feedback"`
 	}
 
 	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
 		return
 	}
+	graderID, _ := getUserIDFromContext(c)
 
 	resp, err := h.examClient.GradeEssay(c.Request.Context(), &pb.GradeEssayRequest{
 		SubmissionId: submissionID,
 		QuestionId:   body.QuestionID,
 		IsCorrect:    body.IsCorrect,
 		ScoreRatio:   body.ScoreRatio,
+		RubricScores: body.RubricScores,
+		Feedback:     body.Feedback,
+		GraderId:     graderID,
 	})
 
 	if err != nil {
@@ -1464,3 +1859,1073 @@ func (h *ExamHandler) GradeEssay(c *gin.Context) {
 
 	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
 }
+
+type rubricRequest struct {
+	Name        string                `json:"name" binding:"required"`
+	Description string                `json:"description"`
+	Criteria    []*pb.RubricCriterion `json:"criteria" binding:"required"`
+}
+
+func (h *ExamHandler) CreateRubric(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req rubricRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.CreateRubric(c.Request.Context(), &pb.CreateRubricRequest{
+		CreatorId:   userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Criteria:    req.Criteria,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, contracts.APIResponse{Data: resp.Rubric})
+}
+
+func (h *ExamHandler) UpdateRubric(c *gin.Context) {
+	rubricID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req rubricRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.UpdateRubric(c.Request.Context(), &pb.UpdateRubricRequest{
+		RubricId:    rubricID,
+		CreatorId:   userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Criteria:    req.Criteria,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Rubric})
+}
+
+func (h *ExamHandler) GetRubric(c *gin.Context) {
+	rubricID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	resp, err := h.examClient.GetRubric(c.Request.Context(), &pb.GetRubricRequest{RubricId: rubricID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Rubric})
+}
+
+func (h *ExamHandler) GetRubrics(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	resp, err := h.examClient.GetRubrics(c.Request.Context(), &pb.GetRubricsRequest{CreatorId: userID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Rubrics})
+}
+
+func (h *ExamHandler) DeleteRubric(c *gin.Context) {
+	rubricID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	_, err = h.examClient.DeleteRubric(c.Request.Context(), &pb.DeleteRubricRequest{RubricId: rubricID, CreatorId: userID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: gin.H{"success": true}})
+}
+
+func (h *ExamHandler) SetQuestionRubric(c *gin.Context) {
+	questionID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		RubricID int64 `json:"rubric_id"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err = h.examClient.SetQuestionRubric(c.Request.Context(), &pb.SetQuestionRubricRequest{
+		QuestionId: questionID,
+		RubricId:   req.RubricID,
+		CreatorId:  userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: gin.H{"success": true}})
+}
+
+func (h *ExamHandler) ConfigureMarking(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		DoubleMarking        bool    `json:"double_marking"`
+		DiscrepancyThreshold float32 `json:"discrepancy_threshold"`
+		ModeratorID          int64   `json:"moderator_id"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.ConfigureMarking(c.Request.Context(), &pb.ConfigureMarkingRequest{
+		ExamId:               examID,
+		InstructorId:         userID,
+		DoubleMarking:        req.DoubleMarking,
+		DiscrepancyThreshold: req.DiscrepancyThreshold,
+		ModeratorId:          req.ModeratorID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Config})
+}
+
+func (h *ExamHandler) AssignMarkers(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		MarkerIDs []int64 `json:"marker_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.AssignMarkers(c.Request.Context(), &pb.AssignMarkersRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		MarkerIds:    req.MarkerIDs,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) GetMarkingOverview(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetMarkingOverview(c.Request.Context(), &pb.GetMarkingOverviewRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) GetMarkingTasks(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetMarkingTasks(c.Request.Context(), &pb.GetMarkingTasksRequest{
+		MarkerId: userID,
+		Status:   c.Query("status"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Tasks})
+}
+
+func (h *ExamHandler) GetMarkingTask(c *gin.Context) {
+	assignmentID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetMarkingTask(c.Request.Context(), &pb.GetMarkingTaskRequest{
+		AssignmentId: assignmentID,
+		MarkerId:     userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) SubmitMarks(c *gin.Context) {
+	assignmentID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Marks []*pb.EssayMarkInput `json:"marks" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.SubmitMarks(c.Request.Context(), &pb.SubmitMarksRequest{
+		AssignmentId: assignmentID,
+		MarkerId:     userID,
+		Marks:        req.Marks,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+type accommodationRequest struct {
+	TimeMultiplier         float32 `json:"time_multiplier"`
+	ExtraMinutes           int32   `json:"extra_minutes"`
+	WindowExtensionMinutes int32   `json:"window_extension_minutes"`
+	ExtraAttempts          int32   `json:"extra_attempts"`
+	Notes                  string  `json:"notes"`
+}
+
+func (r accommodationRequest) terms() *pb.AccommodationTerms {
+	return &pb.AccommodationTerms{
+		TimeMultiplier:         r.TimeMultiplier,
+		ExtraMinutes:           r.ExtraMinutes,
+		WindowExtensionMinutes: r.WindowExtensionMinutes,
+		ExtraAttempts:          r.ExtraAttempts,
+	}
+}
+
+func (h *ExamHandler) SaveAccommodation(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		accommodationRequest
+		UserID  int64 `json:"user_id" binding:"required"`
+		ClassID int64 `json:"class_id"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.SaveAccommodation(c.Request.Context(), &pb.SaveAccommodationRequest{
+		UserId:       req.UserID,
+		ClassId:      req.ClassID,
+		Terms:        req.terms(),
+		Notes:        req.Notes,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Accommodation})
+}
+
+func (h *ExamHandler) GetAccommodations(c *gin.Context) {
+	userID, _ := strconv.ParseInt(c.Query("user_id"), 10, 64)
+	classID, _ := strconv.ParseInt(c.Query("class_id"), 10, 64)
+	instructorID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetAccommodations(c.Request.Context(), &pb.GetAccommodationsRequest{
+		UserId:       userID,
+		ClassId:      classID,
+		InstructorId: instructorID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Accommodations})
+}
+
+func (h *ExamHandler) DeleteAccommodation(c *gin.Context) {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	_, err = h.examClient.DeleteAccommodation(c.Request.Context(), &pb.DeleteAccommodationRequest{
+		Id:           id,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: gin.H{"success": true}})
+}
+
+func (h *ExamHandler) SaveExamAccommodation(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	studentID, _ := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req accommodationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.SaveExamAccommodation(c.Request.Context(), &pb.SaveExamAccommodationRequest{
+		ExamId:       examID,
+		UserId:       studentID,
+		Terms:        req.terms(),
+		Notes:        req.Notes,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Accommodation})
+}
+
+func (h *ExamHandler) GetExamAccommodations(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetExamAccommodations(c.Request.Context(), &pb.GetExamAccommodationsRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Accommodations})
+}
+
+func (h *ExamHandler) DeleteExamAccommodation(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	studentID, _ := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	_, err = h.examClient.DeleteExamAccommodation(c.Request.Context(), &pb.DeleteExamAccommodationRequest{
+		ExamId:       examID,
+		UserId:       studentID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: gin.H{"success": true}})
+}
+
+func (h *ExamHandler) GetSuspicionConfig(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetSuspicionConfig(c.Request.Context(), &pb.GetSuspicionConfigRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Config})
+}
+
+func (h *ExamHandler) UpdateSuspicionConfig(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Weights             map[string]float32 `json:"weights"`
+		BurstWindowSeconds  int32              `json:"burst_window_seconds"`
+		FlagThreshold       float32            `json:"flag_threshold" binding:"required"`
+		LockThreshold       float32            `json:"lock_threshold"`
+		AutoSubmitThreshold float32            `json:"auto_submit_threshold"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.UpdateSuspicionConfig(c.Request.Context(), &pb.UpdateSuspicionConfigRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		Config: &pb.SuspicionConfig{
+			Weights:             req.Weights,
+			BurstWindowSeconds:  req.BurstWindowSeconds,
+			FlagThreshold:       req.FlagThreshold,
+			LockThreshold:       req.LockThreshold,
+			AutoSubmitThreshold: req.AutoSubmitThreshold,
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Config})
+}
+
+func (h *ExamHandler) AnalyzeCollusion(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		MinIdenticalWrong   int32   `json:"min_identical_wrong"`
+		ZThreshold          float32 `json:"z_threshold"`
+		TimingWindowSeconds int32   `json:"timing_window_seconds"`
+		MaxPairs            int32   `json:"max_pairs"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.examClient.AnalyzeCollusion(c.Request.Context(), &pb.AnalyzeCollusionRequest{
+		ExamId:              examID,
+		InstructorId:        userID,
+		MinIdenticalWrong:   req.MinIdenticalWrong,
+		ZThreshold:          req.ZThreshold,
+		TimingWindowSeconds: req.TimingWindowSeconds,
+		MaxPairs:            req.MaxPairs,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Report})
+}
+
+func (h *ExamHandler) GetCollusionReport(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetCollusionReport(c.Request.Context(), &pb.GetCollusionReportRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Report})
+}
+
+func (h *ExamHandler) SaveSimilaritySources(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Sources []*pb.SimilaritySource `json:"sources"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.SaveSimilaritySources(c.Request.Context(), &pb.SaveSimilaritySourcesRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		Sources:      req.Sources,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Sources})
+}
+
+func (h *ExamHandler) GetSimilaritySources(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetSimilaritySources(c.Request.Context(), &pb.GetSimilaritySourcesRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Sources})
+}
+
+func (h *ExamHandler) CheckEssaySimilarity(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		QuestionID    int64   `json:"question_id"`
+		MinSimilarity float32 `json:"min_similarity"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.examClient.CheckEssaySimilarity(c.Request.Context(), &pb.CheckEssaySimilarityRequest{
+		ExamId:        examID,
+		InstructorId:  userID,
+		QuestionId:    req.QuestionID,
+		MinSimilarity: req.MinSimilarity,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) GetEssaySimilarity(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	questionID, _ := strconv.ParseInt(c.Query("question_id"), 10, 64)
+	submissionID, _ := strconv.ParseInt(c.Query("submission_id"), 10, 64)
+
+	resp, err := h.examClient.GetEssaySimilarity(c.Request.Context(), &pb.GetEssaySimilarityRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		QuestionId:   questionID,
+		SubmissionId: submissionID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Matches})
+}
+
+func (h *ExamHandler) GetPracticeReport(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	examID, _ := strconv.ParseInt(c.Query("exam_id"), 10, 64)
+
+	resp, err := h.examClient.GetPracticeReport(c.Request.Context(), &pb.GetPracticeReportRequest{
+		UserId: userID,
+		ExamId: examID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) GetDueReviews(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	topicID, _ := strconv.ParseInt(c.Query("topic_id"), 10, 64)
+	classID, _ := strconv.ParseInt(c.Query("class_id"), 10, 64)
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	resp, err := h.examClient.GetDueReviews(c.Request.Context(), &pb.GetDueReviewsRequest{
+		UserId:  userID,
+		TopicId: topicID,
+		ClassId: classID,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) RecordReview(c *gin.Context) {
+	cardID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Rating *int32 `json:"rating" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.RecordReview(c.Request.Context(), &pb.RecordReviewRequest{
+		UserId: userID,
+		CardId: cardID,
+		Rating: *req.Rating,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Card})
+}
+
+func (h *ExamHandler) GeneratePaperExam(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		VersionCount int32   `json:"version_count"`
+		StudentIDs   []int64 `json:"student_ids"`
+		SchoolName   string  `json:"school_name"`
+		ExamDate     string  `json:"exam_date"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.examClient.GeneratePaperExam(c.Request.Context(), &pb.GeneratePaperExamRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		VersionCount: req.VersionCount,
+		StudentIds:   req.StudentIDs,
+		SchoolName:   req.SchoolName,
+		ExamDate:     req.ExamDate,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.Filename))
+	c.Data(http.StatusOK, "application/zip", resp.ZipData)
+}
+
+func (h *ExamHandler) GenerateAnswerSheets(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		VersionCount int32   `json:"version_count"`
+		StudentIDs   []int64 `json:"student_ids"`
+		SchoolName   string  `json:"school_name"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.examClient.GenerateAnswerSheets(c.Request.Context(), &pb.GenerateAnswerSheetsRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		VersionCount: req.VersionCount,
+		StudentIds:   req.StudentIDs,
+		SchoolName:   req.SchoolName,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.Filename))
+	c.Data(http.StatusOK, "application/pdf", resp.PdfData)
+}
+
+func (h *ExamHandler) GradeScannedSheets(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	if err := c.Request.ParseMultipartForm(50 << 20); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Không đọc được dữ liệu tải lên"})
+		return
+	}
+	headers := c.Request.MultipartForm.File["files"]
+	if len(headers) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Không tìm thấy file phiếu quét (field: files)"})
+		return
+	}
+
+	files := make([]*pb.ScannedFile, 0, len(headers))
+	for _, header := range headers {
+		file, err := header.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		content, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+			return
+		}
+		files = append(files, &pb.ScannedFile{Filename: header.Filename, Content: content})
+	}
+
+	resp, err := h.examClient.GradeScannedSheets(c.Request.Context(), &pb.GradeScannedSheetsRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		Files:        files,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) GetAnswerSheetScans(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetAnswerSheetScans(c.Request.Context(), &pb.GetAnswerSheetScansRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		Status:       c.Query("status"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Scans})
+}
+
+func (h *ExamHandler) ResolveAnswerSheetScan(c *gin.Context) {
+	scanID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Corrections []struct {
+			QuestionID int64    `json:"question_id"`
+			Marked     []string `json:"marked"`
+		} `json:"corrections"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	corrections := make([]*pb.ScanCorrection, 0, len(req.Corrections))
+	for _, corr := range req.Corrections {
+		corrections = append(corrections, &pb.ScanCorrection{QuestionId: corr.QuestionID, Marked: corr.Marked})
+	}
+
+	resp, err := h.examClient.ResolveAnswerSheetScan(c.Request.Context(), &pb.ResolveAnswerSheetScanRequest{
+		ScanId:       scanID,
+		InstructorId: userID,
+		Corrections:  corrections,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Scan})
+}
+
+func (h *ExamHandler) CloneExam(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Title       string  `json:"title"`
+		ShiftDays   int32   `json:"shift_days"`
+		StartTime   string  `json:"start_time"`
+		CopyClasses bool    `json:"copy_classes"`
+		ClassIDs    []int64 `json:"class_ids"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.examClient.CloneExam(c.Request.Context(), &pb.CloneExamRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		Title:        req.Title,
+		ShiftDays:    req.ShiftDays,
+		StartTime:    req.StartTime,
+		CopyClasses:  req.CopyClasses,
+		ClassIds:     req.ClassIDs,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) CreateExamTemplate(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Name           string              `json:"name"`
+		Description    string              `json:"description"`
+		TopicID        int64               `json:"topic_id"`
+		Settings       *pb.ExamSettings    `json:"settings"`
+		SectionConfigs []*pb.SectionConfig `json:"section_configs"`
+		SourceExamID   int64               `json:"source_exam_id"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.CreateExamTemplate(c.Request.Context(), &pb.CreateExamTemplateRequest{
+		InstructorId:   userID,
+		Name:           req.Name,
+		Description:    req.Description,
+		TopicId:        req.TopicID,
+		Settings:       req.Settings,
+		SectionConfigs: req.SectionConfigs,
+		SourceExamId:   req.SourceExamID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Template})
+}
+
+func (h *ExamHandler) GetExamTemplates(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetExamTemplates(c.Request.Context(), &pb.GetExamTemplatesRequest{InstructorId: userID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Templates})
+}
+
+func (h *ExamHandler) DeleteExamTemplate(c *gin.Context) {
+	templateID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.DeleteExamTemplate(c.Request.Context(), &pb.DeleteExamTemplateRequest{
+		TemplateId:   templateID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) InstantiateExamTemplate(c *gin.Context) {
+	templateID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Title     string  `json:"title"`
+		StartTime string  `json:"start_time"`
+		EndTime   string  `json:"end_time"`
+		ClassIDs  []int64 `json:"class_ids"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.examClient.InstantiateExamTemplate(c.Request.Context(), &pb.InstantiateExamTemplateRequest{
+		TemplateId:   templateID,
+		InstructorId: userID,
+		Title:        req.Title,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		ClassIds:     req.ClassIDs,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
+
+func (h *ExamHandler) ValidateExamBlueprint(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		ExamID           int64               `json:"exam_id"`
+		TopicID          int64               `json:"topic_id"`
+		SectionConfigs   []*pb.SectionConfig `json:"section_configs"`
+		FixedQuestionIDs []int64             `json:"fixed_question_ids"`
+		CoverageFactor   int32               `json:"coverage_factor"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.ExamID == 0 && len(req.SectionConfigs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cần exam_id hoặc section_configs"})
+		return
+	}
+
+	resp, err := h.examClient.ValidateExamBlueprint(c.Request.Context(), &pb.ValidateExamBlueprintRequest{
+		InstructorId:     userID,
+		ExamId:           req.ExamID,
+		TopicId:          req.TopicID,
+		SectionConfigs:   req.SectionConfigs,
+		FixedQuestionIds: req.FixedQuestionIDs,
+		CoverageFactor:   req.CoverageFactor,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp})
+}
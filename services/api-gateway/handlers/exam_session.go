@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/06babyshark06/JQKStudy/services/api-gateway/redis"
+	"github.com/06babyshark06/JQKStudy/shared/contracts"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// ControlExamSession nhận thao tác của giám thị qua đường dẫn /exams/:id/session/:action
+// (pause, resume, extend, force_submit, broadcast).
+func (h *ExamHandler) ControlExamSession(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		UserID       int64  `json:"user_id"`
+		ExtraMinutes int32  `json:"extra_minutes"`
+		Message      string `json:"message"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.examClient.ControlExamSession(c.Request.Context(), &pb.ControlExamSessionRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+		Action:       c.Param("action"),
+		UserId:       req.UserID,
+		ExtraMinutes: req.ExtraMinutes,
+		Message:      req.Message,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Action})
+}
+
+func (h *ExamHandler) GetExamSessionActions(c *gin.Context) {
+	examID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.examClient.GetExamSessionActions(c.Request.Context(), &pb.GetExamSessionActionsRequest{
+		ExamId:       examID,
+		InstructorId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, contracts.APIResponse{Data: resp.Actions})
+}
+
+const (
+	sessionTimerInterval  = time.Second
+	sessionResyncInterval = 30 * time.Second
+)
+
+type wsAnswerSave struct {
+	Seq              int64             `json:"seq"`
+	QuestionId       int64             `json:"question_id"`
+	ChosenChoiceId   int64             `json:"chosen_choice_id"`
+	TextAnswer       string            `json:"text_answer"`
+	OrderedChoiceIds []int64           `json:"ordered_choice_ids"`
+	Matches          []*pb.MatchAnswer `json:"matches"`
+	Blanks           []string          `json:"blanks"`
+}
+
+// examSessionClock giữ đồng hồ theo trạng thái lấy từ exam-service; chỉ gateway đếm ngược giữa các lần đồng bộ.
+type examSessionClock struct {
+	state  *pb.GetExamSessionStateResponse
+	syncAt time.Time
+}
+
+func (c *examSessionClock) remaining() int32 {
+	if c.state.Paused {
+		return c.state.RemainingSeconds
+	}
+	remaining := c.state.RemainingSeconds - int32(time.Since(c.syncAt).Seconds())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining
+}
+
+// ExamSessionWS là kênh làm bài của thí sinh: đồng hồ do server quyết định, lưu đáp án có số thứ tự và xác nhận,
+// cùng các sự kiện điều khiển của giám thị. Khi kết nối lại, client gửi lại các đáp án có seq lớn hơn last_answer_seq;
+// đáp án bị ANSWER_NACK phải được gửi lại với seq mới.
+func (h *ExamHandler) ExamSessionWS(c *gin.Context) {
+	examID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid exam id"})
+		return
+	}
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	ipAddress := c.ClientIP()
+	userAgent := c.GetHeader("User-Agent")
+
+	stateReq := &pb.GetExamSessionStateRequest{ExamId: examID, UserId: userID, IpAddress: ipAddress, UserAgent: userAgent}
+	state, err := h.examClient.GetExamSessionState(c.Request.Context(), stateReq)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Printf("Failed to set websocket upgrade: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscriber := redis.Rdb.Subscribe(ctx,
+		fmt.Sprintf("exam_session:%d", examID),
+		fmt.Sprintf("exam_session:%d:user:%d", examID, userID),
+	)
+	defer subscriber.Close()
+
+	ch := subscriber.Channel()
+
+	incoming := make(chan contracts.WSDriverMessage)
+	go func() {
+		defer cancel()
+		for {
+			var msg contracts.WSDriverMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			select {
+			case incoming <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	send := func(msgType string, data any) bool {
+		if err := conn.WriteJSON(contracts.WSMessage{Type: msgType, Data: data}); err != nil {
+			log.Println("write WS error:", err)
+			cancel()
+			return false
+		}
+		return true
+	}
+
+	clock := &examSessionClock{state: state, syncAt: time.Now()}
+	resync := func() bool {
+		state, err := h.examClient.GetExamSessionState(ctx, stateReq)
+		if err != nil {
+			send("ERROR", gin.H{"message": err.Error()})
+			return false
+		}
+		clock = &examSessionClock{state: state, syncAt: time.Now()}
+		return true
+	}
+
+	send("SESSION_STATE", clock.state)
+
+	timer := time.NewTicker(sessionTimerInterval)
+	defer timer.Stop()
+	resyncTicker := time.NewTicker(sessionResyncInterval)
+	defer resyncTicker.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			send("TIMER", gin.H{"remaining_seconds": clock.remaining(), "paused": clock.state.Paused})
+
+		case <-resyncTicker.C:
+			if !resync() {
+				return
+			}
+
+		case msg := <-ch:
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg.Payload)); err != nil {
+				log.Println("write WS error:", err)
+				cancel()
+				continue
+			}
+			var event contracts.WSDriverMessage
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				continue
+			}
+			switch event.Type {
+			case "EXAM_PAUSED", "EXAM_RESUMED", "TIME_EXTENDED":
+				if resync() {
+					send("SESSION_STATE", clock.state)
+				}
+			case "EXAM_AUTO_SUBMITTED", "EXAM_FORCE_SUBMITTED":
+				return
+			}
+
+		case in := <-incoming:
+			switch in.Type {
+			case "SAVE_ANSWER":
+				var save wsAnswerSave
+				if err := json.Unmarshal(in.Data, &save); err != nil || save.Seq <= 0 {
+					send("ANSWER_NACK", gin.H{"seq": save.Seq, "error": "invalid answer payload"})
+					continue
+				}
+				resp, err := h.examClient.SaveAnswer(ctx, &pb.SaveAnswerRequest{
+					UserId:           userID,
+					ExamId:           examID,
+					QuestionId:       save.QuestionId,
+					ChosenChoiceId:   save.ChosenChoiceId,
+					TextAnswer:       save.TextAnswer,
+					OrderedChoiceIds: save.OrderedChoiceIds,
+					Matches:          save.Matches,
+					Blanks:           save.Blanks,
+					IpAddress:        ipAddress,
+					UserAgent:        userAgent,
+					Seq:              save.Seq,
+				})
+				if err != nil {
+					send("ANSWER_NACK", gin.H{"seq": save.Seq, "question_id": save.QuestionId, "error": err.Error()})
+					continue
+				}
+				if resp.Seq > clock.state.LastAnswerSeq {
+					clock.state.LastAnswerSeq = resp.Seq
+				}
+				send("ANSWER_ACK", gin.H{"seq": resp.Seq, "question_id": save.QuestionId, "duplicate": resp.Duplicate, "feedback": resp.Feedback})
+			case "SYNC":
+				if resync() {
+					send("SESSION_STATE", clock.state)
+				}
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
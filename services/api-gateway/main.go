@@ -147,32 +147,82 @@ func main() {
 
 				instructorOnly.GET("/questions", examHandler.GetQuestions)
 				instructorOnly.GET("/questions/:id", examHandler.GetQuestion)
+				instructorOnly.GET("/questions/:id/versions", examHandler.GetQuestionHistory)
+				instructorOnly.GET("/questions/:id/versions/:version", examHandler.GetQuestionVersion)
+				instructorOnly.GET("/questions/:id/diff", examHandler.DiffQuestionVersions)
 				instructorOnly.POST("/questions/import", examHandler.ImportQuestions)
 				instructorOnly.POST("/questions/upload-url", examHandler.GetUploadURL)
 				instructorOnly.POST("/questions", examHandler.CreateQuestion)
 				instructorOnly.POST("/questions/bulk", examHandler.CreateBulkQuestions)
 				instructorOnly.PUT("/questions/:id", examHandler.UpdateQuestion)
+				instructorOnly.PUT("/questions/:id/rubric", examHandler.SetQuestionRubric)
 				instructorOnly.DELETE("/questions/:id", examHandler.DeleteQuestion)
 				instructorOnly.DELETE("/questions", examHandler.DeleteBulkQuestions)
 				instructorOnly.GET("/questions/export", examHandler.ExportQuestions)
 
+				instructorOnly.POST("/rubrics", examHandler.CreateRubric)
+				instructorOnly.GET("/rubrics", examHandler.GetRubrics)
+				instructorOnly.GET("/rubrics/:id", examHandler.GetRubric)
+				instructorOnly.PUT("/rubrics/:id", examHandler.UpdateRubric)
+				instructorOnly.DELETE("/rubrics/:id", examHandler.DeleteRubric)
+
 				instructorOnly.POST("/exams", examHandler.CreateExam)
 				instructorOnly.POST("/exams/generate", examHandler.GenerateExam)
+				instructorOnly.POST("/exams/import/qti", examHandler.ImportQTIPackage)
 				instructorOnly.PUT("/exams/:id", examHandler.UpdateExam)
 				instructorOnly.PUT("/exams/:id/publish", examHandler.PublishExam)
 				instructorOnly.GET("/instructor/exams", examHandler.GetInstructorExams)
 				instructorOnly.GET("/instructor/recent-submissions", examHandler.GetRecentSubmissions)
 				instructorOnly.DELETE("/exams/:id", examHandler.DeleteExam)
+				instructorOnly.POST("/exams/:id/clone", examHandler.CloneExam)
+				instructorOnly.GET("/exam-templates", examHandler.GetExamTemplates)
+				instructorOnly.POST("/exam-templates", examHandler.CreateExamTemplate)
+				instructorOnly.DELETE("/exam-templates/:id", examHandler.DeleteExamTemplate)
+				instructorOnly.POST("/exam-templates/:id/instantiate", examHandler.InstantiateExamTemplate)
+				instructorOnly.POST("/exam-blueprints/validate", examHandler.ValidateExamBlueprint)
 
 				instructorOnly.PUT("/exams/access/approve", examHandler.ApproveAccess)
 				instructorOnly.GET("/exams/:id/stats", examHandler.GetExamStats)
+				instructorOnly.GET("/exams/:id/item-analysis", examHandler.GetItemAnalysis)
 				instructorOnly.GET("/exams/:id/export", examHandler.ExportExamResults)
+				instructorOnly.GET("/exams/:id/export/qti", examHandler.ExportQTIPackage)
+				instructorOnly.POST("/exams/:id/regrade", examHandler.RegradeExam)
+				instructorOnly.GET("/exams/:id/regrades", examHandler.GetRegradeHistory)
 				instructorOnly.GET("/exams/:id/submissions", examHandler.GetExamSubmissions)
 				instructorOnly.GET("/exams/:id/violations", examHandler.GetExamViolations)
 				instructorOnly.GET("/exams/:id/monitor/ws", examHandler.MonitorExamViolationsWS)
+				instructorOnly.POST("/exams/:id/session/:action", examHandler.ControlExamSession)
+				instructorOnly.GET("/exams/:id/session/actions", examHandler.GetExamSessionActions)
+				instructorOnly.GET("/exams/:id/suspicion-config", examHandler.GetSuspicionConfig)
+				instructorOnly.PUT("/exams/:id/suspicion-config", examHandler.UpdateSuspicionConfig)
+				instructorOnly.POST("/exams/:id/collusion", examHandler.AnalyzeCollusion)
+				instructorOnly.GET("/exams/:id/collusion", examHandler.GetCollusionReport)
+				instructorOnly.GET("/exams/:id/similarity-sources", examHandler.GetSimilaritySources)
+				instructorOnly.PUT("/exams/:id/similarity-sources", examHandler.SaveSimilaritySources)
+				instructorOnly.POST("/exams/:id/similarity", examHandler.CheckEssaySimilarity)
+				instructorOnly.GET("/exams/:id/similarity", examHandler.GetEssaySimilarity)
+				instructorOnly.POST("/exams/:id/paper", examHandler.GeneratePaperExam)
+				instructorOnly.POST("/exams/:id/answer-sheets", examHandler.GenerateAnswerSheets)
+				instructorOnly.POST("/exams/:id/scans", examHandler.GradeScannedSheets)
+				instructorOnly.GET("/exams/:id/scans", examHandler.GetAnswerSheetScans)
+				instructorOnly.POST("/scans/:id/resolve", examHandler.ResolveAnswerSheetScan)
 				instructorOnly.GET("/exams/:id/access-requests", examHandler.GetAccessRequests)
 				instructorOnly.GET("/exams/:id/preview", examHandler.GetExamPreview)
 				instructorOnly.POST("/submissions/:submission_id/grade", examHandler.GradeEssay)
+				instructorOnly.PUT("/exams/:id/marking-config", examHandler.ConfigureMarking)
+				instructorOnly.POST("/exams/:id/markers", examHandler.AssignMarkers)
+				instructorOnly.GET("/exams/:id/marking", examHandler.GetMarkingOverview)
+				instructorOnly.GET("/marking/tasks", examHandler.GetMarkingTasks)
+				instructorOnly.GET("/marking/tasks/:id", examHandler.GetMarkingTask)
+				instructorOnly.POST("/marking/tasks/:id/marks", examHandler.SubmitMarks)
+				instructorOnly.GET("/appeals", examHandler.GetAppealQueue)
+				instructorOnly.PUT("/appeals/:id/resolve", examHandler.ResolveAppeal)
+				instructorOnly.POST("/accommodations", examHandler.SaveAccommodation)
+				instructorOnly.GET("/accommodations", examHandler.GetAccommodations)
+				instructorOnly.DELETE("/accommodations/:id", examHandler.DeleteAccommodation)
+				instructorOnly.GET("/exams/:id/accommodations", examHandler.GetExamAccommodations)
+				instructorOnly.PUT("/exams/:id/accommodations/:user_id", examHandler.SaveExamAccommodation)
+				instructorOnly.DELETE("/exams/:id/accommodations/:user_id", examHandler.DeleteExamAccommodation)
 
 				instructorOnly.POST("/classes", classHandler.CreateClass)
 				instructorOnly.PUT("/classes/:id", classHandler.UpdateClass)
@@ -198,12 +248,19 @@ func main() {
 
 				studentOnly.POST("/exams/submit", examHandler.SubmitExam)
 				studentOnly.GET("/submissions/:id", examHandler.GetSubmission)
+				studentOnly.POST("/submissions/:submission_id/appeals", examHandler.CreateAppeal)
+				studentOnly.GET("/appeals/me", examHandler.GetMyAppeals)
 				studentOnly.POST("/exams/access/request", examHandler.RequestAccess)
 				studentOnly.GET("/exams/access/check", examHandler.CheckAccess)
 				studentOnly.POST("/exams/save-answer", examHandler.SaveAnswer)
 				studentOnly.POST("/exams/log-violation", examHandler.LogViolation)
 				studentOnly.POST("/exams/:id/start", examHandler.StartExam)
+				studentOnly.GET("/exams/:id/session/ws", examHandler.ExamSessionWS)
+				studentOnly.POST("/exams/:id/adaptive/next", examHandler.GetNextAdaptiveQuestion)
 				studentOnly.GET("/exams/my-submissions", examHandler.GetMySubmissions)
+				studentOnly.GET("/practice/report", examHandler.GetPracticeReport)
+				studentOnly.GET("/reviews/due", examHandler.GetDueReviews)
+				studentOnly.POST("/reviews/:id", examHandler.RecordReview)
 				studentOnly.GET("/classes/:id/exams", classHandler.GetClassExams)
 				studentOnly.GET("/classes", classHandler.GetClasses)
 				studentOnly.GET("/classes/:id", classHandler.GetClassDetails)
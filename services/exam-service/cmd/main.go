@@ -12,9 +12,12 @@ import (
 	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/infrastructure/events"
 	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/infrastructure/grpc"
 	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/infrastructure/repository"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/infrastructure/scheduler"
 	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/service"
 	"github.com/06babyshark06/JQKStudy/shared/env"
+	pbUser "github.com/06babyshark06/JQKStudy/shared/proto/user"
 	grpcserver "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -38,7 +41,17 @@ func main() {
 	}
 	defer kafkaProducer.Close()
 
-	service := service.NewExamService(repo, kafkaProducer)
+	userSvcAddr := env.GetString("USER_GRPC_ADDR", "user-service:9001")
+	var userClient pbUser.UserServiceClient
+	userConn, err := grpcserver.Dial(userSvcAddr, grpcserver.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Printf("Cảnh báo: Không thể kết nối tới user-service: %v", err)
+	} else {
+		userClient = pbUser.NewUserServiceClient(userConn)
+		defer userConn.Close()
+	}
+
+	service := service.NewExamService(repo, kafkaProducer, userClient)
 
 	kafkaConsumer, err := events.NewKafkaConsumer(service)
 	if err != nil {
@@ -48,7 +61,14 @@ func main() {
 		defer kafkaConsumer.Close()
 	}
 
-	grpcServer := grpcserver.NewServer()
+	autoSubmitScheduler := scheduler.NewAutoSubmitScheduler(service)
+	autoSubmitScheduler.Start()
+	defer autoSubmitScheduler.Stop()
+
+	grpcServer := grpcserver.NewServer(
+		grpcserver.MaxRecvMsgSize(50*1024*1024),
+		grpcserver.MaxSendMsgSize(50*1024*1024),
+	)
 	grpc.NewGRPCHandler(grpcServer, service)
 
 	sigChan := make(chan os.Signal, 1)
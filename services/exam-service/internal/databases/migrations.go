@@ -0,0 +1,82 @@
+package database
+
+import (
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DataMigrationModel ghi lại các lần chuyển đổi dữ liệu đã chạy để mỗi lần chỉ chạy một lần.
+type DataMigrationModel struct {
+	Name      string `gorm:"primaryKey;size:100"`
+	AppliedAt time.Time
+}
+
+func (DataMigrationModel) TableName() string {
+	return "data_migrations"
+}
+
+type dataMigration struct {
+	name string
+	run  func(tx *gorm.DB) error
+}
+
+var dataMigrations = []dataMigration{
+	{name: "awarded_points_absolute", run: migrateAwardedPointsToAbsolute},
+}
+
+func runDataMigrations(db *gorm.DB) {
+	if err := db.AutoMigrate(&DataMigrationModel{}); err != nil {
+		log.Fatalf("❌ Migration failed: %v", err)
+	}
+	for _, m := range dataMigrations {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			var count int64
+			if err := tx.Model(&DataMigrationModel{}).Where("name = ?", m.name).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+			if err := m.run(tx); err != nil {
+				return err
+			}
+			log.Printf("✅ Đã chuyển đổi dữ liệu %s", m.name)
+			return tx.Create(&DataMigrationModel{Name: m.name, AppliedAt: time.Now().UTC()}).Error
+		})
+		if err != nil {
+			log.Fatalf("❌ Chuyển đổi dữ liệu %s thất bại: %v", m.name, err)
+		}
+	}
+}
+
+// migrateAwardedPointsToAbsolute đổi awarded_points của câu tự luận đã chấm từ tỉ lệ (0-1) sang điểm thực
+// bằng cách nhân với điểm của câu trong đề: ưu tiên điểm trong đề riêng của học sinh (đề sinh động),
+// sau đó đến exam_questions, mặc định 1 điểm. Trước đây chỉ chấm tự luận mới ghi awarded_points và
+// tỉ lệ không bao giờ vượt quá 1, nên chỉ các dòng này được đổi.
+func migrateAwardedPointsToAbsolute(tx *gorm.DB) error {
+	return tx.Exec(`
+UPDATE user_answer_models ua
+SET awarded_points = ua.awarded_points * COALESCE(
+	(SELECT CASE WHEN jsonb_typeof(q) = 'object' THEN NULLIF((q->>'points')::float8, 0) ELSE 1 END
+	 FROM exam_submission_models s
+	 JOIN student_exams se ON se.exam_id = s.exam_id AND se.user_id = s.user_id
+	 CROSS JOIN LATERAL jsonb_array_elements(CASE WHEN jsonb_typeof(se.question_ids) = 'array' THEN se.question_ids ELSE '[]'::jsonb END) q
+	 WHERE s.id = ua.submission_id
+	   AND (CASE WHEN jsonb_typeof(q) = 'object' THEN (q->>'id')::bigint ELSE (q #>> '{}')::bigint END) = ua.question_id
+	 LIMIT 1),
+	(SELECT NULLIF(eq.points, 0)
+	 FROM exam_submission_models s
+	 JOIN exam_questions eq ON eq.exam_id = s.exam_id
+	 WHERE s.id = ua.submission_id AND eq.question_id = ua.question_id
+	 LIMIT 1),
+	1)
+WHERE ua.awarded_points IS NOT NULL
+  AND ua.awarded_points <= 1
+  AND ua.question_id IN (
+	SELECT q.id FROM question_models q
+	JOIN question_type_models t ON t.id = q.type_id
+	WHERE t.type = 'essay'
+  )`).Error
+}
@@ -48,15 +48,48 @@ func Connect() {
 		&domain.ExamQuestionModel{},
 		&domain.ExamSubmissionModel{},
 		&domain.StudentExamModel{},
+		&domain.SubmissionChoiceOrderModel{},
 
 		&domain.UserAnswerModel{},
 		&domain.ExamViolationModel{},
 		&domain.ExamAccessRequestModel{},
 
 		&domain.ExamClass{},
+
+		&domain.QuestionIRTParamModel{},
+		&domain.AdaptiveSessionModel{},
+		&domain.QuestionVersionModel{},
+		&domain.QuestionRegradeModel{},
+		&domain.RegradeModel{},
+		&domain.RegradeScoreChangeModel{},
+		&domain.AppealModel{},
+		&domain.RubricModel{},
+		&domain.RubricCriterionModel{},
+		&domain.RubricLevelModel{},
+		&domain.RubricScoreModel{},
+		&domain.MarkingConfigModel{},
+		&domain.EssayMarkingModel{},
+		&domain.MarkerAssignmentModel{},
+		&domain.EssayMarkModel{},
+		&domain.AccommodationModel{},
+		&domain.ExamAccommodationModel{},
+		&domain.ExamSessionActionModel{},
+		&domain.SuspicionConfigModel{},
+		&domain.SubmissionRiskModel{},
+		&domain.CollusionReportModel{},
+		&domain.CollusionPairModel{},
+		&domain.SimilaritySourceModel{},
+		&domain.SimilarityMatchModel{},
+		&domain.EssayFingerprintModel{},
+		&domain.PracticeAttemptModel{},
+		&domain.ReviewCardModel{},
+		&domain.ReviewLogModel{},
+		&domain.AnswerSheetScanModel{},
+		&domain.ExamTemplateModel{},
 	); err != nil {
 		log.Fatalf("❌ Migration failed: %v", err)
 	}
+	runDataMigrations(db)
 
 	DB = db
 	log.Println("✅ Database connected and migrated")
@@ -79,6 +112,10 @@ func seedExamData(db *gorm.DB) {
 		{Type: "multiple_choice"},
 		{Type: "short_answer"},
 		{Type: "essay"},
+		{Type: "numeric"},
+		{Type: "matching"},
+		{Type: "ordering"},
+		{Type: "cloze"},
 	}
 	for _, t := range types {
 		db.FirstOrCreate(&t, domain.QuestionTypeModel{Type: t.Type})
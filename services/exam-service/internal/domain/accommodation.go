@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// AccommodationTerms là các điều chỉnh dành cho học sinh cần hỗ trợ: nhân thời gian, cộng thêm phút,
+// kéo dài hạn đóng đề và cho thêm lượt thi ngoài MaxAttempts.
+type AccommodationTerms struct {
+	TimeMultiplier         float64 `gorm:"default:1" json:"time_multiplier"`
+	ExtraMinutes           int     `gorm:"default:0" json:"extra_minutes"`
+	WindowExtensionMinutes int     `gorm:"default:0" json:"window_extension_minutes"`
+	ExtraAttempts          int     `gorm:"default:0" json:"extra_attempts"`
+}
+
+// Merge lấy mức hỗ trợ cao nhất của từng điều chỉnh.
+func (t AccommodationTerms) Merge(o AccommodationTerms) AccommodationTerms {
+	return AccommodationTerms{
+		TimeMultiplier:         math.Max(t.multiplier(), o.multiplier()),
+		ExtraMinutes:           max(t.ExtraMinutes, o.ExtraMinutes),
+		WindowExtensionMinutes: max(t.WindowExtensionMinutes, o.WindowExtensionMinutes),
+		ExtraAttempts:          max(t.ExtraAttempts, o.ExtraAttempts),
+	}
+}
+
+func (t AccommodationTerms) multiplier() float64 {
+	if t.TimeMultiplier < 1 {
+		return 1
+	}
+	return t.TimeMultiplier
+}
+
+// Duration là thời gian làm bài thực tế; 0 nghĩa là đề không giới hạn thời gian.
+func (t AccommodationTerms) Duration(exam *ExamModel) time.Duration {
+	if exam.DurationMinutes <= 0 {
+		return 0
+	}
+	base := time.Duration(exam.DurationMinutes) * time.Minute
+	return time.Duration(float64(base)*t.multiplier()) + time.Duration(t.ExtraMinutes)*time.Minute
+}
+
+// EndTime là hạn đóng đề sau khi kéo dài.
+func (t AccommodationTerms) EndTime(exam *ExamModel) *time.Time {
+	if exam.EndTime == nil {
+		return nil
+	}
+	end := exam.EndTime.Add(time.Duration(t.WindowExtensionMinutes) * time.Minute)
+	return &end
+}
+
+// MaxAttempts là số lượt thi tối đa; 0 nghĩa là không giới hạn.
+func (t AccommodationTerms) MaxAttempts(exam *ExamModel) int {
+	if exam.MaxAttempts <= 0 {
+		return 0
+	}
+	return exam.MaxAttempts + t.ExtraAttempts
+}
+
+// Deadline là thời điểm phải nộp bài của một lượt thi bắt đầu lúc startedAt, hoặc nil nếu không giới hạn.
+func (t AccommodationTerms) Deadline(exam *ExamModel, startedAt time.Time) *time.Time {
+	var deadline *time.Time
+	if d := t.Duration(exam); d > 0 {
+		due := startedAt.Add(d)
+		deadline = &due
+	}
+	if end := t.EndTime(exam); end != nil && (deadline == nil || end.Before(*deadline)) {
+		deadline = end
+	}
+	return deadline
+}
+
+// AccommodationModel là hỗ trợ của học sinh áp dụng cho mọi đề (ClassID = 0) hoặc các đề được giao cho một lớp.
+type AccommodationModel struct {
+	Id                 int64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID             int64 `gorm:"not null;uniqueIndex:idx_accommodation_user_class" json:"user_id"`
+	ClassID            int64 `gorm:"not null;default:0;uniqueIndex:idx_accommodation_user_class" json:"class_id"`
+	AccommodationTerms `gorm:"embedded"`
+	Notes              string    `gorm:"type:text" json:"notes"`
+	CreatedBy          int64     `json:"created_by"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+func (AccommodationModel) TableName() string {
+	return "student_accommodations"
+}
+
+// ExamAccommodationModel ghi đè hỗ trợ của học sinh cho riêng một đề.
+type ExamAccommodationModel struct {
+	Id                 int64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	ExamID             int64 `gorm:"not null;uniqueIndex:idx_exam_accommodation_user" json:"exam_id"`
+	UserID             int64 `gorm:"not null;uniqueIndex:idx_exam_accommodation_user" json:"user_id"`
+	AccommodationTerms `gorm:"embedded"`
+	Notes              string    `gorm:"type:text" json:"notes"`
+	CreatedBy          int64     `json:"created_by"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+func (ExamAccommodationModel) TableName() string {
+	return "exam_accommodations"
+}
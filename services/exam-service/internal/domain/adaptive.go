@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// QuestionIRTParamModel lưu tham số IRT (a: độ phân biệt, b: độ khó) đã hiệu chỉnh của câu hỏi.
+type QuestionIRTParamModel struct {
+	QuestionID     int64     `gorm:"primaryKey" json:"question_id"`
+	Discrimination float64   `gorm:"default:1" json:"discrimination"`
+	Difficulty     float64   `gorm:"default:0" json:"difficulty"`
+	ResponseCount  int       `json:"response_count"`
+	CalibratedAt   time.Time `json:"calibrated_at"`
+}
+
+func (QuestionIRTParamModel) TableName() string {
+	return "question_irt_params"
+}
+
+// AdaptiveSessionModel lưu trạng thái thi thích ứng (CAT) của một lượt làm bài.
+// Danh sách câu đã ra được lưu trong StudentExamModel.QuestionIDs như đề động.
+type AdaptiveSessionModel struct {
+	SubmissionID      int64     `gorm:"primaryKey" json:"submission_id"`
+	ExamID            int64     `gorm:"not null;index" json:"exam_id"`
+	UserID            int64     `gorm:"not null;index" json:"user_id"`
+	Theta             float64   `json:"theta"`
+	StandardError     float64   `json:"standard_error"`
+	PendingQuestionID *int64    `json:"pending_question_id"`
+	AnsweredCount     int       `json:"answered_count"`
+	Finished          bool      `json:"finished"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+func (AdaptiveSessionModel) TableName() string {
+	return "adaptive_sessions"
+}
+
+type QuestionResponseStat struct {
+	QuestionID   int64
+	SubmissionID int64
+	IsCorrect    bool
+	Score        float64
+}
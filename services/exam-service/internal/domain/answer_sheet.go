@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	ScanStatusGraded      = "graded"
+	ScanStatusNeedsReview = "needs_review"
+	ScanStatusFailed      = "failed"
+)
+
+// AnswerSheetScanModel là một trang phiếu trả lời đã quét. Phiếu có ô tô không chắc chắn nằm ở trạng thái
+// needs_review và chỉ được chấm (tạo bài làm SubmissionID) sau khi giáo viên duyệt.
+type AnswerSheetScanModel struct {
+	Id           int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	ExamID       int64      `gorm:"not null;index" json:"exam_id"`
+	StudentID    int64      `gorm:"index" json:"student_id"`
+	VersionCode  string     `gorm:"size:10" json:"version_code"`
+	SubmissionID *int64     `gorm:"index" json:"submission_id"`
+	FileName     string     `gorm:"size:255" json:"file_name"`
+	Page         int        `json:"page"`
+	ImageURL     string     `gorm:"size:255" json:"image_url"`
+	Status       string     `gorm:"size:20;index" json:"status"`
+	Confidence   float64    `json:"confidence"`
+	Marks        string     `gorm:"type:jsonb;default:'[]'" json:"marks"`
+	Error        string     `gorm:"type:text" json:"error"`
+	Score        float64    `json:"score"`
+	UploadedBy   int64      `json:"uploaded_by"`
+	ReviewedBy   int64      `json:"reviewed_by"`
+	ReviewedAt   *time.Time `json:"reviewed_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func (AnswerSheetScanModel) TableName() string {
+	return "answer_sheet_scans"
+}
+
+// ScanMark là kết quả đọc một câu trên phiếu: các nhãn đã tô (A, B, ...) và tỉ lệ tô của từng ô.
+type ScanMark struct {
+	QuestionID  int64     `json:"question_id"`
+	Number      int       `json:"number"`
+	Marked      []string  `json:"marked"`
+	FillRatios  []float64 `json:"fill_ratios"`
+	Confidence  float64   `json:"confidence"`
+	NeedsReview bool      `json:"needs_review"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+func ParseScanMarks(raw string) []ScanMark {
+	var marks []ScanMark
+	if raw == "" {
+		return marks
+	}
+	_ = json.Unmarshal([]byte(raw), &marks)
+	return marks
+}
+
+func FormatScanMarks(marks []ScanMark) string {
+	if len(marks) == 0 {
+		return "[]"
+	}
+	data, _ := json.Marshal(marks)
+	return string(data)
+}
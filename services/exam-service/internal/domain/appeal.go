@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+const (
+	AppealStatusOpen     = "open"
+	AppealStatusAccepted = "accepted"
+	AppealStatusRejected = "rejected"
+)
+
+// AppealModel là đơn phúc khảo của học sinh cho một câu hỏi trong bài nộp.
+type AppealModel struct {
+	Id            int64         `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubmissionID  int64         `gorm:"not null;uniqueIndex:idx_appeal_submission_question" json:"submission_id"`
+	QuestionID    int64         `gorm:"not null;uniqueIndex:idx_appeal_submission_question" json:"question_id"`
+	ExamID        int64         `gorm:"not null;index" json:"exam_id"`
+	Exam          *ExamModel    `gorm:"foreignKey:ExamID" json:"exam"`
+	Question      QuestionModel `gorm:"foreignKey:QuestionID" json:"question"`
+	UserID        int64         `gorm:"not null;index" json:"user_id"`
+	Reason        string        `gorm:"type:text;not null" json:"reason"`
+	Status        string        `gorm:"size:20;default:'open';index" json:"status"`
+	Response      string        `gorm:"type:text" json:"response"`
+	AwardedPoints *float64      `json:"awarded_points"`
+	OldScore      float64       `json:"old_score"`
+	NewScore      *float64      `json:"new_score"`
+	ResolvedBy    int64         `gorm:"default:0" json:"resolved_by"`
+	ResolvedAt    *time.Time    `json:"resolved_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+func (AppealModel) TableName() string {
+	return "score_appeals"
+}
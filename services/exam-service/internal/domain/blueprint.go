@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	BlueprintSeverityError   = "error"
+	BlueprintSeverityWarning = "warning"
+)
+
+// BlueprintRule là một quy tắc của đề sinh động: rút Count câu độ khó Difficulty từ chương SectionID
+// (0 = toàn bộ chủ đề của đề), mỗi câu Points điểm (0 = 1 điểm).
+type BlueprintRule struct {
+	SectionID  int64   `json:"section_id"`
+	Count      int     `json:"count"`
+	Difficulty string  `json:"difficulty"`
+	Points     float64 `json:"points"`
+}
+
+// Blueprint là cấu hình sinh đề lưu ở ExamModel.DynamicConfig.
+type Blueprint []BlueprintRule
+
+// AnyDifficulty cho biết quy tắc lấy câu ở mọi độ khó.
+func (r BlueprintRule) AnyDifficulty() bool {
+	return r.Difficulty == "" || r.Difficulty == "all"
+}
+
+// PointsPerQuestion trả về điểm mỗi câu của quy tắc, mặc định 1 điểm.
+func (r BlueprintRule) PointsPerQuestion() float64 {
+	if r.Points <= 0 {
+		return 1
+	}
+	return r.Points
+}
+
+// QuestionCount là tổng số câu các quy tắc yêu cầu.
+func (b Blueprint) QuestionCount() int {
+	total := 0
+	for _, r := range b {
+		total += r.Count
+	}
+	return total
+}
+
+// ParseBlueprint đọc cấu hình sinh đề. Chuỗi rỗng và "{}" (giá trị mặc định cũ) là cấu hình rỗng;
+// sai kiểu dữ liệu trả về lỗi thay vì bị bỏ qua.
+func ParseBlueprint(raw string) (Blueprint, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "{}" || raw == "null" {
+		return Blueprint{}, nil
+	}
+	var b Blueprint
+	if err := json.Unmarshal([]byte(raw), &b); err != nil {
+		return nil, fmt.Errorf("cấu hình sinh đề không hợp lệ: %v", err)
+	}
+	return b, nil
+}
+
+func FormatBlueprint(b Blueprint) string {
+	if len(b) == 0 {
+		return "{}"
+	}
+	data, _ := json.Marshal(b)
+	return string(data)
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// CollusionReportModel là một lần phân tích thông đồng trên các bài đã nộp của đề.
+type CollusionReportModel struct {
+	Id                  int64                `gorm:"primaryKey;autoIncrement" json:"id"`
+	ExamID              int64                `gorm:"not null;index" json:"exam_id"`
+	RequestedBy         int64                `json:"requested_by"`
+	StudentCount        int                  `json:"student_count"`
+	PairCount           int                  `json:"pair_count"`
+	MinIdenticalWrong   int                  `json:"min_identical_wrong"`
+	ZThreshold          float64              `json:"z_threshold"`
+	TimingWindowSeconds int                  `json:"timing_window_seconds"`
+	CreatedAt           time.Time            `json:"created_at"`
+	Pairs               []CollusionPairModel `gorm:"foreignKey:ReportID" json:"pairs"`
+}
+
+func (CollusionReportModel) TableName() string {
+	return "collusion_reports"
+}
+
+// CollusionPairModel là chỉ số tương đồng của một cặp bài làm. ExpectedIdenticalWrong là số câu sai giống nhau
+// kỳ vọng nếu hai học sinh làm độc lập; ZScore đo mức vượt kỳ vọng. Evidence là JSON danh sách câu làm bằng chứng.
+type CollusionPairModel struct {
+	Id                     int64   `gorm:"primaryKey;autoIncrement" json:"id"`
+	ReportID               int64   `gorm:"not null;index" json:"report_id"`
+	Rank                   int     `json:"rank"`
+	SubmissionA            int64   `json:"submission_a"`
+	SubmissionB            int64   `json:"submission_b"`
+	UserA                  int64   `json:"user_a"`
+	UserB                  int64   `json:"user_b"`
+	SharedQuestions        int     `json:"shared_questions"`
+	IdenticalAnswers       int     `json:"identical_answers"`
+	IdenticalWrong         int     `json:"identical_wrong"`
+	ExpectedIdenticalWrong float64 `json:"expected_identical_wrong"`
+	ZScore                 float64 `json:"z_score"`
+	TimingMatches          int     `json:"timing_matches"`
+	SubmitSecondsApart     int     `json:"submit_seconds_apart"`
+	Flagged                bool    `gorm:"default:false" json:"flagged"`
+	Evidence               string  `gorm:"type:jsonb;default:'[]'" json:"evidence"`
+}
+
+func (CollusionPairModel) TableName() string {
+	return "collusion_pairs"
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
@@ -49,7 +50,12 @@ type ChoiceModel struct {
 	Content       string
 	IsCorrect     bool
 	AttachmentURL string `gorm:"size:255" json:"attachment_url"`
-	CreatedAt     time.Time
+	MatchTarget   string `gorm:"size:255" json:"match_target"`
+	Position      int    `gorm:"default:0" json:"position"`
+	// PinLast giữ lựa chọn ở cuối khi xáo (ví dụ "Tất cả các đáp án trên").
+	PinLast   bool `gorm:"default:false" json:"pin_last"`
+	CreatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 type QuestionModel struct {
@@ -69,7 +75,11 @@ type QuestionModel struct {
 	DeletedAt     gorm.DeletedAt          `gorm:"index" json:"-"`
 	Choices       []ChoiceModel           `gorm:"foreignKey:QuestionID" json:"choices"`
 	AttachmentURL string                  `gorm:"size:255" json:"attachment_url"`
+	AnswerConfig  string                  `gorm:"type:jsonb;default:'{}'" json:"answer_config"`
+	Version       int                     `gorm:"not null;default:1" json:"version"`
+	RubricID      *int64                  `gorm:"index" json:"rubric_id"`
 	Points        float64                 `gorm:"-" json:"points"`
+	PinnedVersion int                     `gorm:"-" json:"pinned_version"`
 }
 
 type QuestionListItem struct {
@@ -97,12 +107,23 @@ type ExamModel struct {
 	Password        string     `gorm:"size:50" json:"password"`
 
 	ShuffleQuestions      bool `json:"shuffle_questions"`
+	ShuffleChoices        bool `json:"shuffle_choices"`
 	ShowResultImmediately bool `json:"show_result_immediately"`
 	RequiresApproval      bool `json:"requires_approval"`
 
 	IsDynamic     bool   `json:"is_dynamic"`
 	DynamicConfig string `gorm:"type:jsonb" json:"dynamic_config"`
 
+	ScoringPolicy   string  `gorm:"size:30;default:'all_or_nothing'" json:"scoring_policy"`
+	NegativeMarking float64 `gorm:"default:0" json:"negative_marking"`
+	ScoreScale      string  `gorm:"size:20;default:'10'" json:"score_scale"`
+
+	IsAdaptive     bool   `json:"is_adaptive"`
+	AdaptiveConfig string `gorm:"type:jsonb;default:'{}'" json:"adaptive_config"`
+
+	// IsPractice bật chế độ luyện tập: chấm ngay từng câu, không giới hạn số lượt và không tính vào bảng điểm.
+	IsPractice bool `gorm:"default:false" json:"is_practice"`
+
 	TopicID   int64            `gorm:"not null;index" json:"topic_id"`
 	Topic     *TopicModel      `gorm:"foreignKey:TopicID" json:"topic"`
 	CreatorID int64            `gorm:"not null;index" json:"creator_id"`
@@ -114,10 +135,11 @@ type ExamModel struct {
 }
 
 type ExamQuestionModel struct {
-	ExamID     int64 `gorm:"primaryKey"`
-	QuestionID int64 `gorm:"primaryKey"`
-	Sequence   int     `gorm:"default:0"`
-	Points     float64 `gorm:"default:1.0"`
+	ExamID          int64   `gorm:"primaryKey"`
+	QuestionID      int64   `gorm:"primaryKey"`
+	Sequence        int     `gorm:"default:0"`
+	Points          float64 `gorm:"default:1.0"`
+	QuestionVersion int     `gorm:"default:0"`
 }
 
 func (ExamQuestionModel) TableName() string {
@@ -135,6 +157,38 @@ func (StudentExamModel) TableName() string {
 	return "student_exams"
 }
 
+// SubmissionChoiceOrderModel lưu thứ tự lựa chọn đã xáo của một lượt làm bài (question_id -> danh sách choice_id),
+// để tải lại trang, xem lại bài và xuất kết quả đều thấy đúng nhãn A/B/C/D học sinh đã thấy.
+type SubmissionChoiceOrderModel struct {
+	SubmissionID int64     `gorm:"primaryKey"`
+	ExamID       int64     `gorm:"not null;index"`
+	UserID       int64     `gorm:"not null;index"`
+	Seed         int64     `gorm:"not null"`
+	Orders       string    `gorm:"type:jsonb;default:'{}'"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (SubmissionChoiceOrderModel) TableName() string {
+	return "submission_choice_orders"
+}
+
+func ParseChoiceOrders(raw string) map[int64][]int64 {
+	orders := make(map[int64][]int64)
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &orders)
+	}
+	return orders
+}
+
+func FormatChoiceOrders(orders map[int64][]int64) string {
+	if len(orders) == 0 {
+		return "{}"
+	}
+	b, _ := json.Marshal(orders)
+	return string(b)
+}
+
 type ExamAccessRequestModel struct {
 	Id          int64     `gorm:"primaryKey;autoIncrement"`
 	ExamID      int64     `gorm:"not null;index"`
@@ -155,7 +209,18 @@ type ExamSubmissionModel struct {
 	Score       float64
 	StartedAt   time.Time
 	SubmittedAt *time.Time `gorm:"index"`
-	UserAnswers []UserAnswerModel `gorm:"foreignKey:SubmissionID"`
+	// Deadline là hạn nộp của lượt thi đã tính cả hỗ trợ dành riêng cho học sinh và điều chỉnh của giám thị.
+	Deadline *time.Time `gorm:"index"`
+	// PausedAt khác nil khi giám thị đang tạm dừng lượt thi; thời gian còn lại được giữ nguyên cho tới khi tiếp tục.
+	PausedAt *time.Time
+	// TimeAdjustmentSeconds là thời gian giám thị cộng thêm, gồm cả khoảng thời gian bị tạm dừng.
+	TimeAdjustmentSeconds int `gorm:"default:0"`
+	// LastAnswerSeq là số thứ tự lớn nhất của lần lưu đáp án đã được xác nhận qua WebSocket.
+	LastAnswerSeq    int64             `gorm:"default:0"`
+	UserAnswers      []UserAnswerModel `gorm:"foreignKey:SubmissionID"`
+	QuestionVersions string            `gorm:"type:jsonb;default:'{}'"`
+	// PracticeQuestionIDs giới hạn lượt luyện lại vào các câu đã làm sai; rỗng nghĩa là toàn bộ đề.
+	PracticeQuestionIDs string `gorm:"type:jsonb;default:'[]'"`
 }
 
 type SubmissionStatusModel struct {
@@ -168,17 +233,24 @@ type UserAnswerModel struct {
 	QuestionID     int64 `gorm:"not null"`
 	ChosenChoiceID *int64
 	TextAnswer     *string
+	AnswerData     *string       `gorm:"type:jsonb"`
 	Choice         ChoiceModel   `gorm:"foreignKey:ChosenChoiceID"`
 	Question       QuestionModel `gorm:"foreignKey:QuestionID"`
 	IsCorrect      *bool
 	AwardedPoints  *float64
-	CreatedAt      time.Time
+	// PointsOverridden đánh dấu AwardedPoints do giáo viên ấn định (ví dụ khi chấp nhận phúc khảo), được ưu tiên khi chấm lại.
+	PointsOverridden bool    `gorm:"default:false"`
+	Feedback         *string `gorm:"type:text"`
+	// AnsweredAt là thời điểm học sinh lưu đáp án lần cuối qua SaveAnswer.
+	AnsweredAt *time.Time
+	CreatedAt  time.Time
 }
 
 type ExamViolationModel struct {
 	Id            int64  `gorm:"primaryKey;autoIncrement"`
 	ExamID        int64  `gorm:"not null;index"`
 	UserID        int64  `gorm:"not null;index"`
+	SubmissionID  int64  `gorm:"default:0;index"`
 	ViolationType string `gorm:"size:50"`
 	ViolationTime time.Time
 	CreatedAt     time.Time
@@ -261,6 +333,121 @@ type ExamRepository interface {
 	GetSubmissionsByUserID(ctx context.Context, userID int64) ([]*ExamSubmissionModel, error)
 	UpdateUserAnswer(ctx context.Context, tx *gorm.DB, submissionID, questionID int64, updates map[string]interface{}) error
 	GetBestScoresForGradebook(ctx context.Context, examIDs []int64, studentIDs []int64) (map[int64]map[int64]float64, error)
+	GetQuestionsByIDs(ctx context.Context, ids []int64) ([]*QuestionModel, error)
+	UpdateSubmissionScore(ctx context.Context, tx *gorm.DB, submissionID int64, score float64) error
+	LockSubmission(ctx context.Context, tx *gorm.DB, submissionID int64) error
+	GetSubmissionAnswers(ctx context.Context, tx *gorm.DB, submissionID int64) ([]UserAnswerModel, error)
+	GetExpiredSubmissionIDs(ctx context.Context, grace time.Duration, limit int) ([]int64, error)
+	LockInProgressSubmission(ctx context.Context, tx *gorm.DB, submissionID int64) (*ExamSubmissionModel, error)
+	GetExamSubmissionsWithAnswers(ctx context.Context, examID int64) ([]*ExamSubmissionModel, error)
+
+	GetIRTParams(ctx context.Context, questionIDs []int64) (map[int64]*QuestionIRTParamModel, error)
+	UpsertIRTParams(ctx context.Context, params []*QuestionIRTParamModel) error
+	GetQuestionResponseStats(ctx context.Context, questionIDs []int64) ([]*QuestionResponseStat, error)
+	GetAdaptiveSession(ctx context.Context, tx *gorm.DB, submissionID int64) (*AdaptiveSessionModel, error)
+	SaveAdaptiveSession(ctx context.Context, tx *gorm.DB, session *AdaptiveSessionModel) error
+	SaveStudentExamQuestions(ctx context.Context, tx *gorm.DB, examID, userID int64, questionIDs string) error
+	ReplaceUserAnswers(ctx context.Context, tx *gorm.DB, submissionID, questionID int64, answers []*UserAnswerModel) error
+
+	CreateQuestionVersion(ctx context.Context, tx *gorm.DB, version *QuestionVersionModel) error
+	GetQuestionVersion(ctx context.Context, questionID int64, version int) (*QuestionVersionModel, error)
+	GetQuestionVersions(ctx context.Context, questionID int64) ([]*QuestionVersionModel, error)
+	GetQuestionVersionsByKeys(ctx context.Context, versions map[int64]int) ([]*QuestionVersionModel, error)
+	GetCurrentQuestionVersions(ctx context.Context, questionIDs []int64) (map[int64]int, error)
+	PinExamQuestionVersions(ctx context.Context, tx *gorm.DB, examID int64) error
+	UpdateSubmissionQuestionVersions(ctx context.Context, tx *gorm.DB, submissionID int64, versions string) error
+
+	GetQuestionRegrades(ctx context.Context, examID int64) ([]*QuestionRegradeModel, error)
+	SaveQuestionRegrade(ctx context.Context, tx *gorm.DB, regrade *QuestionRegradeModel) error
+	DeleteQuestionRegrade(ctx context.Context, tx *gorm.DB, examID, questionID int64) error
+	CreateRegrade(ctx context.Context, tx *gorm.DB, regrade *RegradeModel) error
+	CreateRegradeScoreChanges(ctx context.Context, tx *gorm.DB, changes []*RegradeScoreChangeModel) error
+	GetRegrades(ctx context.Context, examID int64) ([]*RegradeModel, error)
+	GetChoiceContents(ctx context.Context, questionID int64, choiceIDs []int64) ([]string, error)
+
+	CreateAppeal(ctx context.Context, appeal *AppealModel) error
+	GetAppealByID(ctx context.Context, tx *gorm.DB, id int64) (*AppealModel, error)
+	GetAppealQueue(ctx context.Context, instructorID, examID int64, status string, page, limit int) ([]*AppealModel, int64, error)
+	GetAppealsByUser(ctx context.Context, userID, submissionID int64) ([]*AppealModel, error)
+	UpdateAppeal(ctx context.Context, tx *gorm.DB, id int64, updates map[string]interface{}) error
+	OverrideAnswerPoints(ctx context.Context, tx *gorm.DB, submissionID, questionID int64, points float64, isCorrect bool) error
+
+	CreateRubric(ctx context.Context, rubric *RubricModel) error
+	GetRubricByID(ctx context.Context, id int64) (*RubricModel, error)
+	GetRubricsByCreator(ctx context.Context, creatorID int64) ([]*RubricModel, error)
+	ReplaceRubric(ctx context.Context, tx *gorm.DB, rubric *RubricModel) error
+	DeleteRubric(ctx context.Context, tx *gorm.DB, id int64) error
+	SetQuestionRubric(ctx context.Context, questionID int64, rubricID *int64) error
+	SaveRubricScores(ctx context.Context, tx *gorm.DB, submissionID, questionID int64, scores []*RubricScoreModel) error
+	GetRubricScores(ctx context.Context, submissionID int64) ([]*RubricScoreModel, error)
+
+	GetMarkingConfig(ctx context.Context, examID int64) (*MarkingConfigModel, error)
+	SaveMarkingConfig(ctx context.Context, cfg *MarkingConfigModel) error
+	GetEssayMarkings(ctx context.Context, examID int64) ([]*EssayMarkingModel, error)
+	GetEssayMarking(ctx context.Context, tx *gorm.DB, submissionID int64) (*EssayMarkingModel, error)
+	CreateEssayMarking(ctx context.Context, tx *gorm.DB, marking *EssayMarkingModel) error
+	UpdateEssayMarking(ctx context.Context, tx *gorm.DB, submissionID int64, updates map[string]interface{}) error
+	CreateMarkerAssignment(ctx context.Context, tx *gorm.DB, assignment *MarkerAssignmentModel) error
+	GetMarkerAssignmentByID(ctx context.Context, tx *gorm.DB, id int64) (*MarkerAssignmentModel, error)
+	GetMarkerAssignments(ctx context.Context, markerID int64, status string) ([]*MarkerAssignmentModel, error)
+	GetSubmissionAssignments(ctx context.Context, tx *gorm.DB, submissionID int64) ([]*MarkerAssignmentModel, error)
+	CompleteMarkerAssignment(ctx context.Context, tx *gorm.DB, assignmentID int64, marks []*EssayMarkModel) error
+
+	SaveAccommodation(ctx context.Context, acc *AccommodationModel) error
+	GetAccommodationByID(ctx context.Context, id int64) (*AccommodationModel, error)
+	GetAccommodations(ctx context.Context, userID, classID int64) ([]*AccommodationModel, error)
+	DeleteAccommodation(ctx context.Context, id int64) error
+	SaveExamAccommodation(ctx context.Context, acc *ExamAccommodationModel) error
+	GetExamAccommodations(ctx context.Context, examID int64) ([]*ExamAccommodationModel, error)
+	DeleteExamAccommodation(ctx context.Context, examID, userID int64) error
+	GetApplicableAccommodations(ctx context.Context, examID, userID int64) (*ExamAccommodationModel, []*AccommodationModel, error)
+	GetInProgressSubmissionsByUser(ctx context.Context, userID, examID int64) ([]*ExamSubmissionModel, error)
+	UpdateSubmissionDeadline(ctx context.Context, submissionID int64, deadline *time.Time) error
+
+	GetInProgressSubmissionsByExam(ctx context.Context, examID int64) ([]*ExamSubmissionModel, error)
+	UpdateSubmissionTiming(ctx context.Context, tx *gorm.DB, sub *ExamSubmissionModel) error
+	CreateSessionAction(ctx context.Context, action *ExamSessionActionModel) error
+	GetSessionActions(ctx context.Context, examID int64) ([]*ExamSessionActionModel, error)
+	AdvanceAnswerSeq(ctx context.Context, tx *gorm.DB, submissionID, seq int64) (bool, error)
+
+	GetSuspicionConfig(ctx context.Context, examID int64) (*SuspicionConfigModel, error)
+	SaveSuspicionConfig(ctx context.Context, cfg *SuspicionConfigModel) error
+	GetSubmissionViolations(ctx context.Context, sub *ExamSubmissionModel) ([]*ExamViolationModel, error)
+	SaveSubmissionRisk(ctx context.Context, risk *SubmissionRiskModel) error
+	GetSubmissionRisks(ctx context.Context, submissionIDs []int64) (map[int64]*SubmissionRiskModel, error)
+
+	CreateCollusionReport(ctx context.Context, report *CollusionReportModel) error
+	GetLatestCollusionReport(ctx context.Context, examID int64) (*CollusionReportModel, error)
+	GetSimilaritySources(ctx context.Context, examID int64) ([]*SimilaritySourceModel, error)
+	ReplaceSimilaritySources(ctx context.Context, examID int64, sources []*SimilaritySourceModel) error
+	ReplaceSimilarityMatches(ctx context.Context, examID, questionID, submissionID int64, matches []*SimilarityMatchModel) error
+	GetSimilarityMatches(ctx context.Context, examID, questionID, submissionID int64) ([]*SimilarityMatchModel, error)
+	SaveEssayFingerprints(ctx context.Context, fingerprints []*EssayFingerprintModel) error
+	GetEssayFingerprints(ctx context.Context, examID int64, questionIDs []int64) ([]*EssayFingerprintModel, error)
+	CreatePracticeAttempt(ctx context.Context, attempt *PracticeAttemptModel) error
+	CountPracticeAttempts(ctx context.Context, submissionID, questionID int64) (int64, error)
+	GetPracticeAttempts(ctx context.Context, userID, examID int64) ([]*PracticeAttemptModel, error)
+	GetWrongAnswerRecords(ctx context.Context, userID int64, since time.Time) ([]*WrongAnswerRecord, error)
+	GetReviewCardsByUser(ctx context.Context, userID int64) ([]*ReviewCardModel, error)
+	GetExamsByIDs(ctx context.Context, examIDs []int64) ([]*ExamModel, error)
+	GetReviewCardByID(ctx context.Context, id int64) (*ReviewCardModel, error)
+	SaveReviewCard(ctx context.Context, card *ReviewCardModel) error
+	GetDueReviewCards(ctx context.Context, userID, topicID int64, examIDs []int64, now time.Time, limit int) ([]*ReviewCardModel, int64, error)
+	CountReviewCards(ctx context.Context, userID, topicID int64, examIDs []int64) (int64, error)
+	CreateReviewLog(ctx context.Context, log *ReviewLogModel) error
+	CreateAnswerSheetScan(ctx context.Context, scan *AnswerSheetScanModel) error
+	SaveAnswerSheetScan(ctx context.Context, scan *AnswerSheetScanModel) error
+	GetAnswerSheetScanByID(ctx context.Context, id int64) (*AnswerSheetScanModel, error)
+	GetAnswerSheetScans(ctx context.Context, examID int64, status string) ([]*AnswerSheetScanModel, error)
+	GetGradedScanSubmissionID(ctx context.Context, examID, studentID int64) (int64, error)
+	GetSubmissionChoiceOrder(ctx context.Context, submissionID int64) (*SubmissionChoiceOrderModel, error)
+	GetSubmissionChoiceOrders(ctx context.Context, submissionIDs []int64) ([]*SubmissionChoiceOrderModel, error)
+	SaveSubmissionChoiceOrder(ctx context.Context, order *SubmissionChoiceOrderModel) error
+	GetExamClassIDs(ctx context.Context, examID int64) ([]int64, error)
+	CreateExamTemplate(ctx context.Context, tpl *ExamTemplateModel) error
+	GetExamTemplateByID(ctx context.Context, id int64) (*ExamTemplateModel, error)
+	GetExamTemplatesByCreator(ctx context.Context, creatorID int64) ([]*ExamTemplateModel, error)
+	DeleteExamTemplate(ctx context.Context, id int64) error
 }
 
 type EventProducer interface {
@@ -322,7 +509,73 @@ type ExamService interface {
 	GetRecentSubmissions(ctx context.Context, req *pb.GetRecentSubmissionsRequest) (*pb.GetRecentSubmissionsResponse, error)
 	GetExamPreview(ctx context.Context, req *pb.GetExamPreviewRequest) (*pb.GetExamDetailsResponse, error)
 	GeneratePersonalizedExamForStudents(ctx context.Context, examID int64, studentIDs []int64) error
+	AutoSubmitExpiredSubmissions(ctx context.Context) (int, error)
 	GetMySubmissions(ctx context.Context, req *pb.GetMySubmissionsRequest) (*pb.GetMySubmissionsResponse, error)
 	GradeEssay(ctx context.Context, req *pb.GradeEssayRequest) (*pb.GradeEssayResponse, error)
 	GetClassGradebook(ctx context.Context, req *pb.GetClassGradebookRequest) (*pb.GetClassGradebookResponse, error)
+	GetItemAnalysis(ctx context.Context, req *pb.GetItemAnalysisRequest) (*pb.GetItemAnalysisResponse, error)
+	GetNextAdaptiveQuestion(ctx context.Context, req *pb.GetNextAdaptiveQuestionRequest) (*pb.GetNextAdaptiveQuestionResponse, error)
+	ImportQTIPackage(ctx context.Context, req *pb.ImportQTIPackageRequest) (*pb.ImportQTIPackageResponse, error)
+	ExportQTIPackage(ctx context.Context, req *pb.ExportQTIPackageRequest) (*pb.ExportQTIPackageResponse, error)
+
+	GetQuestionHistory(ctx context.Context, req *pb.GetQuestionHistoryRequest) (*pb.GetQuestionHistoryResponse, error)
+	GetQuestionVersion(ctx context.Context, req *pb.GetQuestionVersionRequest) (*pb.GetQuestionVersionResponse, error)
+	DiffQuestionVersions(ctx context.Context, req *pb.DiffQuestionVersionsRequest) (*pb.DiffQuestionVersionsResponse, error)
+
+	RegradeExam(ctx context.Context, req *pb.RegradeExamRequest) (*pb.RegradeExamResponse, error)
+	GetRegradeHistory(ctx context.Context, req *pb.GetRegradeHistoryRequest) (*pb.GetRegradeHistoryResponse, error)
+
+	CreateAppeal(ctx context.Context, req *pb.CreateAppealRequest) (*pb.CreateAppealResponse, error)
+	GetAppealQueue(ctx context.Context, req *pb.GetAppealQueueRequest) (*pb.GetAppealQueueResponse, error)
+	GetMyAppeals(ctx context.Context, req *pb.GetMyAppealsRequest) (*pb.GetMyAppealsResponse, error)
+	ResolveAppeal(ctx context.Context, req *pb.ResolveAppealRequest) (*pb.ResolveAppealResponse, error)
+
+	CreateRubric(ctx context.Context, req *pb.CreateRubricRequest) (*pb.CreateRubricResponse, error)
+	UpdateRubric(ctx context.Context, req *pb.UpdateRubricRequest) (*pb.UpdateRubricResponse, error)
+	GetRubric(ctx context.Context, req *pb.GetRubricRequest) (*pb.GetRubricResponse, error)
+	GetRubrics(ctx context.Context, req *pb.GetRubricsRequest) (*pb.GetRubricsResponse, error)
+	DeleteRubric(ctx context.Context, req *pb.DeleteRubricRequest) (*pb.DeleteRubricResponse, error)
+	SetQuestionRubric(ctx context.Context, req *pb.SetQuestionRubricRequest) (*pb.SetQuestionRubricResponse, error)
+
+	ConfigureMarking(ctx context.Context, req *pb.ConfigureMarkingRequest) (*pb.ConfigureMarkingResponse, error)
+	AssignMarkers(ctx context.Context, req *pb.AssignMarkersRequest) (*pb.AssignMarkersResponse, error)
+	GetMarkingTasks(ctx context.Context, req *pb.GetMarkingTasksRequest) (*pb.GetMarkingTasksResponse, error)
+	GetMarkingTask(ctx context.Context, req *pb.GetMarkingTaskRequest) (*pb.GetMarkingTaskResponse, error)
+	SubmitMarks(ctx context.Context, req *pb.SubmitMarksRequest) (*pb.SubmitMarksResponse, error)
+	GetMarkingOverview(ctx context.Context, req *pb.GetMarkingOverviewRequest) (*pb.GetMarkingOverviewResponse, error)
+
+	SaveAccommodation(ctx context.Context, req *pb.SaveAccommodationRequest) (*pb.SaveAccommodationResponse, error)
+	GetAccommodations(ctx context.Context, req *pb.GetAccommodationsRequest) (*pb.GetAccommodationsResponse, error)
+	DeleteAccommodation(ctx context.Context, req *pb.DeleteAccommodationRequest) (*pb.DeleteAccommodationResponse, error)
+	SaveExamAccommodation(ctx context.Context, req *pb.SaveExamAccommodationRequest) (*pb.SaveExamAccommodationResponse, error)
+	GetExamAccommodations(ctx context.Context, req *pb.GetExamAccommodationsRequest) (*pb.GetExamAccommodationsResponse, error)
+	DeleteExamAccommodation(ctx context.Context, req *pb.DeleteExamAccommodationRequest) (*pb.DeleteExamAccommodationResponse, error)
+
+	ControlExamSession(ctx context.Context, req *pb.ControlExamSessionRequest) (*pb.ControlExamSessionResponse, error)
+	GetExamSessionActions(ctx context.Context, req *pb.GetExamSessionActionsRequest) (*pb.GetExamSessionActionsResponse, error)
+	GetExamSessionState(ctx context.Context, req *pb.GetExamSessionStateRequest) (*pb.GetExamSessionStateResponse, error)
+
+	GetSuspicionConfig(ctx context.Context, req *pb.GetSuspicionConfigRequest) (*pb.GetSuspicionConfigResponse, error)
+	UpdateSuspicionConfig(ctx context.Context, req *pb.UpdateSuspicionConfigRequest) (*pb.UpdateSuspicionConfigResponse, error)
+
+	AnalyzeCollusion(ctx context.Context, req *pb.AnalyzeCollusionRequest) (*pb.AnalyzeCollusionResponse, error)
+	GetCollusionReport(ctx context.Context, req *pb.GetCollusionReportRequest) (*pb.GetCollusionReportResponse, error)
+	SaveSimilaritySources(ctx context.Context, req *pb.SaveSimilaritySourcesRequest) (*pb.SaveSimilaritySourcesResponse, error)
+	GetSimilaritySources(ctx context.Context, req *pb.GetSimilaritySourcesRequest) (*pb.GetSimilaritySourcesResponse, error)
+	CheckEssaySimilarity(ctx context.Context, req *pb.CheckEssaySimilarityRequest) (*pb.CheckEssaySimilarityResponse, error)
+	GetEssaySimilarity(ctx context.Context, req *pb.GetEssaySimilarityRequest) (*pb.GetEssaySimilarityResponse, error)
+	GetPracticeReport(ctx context.Context, req *pb.GetPracticeReportRequest) (*pb.GetPracticeReportResponse, error)
+	GetDueReviews(ctx context.Context, req *pb.GetDueReviewsRequest) (*pb.GetDueReviewsResponse, error)
+	RecordReview(ctx context.Context, req *pb.RecordReviewRequest) (*pb.RecordReviewResponse, error)
+	GeneratePaperExam(ctx context.Context, req *pb.GeneratePaperExamRequest) (*pb.GeneratePaperExamResponse, error)
+	GenerateAnswerSheets(ctx context.Context, req *pb.GenerateAnswerSheetsRequest) (*pb.GenerateAnswerSheetsResponse, error)
+	GradeScannedSheets(ctx context.Context, req *pb.GradeScannedSheetsRequest) (*pb.GradeScannedSheetsResponse, error)
+	GetAnswerSheetScans(ctx context.Context, req *pb.GetAnswerSheetScansRequest) (*pb.GetAnswerSheetScansResponse, error)
+	ResolveAnswerSheetScan(ctx context.Context, req *pb.ResolveAnswerSheetScanRequest) (*pb.ResolveAnswerSheetScanResponse, error)
+	CloneExam(ctx context.Context, req *pb.CloneExamRequest) (*pb.CloneExamResponse, error)
+	CreateExamTemplate(ctx context.Context, req *pb.CreateExamTemplateRequest) (*pb.CreateExamTemplateResponse, error)
+	GetExamTemplates(ctx context.Context, req *pb.GetExamTemplatesRequest) (*pb.GetExamTemplatesResponse, error)
+	DeleteExamTemplate(ctx context.Context, req *pb.DeleteExamTemplateRequest) (*pb.DeleteExamTemplateResponse, error)
+	InstantiateExamTemplate(ctx context.Context, req *pb.InstantiateExamTemplateRequest) (*pb.InstantiateExamTemplateResponse, error)
+	ValidateExamBlueprint(ctx context.Context, req *pb.ValidateExamBlueprintRequest) (*pb.ValidateExamBlueprintResponse, error)
 }
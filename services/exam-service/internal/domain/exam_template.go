@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// ExamTemplateModel là mẫu đề dùng lại qua các kỳ: lưu cấu hình bài thi (Settings, dạng JSON của pb.ExamSettings)
+// và khung đề (Blueprint, danh sách SectionConfig giống DynamicConfig) nhưng không gắn câu hỏi cụ thể.
+type ExamTemplateModel struct {
+	Id           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatorID    int64     `gorm:"not null;index" json:"creator_id"`
+	Name         string    `gorm:"size:255;not null" json:"name"`
+	Description  string    `gorm:"type:text" json:"description"`
+	TopicID      int64     `gorm:"not null" json:"topic_id"`
+	Settings     string    `gorm:"type:jsonb;default:'{}'" json:"settings"`
+	Blueprint    string    `gorm:"type:jsonb;default:'[]'" json:"blueprint"`
+	SourceExamID int64     `gorm:"default:0" json:"source_exam_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (ExamTemplateModel) TableName() string {
+	return "exam_templates"
+}
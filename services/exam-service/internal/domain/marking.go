@@ -0,0 +1,92 @@
+package domain
+
+import "time"
+
+const (
+	MarkerRoleFirst     = "first"
+	MarkerRoleSecond    = "second"
+	MarkerRoleModerator = "moderator"
+
+	MarkerAssignmentPending   = "pending"
+	MarkerAssignmentCompleted = "completed"
+
+	MarkingStatusInProgress = "in_progress"
+	MarkingStatusEscalated  = "escalated"
+	MarkingStatusReconciled = "reconciled"
+
+	DefaultDiscrepancyThreshold = 0.2
+)
+
+// MarkingConfigModel cấu hình chấm hai vòng cho câu tự luận của một đề.
+// DiscrepancyThreshold là độ lệch tối đa giữa hai người chấm, tính theo tỉ lệ điểm của câu hỏi.
+type MarkingConfigModel struct {
+	ExamID               int64     `gorm:"primaryKey" json:"exam_id"`
+	DoubleMarking        bool      `gorm:"default:false" json:"double_marking"`
+	DiscrepancyThreshold float64   `gorm:"default:0.2" json:"discrepancy_threshold"`
+	ModeratorID          int64     `gorm:"default:0" json:"moderator_id"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+func (MarkingConfigModel) TableName() string {
+	return "exam_marking_configs"
+}
+
+// EssayMarkingModel theo dõi quá trình chấm hai vòng của một bài nộp.
+// AnonymousID là mã ẩn danh hiển thị cho người chấm thay cho mã bài nộp và danh tính học sinh.
+type EssayMarkingModel struct {
+	SubmissionID int64                   `gorm:"primaryKey" json:"submission_id"`
+	ExamID       int64                   `gorm:"not null;index" json:"exam_id"`
+	UserID       int64                   `gorm:"not null" json:"user_id"`
+	AnonymousID  string                  `gorm:"size:20;not null;uniqueIndex" json:"anonymous_id"`
+	Status       string                  `gorm:"size:20;default:'in_progress';index" json:"status"`
+	FinalScore   *float64                `json:"final_score"`
+	ReconciledAt *time.Time              `json:"reconciled_at"`
+	CreatedAt    time.Time               `json:"created_at"`
+	Assignments  []MarkerAssignmentModel `gorm:"foreignKey:SubmissionID;references:SubmissionID" json:"assignments"`
+}
+
+func (EssayMarkingModel) TableName() string {
+	return "essay_markings"
+}
+
+type MarkerAssignmentModel struct {
+	Id           int64              `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubmissionID int64              `gorm:"not null;uniqueIndex:idx_marker_assignment_role" json:"submission_id"`
+	Role         string             `gorm:"size:20;not null;uniqueIndex:idx_marker_assignment_role" json:"role"`
+	ExamID       int64              `gorm:"not null;index" json:"exam_id"`
+	Exam         *ExamModel         `gorm:"foreignKey:ExamID" json:"exam"`
+	MarkerID     int64              `gorm:"not null;index" json:"marker_id"`
+	Status       string             `gorm:"size:20;default:'pending'" json:"status"`
+	Marking      *EssayMarkingModel `gorm:"foreignKey:SubmissionID;references:SubmissionID" json:"marking"`
+	Marks        []EssayMarkModel   `gorm:"foreignKey:AssignmentID" json:"marks"`
+	CreatedAt    time.Time          `json:"created_at"`
+	CompletedAt  *time.Time         `json:"completed_at"`
+}
+
+func (MarkerAssignmentModel) TableName() string {
+	return "marker_assignments"
+}
+
+// TotalPoints là tổng điểm người chấm đã cho trên các câu tự luận.
+func (a *MarkerAssignmentModel) TotalPoints() float64 {
+	total := 0.0
+	for _, m := range a.Marks {
+		total += m.Points
+	}
+	return total
+}
+
+type EssayMarkModel struct {
+	Id           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	AssignmentID int64     `gorm:"not null;uniqueIndex:idx_essay_mark_question" json:"assignment_id"`
+	QuestionID   int64     `gorm:"not null;uniqueIndex:idx_essay_mark_question" json:"question_id"`
+	SubmissionID int64     `gorm:"not null;index" json:"submission_id"`
+	Points       float64   `json:"points"`
+	Feedback     string    `gorm:"type:text" json:"feedback"`
+	RubricScores string    `gorm:"type:jsonb;default:'[]'" json:"rubric_scores"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (EssayMarkModel) TableName() string {
+	return "essay_marks"
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PracticeAttemptModel ghi lại mỗi lần học sinh trả lời một câu ở chế độ luyện tập; dùng để lập báo cáo
+// phần kiến thức còn yếu và chọn các câu sai cho lượt luyện lại.
+type PracticeAttemptModel struct {
+	Id           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubmissionID int64     `gorm:"not null;index" json:"submission_id"`
+	ExamID       int64     `gorm:"not null;index:idx_practice_user_exam" json:"exam_id"`
+	UserID       int64     `gorm:"not null;index:idx_practice_user_exam" json:"user_id"`
+	QuestionID   int64     `gorm:"not null" json:"question_id"`
+	IsCorrect    bool      `json:"is_correct"`
+	Earned       float64   `json:"earned"`
+	Points       float64   `json:"points"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (PracticeAttemptModel) TableName() string {
+	return "practice_attempts"
+}
+
+func ParseQuestionIDs(raw string) []int64 {
+	var ids []int64
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &ids)
+	}
+	return ids
+}
+
+func FormatQuestionIDs(ids []int64) string {
+	if len(ids) == 0 {
+		return "[]"
+	}
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return "[]"
+	}
+	return string(b)
+}
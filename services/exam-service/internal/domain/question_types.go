@@ -0,0 +1,74 @@
+package domain
+
+import "encoding/json"
+
+const (
+	QuestionTypeSingleChoice   = "single_choice"
+	QuestionTypeMultipleChoice = "multiple_choice"
+	QuestionTypeShortAnswer    = "short_answer"
+	QuestionTypeEssay          = "essay"
+	QuestionTypeNumeric        = "numeric"
+	QuestionTypeMatching       = "matching"
+	QuestionTypeOrdering       = "ordering"
+	QuestionTypeCloze          = "cloze"
+
+	ToleranceAbsolute = "absolute"
+	ToleranceRelative = "relative"
+)
+
+// NumericAnswer là đáp án của câu hỏi dạng số. Với sai số tương đối, Tolerance là tỷ lệ (0.05 = 5%).
+type NumericAnswer struct {
+	Value         float64  `json:"value"`
+	Tolerance     float64  `json:"tolerance"`
+	ToleranceType string   `json:"tolerance_type"`
+	Unit          string   `json:"unit,omitempty"`
+	AcceptedUnits []string `json:"accepted_units,omitempty"`
+	RequireUnit   bool     `json:"require_unit,omitempty"`
+}
+
+// ClozeBlank là đáp án của chỗ trống thứ i, được đánh dấu {{i}} trong nội dung câu hỏi.
+type ClozeBlank struct {
+	Answers       []string `json:"answers"`
+	CaseSensitive bool     `json:"case_sensitive,omitempty"`
+}
+
+// AnswerConfig lưu trong cột questions.answer_config cho các loại câu không biểu diễn được bằng lựa chọn.
+type AnswerConfig struct {
+	Numeric *NumericAnswer `json:"numeric,omitempty"`
+	Blanks  []ClozeBlank   `json:"blanks,omitempty"`
+}
+
+func ParseAnswerConfig(raw string) AnswerConfig {
+	var cfg AnswerConfig
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &cfg)
+	}
+	return cfg
+}
+
+func (c AnswerConfig) JSON() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// StructuredAnswer lưu trong user_answers.answer_data cho câu ghép cặp, sắp xếp và điền khuyết.
+type StructuredAnswer struct {
+	Order   []int64          `json:"order,omitempty"`
+	Matches map[int64]string `json:"matches,omitempty"`
+	Blanks  []string         `json:"blanks,omitempty"`
+}
+
+func (a StructuredAnswer) IsEmpty() bool {
+	return len(a.Order) == 0 && len(a.Matches) == 0 && len(a.Blanks) == 0
+}
+
+func (a StructuredAnswer) JSON() string {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
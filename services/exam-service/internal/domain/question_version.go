@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// QuestionVersionModel là ảnh chụp bất biến của câu hỏi tại một phiên bản.
+// Phiên bản mới được tạo mỗi khi nội dung, đáp án hoặc lựa chọn thay đổi.
+type QuestionVersionModel struct {
+	Id         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	QuestionID int64     `gorm:"not null;uniqueIndex:idx_question_version" json:"question_id"`
+	Version    int       `gorm:"not null;uniqueIndex:idx_question_version" json:"version"`
+	Snapshot   string    `gorm:"type:jsonb;not null" json:"snapshot"`
+	EditorID   int64     `gorm:"default:0" json:"editor_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (QuestionVersionModel) TableName() string {
+	return "question_versions"
+}
+
+// ChoiceSnapshot giữ nguyên Id của lựa chọn: các dòng choice_models chỉ bị xóa mềm
+// nên câu trả lời cũ vẫn trỏ đúng vào lựa chọn của phiên bản đã chấm.
+type ChoiceSnapshot struct {
+	Id            int64  `json:"id"`
+	Content       string `json:"content"`
+	IsCorrect     bool   `json:"is_correct"`
+	AttachmentURL string `json:"attachment_url,omitempty"`
+	MatchTarget   string `json:"match_target,omitempty"`
+	Position      int    `json:"position,omitempty"`
+	PinLast       bool   `json:"pin_last,omitempty"`
+}
+
+type QuestionSnapshot struct {
+	Content       string           `json:"content"`
+	Explanation   string           `json:"explanation,omitempty"`
+	TypeID        int64            `json:"type_id"`
+	Type          string           `json:"type"`
+	DifficultyID  int64            `json:"difficulty_id"`
+	Difficulty    string           `json:"difficulty"`
+	AttachmentURL string           `json:"attachment_url,omitempty"`
+	AnswerConfig  AnswerConfig     `json:"answer_config"`
+	Choices       []ChoiceSnapshot `json:"choices"`
+}
+
+func NewQuestionSnapshot(q *QuestionModel) QuestionSnapshot {
+	snap := QuestionSnapshot{
+		Content:       q.Content,
+		Explanation:   q.Explanation,
+		TypeID:        q.TypeID,
+		Type:          q.Type.Type,
+		DifficultyID:  q.DifficultyID,
+		Difficulty:    q.Difficulty.Difficulty,
+		AttachmentURL: q.AttachmentURL,
+		AnswerConfig:  ParseAnswerConfig(q.AnswerConfig),
+		Choices:       make([]ChoiceSnapshot, 0, len(q.Choices)),
+	}
+	for _, c := range q.Choices {
+		snap.Choices = append(snap.Choices, ChoiceSnapshot{
+			Id:            c.Id,
+			Content:       c.Content,
+			IsCorrect:     c.IsCorrect,
+			AttachmentURL: c.AttachmentURL,
+			MatchTarget:   c.MatchTarget,
+			Position:      c.Position,
+			PinLast:       c.PinLast,
+		})
+	}
+	sort.SliceStable(snap.Choices, func(i, j int) bool {
+		if snap.Choices[i].Position != snap.Choices[j].Position {
+			return snap.Choices[i].Position < snap.Choices[j].Position
+		}
+		return snap.Choices[i].Id < snap.Choices[j].Id
+	})
+	return snap
+}
+
+func ParseQuestionSnapshot(raw string) (QuestionSnapshot, error) {
+	var snap QuestionSnapshot
+	err := json.Unmarshal([]byte(raw), &snap)
+	return snap, err
+}
+
+func (s QuestionSnapshot) JSON() string {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// SameContent so sánh hai ảnh chụp bỏ qua Id lựa chọn (luôn đổi khi lựa chọn được tạo lại).
+func (s QuestionSnapshot) SameContent(other QuestionSnapshot) bool {
+	return s.withoutChoiceIDs().JSON() == other.withoutChoiceIDs().JSON()
+}
+
+func (s QuestionSnapshot) withoutChoiceIDs() QuestionSnapshot {
+	choices := make([]ChoiceSnapshot, len(s.Choices))
+	for i, c := range s.Choices {
+		c.Id = 0
+		choices[i] = c
+	}
+	s.Choices = choices
+	return s
+}
+
+// Apply ghi đè nội dung của ảnh chụp lên câu hỏi; phân loại (section, topic) và điểm giữ nguyên.
+func (s QuestionSnapshot) Apply(q *QuestionModel) {
+	q.Content = s.Content
+	q.Explanation = s.Explanation
+	q.TypeID = s.TypeID
+	q.Type = QuestionTypeModel{Id: s.TypeID, Type: s.Type}
+	q.DifficultyID = s.DifficultyID
+	q.Difficulty = QuestionDifficultyModel{Id: s.DifficultyID, Difficulty: s.Difficulty}
+	q.AttachmentURL = s.AttachmentURL
+	q.AnswerConfig = s.AnswerConfig.JSON()
+	q.Choices = make([]ChoiceModel, 0, len(s.Choices))
+	for _, c := range s.Choices {
+		q.Choices = append(q.Choices, ChoiceModel{
+			Id:            c.Id,
+			QuestionID:    q.Id,
+			Content:       c.Content,
+			IsCorrect:     c.IsCorrect,
+			AttachmentURL: c.AttachmentURL,
+			MatchTarget:   c.MatchTarget,
+			Position:      c.Position,
+			PinLast:       c.PinLast,
+		})
+	}
+}
+
+// KeepQuestionPins chép phiên bản đã ghim của các câu vẫn còn trong đề sang danh sách exam_questions mới,
+// để sửa đề đã xuất bản không làm bài thi mới nhận nội dung câu hỏi đã chỉnh sửa.
+func KeepQuestionPins(questions, existing []*ExamQuestionModel) {
+	pins := make(map[int64]int, len(existing))
+	for _, eq := range existing {
+		if eq.QuestionVersion > 0 {
+			pins[eq.QuestionID] = eq.QuestionVersion
+		}
+	}
+	for _, q := range questions {
+		if v, ok := pins[q.QuestionID]; ok && q.QuestionVersion == 0 {
+			q.QuestionVersion = v
+		}
+	}
+}
+
+// ParseQuestionVersions đọc cột exam_submission_models.question_versions (question_id -> version).
+func ParseQuestionVersions(raw string) map[int64]int {
+	versions := make(map[int64]int)
+	if raw != "" {
+		_ = json.Unmarshal([]byte(raw), &versions)
+	}
+	return versions
+}
+
+func FormatQuestionVersions(versions map[int64]int) string {
+	if len(versions) == 0 {
+		return "{}"
+	}
+	b, err := json.Marshal(versions)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
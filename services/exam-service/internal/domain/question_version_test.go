@@ -0,0 +1,38 @@
+package domain
+
+import "testing"
+
+func TestKeepQuestionPinsWhenEditingPublishedExam(t *testing.T) {
+	// Đề đã xuất bản: câu 1 ghim phiên bản 2, câu 2 ghim phiên bản 5, câu 3 chưa ghim.
+	existing := []*ExamQuestionModel{
+		{ExamID: 7, QuestionID: 1, QuestionVersion: 2},
+		{ExamID: 7, QuestionID: 2, QuestionVersion: 5},
+		{ExamID: 7, QuestionID: 3},
+	}
+	// Giáo viên sửa đề: đổi thứ tự, đổi điểm, bỏ câu 2 và thêm câu 4.
+	edited := []*ExamQuestionModel{
+		{QuestionID: 3, Sequence: 0, Points: 1},
+		{QuestionID: 1, Sequence: 1, Points: 2},
+		{QuestionID: 4, Sequence: 2, Points: 1},
+	}
+	KeepQuestionPins(edited, existing)
+
+	want := map[int64]int{3: 0, 1: 2, 4: 0}
+	for _, q := range edited {
+		if q.QuestionVersion != want[q.QuestionID] {
+			t.Errorf("câu %d: QuestionVersion = %d, muốn %d", q.QuestionID, q.QuestionVersion, want[q.QuestionID])
+		}
+	}
+	if edited[1].Points != 2 || edited[1].Sequence != 1 {
+		t.Errorf("thông tin mới của câu 1 bị ghi đè: %+v", edited[1])
+	}
+}
+
+func TestKeepQuestionPinsDoesNotOverrideExplicitVersion(t *testing.T) {
+	existing := []*ExamQuestionModel{{QuestionID: 1, QuestionVersion: 2}}
+	edited := []*ExamQuestionModel{{QuestionID: 1, QuestionVersion: 3}}
+	KeepQuestionPins(edited, existing)
+	if edited[0].QuestionVersion != 3 {
+		t.Errorf("QuestionVersion = %d, muốn giữ 3", edited[0].QuestionVersion)
+	}
+}
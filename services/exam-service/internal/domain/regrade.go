@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	RegradePolicyAcceptMultiple = "accept_multiple"
+	RegradePolicyFullCredit     = "full_credit"
+	RegradePolicyDrop           = "drop"
+	RegradePolicyRescore        = "rescore"
+)
+
+// QuestionRegradeModel là điều chỉnh chấm điểm đang có hiệu lực cho một câu hỏi trong đề.
+// ScoringEngine áp dụng điều chỉnh này cho cả bài nộp cũ (khi chấm lại) lẫn bài nộp mới.
+type QuestionRegradeModel struct {
+	ExamID          int64     `gorm:"primaryKey" json:"exam_id"`
+	QuestionID      int64     `gorm:"primaryKey" json:"question_id"`
+	Policy          string    `gorm:"size:30;not null" json:"policy"`
+	AcceptedAnswers string    `gorm:"type:jsonb;default:'[]'" json:"accepted_answers"`
+	RegradeID       int64     `json:"regrade_id"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (QuestionRegradeModel) TableName() string {
+	return "question_regrades"
+}
+
+func (r QuestionRegradeModel) Answers() []string {
+	var answers []string
+	if r.AcceptedAnswers != "" {
+		_ = json.Unmarshal([]byte(r.AcceptedAnswers), &answers)
+	}
+	return answers
+}
+
+// RegradeModel ghi lại một lần chấm lại; QuestionID = 0 nghĩa là chấm lại toàn bộ đề.
+type RegradeModel struct {
+	Id            int64                      `gorm:"primaryKey;autoIncrement" json:"id"`
+	ExamID        int64                      `gorm:"not null;index" json:"exam_id"`
+	QuestionID    int64                      `gorm:"default:0" json:"question_id"`
+	Policy        string                     `gorm:"size:30;not null" json:"policy"`
+	InstructorID  int64                      `gorm:"not null" json:"instructor_id"`
+	Reason        string                     `gorm:"type:text" json:"reason"`
+	AffectedCount int                        `json:"affected_count"`
+	CreatedAt     time.Time                  `json:"created_at"`
+	Changes       []*RegradeScoreChangeModel `gorm:"foreignKey:RegradeID" json:"changes"`
+}
+
+func (RegradeModel) TableName() string {
+	return "exam_regrades"
+}
+
+type RegradeScoreChangeModel struct {
+	Id           int64   `gorm:"primaryKey;autoIncrement" json:"id"`
+	RegradeID    int64   `gorm:"not null;index" json:"regrade_id"`
+	SubmissionID int64   `gorm:"not null;index" json:"submission_id"`
+	UserID       int64   `gorm:"not null" json:"user_id"`
+	OldScore     float64 `json:"old_score"`
+	NewScore     float64 `json:"new_score"`
+}
+
+func (RegradeScoreChangeModel) TableName() string {
+	return "regrade_score_changes"
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	DefaultEaseFactor = 2.5
+	MinEaseFactor     = 1.3
+	// MinPassingRecall là mức nhớ thấp nhất (thang 0-5 của SM-2) được coi là nhớ được.
+	MinPassingRecall = 3
+	MaxRecallRating  = 5
+)
+
+// ReviewCardModel là thẻ ôn tập ngắt quãng của một học sinh cho một câu hỏi, lập lịch theo thuật toán SM-2.
+// LastWrongAt là lần làm sai gần nhất trong bài thi đã được đưa vào thẻ.
+type ReviewCardModel struct {
+	Id             int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID         int64      `gorm:"not null;uniqueIndex:idx_review_card_user_question;index:idx_review_card_due" json:"user_id"`
+	QuestionID     int64      `gorm:"not null;uniqueIndex:idx_review_card_user_question" json:"question_id"`
+	ExamID         int64      `gorm:"index" json:"exam_id"`
+	TopicID        int64      `gorm:"index" json:"topic_id"`
+	EaseFactor     float64    `gorm:"default:2.5" json:"ease_factor"`
+	IntervalDays   int        `gorm:"default:0" json:"interval_days"`
+	Repetitions    int        `gorm:"default:0" json:"repetitions"`
+	Lapses         int        `gorm:"default:0" json:"lapses"`
+	DueAt          time.Time  `gorm:"not null;index:idx_review_card_due" json:"due_at"`
+	LastRating     int        `json:"last_rating"`
+	LastReviewedAt *time.Time `json:"last_reviewed_at"`
+	LastWrongAt    time.Time  `json:"last_wrong_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func (ReviewCardModel) TableName() string {
+	return "review_cards"
+}
+
+// ReviewLogModel lưu lịch sử đánh giá mức nhớ của học sinh.
+type ReviewLogModel struct {
+	Id           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	CardID       int64     `gorm:"not null;index" json:"card_id"`
+	UserID       int64     `gorm:"not null;index" json:"user_id"`
+	QuestionID   int64     `gorm:"not null" json:"question_id"`
+	Rating       int       `json:"rating"`
+	IntervalDays int       `json:"interval_days"`
+	EaseFactor   float64   `json:"ease_factor"`
+	ReviewedAt   time.Time `json:"reviewed_at"`
+}
+
+func (ReviewLogModel) TableName() string {
+	return "review_logs"
+}
+
+// WrongAnswerRecord là một lần học sinh trả lời sai câu hỏi trong bài đã nộp.
+type WrongAnswerRecord struct {
+	QuestionID  int64
+	ExamID      int64
+	SubmittedAt time.Time
+}
+
+func NewReviewCard(userID, questionID int64, wrongAt time.Time) *ReviewCardModel {
+	return &ReviewCardModel{
+		UserID:      userID,
+		QuestionID:  questionID,
+		EaseFactor:  DefaultEaseFactor,
+		DueAt:       wrongAt,
+		LastWrongAt: wrongAt,
+	}
+}
+
+// Review cập nhật lịch ôn theo SM-2 với mức nhớ rating (0-5).
+func (c *ReviewCardModel) Review(rating int, now time.Time) {
+	if rating < MinPassingRecall {
+		if c.Repetitions > 0 {
+			c.Lapses++
+		}
+		c.Repetitions = 0
+		c.IntervalDays = 1
+	} else {
+		switch c.Repetitions {
+		case 0:
+			c.IntervalDays = 1
+		case 1:
+			c.IntervalDays = 6
+		default:
+			c.IntervalDays = int(math.Round(float64(c.IntervalDays) * c.EaseFactor))
+		}
+		c.Repetitions++
+	}
+
+	miss := float64(MaxRecallRating - rating)
+	c.EaseFactor = math.Max(MinEaseFactor, c.EaseFactor+0.1-miss*(0.08+miss*0.02))
+	c.LastRating = rating
+	c.LastReviewedAt = &now
+	c.DueAt = now.AddDate(0, 0, c.IntervalDays)
+}
+
+// Relapse đưa thẻ về trạng thái cần ôn ngay khi học sinh lại làm sai câu hỏi trong một bài thi mới.
+func (c *ReviewCardModel) Relapse(wrongAt time.Time) {
+	if c.Repetitions > 0 {
+		c.Lapses++
+	}
+	c.Repetitions = 0
+	c.IntervalDays = 0
+	c.EaseFactor = math.Max(MinEaseFactor, c.EaseFactor-0.2)
+	c.LastWrongAt = wrongAt
+	if wrongAt.Before(c.DueAt) {
+		c.DueAt = wrongAt
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// RubricModel là thang chấm dùng lại được cho câu tự luận, gồm nhiều tiêu chí.
+type RubricModel struct {
+	Id          int64                  `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatorID   int64                  `gorm:"not null;index" json:"creator_id"`
+	Name        string                 `gorm:"size:255;not null" json:"name"`
+	Description string                 `gorm:"type:text" json:"description"`
+	Criteria    []RubricCriterionModel `gorm:"foreignKey:RubricID;constraint:OnDelete:CASCADE" json:"criteria"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+func (RubricModel) TableName() string {
+	return "rubrics"
+}
+
+// MaxPoints là tổng điểm cao nhất của các tiêu chí.
+func (r *RubricModel) MaxPoints() float64 {
+	total := 0.0
+	for _, c := range r.Criteria {
+		total += c.MaxPoints()
+	}
+	return total
+}
+
+type RubricCriterionModel struct {
+	Id          int64              `gorm:"primaryKey;autoIncrement" json:"id"`
+	RubricID    int64              `gorm:"not null;index" json:"rubric_id"`
+	Name        string             `gorm:"size:255;not null" json:"name"`
+	Description string             `gorm:"type:text" json:"description"`
+	Position    int                `gorm:"default:0" json:"position"`
+	Levels      []RubricLevelModel `gorm:"foreignKey:CriterionID;constraint:OnDelete:CASCADE" json:"levels"`
+}
+
+func (RubricCriterionModel) TableName() string {
+	return "rubric_criteria"
+}
+
+func (c *RubricCriterionModel) MaxPoints() float64 {
+	best := 0.0
+	for _, l := range c.Levels {
+		if l.Points > best {
+			best = l.Points
+		}
+	}
+	return best
+}
+
+type RubricLevelModel struct {
+	Id          int64   `gorm:"primaryKey;autoIncrement" json:"id"`
+	CriterionID int64   `gorm:"not null;index" json:"criterion_id"`
+	Name        string  `gorm:"size:255;not null" json:"name"`
+	Description string  `gorm:"type:text" json:"description"`
+	Points      float64 `gorm:"not null" json:"points"`
+	Position    int     `gorm:"default:0" json:"position"`
+}
+
+func (RubricLevelModel) TableName() string {
+	return "rubric_levels"
+}
+
+// RubricScoreModel lưu mức đã chọn cho từng tiêu chí khi chấm một câu trả lời.
+// Tên tiêu chí, tên mức và điểm được chép lại để kết quả không đổi khi thang chấm bị sửa sau này.
+type RubricScoreModel struct {
+	Id            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubmissionID  int64     `gorm:"not null;index:idx_rubric_score_answer" json:"submission_id"`
+	QuestionID    int64     `gorm:"not null;index:idx_rubric_score_answer" json:"question_id"`
+	RubricID      int64     `gorm:"not null" json:"rubric_id"`
+	CriterionID   int64     `gorm:"not null" json:"criterion_id"`
+	CriterionName string    `gorm:"size:255" json:"criterion_name"`
+	LevelID       int64     `gorm:"not null" json:"level_id"`
+	LevelName     string    `gorm:"size:255" json:"level_name"`
+	Points        float64   `json:"points"`
+	MaxPoints     float64   `json:"max_points"`
+	Comment       string    `gorm:"type:text" json:"comment"`
+	Position      int       `gorm:"default:0" json:"position"`
+	GraderID      int64     `json:"grader_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (RubricScoreModel) TableName() string {
+	return "answer_rubric_scores"
+}
+
+// ParseRubricScores đọc kết quả chấm theo thang chấm lưu dạng JSON (ví dụ trong EssayMarkModel.RubricScores).
+func ParseRubricScores(raw string) []*RubricScoreModel {
+	var scores []*RubricScoreModel
+	if raw == "" {
+		return scores
+	}
+	_ = json.Unmarshal([]byte(raw), &scores)
+	return scores
+}
+
+func FormatRubricScores(scores []*RubricScoreModel) string {
+	if len(scores) == 0 {
+		return "[]"
+	}
+	data, _ := json.Marshal(scores)
+	return string(data)
+}
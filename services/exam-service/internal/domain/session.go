@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+const (
+	SessionActionPause       = "pause"
+	SessionActionResume      = "resume"
+	SessionActionExtend      = "extend"
+	SessionActionForceSubmit = "force_submit"
+	SessionActionBroadcast   = "broadcast"
+)
+
+// ExamSessionActionModel ghi lại các thao tác giám thị thực hiện trong lúc thi.
+// TargetUserID = 0 nghĩa là áp dụng cho tất cả thí sinh đang làm bài.
+type ExamSessionActionModel struct {
+	Id            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ExamID        int64     `gorm:"not null;index" json:"exam_id"`
+	InstructorID  int64     `gorm:"not null" json:"instructor_id"`
+	Action        string    `gorm:"size:20;not null" json:"action"`
+	TargetUserID  int64     `gorm:"default:0" json:"target_user_id"`
+	ExtraMinutes  int       `gorm:"default:0" json:"extra_minutes"`
+	Message       string    `gorm:"type:text" json:"message"`
+	AffectedCount int       `gorm:"default:0" json:"affected_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (ExamSessionActionModel) TableName() string {
+	return "exam_session_actions"
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SimilaritySourceModel là văn bản tham chiếu mà bài tự luận của đề được đối chiếu. Bài giảng bên course-service
+// chỉ lưu đường dẫn tài liệu chứ không lưu văn bản, nên giáo viên dán trực tiếp nội dung bài giảng vào Content.
+// QuestionID = 0 nghĩa là áp dụng cho mọi câu tự luận của đề.
+type SimilaritySourceModel struct {
+	Id         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ExamID     int64     `gorm:"not null;index" json:"exam_id"`
+	QuestionID int64     `gorm:"not null;default:0" json:"question_id"`
+	Title      string    `gorm:"size:255" json:"title"`
+	Content    string    `gorm:"type:text;not null" json:"content"`
+	CreatedBy  int64     `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (SimilaritySourceModel) TableName() string {
+	return "essay_similarity_sources"
+}
+
+// SimilarityMatchModel là một kết quả trùng lặp của bài tự luận SubmissionID với bài khác (MatchedSubmissionID)
+// hoặc với văn bản tham chiếu (SourceID). Similarity là tỉ lệ dấu vân tay của bài được tìm thấy ở phía đối chiếu.
+type SimilarityMatchModel struct {
+	Id                  int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	ExamID              int64     `gorm:"not null;index" json:"exam_id"`
+	QuestionID          int64     `gorm:"not null;index" json:"question_id"`
+	SubmissionID        int64     `gorm:"not null;index" json:"submission_id"`
+	UserID              int64     `json:"user_id"`
+	MatchedSubmissionID int64     `gorm:"index" json:"matched_submission_id"`
+	MatchedUserID       int64     `json:"matched_user_id"`
+	SourceID            int64     `json:"source_id"`
+	SourceTitle         string    `gorm:"size:255" json:"source_title"`
+	Similarity          float64   `json:"similarity"`
+	SharedFingerprints  int       `json:"shared_fingerprints"`
+	Spans               string    `gorm:"type:jsonb;default:'[]'" json:"spans"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+func (SimilarityMatchModel) TableName() string {
+	return "essay_similarity_matches"
+}
+
+// EssayFingerprintModel lưu dấu vân tay (winnowing) câu tự luận của một bài nộp để lần nộp sau chỉ cần
+// so tập dấu vân tay đã lưu thay vì đọc và băm lại toàn bộ bài của đề.
+type EssayFingerprintModel struct {
+	ExamID       int64     `gorm:"not null;index:idx_essay_fingerprint_question" json:"exam_id"`
+	QuestionID   int64     `gorm:"primaryKey;autoIncrement:false;index:idx_essay_fingerprint_question" json:"question_id"`
+	SubmissionID int64     `gorm:"primaryKey;autoIncrement:false" json:"submission_id"`
+	UserID       int64     `json:"user_id"`
+	Hashes       string    `gorm:"type:text" json:"hashes"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (EssayFingerprintModel) TableName() string {
+	return "essay_fingerprints"
+}
+
+// FormatFingerprintHashes ghi các mã băm dưới dạng hex cách nhau bởi dấu cách.
+func FormatFingerprintHashes(hashes []uint64) string {
+	parts := make([]string, len(hashes))
+	for i, h := range hashes {
+		parts[i] = strconv.FormatUint(h, 16)
+	}
+	return strings.Join(parts, " ")
+}
+
+func ParseFingerprintHashes(raw string) map[uint64]bool {
+	fields := strings.Fields(raw)
+	hashes := make(map[uint64]bool, len(fields))
+	for _, f := range fields {
+		if h, err := strconv.ParseUint(f, 16, 64); err == nil {
+			hashes[h] = true
+		}
+	}
+	return hashes
+}
+
+// SimilaritySpan là đoạn trùng nhau, tính theo vị trí ký tự (rune) trong bài làm và trong văn bản đối chiếu.
+type SimilaritySpan struct {
+	Start        int    `json:"start"`
+	End          int    `json:"end"`
+	MatchedStart int    `json:"matched_start"`
+	MatchedEnd   int    `json:"matched_end"`
+	MatchedText  string `json:"matched_text"`
+}
+
+func ParseSimilaritySpans(raw string) []SimilaritySpan {
+	var spans []SimilaritySpan
+	if raw == "" {
+		return spans
+	}
+	_ = json.Unmarshal([]byte(raw), &spans)
+	return spans
+}
+
+func FormatSimilaritySpans(spans []SimilaritySpan) string {
+	if len(spans) == 0 {
+		return "[]"
+	}
+	data, _ := json.Marshal(spans)
+	return string(data)
+}
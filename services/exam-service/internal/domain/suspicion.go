@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+const (
+	ViolationTabSwitch      = "tab_switch"
+	ViolationFullscreenExit = "fullscreen_exit"
+	ViolationCopyPaste      = "copy_paste"
+	ViolationIPChange       = "ip_change"
+	ViolationOther          = "other"
+
+	DefaultSuspicionFlagThreshold = 30
+	DefaultSuspicionBurstWindow   = 30
+)
+
+// DefaultViolationWeights là điểm nghi vấn của mỗi lần vi phạm khi đề chưa cấu hình riêng.
+var DefaultViolationWeights = map[string]float64{
+	ViolationTabSwitch:      5,
+	ViolationFullscreenExit: 8,
+	ViolationCopyPaste:      10,
+	ViolationIPChange:       25,
+	ViolationOther:          3,
+}
+
+// SuspicionConfigModel cấu hình chấm điểm nghi vấn cho một đề. Weights là JSON {loại vi phạm: điểm};
+// các ngưỡng tự khóa / tự nộp bằng 0 nghĩa là tắt.
+type SuspicionConfigModel struct {
+	ExamID              int64     `gorm:"primaryKey" json:"exam_id"`
+	Weights             string    `gorm:"type:jsonb;default:'{}'" json:"weights"`
+	BurstWindowSeconds  int       `gorm:"default:30" json:"burst_window_seconds"`
+	FlagThreshold       float64   `gorm:"default:30" json:"flag_threshold"`
+	LockThreshold       float64   `gorm:"default:0" json:"lock_threshold"`
+	AutoSubmitThreshold float64   `gorm:"default:0" json:"auto_submit_threshold"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+func (SuspicionConfigModel) TableName() string {
+	return "exam_suspicion_configs"
+}
+
+// SubmissionRiskModel lưu điểm nghi vấn (0 - 100) đã tính của một lượt thi cùng các lý do bị gắn cờ.
+type SubmissionRiskModel struct {
+	SubmissionID   int64     `gorm:"primaryKey" json:"submission_id"`
+	ExamID         int64     `gorm:"not null;index" json:"exam_id"`
+	UserID         int64     `gorm:"not null" json:"user_id"`
+	Score          float64   `json:"score"`
+	Flagged        bool      `gorm:"default:false;index" json:"flagged"`
+	Reasons        string    `gorm:"type:jsonb;default:'[]'" json:"reasons"`
+	ViolationCount int       `json:"violation_count"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func (SubmissionRiskModel) TableName() string {
+	return "submission_risks"
+}
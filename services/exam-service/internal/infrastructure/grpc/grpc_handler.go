@@ -194,3 +194,227 @@ func (h *gRPCHandler) GradeEssay(ctx context.Context, req *pb.GradeEssayRequest)
 func (h *gRPCHandler) GetClassGradebook(ctx context.Context, req *pb.GetClassGradebookRequest) (*pb.GetClassGradebookResponse, error) {
 	return h.service.GetClassGradebook(ctx, req)
 }
+
+func (h *gRPCHandler) GetItemAnalysis(ctx context.Context, req *pb.GetItemAnalysisRequest) (*pb.GetItemAnalysisResponse, error) {
+	return h.service.GetItemAnalysis(ctx, req)
+}
+
+func (h *gRPCHandler) GetNextAdaptiveQuestion(ctx context.Context, req *pb.GetNextAdaptiveQuestionRequest) (*pb.GetNextAdaptiveQuestionResponse, error) {
+	return h.service.GetNextAdaptiveQuestion(ctx, req)
+}
+
+func (h *gRPCHandler) ImportQTIPackage(ctx context.Context, req *pb.ImportQTIPackageRequest) (*pb.ImportQTIPackageResponse, error) {
+	return h.service.ImportQTIPackage(ctx, req)
+}
+
+func (h *gRPCHandler) ExportQTIPackage(ctx context.Context, req *pb.ExportQTIPackageRequest) (*pb.ExportQTIPackageResponse, error) {
+	return h.service.ExportQTIPackage(ctx, req)
+}
+
+func (h *gRPCHandler) GetQuestionHistory(ctx context.Context, req *pb.GetQuestionHistoryRequest) (*pb.GetQuestionHistoryResponse, error) {
+	return h.service.GetQuestionHistory(ctx, req)
+}
+
+func (h *gRPCHandler) GetQuestionVersion(ctx context.Context, req *pb.GetQuestionVersionRequest) (*pb.GetQuestionVersionResponse, error) {
+	return h.service.GetQuestionVersion(ctx, req)
+}
+
+func (h *gRPCHandler) DiffQuestionVersions(ctx context.Context, req *pb.DiffQuestionVersionsRequest) (*pb.DiffQuestionVersionsResponse, error) {
+	return h.service.DiffQuestionVersions(ctx, req)
+}
+
+func (h *gRPCHandler) RegradeExam(ctx context.Context, req *pb.RegradeExamRequest) (*pb.RegradeExamResponse, error) {
+	return h.service.RegradeExam(ctx, req)
+}
+
+func (h *gRPCHandler) GetRegradeHistory(ctx context.Context, req *pb.GetRegradeHistoryRequest) (*pb.GetRegradeHistoryResponse, error) {
+	return h.service.GetRegradeHistory(ctx, req)
+}
+
+func (h *gRPCHandler) CreateAppeal(ctx context.Context, req *pb.CreateAppealRequest) (*pb.CreateAppealResponse, error) {
+	return h.service.CreateAppeal(ctx, req)
+}
+
+func (h *gRPCHandler) GetAppealQueue(ctx context.Context, req *pb.GetAppealQueueRequest) (*pb.GetAppealQueueResponse, error) {
+	return h.service.GetAppealQueue(ctx, req)
+}
+
+func (h *gRPCHandler) GetMyAppeals(ctx context.Context, req *pb.GetMyAppealsRequest) (*pb.GetMyAppealsResponse, error) {
+	return h.service.GetMyAppeals(ctx, req)
+}
+
+func (h *gRPCHandler) ResolveAppeal(ctx context.Context, req *pb.ResolveAppealRequest) (*pb.ResolveAppealResponse, error) {
+	return h.service.ResolveAppeal(ctx, req)
+}
+
+func (h *gRPCHandler) CreateRubric(ctx context.Context, req *pb.CreateRubricRequest) (*pb.CreateRubricResponse, error) {
+	return h.service.CreateRubric(ctx, req)
+}
+
+func (h *gRPCHandler) UpdateRubric(ctx context.Context, req *pb.UpdateRubricRequest) (*pb.UpdateRubricResponse, error) {
+	return h.service.UpdateRubric(ctx, req)
+}
+
+func (h *gRPCHandler) GetRubric(ctx context.Context, req *pb.GetRubricRequest) (*pb.GetRubricResponse, error) {
+	return h.service.GetRubric(ctx, req)
+}
+
+func (h *gRPCHandler) GetRubrics(ctx context.Context, req *pb.GetRubricsRequest) (*pb.GetRubricsResponse, error) {
+	return h.service.GetRubrics(ctx, req)
+}
+
+func (h *gRPCHandler) DeleteRubric(ctx context.Context, req *pb.DeleteRubricRequest) (*pb.DeleteRubricResponse, error) {
+	return h.service.DeleteRubric(ctx, req)
+}
+
+func (h *gRPCHandler) SetQuestionRubric(ctx context.Context, req *pb.SetQuestionRubricRequest) (*pb.SetQuestionRubricResponse, error) {
+	return h.service.SetQuestionRubric(ctx, req)
+}
+
+func (h *gRPCHandler) ConfigureMarking(ctx context.Context, req *pb.ConfigureMarkingRequest) (*pb.ConfigureMarkingResponse, error) {
+	return h.service.ConfigureMarking(ctx, req)
+}
+
+func (h *gRPCHandler) AssignMarkers(ctx context.Context, req *pb.AssignMarkersRequest) (*pb.AssignMarkersResponse, error) {
+	return h.service.AssignMarkers(ctx, req)
+}
+
+func (h *gRPCHandler) GetMarkingTasks(ctx context.Context, req *pb.GetMarkingTasksRequest) (*pb.GetMarkingTasksResponse, error) {
+	return h.service.GetMarkingTasks(ctx, req)
+}
+
+func (h *gRPCHandler) GetMarkingTask(ctx context.Context, req *pb.GetMarkingTaskRequest) (*pb.GetMarkingTaskResponse, error) {
+	return h.service.GetMarkingTask(ctx, req)
+}
+
+func (h *gRPCHandler) SubmitMarks(ctx context.Context, req *pb.SubmitMarksRequest) (*pb.SubmitMarksResponse, error) {
+	return h.service.SubmitMarks(ctx, req)
+}
+
+func (h *gRPCHandler) GetMarkingOverview(ctx context.Context, req *pb.GetMarkingOverviewRequest) (*pb.GetMarkingOverviewResponse, error) {
+	return h.service.GetMarkingOverview(ctx, req)
+}
+
+func (h *gRPCHandler) SaveAccommodation(ctx context.Context, req *pb.SaveAccommodationRequest) (*pb.SaveAccommodationResponse, error) {
+	return h.service.SaveAccommodation(ctx, req)
+}
+
+func (h *gRPCHandler) GetAccommodations(ctx context.Context, req *pb.GetAccommodationsRequest) (*pb.GetAccommodationsResponse, error) {
+	return h.service.GetAccommodations(ctx, req)
+}
+
+func (h *gRPCHandler) DeleteAccommodation(ctx context.Context, req *pb.DeleteAccommodationRequest) (*pb.DeleteAccommodationResponse, error) {
+	return h.service.DeleteAccommodation(ctx, req)
+}
+
+func (h *gRPCHandler) SaveExamAccommodation(ctx context.Context, req *pb.SaveExamAccommodationRequest) (*pb.SaveExamAccommodationResponse, error) {
+	return h.service.SaveExamAccommodation(ctx, req)
+}
+
+func (h *gRPCHandler) GetExamAccommodations(ctx context.Context, req *pb.GetExamAccommodationsRequest) (*pb.GetExamAccommodationsResponse, error) {
+	return h.service.GetExamAccommodations(ctx, req)
+}
+
+func (h *gRPCHandler) DeleteExamAccommodation(ctx context.Context, req *pb.DeleteExamAccommodationRequest) (*pb.DeleteExamAccommodationResponse, error) {
+	return h.service.DeleteExamAccommodation(ctx, req)
+}
+
+func (h *gRPCHandler) ControlExamSession(ctx context.Context, req *pb.ControlExamSessionRequest) (*pb.ControlExamSessionResponse, error) {
+	return h.service.ControlExamSession(ctx, req)
+}
+
+func (h *gRPCHandler) GetExamSessionActions(ctx context.Context, req *pb.GetExamSessionActionsRequest) (*pb.GetExamSessionActionsResponse, error) {
+	return h.service.GetExamSessionActions(ctx, req)
+}
+
+func (h *gRPCHandler) GetExamSessionState(ctx context.Context, req *pb.GetExamSessionStateRequest) (*pb.GetExamSessionStateResponse, error) {
+	return h.service.GetExamSessionState(ctx, req)
+}
+
+func (h *gRPCHandler) GetSuspicionConfig(ctx context.Context, req *pb.GetSuspicionConfigRequest) (*pb.GetSuspicionConfigResponse, error) {
+	return h.service.GetSuspicionConfig(ctx, req)
+}
+
+func (h *gRPCHandler) UpdateSuspicionConfig(ctx context.Context, req *pb.UpdateSuspicionConfigRequest) (*pb.UpdateSuspicionConfigResponse, error) {
+	return h.service.UpdateSuspicionConfig(ctx, req)
+}
+
+func (h *gRPCHandler) AnalyzeCollusion(ctx context.Context, req *pb.AnalyzeCollusionRequest) (*pb.AnalyzeCollusionResponse, error) {
+	return h.service.AnalyzeCollusion(ctx, req)
+}
+
+func (h *gRPCHandler) GetCollusionReport(ctx context.Context, req *pb.GetCollusionReportRequest) (*pb.GetCollusionReportResponse, error) {
+	return h.service.GetCollusionReport(ctx, req)
+}
+
+func (h *gRPCHandler) SaveSimilaritySources(ctx context.Context, req *pb.SaveSimilaritySourcesRequest) (*pb.SaveSimilaritySourcesResponse, error) {
+	return h.service.SaveSimilaritySources(ctx, req)
+}
+
+func (h *gRPCHandler) GetSimilaritySources(ctx context.Context, req *pb.GetSimilaritySourcesRequest) (*pb.GetSimilaritySourcesResponse, error) {
+	return h.service.GetSimilaritySources(ctx, req)
+}
+
+func (h *gRPCHandler) CheckEssaySimilarity(ctx context.Context, req *pb.CheckEssaySimilarityRequest) (*pb.CheckEssaySimilarityResponse, error) {
+	return h.service.CheckEssaySimilarity(ctx, req)
+}
+
+func (h *gRPCHandler) GetEssaySimilarity(ctx context.Context, req *pb.GetEssaySimilarityRequest) (*pb.GetEssaySimilarityResponse, error) {
+	return h.service.GetEssaySimilarity(ctx, req)
+}
+
+func (h *gRPCHandler) GetPracticeReport(ctx context.Context, req *pb.GetPracticeReportRequest) (*pb.GetPracticeReportResponse, error) {
+	return h.service.GetPracticeReport(ctx, req)
+}
+
+func (h *gRPCHandler) GetDueReviews(ctx context.Context, req *pb.GetDueReviewsRequest) (*pb.GetDueReviewsResponse, error) {
+	return h.service.GetDueReviews(ctx, req)
+}
+
+func (h *gRPCHandler) RecordReview(ctx context.Context, req *pb.RecordReviewRequest) (*pb.RecordReviewResponse, error) {
+	return h.service.RecordReview(ctx, req)
+}
+
+func (h *gRPCHandler) GeneratePaperExam(ctx context.Context, req *pb.GeneratePaperExamRequest) (*pb.GeneratePaperExamResponse, error) {
+	return h.service.GeneratePaperExam(ctx, req)
+}
+
+func (h *gRPCHandler) GenerateAnswerSheets(ctx context.Context, req *pb.GenerateAnswerSheetsRequest) (*pb.GenerateAnswerSheetsResponse, error) {
+	return h.service.GenerateAnswerSheets(ctx, req)
+}
+
+func (h *gRPCHandler) GradeScannedSheets(ctx context.Context, req *pb.GradeScannedSheetsRequest) (*pb.GradeScannedSheetsResponse, error) {
+	return h.service.GradeScannedSheets(ctx, req)
+}
+
+func (h *gRPCHandler) GetAnswerSheetScans(ctx context.Context, req *pb.GetAnswerSheetScansRequest) (*pb.GetAnswerSheetScansResponse, error) {
+	return h.service.GetAnswerSheetScans(ctx, req)
+}
+
+func (h *gRPCHandler) ResolveAnswerSheetScan(ctx context.Context, req *pb.ResolveAnswerSheetScanRequest) (*pb.ResolveAnswerSheetScanResponse, error) {
+	return h.service.ResolveAnswerSheetScan(ctx, req)
+}
+
+func (h *gRPCHandler) CloneExam(ctx context.Context, req *pb.CloneExamRequest) (*pb.CloneExamResponse, error) {
+	return h.service.CloneExam(ctx, req)
+}
+
+func (h *gRPCHandler) CreateExamTemplate(ctx context.Context, req *pb.CreateExamTemplateRequest) (*pb.CreateExamTemplateResponse, error) {
+	return h.service.CreateExamTemplate(ctx, req)
+}
+
+func (h *gRPCHandler) GetExamTemplates(ctx context.Context, req *pb.GetExamTemplatesRequest) (*pb.GetExamTemplatesResponse, error) {
+	return h.service.GetExamTemplates(ctx, req)
+}
+
+func (h *gRPCHandler) DeleteExamTemplate(ctx context.Context, req *pb.DeleteExamTemplateRequest) (*pb.DeleteExamTemplateResponse, error) {
+	return h.service.DeleteExamTemplate(ctx, req)
+}
+
+func (h *gRPCHandler) InstantiateExamTemplate(ctx context.Context, req *pb.InstantiateExamTemplateRequest) (*pb.InstantiateExamTemplateResponse, error) {
+	return h.service.InstantiateExamTemplate(ctx, req)
+}
+
+func (h *gRPCHandler) ValidateExamBlueprint(ctx context.Context, req *pb.ValidateExamBlueprintRequest) (*pb.ValidateExamBlueprintResponse, error) {
+	return h.service.ValidateExamBlueprint(ctx, req)
+}
@@ -197,14 +197,15 @@ func (r *examRepository) GetExamDetails(ctx context.Context, examID int64) (*dom
 	}
 
 	type ExamQuestionInfo struct {
-		QuestionID int64
-		Sequence   int
-		Points     float64
+		QuestionID      int64
+		Sequence        int
+		Points          float64
+		QuestionVersion int
 	}
 	var infos []ExamQuestionInfo
 	database.DB.WithContext(ctx).
 		Table("exam_questions").
-		Select("question_id, sequence, points").
+		Select("question_id, sequence, points, question_version").
 		Where("exam_id = ?", examID).
 		Scan(&infos)
 
@@ -221,6 +222,7 @@ func (r *examRepository) GetExamDetails(ctx context.Context, examID int64) (*dom
 		for _, q := range exam.Questions {
 			if info, ok := infoMap[q.Id]; ok {
 				q.Points = info.Points
+				q.PinnedVersion = info.QuestionVersion
 			}
 		}
 	}
@@ -375,6 +377,10 @@ func (r *examRepository) SaveUserAnswer(ctx context.Context, tx *gorm.DB, ans *d
 		updates := map[string]interface{}{
 			"chosen_choice_id": ans.ChosenChoiceID,
 			"is_correct":       ans.IsCorrect,
+			"answer_data":      ans.AnswerData,
+		}
+		if ans.AnsweredAt != nil {
+			updates["answered_at"] = ans.AnsweredAt
 		}
 		if ans.TextAnswer != nil {
 			updates["text_answer"] = *ans.TextAnswer
@@ -445,7 +451,7 @@ func (r *examRepository) GetQuestions(ctx context.Context, sectionID int64, topi
             s.topic_id, t.name as topic_name,
             q.attachment_url,
             q.creator_id,
-            (SELECT COUNT(*) FROM choice_models WHERE question_id = q.id) as choice_count
+            (SELECT COUNT(*) FROM choice_models WHERE question_id = q.id AND deleted_at IS NULL) as choice_count
         `).
 		Joins("LEFT JOIN question_type_models qt ON q.type_id = qt.id").
 		Joins("LEFT JOIN question_difficulty_models qd ON q.difficulty_id = qd.id").
@@ -505,6 +511,12 @@ func (r *examRepository) CountUniqueParticipants(ctx context.Context, examID int
 }
 
 func (r *examRepository) ReplaceExamQuestions(ctx context.Context, tx *gorm.DB, examID int64, questions []*domain.ExamQuestionModel) error {
+	var existing []*domain.ExamQuestionModel
+	if err := tx.WithContext(ctx).Where("exam_id = ? AND question_version > 0", examID).Find(&existing).Error; err != nil {
+		return err
+	}
+	domain.KeepQuestionPins(questions, existing)
+
 	if err := tx.WithContext(ctx).Where("exam_id = ?", examID).Delete(&domain.ExamQuestionModel{}).Error; err != nil {
 		return err
 	}
@@ -702,7 +714,7 @@ func (r *examRepository) GetBestScoresForGradebook(ctx context.Context, examIDs
 
 	err := database.DB.WithContext(ctx).Table("exam_submission_models").
 		Select("user_id, exam_id, MAX(score) as best_score").
-		Where("exam_id IN ? AND user_id IN ?", examIDs, studentIDs).
+		Where("exam_id IN ? AND user_id IN ? AND status_id = (SELECT id FROM submission_status_models WHERE status = 'completed')", examIDs, studentIDs).
 		Group("user_id, exam_id").
 		Scan(&results).Error
 
@@ -721,3 +733,1038 @@ func (r *examRepository) GetBestScoresForGradebook(ctx context.Context, examIDs
 	return scoreMap, nil
 }
 
+func (r *examRepository) GetQuestionsByIDs(ctx context.Context, ids []int64) ([]*domain.QuestionModel, error) {
+	var questions []*domain.QuestionModel
+	if len(ids) == 0 {
+		return questions, nil
+	}
+	err := database.DB.WithContext(ctx).
+		Where("id IN ?", ids).
+		Preload("Choices").
+		Preload("Type").
+		Preload("Difficulty").
+		Preload("Section").Preload("Section.Topic").
+		Find(&questions).Error
+	return questions, err
+}
+
+func (r *examRepository) UpdateSubmissionScore(ctx context.Context, tx *gorm.DB, submissionID int64, score float64) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	return db.WithContext(ctx).Model(&domain.ExamSubmissionModel{}).
+		Where("id = ?", submissionID).
+		Update("score", score).Error
+}
+
+// LockSubmission khóa dòng bài nộp (FOR UPDATE) để các lần cập nhật điểm đồng thời được xử lý lần lượt.
+func (r *examRepository) LockSubmission(ctx context.Context, tx *gorm.DB, submissionID int64) error {
+	var sub domain.ExamSubmissionModel
+	return tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&sub, submissionID).Error
+}
+
+func (r *examRepository) GetSubmissionAnswers(ctx context.Context, tx *gorm.DB, submissionID int64) ([]domain.UserAnswerModel, error) {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	var answers []domain.UserAnswerModel
+	err := db.WithContext(ctx).Where("submission_id = ?", submissionID).Order("id ASC").Find(&answers).Error
+	return answers, err
+}
+
+func (r *examRepository) GetExpiredSubmissionIDs(ctx context.Context, grace time.Duration, limit int) ([]int64, error) {
+	var ids []int64
+	graceSeconds := int(grace.Seconds())
+	err := database.DB.WithContext(ctx).
+		Model(&domain.ExamSubmissionModel{}).
+		Joins("JOIN exam_models ON exam_submission_models.exam_id = exam_models.id").
+		Where("exam_submission_models.status_id = (SELECT id FROM submission_status_models WHERE status = 'in_progress')").
+		Where("exam_submission_models.paused_at IS NULL").
+		Where("(exam_submission_models.deadline IS NOT NULL AND exam_submission_models.deadline + make_interval(secs => ?) < NOW()) OR (exam_submission_models.deadline IS NULL AND ((exam_models.duration_minutes > 0 AND exam_submission_models.started_at + make_interval(mins => exam_models.duration_minutes, secs => ?) < NOW()) OR (exam_models.end_time IS NOT NULL AND exam_models.end_time + make_interval(secs => ?) < NOW())))", graceSeconds, graceSeconds, graceSeconds).
+		Order("exam_submission_models.started_at ASC").
+		Limit(limit).
+		Pluck("exam_submission_models.id", &ids).Error
+	return ids, err
+}
+
+func (r *examRepository) LockInProgressSubmission(ctx context.Context, tx *gorm.DB, submissionID int64) (*domain.ExamSubmissionModel, error) {
+	var sub domain.ExamSubmissionModel
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ? AND status_id = (SELECT id FROM submission_status_models WHERE status = 'in_progress')", submissionID).
+		First(&sub).Error
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.WithContext(ctx).Where("submission_id = ?", sub.Id).Find(&sub.UserAnswers).Error; err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+func (r *examRepository) GetExamSubmissionsWithAnswers(ctx context.Context, examID int64) ([]*domain.ExamSubmissionModel, error) {
+	var subs []*domain.ExamSubmissionModel
+	err := database.DB.WithContext(ctx).
+		Preload("UserAnswers").
+		Where("exam_id = ? AND status_id = (SELECT id FROM submission_status_models WHERE status = 'completed')", examID).
+		Order("submitted_at ASC").
+		Find(&subs).Error
+	return subs, err
+}
+
+func (r *examRepository) GetIRTParams(ctx context.Context, questionIDs []int64) (map[int64]*domain.QuestionIRTParamModel, error) {
+	result := make(map[int64]*domain.QuestionIRTParamModel)
+	if len(questionIDs) == 0 {
+		return result, nil
+	}
+	var params []*domain.QuestionIRTParamModel
+	if err := database.DB.WithContext(ctx).Where("question_id IN ?", questionIDs).Find(&params).Error; err != nil {
+		return nil, err
+	}
+	for _, p := range params {
+		result[p.QuestionID] = p
+	}
+	return result, nil
+}
+
+func (r *examRepository) UpsertIRTParams(ctx context.Context, params []*domain.QuestionIRTParamModel) error {
+	if len(params) == 0 {
+		return nil
+	}
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "question_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"discrimination", "difficulty", "response_count", "calibrated_at"}),
+	}).Create(&params).Error
+}
+
+func (r *examRepository) GetQuestionResponseStats(ctx context.Context, questionIDs []int64) ([]*domain.QuestionResponseStat, error) {
+	var stats []*domain.QuestionResponseStat
+	if len(questionIDs) == 0 {
+		return stats, nil
+	}
+	err := database.DB.WithContext(ctx).Table("user_answer_models ua").
+		Select("ua.question_id, ua.submission_id, BOOL_OR(ua.is_correct) AS is_correct, s.score / CASE WHEN e.score_scale IN ('100', 'percentage') THEN 100.0 ELSE 10.0 END AS score").
+		Joins("JOIN exam_submission_models s ON s.id = ua.submission_id").
+		Joins("JOIN exam_models e ON e.id = s.exam_id").
+		Where("ua.question_id IN ? AND ua.is_correct IS NOT NULL AND s.status_id = (SELECT id FROM submission_status_models WHERE status = 'completed')", questionIDs).
+		Group("ua.question_id, ua.submission_id, s.score, e.score_scale").
+		Scan(&stats).Error
+	return stats, err
+}
+
+func (r *examRepository) GetAdaptiveSession(ctx context.Context, tx *gorm.DB, submissionID int64) (*domain.AdaptiveSessionModel, error) {
+	query := database.DB.WithContext(ctx)
+	if tx != nil {
+		query = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var session domain.AdaptiveSessionModel
+	if err := query.Where("submission_id = ?", submissionID).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *examRepository) SaveAdaptiveSession(ctx context.Context, tx *gorm.DB, session *domain.AdaptiveSessionModel) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	return db.WithContext(ctx).Save(session).Error
+}
+
+func (r *examRepository) SaveStudentExamQuestions(ctx context.Context, tx *gorm.DB, examID, userID int64, questionIDs string) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	sExam := &domain.StudentExamModel{
+		ExamID:      examID,
+		UserID:      userID,
+		QuestionIDs: questionIDs,
+		CreatedAt:   time.Now().UTC(),
+	}
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "exam_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"question_ids"}),
+	}).Create(sExam).Error
+}
+
+func (r *examRepository) ReplaceUserAnswers(ctx context.Context, tx *gorm.DB, submissionID, questionID int64, answers []*domain.UserAnswerModel) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	if err := db.WithContext(ctx).Where("submission_id = ? AND question_id = ?", submissionID, questionID).Delete(&domain.UserAnswerModel{}).Error; err != nil {
+		return err
+	}
+	if len(answers) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(&answers).Error
+}
+
+func (r *examRepository) CreateQuestionVersion(ctx context.Context, tx *gorm.DB, version *domain.QuestionVersionModel) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "question_id"}, {Name: "version"}},
+		DoNothing: true,
+	}).Create(version).Error
+}
+
+func (r *examRepository) GetQuestionVersion(ctx context.Context, questionID int64, version int) (*domain.QuestionVersionModel, error) {
+	var v domain.QuestionVersionModel
+	err := database.DB.WithContext(ctx).
+		Where("question_id = ? AND version = ?", questionID, version).
+		First(&v).Error
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (r *examRepository) GetQuestionVersions(ctx context.Context, questionID int64) ([]*domain.QuestionVersionModel, error) {
+	var versions []*domain.QuestionVersionModel
+	err := database.DB.WithContext(ctx).
+		Where("question_id = ?", questionID).
+		Order("version DESC").
+		Find(&versions).Error
+	return versions, err
+}
+
+func (r *examRepository) GetQuestionVersionsByKeys(ctx context.Context, versions map[int64]int) ([]*domain.QuestionVersionModel, error) {
+	var result []*domain.QuestionVersionModel
+	if len(versions) == 0 {
+		return result, nil
+	}
+	keys := make([][]interface{}, 0, len(versions))
+	for qID, v := range versions {
+		keys = append(keys, []interface{}{qID, v})
+	}
+	err := database.DB.WithContext(ctx).
+		Where("(question_id, version) IN ?", keys).
+		Find(&result).Error
+	return result, err
+}
+
+func (r *examRepository) GetCurrentQuestionVersions(ctx context.Context, questionIDs []int64) (map[int64]int, error) {
+	result := make(map[int64]int)
+	if len(questionIDs) == 0 {
+		return result, nil
+	}
+	var rows []struct {
+		Id      int64
+		Version int
+	}
+	err := database.DB.WithContext(ctx).Model(&domain.QuestionModel{}).
+		Unscoped().
+		Select("id, version").
+		Where("id IN ?", questionIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.Id] = row.Version
+	}
+	return result, nil
+}
+
+func (r *examRepository) PinExamQuestionVersions(ctx context.Context, tx *gorm.DB, examID int64) error {
+	return tx.WithContext(ctx).Exec(`UPDATE exam_questions SET question_version = q.version
+		FROM question_models q
+		WHERE exam_questions.question_id = q.id AND exam_questions.exam_id = ? AND exam_questions.question_version = 0`, examID).Error
+}
+
+func (r *examRepository) UpdateSubmissionQuestionVersions(ctx context.Context, tx *gorm.DB, submissionID int64, versions string) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	return db.WithContext(ctx).Model(&domain.ExamSubmissionModel{}).
+		Where("id = ?", submissionID).
+		Update("question_versions", versions).Error
+}
+
+func (r *examRepository) GetQuestionRegrades(ctx context.Context, examID int64) ([]*domain.QuestionRegradeModel, error) {
+	var regrades []*domain.QuestionRegradeModel
+	err := database.DB.WithContext(ctx).Where("exam_id = ?", examID).Find(&regrades).Error
+	return regrades, err
+}
+
+func (r *examRepository) SaveQuestionRegrade(ctx context.Context, tx *gorm.DB, regrade *domain.QuestionRegradeModel) error {
+	return tx.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "exam_id"}, {Name: "question_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"policy", "accepted_answers", "regrade_id", "updated_at"}),
+	}).Create(regrade).Error
+}
+
+func (r *examRepository) DeleteQuestionRegrade(ctx context.Context, tx *gorm.DB, examID, questionID int64) error {
+	return tx.WithContext(ctx).Where("exam_id = ? AND question_id = ?", examID, questionID).Delete(&domain.QuestionRegradeModel{}).Error
+}
+
+func (r *examRepository) CreateRegrade(ctx context.Context, tx *gorm.DB, regrade *domain.RegradeModel) error {
+	return tx.WithContext(ctx).Omit("Changes").Create(regrade).Error
+}
+
+func (r *examRepository) CreateRegradeScoreChanges(ctx context.Context, tx *gorm.DB, changes []*domain.RegradeScoreChangeModel) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return tx.WithContext(ctx).Create(&changes).Error
+}
+
+func (r *examRepository) GetRegrades(ctx context.Context, examID int64) ([]*domain.RegradeModel, error) {
+	var regrades []*domain.RegradeModel
+	err := database.DB.WithContext(ctx).
+		Preload("Changes").
+		Where("exam_id = ?", examID).
+		Order("created_at DESC").
+		Find(&regrades).Error
+	return regrades, err
+}
+
+// GetChoiceContents đọc cả lựa chọn đã bị xóa mềm vì giáo viên có thể chọn đáp án từ phiên bản cũ.
+func (r *examRepository) GetChoiceContents(ctx context.Context, questionID int64, choiceIDs []int64) ([]string, error) {
+	var contents []string
+	if len(choiceIDs) == 0 {
+		return contents, nil
+	}
+	err := database.DB.WithContext(ctx).Unscoped().Model(&domain.ChoiceModel{}).
+		Where("question_id = ? AND id IN ?", questionID, choiceIDs).
+		Pluck("content", &contents).Error
+	return contents, err
+}
+
+func (r *examRepository) CreateAppeal(ctx context.Context, appeal *domain.AppealModel) error {
+	return database.DB.WithContext(ctx).Omit("Exam", "Question").Create(appeal).Error
+}
+
+func (r *examRepository) GetAppealByID(ctx context.Context, tx *gorm.DB, id int64) (*domain.AppealModel, error) {
+	query := database.DB.WithContext(ctx)
+	if tx != nil {
+		query = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var appeal domain.AppealModel
+	if err := query.First(&appeal, id).Error; err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+func (r *examRepository) GetAppealQueue(ctx context.Context, instructorID, examID int64, status string, page, limit int) ([]*domain.AppealModel, int64, error) {
+	var appeals []*domain.AppealModel
+	var total int64
+
+	query := database.DB.WithContext(ctx).Model(&domain.AppealModel{}).
+		Joins("JOIN exam_models ON exam_models.id = score_appeals.exam_id")
+	if instructorID > 0 {
+		query = query.Where("exam_models.creator_id = ?", instructorID)
+	}
+	if examID > 0 {
+		query = query.Where("score_appeals.exam_id = ?", examID)
+	}
+	if status != "" {
+		query = query.Where("score_appeals.status = ?", status)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := query.Preload("Exam").Preload("Question").
+		Order("score_appeals.created_at ASC").
+		Offset(offset).Limit(limit).
+		Find(&appeals).Error
+	return appeals, total, err
+}
+
+func (r *examRepository) GetAppealsByUser(ctx context.Context, userID, submissionID int64) ([]*domain.AppealModel, error) {
+	var appeals []*domain.AppealModel
+	query := database.DB.WithContext(ctx).Where("user_id = ?", userID)
+	if submissionID > 0 {
+		query = query.Where("submission_id = ?", submissionID)
+	}
+	err := query.Preload("Exam").Preload("Question").Order("created_at DESC").Find(&appeals).Error
+	return appeals, err
+}
+
+func (r *examRepository) UpdateAppeal(ctx context.Context, tx *gorm.DB, id int64, updates map[string]interface{}) error {
+	return tx.WithContext(ctx).Model(&domain.AppealModel{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// OverrideAnswerPoints ấn định điểm cho câu hỏi; nếu học sinh bỏ trống thì tạo dòng trả lời mới để giữ điểm.
+func (r *examRepository) OverrideAnswerPoints(ctx context.Context, tx *gorm.DB, submissionID, questionID int64, points float64, isCorrect bool) error {
+	res := tx.WithContext(ctx).Model(&domain.UserAnswerModel{}).
+		Where("submission_id = ? AND question_id = ?", submissionID, questionID).
+		Updates(map[string]interface{}{"awarded_points": points, "is_correct": isCorrect, "points_overridden": true})
+	if res.Error != nil || res.RowsAffected > 0 {
+		return res.Error
+	}
+	return tx.WithContext(ctx).Create(&domain.UserAnswerModel{
+		SubmissionID:     submissionID,
+		QuestionID:       questionID,
+		AwardedPoints:    &points,
+		IsCorrect:        &isCorrect,
+		PointsOverridden: true,
+	}).Error
+}
+
+func (r *examRepository) CreateRubric(ctx context.Context, rubric *domain.RubricModel) error {
+	return database.DB.WithContext(ctx).Create(rubric).Error
+}
+
+func (r *examRepository) GetRubricByID(ctx context.Context, id int64) (*domain.RubricModel, error) {
+	var rubric domain.RubricModel
+	err := database.DB.WithContext(ctx).
+		Preload("Criteria", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC, id ASC") }).
+		Preload("Criteria.Levels", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC, id ASC") }).
+		First(&rubric, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rubric, nil
+}
+
+func (r *examRepository) GetRubricsByCreator(ctx context.Context, creatorID int64) ([]*domain.RubricModel, error) {
+	var rubrics []*domain.RubricModel
+	err := database.DB.WithContext(ctx).Where("creator_id = ?", creatorID).
+		Preload("Criteria", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC, id ASC") }).
+		Preload("Criteria.Levels", func(db *gorm.DB) *gorm.DB { return db.Order("position ASC, id ASC") }).
+		Order("updated_at DESC").
+		Find(&rubrics).Error
+	return rubrics, err
+}
+
+func (r *examRepository) deleteRubricCriteria(ctx context.Context, tx *gorm.DB, rubricID int64) error {
+	criteria := database.DB.Model(&domain.RubricCriterionModel{}).Select("id").Where("rubric_id = ?", rubricID)
+	if err := tx.WithContext(ctx).Where("criterion_id IN (?)", criteria).Delete(&domain.RubricLevelModel{}).Error; err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Where("rubric_id = ?", rubricID).Delete(&domain.RubricCriterionModel{}).Error
+}
+
+// ReplaceRubric cập nhật thông tin thang chấm và thay toàn bộ tiêu chí, mức điểm bằng danh sách mới.
+func (r *examRepository) ReplaceRubric(ctx context.Context, tx *gorm.DB, rubric *domain.RubricModel) error {
+	err := tx.WithContext(ctx).Model(&domain.RubricModel{}).Where("id = ?", rubric.Id).
+		Updates(map[string]interface{}{"name": rubric.Name, "description": rubric.Description, "updated_at": time.Now()}).Error
+	if err != nil {
+		return err
+	}
+	if err := r.deleteRubricCriteria(ctx, tx, rubric.Id); err != nil {
+		return err
+	}
+	for i := range rubric.Criteria {
+		rubric.Criteria[i].RubricID = rubric.Id
+	}
+	if len(rubric.Criteria) == 0 {
+		return nil
+	}
+	return tx.WithContext(ctx).Create(&rubric.Criteria).Error
+}
+
+func (r *examRepository) DeleteRubric(ctx context.Context, tx *gorm.DB, id int64) error {
+	if err := tx.WithContext(ctx).Model(&domain.QuestionModel{}).Where("rubric_id = ?", id).Update("rubric_id", nil).Error; err != nil {
+		return err
+	}
+	if err := r.deleteRubricCriteria(ctx, tx, id); err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Delete(&domain.RubricModel{}, id).Error
+}
+
+func (r *examRepository) SetQuestionRubric(ctx context.Context, questionID int64, rubricID *int64) error {
+	return database.DB.WithContext(ctx).Model(&domain.QuestionModel{}).Where("id = ?", questionID).Update("rubric_id", rubricID).Error
+}
+
+func (r *examRepository) SaveRubricScores(ctx context.Context, tx *gorm.DB, submissionID, questionID int64, scores []*domain.RubricScoreModel) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	if err := db.WithContext(ctx).Where("submission_id = ? AND question_id = ?", submissionID, questionID).Delete(&domain.RubricScoreModel{}).Error; err != nil {
+		return err
+	}
+	if len(scores) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(&scores).Error
+}
+
+func (r *examRepository) GetRubricScores(ctx context.Context, submissionID int64) ([]*domain.RubricScoreModel, error) {
+	var scores []*domain.RubricScoreModel
+	err := database.DB.WithContext(ctx).Where("submission_id = ?", submissionID).
+		Order("question_id ASC, position ASC").
+		Find(&scores).Error
+	return scores, err
+}
+
+func (r *examRepository) GetMarkingConfig(ctx context.Context, examID int64) (*domain.MarkingConfigModel, error) {
+	var configs []*domain.MarkingConfigModel
+	if err := database.DB.WithContext(ctx).Where("exam_id = ?", examID).Limit(1).Find(&configs).Error; err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	return configs[0], nil
+}
+
+func (r *examRepository) SaveMarkingConfig(ctx context.Context, cfg *domain.MarkingConfigModel) error {
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "exam_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"double_marking", "discrepancy_threshold", "moderator_id", "updated_at"}),
+	}).Create(cfg).Error
+}
+
+func (r *examRepository) GetEssayMarkings(ctx context.Context, examID int64) ([]*domain.EssayMarkingModel, error) {
+	var markings []*domain.EssayMarkingModel
+	err := database.DB.WithContext(ctx).Where("exam_id = ?", examID).
+		Preload("Assignments", func(db *gorm.DB) *gorm.DB { return db.Order("id ASC") }).
+		Preload("Assignments.Marks").
+		Order("created_at ASC").
+		Find(&markings).Error
+	return markings, err
+}
+
+func (r *examRepository) GetEssayMarking(ctx context.Context, tx *gorm.DB, submissionID int64) (*domain.EssayMarkingModel, error) {
+	query := database.DB.WithContext(ctx)
+	if tx != nil {
+		query = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var marking domain.EssayMarkingModel
+	if err := query.First(&marking, "submission_id = ?", submissionID).Error; err != nil {
+		return nil, err
+	}
+	return &marking, nil
+}
+
+func (r *examRepository) CreateEssayMarking(ctx context.Context, tx *gorm.DB, marking *domain.EssayMarkingModel) error {
+	return tx.WithContext(ctx).Omit("Assignments").Create(marking).Error
+}
+
+func (r *examRepository) UpdateEssayMarking(ctx context.Context, tx *gorm.DB, submissionID int64, updates map[string]interface{}) error {
+	return tx.WithContext(ctx).Model(&domain.EssayMarkingModel{}).Where("submission_id = ?", submissionID).Updates(updates).Error
+}
+
+func (r *examRepository) CreateMarkerAssignment(ctx context.Context, tx *gorm.DB, assignment *domain.MarkerAssignmentModel) error {
+	return tx.WithContext(ctx).Omit("Exam", "Marking", "Marks").Create(assignment).Error
+}
+
+func (r *examRepository) GetMarkerAssignmentByID(ctx context.Context, tx *gorm.DB, id int64) (*domain.MarkerAssignmentModel, error) {
+	query := database.DB.WithContext(ctx).Preload("Exam").Preload("Marking").Preload("Marks")
+	if tx != nil {
+		query = tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var assignment domain.MarkerAssignmentModel
+	if err := query.First(&assignment, id).Error; err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func (r *examRepository) GetMarkerAssignments(ctx context.Context, markerID int64, status string) ([]*domain.MarkerAssignmentModel, error) {
+	var assignments []*domain.MarkerAssignmentModel
+	query := database.DB.WithContext(ctx).Where("marker_id = ?", markerID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Preload("Exam").Preload("Marking").Order("created_at ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *examRepository) GetSubmissionAssignments(ctx context.Context, tx *gorm.DB, submissionID int64) ([]*domain.MarkerAssignmentModel, error) {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	var assignments []*domain.MarkerAssignmentModel
+	err := db.WithContext(ctx).Where("submission_id = ?", submissionID).Preload("Marks").Order("id ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *examRepository) CompleteMarkerAssignment(ctx context.Context, tx *gorm.DB, assignmentID int64, marks []*domain.EssayMarkModel) error {
+	if len(marks) > 0 {
+		if err := tx.WithContext(ctx).Create(&marks).Error; err != nil {
+			return err
+		}
+	}
+	return tx.WithContext(ctx).Model(&domain.MarkerAssignmentModel{}).Where("id = ?", assignmentID).
+		Updates(map[string]interface{}{"status": domain.MarkerAssignmentCompleted, "completed_at": time.Now().UTC()}).Error
+}
+
+func (r *examRepository) SaveAccommodation(ctx context.Context, acc *domain.AccommodationModel) error {
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "class_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"time_multiplier", "extra_minutes", "window_extension_minutes", "extra_attempts", "notes", "created_by", "updated_at"}),
+	}).Create(acc).Error
+}
+
+func (r *examRepository) GetAccommodationByID(ctx context.Context, id int64) (*domain.AccommodationModel, error) {
+	var acc domain.AccommodationModel
+	if err := database.DB.WithContext(ctx).First(&acc, id).Error; err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func (r *examRepository) GetAccommodations(ctx context.Context, userID, classID int64) ([]*domain.AccommodationModel, error) {
+	var accs []*domain.AccommodationModel
+	query := database.DB.WithContext(ctx)
+	if userID > 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if classID > 0 {
+		query = query.Where("class_id = ?", classID)
+	}
+	err := query.Order("user_id ASC, class_id ASC").Find(&accs).Error
+	return accs, err
+}
+
+func (r *examRepository) DeleteAccommodation(ctx context.Context, id int64) error {
+	return database.DB.WithContext(ctx).Delete(&domain.AccommodationModel{}, id).Error
+}
+
+func (r *examRepository) SaveExamAccommodation(ctx context.Context, acc *domain.ExamAccommodationModel) error {
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "exam_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"time_multiplier", "extra_minutes", "window_extension_minutes", "extra_attempts", "notes", "created_by", "updated_at"}),
+	}).Create(acc).Error
+}
+
+func (r *examRepository) GetExamAccommodations(ctx context.Context, examID int64) ([]*domain.ExamAccommodationModel, error) {
+	var accs []*domain.ExamAccommodationModel
+	err := database.DB.WithContext(ctx).Where("exam_id = ?", examID).Order("user_id ASC").Find(&accs).Error
+	return accs, err
+}
+
+func (r *examRepository) DeleteExamAccommodation(ctx context.Context, examID, userID int64) error {
+	return database.DB.WithContext(ctx).Where("exam_id = ? AND user_id = ?", examID, userID).Delete(&domain.ExamAccommodationModel{}).Error
+}
+
+// GetApplicableAccommodations trả về hỗ trợ ghi đè riêng cho đề (nếu có) cùng các hỗ trợ chung
+// và hỗ trợ theo những lớp được giao đề này.
+func (r *examRepository) GetApplicableAccommodations(ctx context.Context, examID, userID int64) (*domain.ExamAccommodationModel, []*domain.AccommodationModel, error) {
+	var overrides []*domain.ExamAccommodationModel
+	if err := database.DB.WithContext(ctx).Where("exam_id = ? AND user_id = ?", examID, userID).Limit(1).Find(&overrides).Error; err != nil {
+		return nil, nil, err
+	}
+	var override *domain.ExamAccommodationModel
+	if len(overrides) > 0 {
+		override = overrides[0]
+	}
+
+	var accs []*domain.AccommodationModel
+	err := database.DB.WithContext(ctx).
+		Where("user_id = ? AND (class_id = 0 OR class_id IN (SELECT class_id FROM exam_classes WHERE exam_id = ?))", userID, examID).
+		Find(&accs).Error
+	return override, accs, err
+}
+
+func (r *examRepository) GetInProgressSubmissionsByUser(ctx context.Context, userID, examID int64) ([]*domain.ExamSubmissionModel, error) {
+	var subs []*domain.ExamSubmissionModel
+	query := database.DB.WithContext(ctx).
+		Where("user_id = ? AND status_id = (SELECT id FROM submission_status_models WHERE status = 'in_progress')", userID)
+	if examID > 0 {
+		query = query.Where("exam_id = ?", examID)
+	}
+	err := query.Find(&subs).Error
+	return subs, err
+}
+
+func (r *examRepository) UpdateSubmissionDeadline(ctx context.Context, submissionID int64, deadline *time.Time) error {
+	return database.DB.WithContext(ctx).Model(&domain.ExamSubmissionModel{}).Where("id = ?", submissionID).Update("deadline", deadline).Error
+}
+
+func (r *examRepository) GetInProgressSubmissionsByExam(ctx context.Context, examID int64) ([]*domain.ExamSubmissionModel, error) {
+	var subs []*domain.ExamSubmissionModel
+	err := database.DB.WithContext(ctx).
+		Where("exam_id = ? AND status_id = (SELECT id FROM submission_status_models WHERE status = 'in_progress')", examID).
+		Order("started_at ASC").
+		Find(&subs).Error
+	return subs, err
+}
+
+func (r *examRepository) UpdateSubmissionTiming(ctx context.Context, tx *gorm.DB, sub *domain.ExamSubmissionModel) error {
+	db := tx
+	if db == nil {
+		db = database.DB
+	}
+	return db.WithContext(ctx).Model(&domain.ExamSubmissionModel{}).
+		Where("id = ?", sub.Id).
+		Updates(map[string]interface{}{
+			"deadline":                sub.Deadline,
+			"paused_at":               sub.PausedAt,
+			"time_adjustment_seconds": sub.TimeAdjustmentSeconds,
+		}).Error
+}
+
+func (r *examRepository) CreateSessionAction(ctx context.Context, action *domain.ExamSessionActionModel) error {
+	return database.DB.WithContext(ctx).Create(action).Error
+}
+
+func (r *examRepository) GetSessionActions(ctx context.Context, examID int64) ([]*domain.ExamSessionActionModel, error) {
+	var actions []*domain.ExamSessionActionModel
+	err := database.DB.WithContext(ctx).Where("exam_id = ?", examID).Order("created_at DESC").Find(&actions).Error
+	return actions, err
+}
+
+// AdvanceAnswerSeq chỉ tăng số thứ tự khi seq mới lớn hơn giá trị đã lưu; trả về false nếu đây là lần gửi lại.
+func (r *examRepository) AdvanceAnswerSeq(ctx context.Context, tx *gorm.DB, submissionID, seq int64) (bool, error) {
+	res := tx.WithContext(ctx).Model(&domain.ExamSubmissionModel{}).
+		Where("id = ? AND last_answer_seq < ?", submissionID, seq).
+		Update("last_answer_seq", seq)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *examRepository) GetSuspicionConfig(ctx context.Context, examID int64) (*domain.SuspicionConfigModel, error) {
+	var configs []*domain.SuspicionConfigModel
+	if err := database.DB.WithContext(ctx).Where("exam_id = ?", examID).Limit(1).Find(&configs).Error; err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	return configs[0], nil
+}
+
+func (r *examRepository) SaveSuspicionConfig(ctx context.Context, cfg *domain.SuspicionConfigModel) error {
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "exam_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"weights", "burst_window_seconds", "flag_threshold", "lock_threshold", "auto_submit_threshold", "updated_at"}),
+	}).Create(cfg).Error
+}
+
+// GetSubmissionViolations lấy vi phạm của một lượt thi; các bản ghi cũ chưa gắn submission_id được xác định theo khoảng thời gian làm bài.
+func (r *examRepository) GetSubmissionViolations(ctx context.Context, sub *domain.ExamSubmissionModel) ([]*domain.ExamViolationModel, error) {
+	end := time.Now().UTC()
+	if sub.SubmittedAt != nil {
+		end = *sub.SubmittedAt
+	}
+	var vs []*domain.ExamViolationModel
+	err := database.DB.WithContext(ctx).
+		Where("submission_id = ? OR (submission_id = 0 AND exam_id = ? AND user_id = ? AND violation_time BETWEEN ? AND ?)", sub.Id, sub.ExamID, sub.UserID, sub.StartedAt, end).
+		Order("violation_time ASC").
+		Find(&vs).Error
+	return vs, err
+}
+
+func (r *examRepository) SaveSubmissionRisk(ctx context.Context, risk *domain.SubmissionRiskModel) error {
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "submission_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"score", "flagged", "reasons", "violation_count", "updated_at"}),
+	}).Create(risk).Error
+}
+
+func (r *examRepository) GetSubmissionRisks(ctx context.Context, submissionIDs []int64) (map[int64]*domain.SubmissionRiskModel, error) {
+	result := make(map[int64]*domain.SubmissionRiskModel)
+	if len(submissionIDs) == 0 {
+		return result, nil
+	}
+	var risks []*domain.SubmissionRiskModel
+	if err := database.DB.WithContext(ctx).Where("submission_id IN ?", submissionIDs).Find(&risks).Error; err != nil {
+		return nil, err
+	}
+	for _, risk := range risks {
+		result[risk.SubmissionID] = risk
+	}
+	return result, nil
+}
+
+func (r *examRepository) CreateCollusionReport(ctx context.Context, report *domain.CollusionReportModel) error {
+	return database.DB.WithContext(ctx).Create(report).Error
+}
+
+func (r *examRepository) GetLatestCollusionReport(ctx context.Context, examID int64) (*domain.CollusionReportModel, error) {
+	var report domain.CollusionReportModel
+	err := database.DB.WithContext(ctx).
+		Preload("Pairs", func(db *gorm.DB) *gorm.DB { return db.Order("rank ASC") }).
+		Where("exam_id = ?", examID).
+		Order("created_at DESC").
+		First(&report).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (r *examRepository) GetSimilaritySources(ctx context.Context, examID int64) ([]*domain.SimilaritySourceModel, error) {
+	var sources []*domain.SimilaritySourceModel
+	err := database.DB.WithContext(ctx).
+		Where("exam_id = ?", examID).
+		Order("id ASC").
+		Find(&sources).Error
+	return sources, err
+}
+
+func (r *examRepository) ReplaceSimilaritySources(ctx context.Context, examID int64, sources []*domain.SimilaritySourceModel) error {
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("exam_id = ?", examID).Delete(&domain.SimilaritySourceModel{}).Error; err != nil {
+			return err
+		}
+		if len(sources) == 0 {
+			return nil
+		}
+		return tx.Create(&sources).Error
+	})
+}
+
+// ReplaceSimilarityMatches xóa kết quả cũ rồi ghi kết quả mới của một câu hỏi; submissionID > 0 chỉ thay
+// các kết quả liên quan tới bài nộp đó (cả hai chiều).
+func (r *examRepository) ReplaceSimilarityMatches(ctx context.Context, examID, questionID, submissionID int64, matches []*domain.SimilarityMatchModel) error {
+	return database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("exam_id = ? AND question_id = ?", examID, questionID)
+		if submissionID > 0 {
+			query = query.Where("submission_id = ? OR matched_submission_id = ?", submissionID, submissionID)
+		}
+		if err := query.Delete(&domain.SimilarityMatchModel{}).Error; err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&matches, 200).Error
+	})
+}
+
+func (r *examRepository) GetSimilarityMatches(ctx context.Context, examID, questionID, submissionID int64) ([]*domain.SimilarityMatchModel, error) {
+	var matches []*domain.SimilarityMatchModel
+	query := database.DB.WithContext(ctx).Where("exam_id = ?", examID)
+	if questionID > 0 {
+		query = query.Where("question_id = ?", questionID)
+	}
+	if submissionID > 0 {
+		query = query.Where("submission_id = ?", submissionID)
+	}
+	err := query.Order("similarity DESC, id ASC").Find(&matches).Error
+	return matches, err
+}
+
+func (r *examRepository) SaveEssayFingerprints(ctx context.Context, fingerprints []*domain.EssayFingerprintModel) error {
+	if len(fingerprints) == 0 {
+		return nil
+	}
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "question_id"}, {Name: "submission_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"hashes", "user_id"}),
+	}).CreateInBatches(&fingerprints, 200).Error
+}
+
+func (r *examRepository) GetEssayFingerprints(ctx context.Context, examID int64, questionIDs []int64) ([]*domain.EssayFingerprintModel, error) {
+	var fingerprints []*domain.EssayFingerprintModel
+	if len(questionIDs) == 0 {
+		return fingerprints, nil
+	}
+	err := database.DB.WithContext(ctx).
+		Joins("JOIN exam_submission_models s ON s.id = essay_fingerprints.submission_id").
+		Where("essay_fingerprints.exam_id = ? AND essay_fingerprints.question_id IN ?", examID, questionIDs).
+		Where("s.status_id = (SELECT id FROM submission_status_models WHERE status = 'completed')").
+		Find(&fingerprints).Error
+	return fingerprints, err
+}
+
+func (r *examRepository) CreatePracticeAttempt(ctx context.Context, attempt *domain.PracticeAttemptModel) error {
+	return database.DB.WithContext(ctx).Create(attempt).Error
+}
+
+func (r *examRepository) CountPracticeAttempts(ctx context.Context, submissionID, questionID int64) (int64, error) {
+	var count int64
+	err := database.DB.WithContext(ctx).Model(&domain.PracticeAttemptModel{}).
+		Where("submission_id = ? AND question_id = ?", submissionID, questionID).
+		Count(&count).Error
+	return count, err
+}
+
+// GetPracticeAttempts lấy lịch sử luyện tập của học sinh theo thứ tự thời gian; examID = 0 lấy mọi đề.
+func (r *examRepository) GetPracticeAttempts(ctx context.Context, userID, examID int64) ([]*domain.PracticeAttemptModel, error) {
+	var attempts []*domain.PracticeAttemptModel
+	query := database.DB.WithContext(ctx).Where("user_id = ?", userID)
+	if examID > 0 {
+		query = query.Where("exam_id = ?", examID)
+	}
+	err := query.Order("created_at ASC, id ASC").Find(&attempts).Error
+	return attempts, err
+}
+
+// GetWrongAnswerRecords lấy các câu học sinh làm sai trong những bài đã nộp sau thời điểm since.
+func (r *examRepository) GetWrongAnswerRecords(ctx context.Context, userID int64, since time.Time) ([]*domain.WrongAnswerRecord, error) {
+	var records []*domain.WrongAnswerRecord
+	err := database.DB.WithContext(ctx).Table("user_answer_models ua").
+		Select("ua.question_id, s.exam_id, s.submitted_at").
+		Joins("JOIN exam_submission_models s ON s.id = ua.submission_id").
+		Where("s.user_id = ? AND s.submitted_at > ? AND ua.is_correct IS NOT NULL", userID, since).
+		Where("s.status_id = (SELECT id FROM submission_status_models WHERE status = 'completed')").
+		Group("ua.question_id, s.exam_id, s.submitted_at").
+		Having("NOT BOOL_OR(ua.is_correct)").
+		Order("s.submitted_at ASC").
+		Scan(&records).Error
+	return records, err
+}
+
+func (r *examRepository) GetExamsByIDs(ctx context.Context, examIDs []int64) ([]*domain.ExamModel, error) {
+	var exams []*domain.ExamModel
+	if len(examIDs) == 0 {
+		return exams, nil
+	}
+	err := database.DB.WithContext(ctx).Where("id IN ?", examIDs).Find(&exams).Error
+	return exams, err
+}
+
+func (r *examRepository) GetReviewCardsByUser(ctx context.Context, userID int64) ([]*domain.ReviewCardModel, error) {
+	var cards []*domain.ReviewCardModel
+	err := database.DB.WithContext(ctx).Where("user_id = ?", userID).Find(&cards).Error
+	return cards, err
+}
+
+func (r *examRepository) GetReviewCardByID(ctx context.Context, id int64) (*domain.ReviewCardModel, error) {
+	var card domain.ReviewCardModel
+	if err := database.DB.WithContext(ctx).First(&card, id).Error; err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+func (r *examRepository) SaveReviewCard(ctx context.Context, card *domain.ReviewCardModel) error {
+	return database.DB.WithContext(ctx).Save(card).Error
+}
+
+func reviewCardScope(userID, topicID int64, examIDs []int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("user_id = ?", userID)
+		if topicID > 0 {
+			db = db.Where("topic_id = ?", topicID)
+		}
+		if examIDs != nil {
+			db = db.Where("exam_id IN ?", examIDs)
+		}
+		return db
+	}
+}
+
+// GetDueReviewCards trả về các thẻ đã đến hạn, thẻ quá hạn lâu nhất đứng trước; examIDs khác nil giới hạn theo lớp.
+func (r *examRepository) GetDueReviewCards(ctx context.Context, userID, topicID int64, examIDs []int64, now time.Time, limit int) ([]*domain.ReviewCardModel, int64, error) {
+	var cards []*domain.ReviewCardModel
+	var total int64
+	query := database.DB.WithContext(ctx).Model(&domain.ReviewCardModel{}).
+		Scopes(reviewCardScope(userID, topicID, examIDs)).
+		Where("due_at <= ?", now)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("due_at ASC, id ASC").Limit(limit).Find(&cards).Error
+	return cards, total, err
+}
+
+func (r *examRepository) CountReviewCards(ctx context.Context, userID, topicID int64, examIDs []int64) (int64, error) {
+	var count int64
+	err := database.DB.WithContext(ctx).Model(&domain.ReviewCardModel{}).
+		Scopes(reviewCardScope(userID, topicID, examIDs)).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *examRepository) CreateReviewLog(ctx context.Context, log *domain.ReviewLogModel) error {
+	return database.DB.WithContext(ctx).Create(log).Error
+}
+
+func (r *examRepository) CreateAnswerSheetScan(ctx context.Context, scan *domain.AnswerSheetScanModel) error {
+	return database.DB.WithContext(ctx).Create(scan).Error
+}
+
+func (r *examRepository) SaveAnswerSheetScan(ctx context.Context, scan *domain.AnswerSheetScanModel) error {
+	return database.DB.WithContext(ctx).Save(scan).Error
+}
+
+func (r *examRepository) GetAnswerSheetScanByID(ctx context.Context, id int64) (*domain.AnswerSheetScanModel, error) {
+	var scan domain.AnswerSheetScanModel
+	if err := database.DB.WithContext(ctx).First(&scan, id).Error; err != nil {
+		return nil, err
+	}
+	return &scan, nil
+}
+
+func (r *examRepository) GetAnswerSheetScans(ctx context.Context, examID int64, status string) ([]*domain.AnswerSheetScanModel, error) {
+	var scans []*domain.AnswerSheetScanModel
+	query := database.DB.WithContext(ctx).Where("exam_id = ?", examID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("created_at DESC, id DESC").Find(&scans).Error
+	return scans, err
+}
+
+// GetGradedScanSubmissionID trả về bài làm đã tạo từ lần quét phiếu trước của học sinh (0 nếu chưa có),
+// để quét lại thì chấm đè lên bài cũ thay vì sinh thêm lượt làm.
+func (r *examRepository) GetGradedScanSubmissionID(ctx context.Context, examID, studentID int64) (int64, error) {
+	var ids []int64
+	err := database.DB.WithContext(ctx).Model(&domain.AnswerSheetScanModel{}).
+		Where("exam_id = ? AND student_id = ? AND submission_id IS NOT NULL", examID, studentID).
+		Order("id DESC").Limit(1).
+		Pluck("submission_id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	return ids[0], nil
+}
+
+func (r *examRepository) GetSubmissionChoiceOrder(ctx context.Context, submissionID int64) (*domain.SubmissionChoiceOrderModel, error) {
+	var order domain.SubmissionChoiceOrderModel
+	if err := database.DB.WithContext(ctx).Where("submission_id = ?", submissionID).First(&order).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *examRepository) GetSubmissionChoiceOrders(ctx context.Context, submissionIDs []int64) ([]*domain.SubmissionChoiceOrderModel, error) {
+	var orders []*domain.SubmissionChoiceOrderModel
+	if len(submissionIDs) == 0 {
+		return orders, nil
+	}
+	err := database.DB.WithContext(ctx).Where("submission_id IN ?", submissionIDs).Find(&orders).Error
+	return orders, err
+}
+
+func (r *examRepository) SaveSubmissionChoiceOrder(ctx context.Context, order *domain.SubmissionChoiceOrderModel) error {
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "submission_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"orders", "updated_at"}),
+	}).Create(order).Error
+}
+
+func (r *examRepository) GetExamClassIDs(ctx context.Context, examID int64) ([]int64, error) {
+	var ids []int64
+	err := database.DB.WithContext(ctx).Model(&domain.ExamClass{}).
+		Where("exam_id = ?", examID).
+		Order("assigned_at ASC").
+		Pluck("class_id", &ids).Error
+	return ids, err
+}
+
+func (r *examRepository) CreateExamTemplate(ctx context.Context, tpl *domain.ExamTemplateModel) error {
+	return database.DB.WithContext(ctx).Create(tpl).Error
+}
+
+func (r *examRepository) GetExamTemplateByID(ctx context.Context, id int64) (*domain.ExamTemplateModel, error) {
+	var tpl domain.ExamTemplateModel
+	if err := database.DB.WithContext(ctx).First(&tpl, id).Error; err != nil {
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+func (r *examRepository) GetExamTemplatesByCreator(ctx context.Context, creatorID int64) ([]*domain.ExamTemplateModel, error) {
+	var tpls []*domain.ExamTemplateModel
+	err := database.DB.WithContext(ctx).
+		Where("creator_id = ?", creatorID).
+		Order("updated_at DESC").
+		Find(&tpls).Error
+	return tpls, err
+}
+
+func (r *examRepository) DeleteExamTemplate(ctx context.Context, id int64) error {
+	return database.DB.WithContext(ctx).Delete(&domain.ExamTemplateModel{}, id).Error
+}
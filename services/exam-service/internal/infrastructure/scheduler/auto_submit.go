@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/env"
+)
+
+type AutoSubmitScheduler struct {
+	service  domain.ExamService
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewAutoSubmitScheduler(service domain.ExamService) *AutoSubmitScheduler {
+	interval := env.GetInt("AUTO_SUBMIT_INTERVAL_SECONDS", 30)
+	if interval <= 0 {
+		interval = 30
+	}
+	return &AutoSubmitScheduler{
+		service:  service,
+		interval: time.Duration(interval) * time.Second,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+func (s *AutoSubmitScheduler) Start() {
+	log.Printf("✅ Auto-submit scheduler đã khởi động (chu kỳ %v)", s.interval)
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				s.run()
+			}
+		}
+	}()
+}
+
+func (s *AutoSubmitScheduler) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.interval)
+	defer cancel()
+
+	count, err := s.service.AutoSubmitExpiredSubmissions(ctx)
+	if err != nil {
+		log.Printf("Lỗi quét bài thi quá hạn: %v", err)
+		return
+	}
+	if count > 0 {
+		log.Printf("⏰ Đã tự động nộp %d bài thi quá hạn", count)
+	}
+}
+
+func (s *AutoSubmitScheduler) Stop() {
+	close(s.stop)
+	<-s.done
+}
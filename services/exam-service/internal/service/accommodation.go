@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	pbUser "github.com/06babyshark06/JQKStudy/shared/proto/user"
+)
+
+const maxTimeMultiplier = 5
+
+// accommodationFor trả về hỗ trợ có hiệu lực của học sinh cho đề: ghi đè theo đề được ưu tiên,
+// nếu không có thì lấy mức cao nhất giữa hỗ trợ chung và hỗ trợ theo lớp.
+func (s *examService) accommodationFor(ctx context.Context, exam *domain.ExamModel, userID int64) domain.AccommodationTerms {
+	terms := domain.AccommodationTerms{TimeMultiplier: 1}
+	if exam == nil || userID == 0 {
+		return terms
+	}
+	override, accs, err := s.repo.GetApplicableAccommodations(ctx, exam.Id, userID)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được hỗ trợ của user %d cho đề %d: %v", userID, exam.Id, err)
+		return terms
+	}
+	if override != nil {
+		return terms.Merge(override.AccommodationTerms)
+	}
+	for _, acc := range accs {
+		terms = terms.Merge(acc.AccommodationTerms)
+	}
+	return terms
+}
+
+// refreshDeadlines tính lại hạn nộp cho các lượt thi đang làm dở khi hỗ trợ của học sinh thay đổi.
+func (s *examService) refreshDeadlines(ctx context.Context, userID, examID int64) {
+	subs, err := s.repo.GetInProgressSubmissionsByUser(ctx, userID, examID)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được lượt thi đang làm của user %d: %v", userID, err)
+		return
+	}
+	for _, sub := range subs {
+		exam, err := s.repo.GetExamDetails(ctx, sub.ExamID)
+		if err != nil {
+			continue
+		}
+		deadline := s.submissionDeadline(ctx, exam, sub)
+		if err := s.repo.UpdateSubmissionDeadline(ctx, sub.Id, deadline); err != nil {
+			log.Printf("⚠️ Không cập nhật được hạn nộp của bài %d: %v", sub.Id, err)
+		}
+	}
+}
+
+func (s *examService) SaveAccommodation(ctx context.Context, req *pb.SaveAccommodationRequest) (*pb.SaveAccommodationResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Thiếu mã học sinh")
+	}
+	terms, err := termsFromProto(req.Terms)
+	if err != nil {
+		return nil, err
+	}
+	if req.ClassId > 0 {
+		if err := s.checkClassOwner(ctx, req.ClassId, req.InstructorId); err != nil {
+			return nil, err
+		}
+	} else {
+		existing, err := s.repo.GetAccommodations(ctx, req.UserId, 0)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách hỗ trợ: %v", err)
+		}
+		var current *domain.AccommodationModel
+		for _, acc := range existing {
+			if acc.ClassID == 0 {
+				current = acc
+			}
+		}
+		if current != nil {
+			if err := s.checkAccommodationAccess(ctx, current, req.InstructorId); err != nil {
+				return nil, err
+			}
+		} else if err := s.checkTeachesStudent(ctx, req.InstructorId, req.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	acc := &domain.AccommodationModel{
+		UserID:             req.UserId,
+		ClassID:            req.ClassId,
+		AccommodationTerms: terms,
+		Notes:              strings.TrimSpace(req.Notes),
+		CreatedBy:          req.InstructorId,
+		UpdatedAt:          time.Now().UTC(),
+	}
+	if err := s.repo.SaveAccommodation(ctx, acc); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu hỗ trợ cho học sinh: %v", err)
+	}
+	s.refreshDeadlines(ctx, req.UserId, 0)
+
+	return &pb.SaveAccommodationResponse{Accommodation: accommodationToProto(acc)}, nil
+}
+
+func (s *examService) GetAccommodations(ctx context.Context, req *pb.GetAccommodationsRequest) (*pb.GetAccommodationsResponse, error) {
+	if req.ClassId > 0 {
+		if err := s.checkClassOwner(ctx, req.ClassId, req.InstructorId); err != nil {
+			return nil, err
+		}
+	}
+	accs, err := s.repo.GetAccommodations(ctx, req.UserId, req.ClassId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách hỗ trợ: %v", err)
+	}
+	owned, err := s.teacherClassIDs(ctx, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetAccommodationsResponse{Accommodations: []*pb.Accommodation{}}
+	for _, acc := range accs {
+		if acc.CreatedBy != req.InstructorId && !owned[acc.ClassID] {
+			continue
+		}
+		resp.Accommodations = append(resp.Accommodations, accommodationToProto(acc))
+	}
+	return resp, nil
+}
+
+func (s *examService) DeleteAccommodation(ctx context.Context, req *pb.DeleteAccommodationRequest) (*pb.DeleteAccommodationResponse, error) {
+	acc, err := s.repo.GetAccommodationByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy hỗ trợ: %v", err)
+	}
+	if err := s.checkAccommodationAccess(ctx, acc, req.InstructorId); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteAccommodation(ctx, acc.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi xóa hỗ trợ: %v", err)
+	}
+	s.refreshDeadlines(ctx, acc.UserID, 0)
+	return &pb.DeleteAccommodationResponse{Success: true}, nil
+}
+
+// checkAccommodationAccess cho phép giáo viên tạo hỗ trợ hoặc giáo viên chủ nhiệm lớp của hỗ trợ theo lớp sửa, xóa nó.
+func (s *examService) checkAccommodationAccess(ctx context.Context, acc *domain.AccommodationModel, instructorID int64) error {
+	if instructorID > 0 && acc.CreatedBy == instructorID {
+		return nil
+	}
+	if acc.ClassID > 0 {
+		return s.checkClassOwner(ctx, acc.ClassID, instructorID)
+	}
+	return status.Error(codes.PermissionDenied, "Bạn không có quyền quản lý hỗ trợ này")
+}
+
+// checkClassOwner kiểm tra giáo viên là người phụ trách lớp.
+func (s *examService) checkClassOwner(ctx context.Context, classID, instructorID int64) error {
+	if instructorID <= 0 {
+		return status.Error(codes.PermissionDenied, "Bạn không có quyền quản lý lớp học này")
+	}
+	if s.userClient == nil {
+		return status.Error(codes.Unavailable, "Không kiểm tra được quyền với lớp học")
+	}
+	class, err := s.userClient.GetClassDetails(ctx, &pbUser.GetClassDetailsRequest{ClassId: classID})
+	if err != nil || class.Class == nil {
+		return status.Errorf(codes.NotFound, "Không tìm thấy lớp học: %v", err)
+	}
+	if class.Class.TeacherId != instructorID {
+		return status.Error(codes.PermissionDenied, "Bạn không có quyền quản lý lớp học này")
+	}
+	return nil
+}
+
+// teacherClassIDs trả về các lớp giáo viên đang phụ trách.
+func (s *examService) teacherClassIDs(ctx context.Context, instructorID int64) (map[int64]bool, error) {
+	if instructorID <= 0 {
+		return nil, status.Error(codes.PermissionDenied, "Thiếu thông tin giáo viên")
+	}
+	owned := make(map[int64]bool)
+	if s.userClient == nil {
+		return nil, status.Error(codes.Unavailable, "Không kiểm tra được quyền với lớp học")
+	}
+	resp, err := s.userClient.GetClasses(ctx, &pbUser.GetClassesRequest{TeacherId: instructorID, Page: 1, Limit: 1000})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Không lấy được danh sách lớp: %v", err)
+	}
+	for _, c := range resp.Classes {
+		owned[c.Id] = true
+	}
+	return owned, nil
+}
+
+// checkTeachesStudent kiểm tra học sinh thuộc ít nhất một lớp của giáo viên trước khi tạo hỗ trợ chung.
+func (s *examService) checkTeachesStudent(ctx context.Context, instructorID, userID int64) error {
+	owned, err := s.teacherClassIDs(ctx, instructorID)
+	if err != nil {
+		return err
+	}
+	classIDs := make([]int64, 0, len(owned))
+	for id := range owned {
+		classIDs = append(classIDs, id)
+	}
+	if len(classIDs) > 0 {
+		check, err := s.userClient.CheckUserInClass(ctx, &pbUser.CheckUserInClassRequest{UserId: userID, ClassIds: classIDs})
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "Không kiểm tra được lớp của học sinh: %v", err)
+		}
+		if check.IsMember {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "Học sinh không thuộc lớp nào bạn phụ trách")
+}
+
+func (s *examService) SaveExamAccommodation(ctx context.Context, req *pb.SaveExamAccommodationRequest) (*pb.SaveExamAccommodationResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Thiếu mã học sinh")
+	}
+	terms, err := termsFromProto(req.Terms)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := &domain.ExamAccommodationModel{
+		ExamID:             req.ExamId,
+		UserID:             req.UserId,
+		AccommodationTerms: terms,
+		Notes:              strings.TrimSpace(req.Notes),
+		CreatedBy:          req.InstructorId,
+		UpdatedAt:          time.Now().UTC(),
+	}
+	if err := s.repo.SaveExamAccommodation(ctx, acc); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu hỗ trợ cho đề: %v", err)
+	}
+	s.refreshDeadlines(ctx, req.UserId, req.ExamId)
+
+	return &pb.SaveExamAccommodationResponse{Accommodation: examAccommodationToProto(acc)}, nil
+}
+
+func (s *examService) GetExamAccommodations(ctx context.Context, req *pb.GetExamAccommodationsRequest) (*pb.GetExamAccommodationsResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	accs, err := s.repo.GetExamAccommodations(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách hỗ trợ của đề: %v", err)
+	}
+	resp := &pb.GetExamAccommodationsResponse{Accommodations: []*pb.Accommodation{}}
+	for _, acc := range accs {
+		resp.Accommodations = append(resp.Accommodations, examAccommodationToProto(acc))
+	}
+	return resp, nil
+}
+
+func (s *examService) DeleteExamAccommodation(ctx context.Context, req *pb.DeleteExamAccommodationRequest) (*pb.DeleteExamAccommodationResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteExamAccommodation(ctx, req.ExamId, req.UserId); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi xóa hỗ trợ của đề: %v", err)
+	}
+	s.refreshDeadlines(ctx, req.UserId, req.ExamId)
+	return &pb.DeleteExamAccommodationResponse{Success: true}, nil
+}
+
+func (s *examService) getOwnedExam(ctx context.Context, examID, instructorID int64) (*domain.ExamModel, error) {
+	exam, err := s.repo.GetExamDetails(ctx, examID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if instructorID <= 0 || exam.CreatorID != instructorID {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền quản lý bài thi này")
+	}
+	return exam, nil
+}
+
+func termsFromProto(t *pb.AccommodationTerms) (domain.AccommodationTerms, error) {
+	terms := domain.AccommodationTerms{TimeMultiplier: 1}
+	if t == nil {
+		return terms, nil
+	}
+	if t.TimeMultiplier != 0 {
+		if t.TimeMultiplier < 1 || t.TimeMultiplier > maxTimeMultiplier {
+			return terms, status.Errorf(codes.InvalidArgument, "Hệ số thời gian phải nằm trong khoảng 1 - %d", maxTimeMultiplier)
+		}
+		terms.TimeMultiplier = float64(t.TimeMultiplier)
+	}
+	if t.ExtraMinutes < 0 || t.WindowExtensionMinutes < 0 || t.ExtraAttempts < 0 {
+		return terms, status.Error(codes.InvalidArgument, "Thời gian và số lượt cộng thêm không được âm")
+	}
+	terms.ExtraMinutes = int(t.ExtraMinutes)
+	terms.WindowExtensionMinutes = int(t.WindowExtensionMinutes)
+	terms.ExtraAttempts = int(t.ExtraAttempts)
+	return terms, nil
+}
+
+func termsToProto(t domain.AccommodationTerms) *pb.AccommodationTerms {
+	return &pb.AccommodationTerms{
+		TimeMultiplier:         float32(t.TimeMultiplier),
+		ExtraMinutes:           int32(t.ExtraMinutes),
+		WindowExtensionMinutes: int32(t.WindowExtensionMinutes),
+		ExtraAttempts:          int32(t.ExtraAttempts),
+	}
+}
+
+func accommodationToProto(acc *domain.AccommodationModel) *pb.Accommodation {
+	return &pb.Accommodation{
+		Id:        acc.Id,
+		UserId:    acc.UserID,
+		ClassId:   acc.ClassID,
+		Terms:     termsToProto(acc.AccommodationTerms),
+		Notes:     acc.Notes,
+		CreatedBy: acc.CreatedBy,
+		UpdatedAt: acc.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func examAccommodationToProto(acc *domain.ExamAccommodationModel) *pb.Accommodation {
+	return &pb.Accommodation{
+		Id:        acc.Id,
+		UserId:    acc.UserID,
+		ExamId:    acc.ExamID,
+		Terms:     termsToProto(acc.AccommodationTerms),
+		Notes:     acc.Notes,
+		CreatedBy: acc.CreatedBy,
+		UpdatedAt: acc.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func sameDeadline(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
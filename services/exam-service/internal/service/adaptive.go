@@ -0,0 +1,509 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	IRTModel1PL = "1pl"
+	IRTModel2PL = "2pl"
+
+	irtMinResponses      = 30
+	irtCalibrationTTL    = 24 * time.Hour
+	adaptiveExposureTopK = 3
+)
+
+// AdaptiveConfig là cấu hình JSON của chế độ thi thích ứng (ExamModel.AdaptiveConfig).
+type AdaptiveConfig struct {
+	Model        string  `json:"model"`
+	MinQuestions int     `json:"min_questions"`
+	MaxQuestions int     `json:"max_questions"`
+	SEThreshold  float64 `json:"se_threshold"`
+	Points       float64 `json:"points"`
+	SectionIDs   []int64 `json:"section_ids"`
+}
+
+func parseAdaptiveConfig(raw string) AdaptiveConfig {
+	var cfg AdaptiveConfig
+	if raw != "" {
+		if err := json.Unmarshal([]byte(raw), &cfg); err != nil {
+			log.Printf("Cấu hình thi thích ứng không hợp lệ, dùng mặc định: %v", err)
+		}
+	}
+	if cfg.Model != IRTModel1PL {
+		cfg.Model = IRTModel2PL
+	}
+	if cfg.MaxQuestions <= 0 {
+		cfg.MaxQuestions = 20
+	}
+	if cfg.MinQuestions <= 0 {
+		cfg.MinQuestions = 5
+	}
+	if cfg.MinQuestions > cfg.MaxQuestions {
+		cfg.MinQuestions = cfg.MaxQuestions
+	}
+	if cfg.SEThreshold <= 0 {
+		cfg.SEThreshold = 0.3
+	}
+	if cfg.Points <= 0 {
+		cfg.Points = 1.0
+	}
+	return cfg
+}
+
+type irtItem struct {
+	a float64
+	b float64
+}
+
+func irtProbability(theta float64, it irtItem) float64 {
+	return 1.0 / (1.0 + math.Exp(-it.a*(theta-it.b)))
+}
+
+func irtInformation(theta float64, it irtItem) float64 {
+	p := irtProbability(theta, it)
+	return it.a * it.a * p * (1 - p)
+}
+
+// estimateAbility ước lượng năng lực theo EAP với prior N(0, 1), trả về theta và sai số chuẩn (SD hậu nghiệm).
+func estimateAbility(items []irtItem, responses []bool) (float64, float64) {
+	const lo, hi, step = -4.0, 4.0, 0.05
+
+	var thetas, logPost []float64
+	maxLog := math.Inf(-1)
+	for t := lo; t <= hi+1e-9; t += step {
+		lp := -t * t / 2
+		for i, it := range items {
+			p := irtProbability(t, it)
+			if responses[i] {
+				lp += math.Log(math.Max(p, 1e-12))
+			} else {
+				lp += math.Log(math.Max(1-p, 1e-12))
+			}
+		}
+		thetas = append(thetas, t)
+		logPost = append(logPost, lp)
+		if lp > maxLog {
+			maxLog = lp
+		}
+	}
+
+	var sumW, sumWT float64
+	weights := make([]float64, len(thetas))
+	for i, lp := range logPost {
+		weights[i] = math.Exp(lp - maxLog)
+		sumW += weights[i]
+		sumWT += weights[i] * thetas[i]
+	}
+	theta := sumWT / sumW
+
+	var variance float64
+	for i, t := range thetas {
+		variance += weights[i] * (t - theta) * (t - theta)
+	}
+	return theta, math.Sqrt(variance / sumW)
+}
+
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
+
+func difficultyPrior(q *domain.QuestionModel) float64 {
+	switch q.Difficulty.Difficulty {
+	case "easy":
+		return -1.0
+	case "hard":
+		return 1.0
+	default:
+		return 0
+	}
+}
+
+func (s *examService) startAdaptiveSession(ctx context.Context, exam *domain.ExamModel, submissionID, userID int64) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.SaveStudentExamQuestions(ctx, tx, exam.Id, userID, "[]"); err != nil {
+			return err
+		}
+		return s.repo.SaveAdaptiveSession(ctx, tx, &domain.AdaptiveSessionModel{
+			SubmissionID:  submissionID,
+			ExamID:        exam.Id,
+			UserID:        userID,
+			Theta:         0,
+			StandardError: 1,
+		})
+	})
+}
+
+// adaptivePool trả về ngân hàng câu hỏi cho CAT: câu gắn với đề nếu có, ngược lại lấy theo section/topic.
+// Câu tự luận bị loại vì cần chấm ngay để cập nhật năng lực.
+func (s *examService) adaptivePool(ctx context.Context, exam *domain.ExamModel, cfg AdaptiveConfig) ([]*domain.QuestionModel, map[int64]float64, error) {
+	points := make(map[int64]float64)
+	var candidates []*domain.QuestionModel
+
+	if len(exam.Questions) > 0 {
+		candidates = exam.Questions
+		for _, q := range exam.Questions {
+			points[q.Id] = q.Points
+		}
+	} else {
+		var ids []int64
+		if len(cfg.SectionIDs) > 0 {
+			for _, secID := range cfg.SectionIDs {
+				secIDs, err := s.repo.GetQuestionIDsForSection(ctx, secID, "", exam.TopicID)
+				if err != nil {
+					return nil, nil, err
+				}
+				ids = append(ids, secIDs...)
+			}
+		} else {
+			topicIDs, err := s.repo.GetQuestionIDsForSection(ctx, 0, "", exam.TopicID)
+			if err != nil {
+				return nil, nil, err
+			}
+			ids = topicIDs
+		}
+
+		questions, err := s.repo.GetQuestionsByIDs(ctx, ids)
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = questions
+	}
+
+	var pool []*domain.QuestionModel
+	for _, q := range candidates {
+		if q.Type.Type == "essay" {
+			continue
+		}
+		if points[q.Id] <= 0 {
+			points[q.Id] = cfg.Points
+		}
+		pool = append(pool, q)
+	}
+	sort.Slice(pool, func(i, j int) bool { return pool[i].Id < pool[j].Id })
+	return pool, points, nil
+}
+
+// loadIRTItems lấy tham số IRT của ngân hàng câu hỏi, hiệu chỉnh lại những câu chưa có hoặc đã cũ.
+func (s *examService) loadIRTItems(ctx context.Context, pool []*domain.QuestionModel, model string) map[int64]irtItem {
+	ids := make([]int64, len(pool))
+	for i, q := range pool {
+		ids[i] = q.Id
+	}
+
+	params, err := s.repo.GetIRTParams(ctx, ids)
+	if err != nil {
+		log.Printf("Lỗi lấy tham số IRT: %v", err)
+		params = make(map[int64]*domain.QuestionIRTParamModel)
+	}
+
+	var stale []*domain.QuestionModel
+	for _, q := range pool {
+		if p, ok := params[q.Id]; !ok || time.Since(p.CalibratedAt) > irtCalibrationTTL {
+			stale = append(stale, q)
+		}
+	}
+	if len(stale) > 0 {
+		fresh := s.calibrateItemParameters(ctx, stale)
+		if err := s.repo.UpsertIRTParams(ctx, fresh); err != nil {
+			log.Printf("Lỗi lưu tham số IRT: %v", err)
+		}
+		for _, p := range fresh {
+			params[p.QuestionID] = p
+		}
+	}
+
+	items := make(map[int64]irtItem, len(pool))
+	for _, q := range pool {
+		it := irtItem{a: 1, b: difficultyPrior(q)}
+		if p, ok := params[q.Id]; ok {
+			it = irtItem{a: p.Discrimination, b: p.Difficulty}
+		}
+		if model == IRTModel1PL {
+			it.a = 1
+		}
+		items[q.Id] = it
+	}
+	return items
+}
+
+// calibrateItemParameters ước lượng tham số IRT từ dữ liệu UserAnswerModel của các bài đã nộp.
+// Độ khó 1PL dùng logit của tỷ lệ trả lời sai; 2PL dùng xấp xỉ của Lord từ hệ số biserial.
+// Câu có ít hơn irtMinResponses lượt trả lời dùng độ khó gán nhãn làm giá trị khởi tạo.
+func (s *examService) calibrateItemParameters(ctx context.Context, questions []*domain.QuestionModel) []*domain.QuestionIRTParamModel {
+	ids := make([]int64, len(questions))
+	for i, q := range questions {
+		ids[i] = q.Id
+	}
+
+	stats, err := s.repo.GetQuestionResponseStats(ctx, ids)
+	if err != nil {
+		log.Printf("Lỗi lấy dữ liệu hiệu chỉnh IRT: %v", err)
+	}
+	grouped := make(map[int64][]*domain.QuestionResponseStat)
+	for _, st := range stats {
+		grouped[st.QuestionID] = append(grouped[st.QuestionID], st)
+	}
+
+	now := time.Now().UTC()
+	var params []*domain.QuestionIRTParamModel
+	for _, q := range questions {
+		rows := grouped[q.Id]
+		param := &domain.QuestionIRTParamModel{
+			QuestionID:     q.Id,
+			Discrimination: 1,
+			Difficulty:     difficultyPrior(q),
+			ResponseCount:  len(rows),
+			CalibratedAt:   now,
+		}
+
+		if len(rows) >= irtMinResponses {
+			correct := make([]float64, len(rows))
+			scores := make([]float64, len(rows))
+			for i, r := range rows {
+				if r.IsCorrect {
+					correct[i] = 1
+				}
+				scores[i] = r.Score
+			}
+			p, _ := meanStdDev(correct)
+			p = math.Min(math.Max(p, 0.01), 0.99)
+
+			param.Difficulty = math.Log((1 - p) / p)
+
+			y := normalQuantile(p)
+			ordinate := math.Exp(-y*y/2) / math.Sqrt(2*math.Pi)
+			rBis := pearson(correct, scores) * math.Sqrt(p*(1-p)) / ordinate
+			if rBis > 0.05 {
+				rBis = math.Min(rBis, 0.95)
+				param.Discrimination = 1.7 * rBis / math.Sqrt(1-rBis*rBis)
+				param.Difficulty = -y / rBis
+			}
+		}
+
+		param.Discrimination = math.Min(math.Max(param.Discrimination, 0.2), 3.0)
+		param.Difficulty = math.Min(math.Max(param.Difficulty, -4), 4)
+		params = append(params, param)
+	}
+	return params
+}
+
+// selectNextAdaptiveItem chọn ngẫu nhiên trong top-K câu có lượng thông tin Fisher lớn nhất tại theta
+// để hạn chế việc một câu bị lộ quá nhiều.
+func selectNextAdaptiveItem(pool []*domain.QuestionModel, items map[int64]irtItem, administered map[int64]bool, theta float64) *domain.QuestionModel {
+	type candidate struct {
+		q    *domain.QuestionModel
+		info float64
+	}
+	var candidates []candidate
+	for _, q := range pool {
+		if administered[q.Id] {
+			continue
+		}
+		candidates = append(candidates, candidate{q: q, info: irtInformation(theta, items[q.Id])})
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].info > candidates[j].info })
+
+	k := adaptiveExposureTopK
+	if k > len(candidates) {
+		k = len(candidates)
+	}
+	return candidates[rand.Intn(k)].q
+}
+
+func (s *examService) GetNextAdaptiveQuestion(ctx context.Context, req *pb.GetNextAdaptiveQuestionRequest) (*pb.GetNextAdaptiveQuestionResponse, error) {
+	if err := s.validateSessionLock(ctx, req.ExamId, req.UserId, req.IpAddress, req.UserAgent); err != nil {
+		return nil, err
+	}
+
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if !exam.IsAdaptive {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi không ở chế độ thích ứng")
+	}
+
+	var submission domain.ExamSubmissionModel
+	err = database.DB.Where("exam_id = ? AND user_id = ? AND status_id = (SELECT id FROM submission_status_models WHERE status = 'in_progress')", req.ExamId, req.UserId).
+		First(&submission).Error
+	if err != nil {
+		return nil, errors.New("không tìm thấy bài làm đang diễn ra")
+	}
+
+	remaining := examRemainingSeconds(exam, &submission)
+	if remaining <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Đã hết thời gian làm bài")
+	}
+	if submission.PausedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi đang được giám thị tạm dừng")
+	}
+
+	if _, err := s.repo.GetAdaptiveSession(ctx, nil, submission.Id); err != nil {
+		if err := s.startAdaptiveSession(ctx, exam, submission.Id, req.UserId); err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi khởi tạo phiên thi thích ứng: %v", err)
+		}
+	}
+
+	cfg := parseAdaptiveConfig(exam.AdaptiveConfig)
+	pool, poolPoints, err := s.adaptivePool(ctx, exam, cfg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy ngân hàng câu hỏi: %v", err)
+	}
+	if len(pool) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Ngân hàng câu hỏi của đề thi đang trống")
+	}
+	items := s.loadIRTItems(ctx, pool, cfg.Model)
+	poolByID := make(map[int64]*domain.QuestionModel, len(pool))
+	for _, q := range pool {
+		poolByID[q.Id] = q
+	}
+
+	resp := &pb.GetNextAdaptiveQuestionResponse{
+		MaxQuestions:     int32(cfg.MaxQuestions),
+		RemainingSeconds: remaining,
+	}
+	var nextQuestion *domain.QuestionModel
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		session, err := s.repo.GetAdaptiveSession(ctx, tx, submission.Id)
+		if err != nil {
+			return err
+		}
+
+		var administered []DynamicQuestion
+		if sExam, err := s.repo.GetStudentExam(ctx, req.ExamId, req.UserId); err == nil {
+			administered = parseStudentExamQuestions(sExam.QuestionIDs)
+		}
+
+		if session.PendingQuestionID != nil {
+			pendingID := *session.PendingQuestionID
+			if req.QuestionId == 0 {
+				nextQuestion = poolByID[pendingID]
+				fillAdaptiveResponse(resp, session)
+				return nil
+			}
+			if req.QuestionId != pendingID {
+				return status.Error(codes.InvalidArgument, "Câu trả lời không khớp với câu hỏi hiện tại")
+			}
+
+			q, ok := poolByID[pendingID]
+			if !ok {
+				return status.Error(codes.FailedPrecondition, "Câu hỏi hiện tại không còn trong ngân hàng câu hỏi")
+			}
+
+			points := poolPoints[pendingID]
+			for _, dq := range administered {
+				if dq.ID == pendingID && dq.Points > 0 {
+					points = dq.Points
+				}
+			}
+
+			ans := AnswerResponse{ChoiceIDs: req.ChoiceIds, Text: req.TextAnswer}
+			mergeStructuredAnswer(&ans, req.OrderedChoiceIds, req.Matches, req.Blanks)
+			engine, err := s.scoringEngineFor(ctx, exam)
+			if err != nil {
+				return err
+			}
+			r := engine.ScoreQuestion(q, ans, points)
+			answeredAt := time.Now().UTC()
+			rows := buildUserAnswerModels(submission.Id, []*domain.QuestionModel{q}, map[int64]AnswerResponse{q.Id: ans}, SubmissionResult{Results: map[int64]ScoreResult{q.Id: r}}, map[int64]*time.Time{q.Id: &answeredAt})
+			if err := s.repo.ReplaceUserAnswers(ctx, tx, submission.Id, q.Id, rows); err != nil {
+				return err
+			}
+
+			var answered []domain.UserAnswerModel
+			if err := tx.WithContext(ctx).Where("submission_id = ?", submission.Id).Find(&answered).Error; err != nil {
+				return err
+			}
+			correctMap := make(map[int64]bool)
+			for _, ua := range answered {
+				if ua.IsCorrect != nil && *ua.IsCorrect {
+					correctMap[ua.QuestionID] = true
+				}
+			}
+
+			var respItems []irtItem
+			var responses []bool
+			for _, dq := range administered {
+				it, ok := items[dq.ID]
+				if !ok {
+					it = irtItem{a: 1, b: 0}
+				}
+				respItems = append(respItems, it)
+				responses = append(responses, correctMap[dq.ID])
+			}
+
+			session.Theta, session.StandardError = estimateAbility(respItems, responses)
+			session.AnsweredCount++
+			session.PendingQuestionID = nil
+		}
+
+		administeredSet := make(map[int64]bool, len(administered))
+		for _, dq := range administered {
+			administeredSet[dq.ID] = true
+		}
+
+		stop := session.Finished ||
+			session.AnsweredCount >= cfg.MaxQuestions ||
+			(session.AnsweredCount >= cfg.MinQuestions && session.StandardError <= cfg.SEThreshold)
+		if !stop {
+			nextQuestion = selectNextAdaptiveItem(pool, items, administeredSet, session.Theta)
+		}
+
+		if nextQuestion == nil {
+			session.Finished = true
+		} else {
+			administered = append(administered, DynamicQuestion{ID: nextQuestion.Id, Points: poolPoints[nextQuestion.Id]})
+			qBytes, _ := json.Marshal(administered)
+			if err := s.repo.SaveStudentExamQuestions(ctx, tx, req.ExamId, req.UserId, string(qBytes)); err != nil {
+				return err
+			}
+			pendingID := nextQuestion.Id
+			session.PendingQuestionID = &pendingID
+		}
+
+		fillAdaptiveResponse(resp, session)
+		return s.repo.SaveAdaptiveSession(ctx, tx, session)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "Lỗi xử lý câu hỏi thích ứng: %v", err)
+	}
+
+	if nextQuestion != nil {
+		pbQ := s.convertToPBQuestion(nextQuestion)
+		pbQ.Points = float32(poolPoints[nextQuestion.Id])
+		choiceOrders := s.ensureChoiceOrders(ctx, exam, &submission, []*pb.QuestionDetails{pbQ})
+		applyChoiceOrder(pbQ, choiceOrders[pbQ.Id])
+		resp.Question = pbQ
+	}
+
+	return resp, nil
+}
+
+func fillAdaptiveResponse(resp *pb.GetNextAdaptiveQuestionResponse, session *domain.AdaptiveSessionModel) {
+	resp.Finished = session.Finished
+	resp.Theta = session.Theta
+	resp.StandardError = session.StandardError
+	resp.AnsweredCount = int32(session.AnsweredCount)
+}
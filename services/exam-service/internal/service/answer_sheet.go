@@ -0,0 +1,572 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/env"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	maxScanPages = 200
+	// omrReviewConfidence là độ chắc chắn tối thiểu của một câu để được chấm tự động mà không cần giáo viên duyệt.
+	omrReviewConfidence = 0.6
+)
+
+// sheetSlot là một dòng ô tròn trên phiếu trả lời, ứng với câu thứ number trong mã đề.
+type sheetSlot struct {
+	number int
+	pq     paperQuestion
+}
+
+// answerSheetSlots chọn các câu trắc nghiệm tô được trên phiếu theo thứ tự của mã đề. Các câu còn lại
+// (tự luận, điền đáp án, hoặc quá sheetMaxChoices lựa chọn) làm trực tiếp trên đề.
+func answerSheetSlots(v *paperVersion) ([]sheetSlot, []int) {
+	var slots []sheetSlot
+	var manual []int
+	for i, pq := range v.questions {
+		switch pq.question.Type.Type {
+		case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice:
+			if len(pq.choices) > 0 && len(pq.choices) <= sheetMaxChoices {
+				slots = append(slots, sheetSlot{number: i + 1, pq: pq})
+				continue
+			}
+		}
+		manual = append(manual, i+1)
+	}
+	return slots, manual
+}
+
+// paperVersionFor dựng lại mã đề đã in cho phiếu quét: đề cố định theo mã đề, đề động theo học sinh.
+func (s *examService) paperVersionFor(ctx context.Context, exam *domain.ExamModel, studentID int64, code int) (*paperVersion, error) {
+	if exam.IsDynamic {
+		versions, err := s.dynamicPaperVersions(ctx, exam, []int64{studentID})
+		if err != nil {
+			return nil, err
+		}
+		versions[0].code = strconv.Itoa(code)
+		return versions[0], nil
+	}
+	index := code - firstPaperCode
+	if index < 0 || index >= maxPaperVersions {
+		return nil, status.Errorf(codes.InvalidArgument, "Mã đề %d không hợp lệ", code)
+	}
+	versions, err := s.staticPaperVersions(ctx, exam, index+1)
+	if err != nil {
+		return nil, err
+	}
+	v := versions[index]
+	v.studentID = studentID
+	return v, nil
+}
+
+// GenerateAnswerSheets in phiếu trả lời trắc nghiệm cho từng học sinh, mỗi phiếu mang mã bài thi, học sinh và mã đề.
+// Với đề cố định, học sinh được chia lần lượt vào các mã đề giống GeneratePaperExam.
+func (s *examService) GenerateAnswerSheets(ctx context.Context, req *pb.GenerateAnswerSheetsRequest) (*pb.GenerateAnswerSheetsResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	if exam.IsAdaptive {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi thích ứng chọn câu theo từng lượt trả lời nên không thể in phiếu trả lời")
+	}
+	if len(req.StudentIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Cần danh sách học sinh để in phiếu trả lời")
+	}
+
+	var versions []*paperVersion
+	if exam.IsDynamic {
+		versions, err = s.dynamicPaperVersions(ctx, exam, req.StudentIds)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if len(req.StudentIds) > maxPaperStudents {
+			return nil, status.Errorf(codes.InvalidArgument, "Chỉ có thể in tối đa %d học sinh mỗi lần", maxPaperStudents)
+		}
+		base, err := s.staticPaperVersions(ctx, exam, int(req.VersionCount))
+		if err != nil {
+			return nil, err
+		}
+		for i, studentID := range req.StudentIds {
+			v := *base[i%len(base)]
+			v.studentID = studentID
+			v.studentName, _ = s.lookupUser(ctx, studentID)
+			versions = append(versions, &v)
+		}
+	}
+
+	renderer, err := newPaperRenderer(env.GetString("EXAM_PDF_FONT_DIR", "/usr/share/fonts/truetype/dejavu"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi khởi tạo trình in PDF: %v", err)
+	}
+	pdf := renderer.newSheetDocument()
+	resp := &pb.GenerateAnswerSheetsResponse{Sheets: []*pb.AnswerSheetInfo{}}
+	for _, v := range versions {
+		slots, manual := answerSheetSlots(v)
+		if len(slots) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "Đề thi không có câu trắc nghiệm nào để tô trên phiếu")
+		}
+		if len(slots) > sheetMaxQuestions {
+			return nil, status.Errorf(codes.FailedPrecondition, "Phiếu trả lời chỉ chứa tối đa %d câu trắc nghiệm", sheetMaxQuestions)
+		}
+		code, _ := strconv.Atoi(v.code)
+		sc := sheetCode{examID: exam.Id, studentID: v.studentID, version: code}
+		if err := sc.validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Không thể in phiếu trả lời: %v", err)
+		}
+		renderer.writeAnswerSheet(pdf, exam, v, slots, manual, sc, req.SchoolName)
+		resp.Sheets = append(resp.Sheets, &pb.AnswerSheetInfo{
+			StudentId:       v.studentID,
+			StudentName:     v.studentName,
+			VersionCode:     v.code,
+			BubbleQuestions: int32(len(slots)),
+		})
+	}
+	data, err := outputPDF(pdf)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi in phiếu trả lời: %v", err)
+	}
+
+	resp.PdfData = data
+	resp.Filename = fmt.Sprintf("phieu_tra_loi_%d.pdf", exam.Id)
+	return resp, nil
+}
+
+func (r *paperRenderer) newSheetDocument() *fpdf.Fpdf {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(paperFontFamily, "", r.regular)
+	pdf.AddUTF8FontFromBytes(paperFontFamily, "B", r.bold)
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	return pdf
+}
+
+// writeAnswerSheet vẽ một phiếu: bốn dấu định vị, dải mã nhị phân và lưới ô tròn theo bố cục trong omr.go.
+func (r *paperRenderer) writeAnswerSheet(pdf *fpdf.Fpdf, exam *domain.ExamModel, v *paperVersion, slots []sheetSlot, manual []int, code sheetCode, schoolName string) {
+	pdf.AddPage()
+	pdf.SetFillColor(0, 0, 0)
+	for _, m := range sheetMarkCenters() {
+		pdf.Rect(m[0]-sheetMarkSize/2, m[1]-sheetMarkSize/2, sheetMarkSize, sheetMarkSize, "F")
+	}
+
+	textX, textW := 25.0, sheetWidth-50
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont(paperFontFamily, "B", 13)
+	pdf.SetXY(textX, 10)
+	pdf.CellFormat(textW, 6, "PHIẾU TRẢ LỜI TRẮC NGHIỆM", "", 1, "C", false, 0, "")
+	pdf.SetFont(paperFontFamily, "", 9)
+	pdf.SetX(textX)
+	pdf.CellFormat(textW, 5, strings.ToUpper(schoolName), "", 1, "C", false, 0, "")
+
+	pdf.SetFont(paperFontFamily, "", 10)
+	pdf.SetXY(textX, 24)
+	pdf.CellFormat(textW, 5, "Bài thi: "+exam.Title, "", 1, "L", false, 0, "")
+	pdf.SetX(textX)
+	pdf.CellFormat(textW, 5, "Họ và tên: "+firstNonEmpty(v.studentName, ".................................................."), "", 1, "L", false, 0, "")
+	pdf.SetX(textX)
+	pdf.SetFont(paperFontFamily, "B", 10)
+	pdf.CellFormat(textW, 5, fmt.Sprintf("Mã học sinh: %d        Mã đề: %s", v.studentID, v.code), "", 1, "L", false, 0, "")
+
+	pdf.SetDrawColor(190, 190, 190)
+	pdf.SetLineWidth(0.2)
+	stripW := float64(sheetCodeCols-1)*sheetCodePitch + sheetCodeCell + 3
+	pdf.Rect(sheetCodeX-sheetCodeCell/2-1.5, sheetCodeY-sheetCodeCell/2-1.5, stripW, 2*sheetCodePitch+sheetCodeCell+3, "D")
+	for i, bit := range code.bits() {
+		if bit {
+			x, y := sheetCodeCellCenter(i)
+			pdf.Rect(x-sheetCodeCell/2, y-sheetCodeCell/2, sheetCodeCell, sheetCodeCell, "F")
+		}
+	}
+
+	pdf.SetFont(paperFontFamily, "", 8)
+	pdf.SetXY(textX, 62)
+	pdf.MultiCell(textW, 4, "Dùng bút chì đen tô kín ô tròn của đáp án đã chọn. Câu có dấu * có thể có nhiều đáp án đúng, tô tất cả các ô đúng. "+
+		"Không gạch xoá, không viết hoặc làm bẩn dải mã và các ô vuông đen ở góc phiếu.", "", "L", false)
+
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.SetLineWidth(0.3)
+	for slot, s := range slots {
+		x0, y := sheetBubbleCenter(slot, 0)
+		label := strconv.Itoa(s.number)
+		if s.pq.question.Type.Type == domain.QuestionTypeMultipleChoice {
+			label += "*"
+		}
+		pdf.SetTextColor(0, 0, 0)
+		pdf.SetFont(paperFontFamily, "B", 9)
+		pdf.SetXY(x0-sheetBubbleRadius-sheetLabelWidth, y-2.5)
+		pdf.CellFormat(sheetLabelWidth-1, 5, label, "", 0, "R", false, 0, "")
+		pdf.SetFont(paperFontFamily, "", 7)
+		pdf.SetTextColor(170, 170, 170)
+		for j := range s.pq.choices {
+			x, _ := sheetBubbleCenter(slot, j)
+			pdf.Circle(x, y, sheetBubbleRadius, "D")
+			pdf.SetXY(x-sheetBubbleRadius, y-sheetBubbleRadius)
+			pdf.CellFormat(2*sheetBubbleRadius, 2*sheetBubbleRadius, choiceLabel(j), "", 0, "C", false, 0, "")
+		}
+	}
+
+	pdf.SetTextColor(0, 0, 0)
+	if len(manual) > 0 {
+		numbers := make([]string, 0, len(manual))
+		for _, n := range manual {
+			numbers = append(numbers, strconv.Itoa(n))
+		}
+		pdf.SetFont(paperFontFamily, "", 9)
+		pdf.SetXY(textX, sheetGridY+float64(sheetRowsPerColumn)*sheetRowPitch+2)
+		pdf.MultiCell(textW, 4.5, "Các câu làm trực tiếp trên đề: "+strings.Join(numbers, ", "), "", "L", false)
+	}
+}
+
+// readSheetMarks đo độ tô từng ô tròn; câu có ô mờ (tẩy chưa sạch, tô nhạt) hoặc tô nhiều ô ở câu một đáp án
+// được đánh dấu để giáo viên duyệt.
+func readSheetMarks(scan *sheetScan, slots []sheetSlot) []domain.ScanMark {
+	marks := make([]domain.ScanMark, 0, len(slots))
+	for slot, s := range slots {
+		m := domain.ScanMark{QuestionID: s.pq.question.Id, Number: s.number, Marked: []string{}, Confidence: 1}
+		for j := range s.pq.choices {
+			x, y := sheetBubbleCenter(slot, j)
+			ratio := scan.fillRatio(x, y, sheetBubbleRadius*0.6)
+			m.FillRatios = append(m.FillRatios, math.Round(ratio*100)/100)
+			m.Confidence = math.Min(m.Confidence, omrConfidence(ratio))
+			if ratio >= omrMarkedRatio {
+				m.Marked = append(m.Marked, choiceLabel(j))
+			}
+		}
+		if m.Confidence < omrReviewConfidence {
+			m.NeedsReview = true
+			m.Reason = "Ô tô mờ hoặc tẩy chưa sạch"
+		}
+		if s.pq.question.Type.Type == domain.QuestionTypeSingleChoice && len(m.Marked) > 1 {
+			m.NeedsReview = true
+			m.Reason = "Tô nhiều hơn một ô ở câu một đáp án"
+		}
+		marks = append(marks, m)
+	}
+	return marks
+}
+
+func scanAnswers(marks []domain.ScanMark, slots []sheetSlot) map[int64]AnswerResponse {
+	byQuestion := make(map[int64]paperQuestion, len(slots))
+	for _, s := range slots {
+		byQuestion[s.pq.question.Id] = s.pq
+	}
+	answers := make(map[int64]AnswerResponse)
+	for _, m := range marks {
+		pq, ok := byQuestion[m.QuestionID]
+		if !ok || len(m.Marked) == 0 {
+			continue
+		}
+		var ans AnswerResponse
+		for _, label := range m.Marked {
+			for j, c := range pq.choices {
+				if choiceLabel(j) == label {
+					ans.ChoiceIDs = append(ans.ChoiceIDs, c.Id)
+				}
+			}
+		}
+		if len(ans.ChoiceIDs) > 0 {
+			answers[m.QuestionID] = ans
+		}
+	}
+	return answers
+}
+
+// GradeScannedSheets đọc các trang phiếu đã quét. Phiếu đọc chắc chắn được chấm ngay qua luồng nộp bài thông thường,
+// phiếu có câu không chắc chắn được đưa vào hàng đợi duyệt.
+func (s *examService) GradeScannedSheets(ctx context.Context, req *pb.GradeScannedSheetsRequest) (*pb.GradeScannedSheetsResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Files) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Chưa có file ảnh quét nào")
+	}
+
+	client, err := s.createR2ClientForUpload(ctx)
+	if err != nil {
+		log.Printf("⚠️ Không lưu được ảnh phiếu quét của bài thi %d: %v", exam.Id, err)
+		client = nil
+	}
+
+	resp := &pb.GradeScannedSheetsResponse{Scans: []*pb.AnswerSheetScan{}}
+	pageCount := 0
+	for _, f := range req.Files {
+		pages, err := decodeScanFile(f.Content)
+		if err != nil {
+			scan := &domain.AnswerSheetScanModel{ExamID: exam.Id, FileName: f.Filename, Status: domain.ScanStatusFailed, Error: err.Error(), Marks: "[]", UploadedBy: req.InstructorId}
+			if err := s.repo.CreateAnswerSheetScan(ctx, scan); err != nil {
+				return nil, status.Errorf(codes.Internal, "Lỗi lưu kết quả quét: %v", err)
+			}
+			resp.Scans = append(resp.Scans, scanToProto(scan))
+			resp.FailedCount++
+			continue
+		}
+		for i, page := range pages {
+			if pageCount++; pageCount > maxScanPages {
+				return nil, status.Errorf(codes.InvalidArgument, "Chỉ xử lý tối đa %d trang mỗi lần", maxScanPages)
+			}
+			scan := &domain.AnswerSheetScanModel{ExamID: exam.Id, FileName: f.Filename, Page: i + 1, Marks: "[]", UploadedBy: req.InstructorId}
+			if client != nil {
+				ext := ".png"
+				if page.contentType == "image/jpeg" {
+					ext = ".jpg"
+				}
+				key := fmt.Sprintf("answer-sheets/%d/%d_%d%s", exam.Id, time.Now().UnixNano(), i+1, ext)
+				if url, err := s.putR2Object(ctx, client, key, page.contentType, page.data); err == nil {
+					scan.ImageURL = url
+				} else {
+					log.Printf("⚠️ Không lưu được ảnh trang %d của %s: %v", i+1, f.Filename, err)
+				}
+			}
+			if err := s.processScanPage(ctx, exam, scan, page); err != nil {
+				return nil, err
+			}
+			switch scan.Status {
+			case domain.ScanStatusGraded:
+				resp.GradedCount++
+			case domain.ScanStatusNeedsReview:
+				resp.ReviewCount++
+			default:
+				resp.FailedCount++
+			}
+			resp.Scans = append(resp.Scans, scanToProto(scan))
+		}
+	}
+	log.Printf("📄 Bài thi %d: quét %d phiếu, chấm %d, chờ duyệt %d, lỗi %d", exam.Id, len(resp.Scans), resp.GradedCount, resp.ReviewCount, resp.FailedCount)
+	return resp, nil
+}
+
+// processScanPage đọc một trang và lưu kết quả; chỉ trả lỗi khi không ghi được vào cơ sở dữ liệu.
+func (s *examService) processScanPage(ctx context.Context, exam *domain.ExamModel, scan *domain.AnswerSheetScanModel, page scanPage) error {
+	fail := func(msg string) error {
+		scan.Status = domain.ScanStatusFailed
+		scan.Error = msg
+		if err := s.repo.CreateAnswerSheetScan(ctx, scan); err != nil {
+			return status.Errorf(codes.Internal, "Lỗi lưu kết quả quét: %v", err)
+		}
+		return nil
+	}
+
+	sheet, code, err := readAnswerSheet(page.img)
+	if err != nil {
+		return fail(err.Error())
+	}
+	if code.examID != exam.Id {
+		return fail(fmt.Sprintf("Phiếu thuộc bài thi %d, không phải bài thi này", code.examID))
+	}
+	v, err := s.paperVersionFor(ctx, exam, code.studentID, code.version)
+	if err != nil {
+		return fail(fmt.Sprintf("Không dựng lại được mã đề %d: %v", code.version, err))
+	}
+	slots, _ := answerSheetSlots(v)
+	marks := readSheetMarks(sheet, slots)
+
+	scan.StudentID = code.studentID
+	scan.VersionCode = v.code
+	scan.Marks = domain.FormatScanMarks(marks)
+	scan.Confidence = 1
+	scan.Status = domain.ScanStatusGraded
+	for _, m := range marks {
+		scan.Confidence = math.Min(scan.Confidence, m.Confidence)
+		if m.NeedsReview {
+			scan.Status = domain.ScanStatusNeedsReview
+		}
+	}
+	if err := s.repo.CreateAnswerSheetScan(ctx, scan); err != nil {
+		return status.Errorf(codes.Internal, "Lỗi lưu kết quả quét: %v", err)
+	}
+	if scan.Status == domain.ScanStatusGraded {
+		return s.gradeAnswerSheet(ctx, exam, scan, slots, marks)
+	}
+	return nil
+}
+
+// gradeAnswerSheet ghi đáp án đọc được thành bài làm và chấm bằng finalizeSubmission như khi nộp bài trực tuyến.
+// Quét lại phiếu của cùng học sinh sẽ chấm đè lên bài làm đã tạo từ lần quét trước.
+func (s *examService) gradeAnswerSheet(ctx context.Context, exam *domain.ExamModel, scan *domain.AnswerSheetScanModel, slots []sheetSlot, marks []domain.ScanMark) error {
+	answers := scanAnswers(marks, slots)
+	prevID, err := s.repo.GetGradedScanSubmissionID(ctx, exam.Id, scan.StudentID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Lỗi tìm bài làm từ lần quét trước: %v", err)
+	}
+	if scan.SubmissionID != nil {
+		prevID = *scan.SubmissionID
+	}
+
+	submission := &domain.ExamSubmissionModel{}
+	var result SubmissionResult
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if prevID > 0 {
+			if err := tx.WithContext(ctx).First(submission, prevID).Error; err != nil {
+				return err
+			}
+		} else {
+			var inProgressStatus domain.SubmissionStatusModel
+			if err := tx.WithContext(ctx).Where("status = ?", "in_progress").First(&inProgressStatus).Error; err != nil {
+				return errors.New("lỗi hệ thống: chưa cấu hình status in_progress")
+			}
+			submission = &domain.ExamSubmissionModel{
+				ExamID:              exam.Id,
+				UserID:              scan.StudentID,
+				StatusID:            inProgressStatus.Id,
+				StartedAt:           time.Now().UTC(),
+				QuestionVersions:    "{}",
+				PracticeQuestionIDs: "[]",
+			}
+			if _, err := s.repo.CreateSubmission(ctx, tx, submission); err != nil {
+				return err
+			}
+		}
+		questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, submission)
+		if err != nil {
+			return err
+		}
+		result, err = s.finalizeSubmission(ctx, tx, exam, submission, questions, qPointsMap, answers)
+		return err
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Lỗi chấm phiếu của học sinh %d: %v", scan.StudentID, err)
+	}
+
+	scan.SubmissionID = &submission.Id
+	scan.Score = result.Score
+	scan.Status = domain.ScanStatusGraded
+	if err := s.repo.SaveAnswerSheetScan(ctx, scan); err != nil {
+		return status.Errorf(codes.Internal, "Lỗi lưu kết quả quét: %v", err)
+	}
+
+	fullName, email := s.lookupUser(ctx, scan.StudentID)
+	s.publishExamSubmitted(exam, submission, result.Score, fullName, email)
+	return nil
+}
+
+// GetAnswerSheetScans trả về các phiếu đã quét của bài thi; lọc status = needs_review để lấy hàng đợi duyệt.
+func (s *examService) GetAnswerSheetScans(ctx context.Context, req *pb.GetAnswerSheetScansRequest) (*pb.GetAnswerSheetScansResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	scans, err := s.repo.GetAnswerSheetScans(ctx, req.ExamId, req.Status)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách phiếu quét: %v", err)
+	}
+	resp := &pb.GetAnswerSheetScansResponse{Scans: []*pb.AnswerSheetScan{}}
+	for _, scan := range scans {
+		resp.Scans = append(resp.Scans, scanToProto(scan))
+	}
+	return resp, nil
+}
+
+// ResolveAnswerSheetScan áp dụng phần sửa của giáo viên (các câu không sửa giữ kết quả đọc được) rồi chấm phiếu.
+func (s *examService) ResolveAnswerSheetScan(ctx context.Context, req *pb.ResolveAnswerSheetScanRequest) (*pb.ResolveAnswerSheetScanResponse, error) {
+	scan, err := s.repo.GetAnswerSheetScanByID(ctx, req.ScanId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "Không tìm thấy phiếu quét")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy phiếu quét: %v", err)
+	}
+	exam, err := s.getOwnedExam(ctx, scan.ExamID, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	if scan.Status == domain.ScanStatusFailed {
+		return nil, status.Error(codes.FailedPrecondition, "Phiếu không đọc được mã, hãy quét lại")
+	}
+
+	code, _ := strconv.Atoi(scan.VersionCode)
+	v, err := s.paperVersionFor(ctx, exam, scan.StudentID, code)
+	if err != nil {
+		return nil, err
+	}
+	slots, _ := answerSheetSlots(v)
+	choiceCount := make(map[int64]int, len(slots))
+	for _, sl := range slots {
+		choiceCount[sl.pq.question.Id] = len(sl.pq.choices)
+	}
+
+	corrections := make(map[int64][]string, len(req.Corrections))
+	for _, c := range req.Corrections {
+		n, ok := choiceCount[c.QuestionId]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Câu hỏi %d không có trên phiếu", c.QuestionId)
+		}
+		labels := []string{}
+		for _, label := range c.Marked {
+			label = strings.ToUpper(strings.TrimSpace(label))
+			if len(label) != 1 || label[0] < 'A' || int(label[0]-'A') >= n {
+				return nil, status.Errorf(codes.InvalidArgument, "Đáp án %q không hợp lệ cho câu hỏi %d", label, c.QuestionId)
+			}
+			labels = append(labels, label)
+		}
+		corrections[c.QuestionId] = labels
+	}
+
+	marks := domain.ParseScanMarks(scan.Marks)
+	for i := range marks {
+		if labels, ok := corrections[marks[i].QuestionID]; ok {
+			marks[i].Marked = labels
+			marks[i].Confidence = 1
+		}
+		marks[i].NeedsReview = false
+	}
+	now := time.Now().UTC()
+	scan.Marks = domain.FormatScanMarks(marks)
+	scan.ReviewedBy = req.InstructorId
+	scan.ReviewedAt = &now
+
+	if err := s.gradeAnswerSheet(ctx, exam, scan, slots, marks); err != nil {
+		return nil, err
+	}
+	return &pb.ResolveAnswerSheetScanResponse{Scan: scanToProto(scan)}, nil
+}
+
+func scanToProto(scan *domain.AnswerSheetScanModel) *pb.AnswerSheetScan {
+	res := &pb.AnswerSheetScan{
+		Id:          scan.Id,
+		ExamId:      scan.ExamID,
+		StudentId:   scan.StudentID,
+		VersionCode: scan.VersionCode,
+		FileName:    scan.FileName,
+		Page:        int32(scan.Page),
+		ImageUrl:    scan.ImageURL,
+		Status:      scan.Status,
+		Confidence:  float32(scan.Confidence),
+		Marks:       []*pb.ScanMark{},
+		Error:       scan.Error,
+		Score:       float32(scan.Score),
+		CreatedAt:   scan.CreatedAt.Format(time.RFC3339),
+	}
+	if scan.SubmissionID != nil {
+		res.SubmissionId = *scan.SubmissionID
+	}
+	for _, m := range domain.ParseScanMarks(scan.Marks) {
+		mark := &pb.ScanMark{
+			QuestionId:  m.QuestionID,
+			Number:      int32(m.Number),
+			Marked:      m.Marked,
+			Confidence:  float32(m.Confidence),
+			NeedsReview: m.NeedsReview,
+			Reason:      m.Reason,
+		}
+		for _, r := range m.FillRatios {
+			mark.FillRatios = append(mark.FillRatios, float32(r))
+		}
+		res.Marks = append(res.Marks, mark)
+	}
+	return res
+}
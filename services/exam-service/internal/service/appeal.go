@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/contracts"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const scoreAppealsTopic = "score_appeals"
+
+func (s *examService) CreateAppeal(ctx context.Context, req *pb.CreateAppealRequest) (*pb.CreateAppealResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "Vui lòng nhập lý do phúc khảo")
+	}
+
+	submission, err := s.repo.GetSubmissionByID(ctx, req.SubmissionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài nộp: %v", err)
+	}
+	if submission.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền phúc khảo bài nộp này")
+	}
+	if submission.Status.Status != "completed" {
+		return nil, status.Error(codes.FailedPrecondition, "Chỉ có thể phúc khảo bài đã nộp")
+	}
+
+	exam, err := s.repo.GetExamDetails(ctx, submission.ExamID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài thi: %v", err)
+	}
+	questions, _, err := s.getSubmissionQuestions(ctx, exam, submission)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách câu hỏi: %v", err)
+	}
+	if !containsQuestion(questions, req.QuestionId) {
+		return nil, status.Error(codes.InvalidArgument, "Câu hỏi không thuộc bài thi này")
+	}
+
+	existing, err := s.repo.GetAppealsByUser(ctx, req.UserId, submission.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi kiểm tra đơn phúc khảo: %v", err)
+	}
+	for _, a := range existing {
+		if a.QuestionID == req.QuestionId {
+			return nil, status.Error(codes.AlreadyExists, "Bạn đã gửi phúc khảo cho câu hỏi này")
+		}
+	}
+
+	appeal := &domain.AppealModel{
+		SubmissionID: submission.Id,
+		QuestionID:   req.QuestionId,
+		ExamID:       exam.Id,
+		UserID:       req.UserId,
+		Reason:       reason,
+		Status:       domain.AppealStatusOpen,
+		OldScore:     submission.Score,
+	}
+	if err := s.repo.CreateAppeal(ctx, appeal); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi tạo đơn phúc khảo: %v", err)
+	}
+
+	log.Printf("📝 Học sinh %d phúc khảo câu %d của bài nộp %d", req.UserId, req.QuestionId, submission.Id)
+	go s.notifyAppeal(context.Background(), exam, appeal, exam.CreatorID, "APPEAL_CREATED",
+		fmt.Sprintf("Có đơn phúc khảo mới cho bài thi \"%s\"", exam.Title))
+
+	appeal.Exam = exam
+	for _, q := range questions {
+		if q.Id == appeal.QuestionID {
+			appeal.Question = *q
+		}
+	}
+	return &pb.CreateAppealResponse{Appeal: appealToProto(appeal)}, nil
+}
+
+func (s *examService) GetAppealQueue(ctx context.Context, req *pb.GetAppealQueueRequest) (*pb.GetAppealQueueResponse, error) {
+	page, limit := int(req.Page), int(req.Limit)
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	statusFilter := req.Status
+	if statusFilter == "" {
+		statusFilter = domain.AppealStatusOpen
+	} else if statusFilter == "all" {
+		statusFilter = ""
+	}
+
+	appeals, total, err := s.repo.GetAppealQueue(ctx, req.InstructorId, req.ExamId, statusFilter, page, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách phúc khảo: %v", err)
+	}
+
+	resp := &pb.GetAppealQueueResponse{Appeals: []*pb.ScoreAppeal{}, Total: total, Page: int32(page), Limit: int32(limit)}
+	for _, a := range appeals {
+		resp.Appeals = append(resp.Appeals, appealToProto(a))
+	}
+	return resp, nil
+}
+
+func (s *examService) GetMyAppeals(ctx context.Context, req *pb.GetMyAppealsRequest) (*pb.GetMyAppealsResponse, error) {
+	appeals, err := s.repo.GetAppealsByUser(ctx, req.UserId, req.SubmissionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách phúc khảo: %v", err)
+	}
+
+	resp := &pb.GetMyAppealsResponse{Appeals: []*pb.ScoreAppeal{}}
+	for _, a := range appeals {
+		resp.Appeals = append(resp.Appeals, appealToProto(a))
+	}
+	return resp, nil
+}
+
+func (s *examService) ResolveAppeal(ctx context.Context, req *pb.ResolveAppealRequest) (*pb.ResolveAppealResponse, error) {
+	if req.Status != domain.AppealStatusAccepted && req.Status != domain.AppealStatusRejected {
+		return nil, status.Errorf(codes.InvalidArgument, "Trạng thái phúc khảo %q không hợp lệ", req.Status)
+	}
+
+	appeal, err := s.repo.GetAppealByID(ctx, nil, req.AppealId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy đơn phúc khảo: %v", err)
+	}
+	exam, err := s.repo.GetExamDetails(ctx, appeal.ExamID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài thi: %v", err)
+	}
+	if req.InstructorId <= 0 || exam.CreatorID != req.InstructorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền xử lý đơn phúc khảo này")
+	}
+
+	submission, err := s.repo.GetSubmissionByID(ctx, appeal.SubmissionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài nộp: %v", err)
+	}
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, submission)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách câu hỏi: %v", err)
+	}
+
+	if req.Status == domain.AppealStatusAccepted && s.usesDoubleMarking(ctx, exam.Id) {
+		for _, q := range questions {
+			if q.Id == appeal.QuestionID && q.Type.Type == domain.QuestionTypeEssay {
+				return nil, status.Error(codes.FailedPrecondition, "Bài thi dùng chấm hai vòng, phúc khảo câu tự luận phải được chấm lại qua phân công chấm")
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	updates := map[string]interface{}{
+		"status":      req.Status,
+		"response":    strings.TrimSpace(req.Response),
+		"resolved_by": req.InstructorId,
+		"resolved_at": now,
+	}
+
+	qPts := 1.0
+	if pts, ok := qPointsMap[appeal.QuestionID]; ok && pts > 0 {
+		qPts = pts
+	}
+	points, newScore := qPts, submission.Score
+	if req.Status == domain.AppealStatusAccepted && req.AwardedPoints != nil {
+		points = math.Max(0, math.Min(float64(*req.AwardedPoints), qPts))
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		locked, err := s.repo.GetAppealByID(ctx, tx, appeal.Id)
+		if err != nil {
+			return err
+		}
+		if locked.Status != domain.AppealStatusOpen {
+			return status.Error(codes.FailedPrecondition, "Đơn phúc khảo đã được xử lý")
+		}
+		if req.Status == domain.AppealStatusAccepted {
+			// Khóa bài nộp và đọc lại câu trả lời trong giao dịch để không ghi đè điểm của phúc khảo hay lần chấm khác chạy song song.
+			if err := s.repo.LockSubmission(ctx, tx, submission.Id); err != nil {
+				return err
+			}
+			rows, err := s.repo.GetSubmissionAnswers(ctx, tx, submission.Id)
+			if err != nil {
+				return err
+			}
+			answers := groupUserAnswers(rows)
+			ans := answers[appeal.QuestionID]
+			ans.OverridePoints = &points
+			answers[appeal.QuestionID] = ans
+			engine, err := s.scoringEngineFor(ctx, exam)
+			if err != nil {
+				return err
+			}
+			newScore = engine.ScoreSubmission(questions, qPointsMap, answers).Score
+
+			updates["awarded_points"] = points
+			updates["new_score"] = newScore
+		}
+		if err := s.repo.UpdateAppeal(ctx, tx, appeal.Id, updates); err != nil {
+			return err
+		}
+		if req.Status != domain.AppealStatusAccepted {
+			return nil
+		}
+		if err := s.repo.OverrideAnswerPoints(ctx, tx, submission.Id, appeal.QuestionID, points, points >= qPts); err != nil {
+			return err
+		}
+		return s.repo.UpdateSubmissionScore(ctx, tx, submission.Id, newScore)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "Lỗi xử lý đơn phúc khảo: %v", err)
+	}
+
+	appeal, err = s.repo.GetAppealByID(ctx, nil, appeal.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy đơn phúc khảo: %v", err)
+	}
+	appeal.Exam = exam
+	for _, q := range questions {
+		if q.Id == appeal.QuestionID {
+			appeal.Question = *q
+		}
+	}
+
+	log.Printf("⚖️ Đơn phúc khảo %d đã được %s bởi giáo viên %d", appeal.Id, req.Status, req.InstructorId)
+	message := fmt.Sprintf("Đơn phúc khảo bài thi \"%s\" đã bị từ chối", exam.Title)
+	if req.Status == domain.AppealStatusAccepted {
+		message = fmt.Sprintf("Đơn phúc khảo bài thi \"%s\" đã được chấp nhận: %.2f → %.2f", exam.Title, appeal.OldScore, newScore)
+	}
+	go s.notifyAppeal(context.Background(), exam, appeal, appeal.UserID, "APPEAL_RESOLVED", message)
+
+	return &pb.ResolveAppealResponse{Appeal: appealToProto(appeal)}, nil
+}
+
+// notifyAppeal báo cho người nhận (giáo viên khi có đơn mới, học sinh khi đơn được xử lý) qua Kafka và Redis.
+func (s *examService) notifyAppeal(ctx context.Context, exam *domain.ExamModel, appeal *domain.AppealModel, recipientID int64, notifType, message string) {
+	event := "created"
+	if appeal.Status != domain.AppealStatusOpen {
+		event = "resolved"
+	}
+
+	fullName, email := s.lookupUser(ctx, recipientID)
+	payload := contracts.ScoreAppealEvent{
+		AppealID:     appeal.Id,
+		Event:        event,
+		RecipientID:  recipientID,
+		Email:        email,
+		FullName:     fullName,
+		ExamID:       exam.Id,
+		ExamTitle:    exam.Title,
+		SubmissionID: appeal.SubmissionID,
+		QuestionID:   appeal.QuestionID,
+		Reason:       appeal.Reason,
+		Status:       appeal.Status,
+		Response:     appeal.Response,
+		OldScore:     appeal.OldScore,
+		NewScore:     appeal.OldScore,
+	}
+	if appeal.NewScore != nil {
+		payload.NewScore = *appeal.NewScore
+	}
+	eventBytes, _ := json.Marshal(payload)
+	if err := s.producer.Produce(scoreAppealsTopic, []byte(strconv.FormatInt(appeal.Id, 10)), eventBytes); err != nil {
+		log.Printf("❌ Lỗi gửi sự kiện phúc khảo %d: %v", appeal.Id, err)
+	}
+
+	if database.RedisClient != nil {
+		msg := map[string]interface{}{
+			"type":          notifType,
+			"appeal_id":     appeal.Id,
+			"exam_id":       exam.Id,
+			"submission_id": appeal.SubmissionID,
+			"status":        appeal.Status,
+			"score":         payload.NewScore,
+			"message":       message,
+			"timestamp":     time.Now().UTC().Format(time.RFC3339),
+		}
+		jsonMsg, _ := json.Marshal(msg)
+		database.RedisClient.Publish(ctx, fmt.Sprintf("notifications:%d", recipientID), string(jsonMsg))
+	}
+}
+
+func appealToProto(a *domain.AppealModel) *pb.ScoreAppeal {
+	res := &pb.ScoreAppeal{
+		Id:              a.Id,
+		SubmissionId:    a.SubmissionID,
+		ExamId:          a.ExamID,
+		QuestionId:      a.QuestionID,
+		UserId:          a.UserID,
+		Reason:          a.Reason,
+		Status:          a.Status,
+		Response:        a.Response,
+		OldScore:        float32(a.OldScore),
+		ResolvedBy:      a.ResolvedBy,
+		CreatedAt:       a.CreatedAt.Format(time.RFC3339),
+		QuestionContent: a.Question.Content,
+	}
+	if a.Exam != nil {
+		res.ExamTitle = a.Exam.Title
+	}
+	if a.AwardedPoints != nil {
+		pts := float32(*a.AwardedPoints)
+		res.AwardedPoints = &pts
+	}
+	if a.NewScore != nil {
+		score := float32(*a.NewScore)
+		res.NewScore = &score
+	}
+	if a.ResolvedAt != nil {
+		res.ResolvedAt = a.ResolvedAt.Format(time.RFC3339)
+	}
+	return res
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pbUser "github.com/06babyshark06/JQKStudy/shared/proto/user"
+	"gorm.io/gorm"
+)
+
+const (
+	autoSubmitGracePeriod = 30 * time.Second
+	autoSubmitBatchSize   = 100
+
+	autoSubmitReasonTimeout    = "timeout"
+	autoSubmitReasonInstructor = "instructor"
+	autoSubmitReasonSuspicion  = "suspicion"
+)
+
+// AutoSubmitExpiredSubmissions nộp bài cho các lượt thi in_progress đã quá thời gian làm bài
+// hoặc quá EndTime của đề. Mỗi bài được khóa bằng FOR UPDATE SKIP LOCKED nên nhiều replica
+// có thể chạy song song mà không chấm trùng.
+func (s *examService) AutoSubmitExpiredSubmissions(ctx context.Context) (int, error) {
+	ids, err := s.repo.GetExpiredSubmissionIDs(ctx, autoSubmitGracePeriod, autoSubmitBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, id := range ids {
+		submitted, err := s.autoSubmitSubmission(ctx, id, autoSubmitReasonTimeout)
+		if err != nil {
+			log.Printf("❌ Lỗi tự động nộp bài %d: %v", id, err)
+			continue
+		}
+		if submitted {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// autoSubmitSubmission nộp bài với các đáp án đã lưu; reason cho biết bài bị nộp do hết giờ, giám thị thu bài
+// hay vượt ngưỡng nghi vấn.
+func (s *examService) autoSubmitSubmission(ctx context.Context, submissionID int64, reason string) (bool, error) {
+	var submission *domain.ExamSubmissionModel
+	var exam *domain.ExamModel
+	var result SubmissionResult
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		submission, err = s.repo.LockInProgressSubmission(ctx, tx, submissionID)
+		if err != nil {
+			return err
+		}
+
+		exam, err = s.repo.GetExamDetails(ctx, submission.ExamID)
+		if err != nil {
+			return err
+		}
+
+		questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, submission)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.finalizeSubmission(ctx, tx, exam, submission, questions, qPointsMap, groupUserAnswers(submission.UserAnswers))
+		return err
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if database.RedisClient != nil {
+		sessionKey := fmt.Sprintf("exam:%d:user:%d:session_lock", submission.ExamID, submission.UserID)
+		database.RedisClient.Del(ctx, sessionKey)
+	}
+
+	fullName, email := s.lookupUser(ctx, submission.UserID)
+	s.publishExamSubmitted(exam, submission, result.Score, fullName, email)
+	go s.checkSubmissionSimilarity(context.Background(), submission.ExamID, submission.Id)
+
+	msgType := "EXAM_AUTO_SUBMITTED"
+	message := fmt.Sprintf("Bài thi \"%s\" đã được tự động nộp do hết thời gian", exam.Title)
+	switch reason {
+	case autoSubmitReasonInstructor:
+		msgType = "EXAM_FORCE_SUBMITTED"
+		message = fmt.Sprintf("Bài thi \"%s\" đã được giám thị thu bài", exam.Title)
+	case autoSubmitReasonSuspicion:
+		msgType = "EXAM_FORCE_SUBMITTED"
+		message = fmt.Sprintf("Bài thi \"%s\" đã bị tự động nộp do phát hiện nhiều hành vi bất thường", exam.Title)
+	}
+
+	if database.RedisClient != nil {
+		msg := map[string]interface{}{
+			"type":          msgType,
+			"exam_id":       submission.ExamID,
+			"submission_id": submission.Id,
+			"score":         result.Score,
+			"message":       message,
+			"timestamp":     time.Now().UTC().Format(time.RFC3339),
+		}
+		jsonMsg, _ := json.Marshal(msg)
+		database.RedisClient.Publish(ctx, fmt.Sprintf("notifications:%d", submission.UserID), string(jsonMsg))
+	}
+	s.publishSessionEvent(ctx, submission.ExamID, submission.UserID, msgType, map[string]interface{}{
+		"submission_id": submission.Id,
+		"message":       message,
+	})
+
+	log.Printf("⏰ Đã tự động nộp bài %d (exam %d, user %d, lý do: %s), điểm %.2f", submission.Id, submission.ExamID, submission.UserID, reason, result.Score)
+	return true, nil
+}
+
+func (s *examService) lookupUser(ctx context.Context, userID int64) (string, string) {
+	if s.userClient == nil {
+		return "", ""
+	}
+	profile, err := s.userClient.GetProfile(ctx, &pbUser.GetProfileRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Cảnh báo: Không lấy được thông tin user %d: %v", userID, err)
+		return "", ""
+	}
+	return profile.FullName, profile.Email
+}
@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/env"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blueprintSimulationTrials là số lần rút thử khi xem trước độ dài đề.
+const blueprintSimulationTrials = 200
+
+const (
+	blueprintIssueInvalidCount        = "invalid_count"
+	blueprintIssueInvalidPoints       = "invalid_points"
+	blueprintIssueUnknownDifficulty   = "unknown_difficulty"
+	blueprintIssueUnknownSection      = "unknown_section"
+	blueprintIssueSectionOutsideTopic = "section_outside_topic"
+	blueprintIssueDuplicateRule       = "duplicate_rule"
+	blueprintIssueInsufficientPool    = "insufficient_pool"
+	blueprintIssueOverlapShortfall    = "overlap_shortfall"
+	blueprintIssueLowCoverage         = "low_coverage"
+	blueprintIssueEmpty               = "empty_blueprint"
+)
+
+// blueprintCoverageFactor trả về hệ số phủ N: ngân hàng của mỗi quy tắc cần ít nhất N lần số câu yêu cầu.
+func blueprintCoverageFactor(requested int32) int {
+	if requested > 0 {
+		return int(requested)
+	}
+	return env.GetInt("EXAM_BLUEPRINT_COVERAGE", 2)
+}
+
+func blueprintFromProto(configs []*pb.SectionConfig) domain.Blueprint {
+	rules := make(domain.Blueprint, 0, len(configs))
+	for _, cfg := range configs {
+		rules = append(rules, domain.BlueprintRule{
+			SectionID:  cfg.SectionId,
+			Count:      int(cfg.Count),
+			Difficulty: cfg.Difficulty,
+			Points:     float64(cfg.Points),
+		})
+	}
+	return rules
+}
+
+func blueprintToProto(rules domain.Blueprint) []*pb.SectionConfig {
+	configs := make([]*pb.SectionConfig, 0, len(rules))
+	for _, r := range rules {
+		configs = append(configs, &pb.SectionConfig{
+			SectionId:  r.SectionID,
+			Count:      int32(r.Count),
+			Difficulty: r.Difficulty,
+			Points:     float32(r.Points),
+		})
+	}
+	return configs
+}
+
+// blueprintPools lấy ngân hàng câu hỏi của từng quy tắc theo đúng truy vấn dùng khi sinh đề.
+func (s *examService) blueprintPools(ctx context.Context, topicID int64, rules domain.Blueprint) ([][]int64, error) {
+	pools := make([][]int64, len(rules))
+	for i, r := range rules {
+		ids, err := s.repo.GetQuestionIDsForSection(ctx, r.SectionID, r.Difficulty, topicID)
+		if err != nil {
+			return nil, fmt.Errorf("lỗi khi kiểm tra ngân hàng câu hỏi: %v", err)
+		}
+		pools[i] = ids
+	}
+	return pools, nil
+}
+
+// drawBlueprint rút câu cho một đề theo blueprint. used chứa các câu đã có trong đề (câu cố định, câu của quy tắc trước)
+// và được cập nhật; short[i] là số câu quy tắc i còn thiếu.
+func drawBlueprint(rng *rand.Rand, used map[int64]bool, rules domain.Blueprint, pools [][]int64) ([]DynamicQuestion, []int) {
+	var picked []DynamicQuestion
+	short := make([]int, len(rules))
+	for i, r := range rules {
+		pool := append([]int64(nil), pools[i]...)
+		rng.Shuffle(len(pool), func(a, b int) { pool[a], pool[b] = pool[b], pool[a] })
+
+		collected := 0
+		for _, id := range pool {
+			if collected >= r.Count {
+				break
+			}
+			if used[id] {
+				continue
+			}
+			used[id] = true
+			picked = append(picked, DynamicQuestion{ID: id, Points: r.PointsPerQuestion()})
+			collected++
+		}
+		if collected < r.Count {
+			short[i] = r.Count - collected
+		}
+	}
+	return picked, short
+}
+
+func blueprintIssue(rule int, severity, code, format string, args ...interface{}) *pb.BlueprintIssue {
+	return &pb.BlueprintIssue{Rule: int32(rule), Severity: severity, Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func blueprintRuleLabel(report *pb.BlueprintRuleReport) string {
+	sectionName := "Tất cả chương"
+	if report.SectionId > 0 {
+		sectionName = fmt.Sprintf("chương '%s'", firstNonEmpty(report.SectionName, fmt.Sprint(report.SectionId)))
+	}
+	diffLabel := report.Difficulty
+	if diffLabel == "all" || diffLabel == "" {
+		diffLabel = "tất cả độ khó"
+	}
+	return fmt.Sprintf("%s, %s", sectionName, diffLabel)
+}
+
+// analyzeBlueprint kiểm tra blueprint của đề sinh động: lược đồ từng quy tắc, độ phủ ngân hàng so với hệ số factor,
+// phần giao giữa các quy tắc và số câu chắc chắn rút được trong trường hợp xấu nhất. trials > 0 thì rút thử để ước
+// lượng độ dài đề học sinh thực nhận.
+func (s *examService) analyzeBlueprint(ctx context.Context, topicID int64, rules domain.Blueprint, fixed []DynamicQuestion, factor, trials int) (*pb.ValidateExamBlueprintResponse, error) {
+	resp := &pb.ValidateExamBlueprintResponse{CoverageFactor: int32(factor)}
+
+	fixedSet := make(map[int64]bool)
+	fixedPoints := 0.0
+	for _, q := range fixed {
+		if !fixedSet[q.ID] {
+			fixedSet[q.ID] = true
+			resp.FixedCount++
+			fixedPoints += q.Points
+		}
+	}
+	resp.DesignedPoints = fixedPoints
+	if len(rules) == 0 && len(fixedSet) == 0 {
+		resp.Issues = append(resp.Issues, blueprintIssue(0, domain.BlueprintSeverityWarning, blueprintIssueEmpty,
+			"Đề sinh động chưa có quy tắc rút câu hay câu hỏi cố định nào"))
+	}
+
+	type ruleKey struct {
+		sectionID  int64
+		difficulty string
+	}
+	seen := make(map[ruleKey]int)
+	pools := make([][]int64, len(rules))
+	checked := make([]bool, len(rules))
+	for i, r := range rules {
+		report := &pb.BlueprintRuleReport{
+			Index: int32(i + 1), SectionId: r.SectionID, Difficulty: r.Difficulty,
+			Count: int32(r.Count), Points: float32(r.PointsPerQuestion()),
+		}
+		resp.Rules = append(resp.Rules, report)
+		resp.DesignedPoints += float64(r.Count) * r.PointsPerQuestion()
+
+		ok := true
+		if r.Count <= 0 {
+			resp.Issues = append(resp.Issues, blueprintIssue(i+1, domain.BlueprintSeverityError, blueprintIssueInvalidCount,
+				"quy tắc %d: số câu phải lớn hơn 0", i+1))
+			ok = false
+		}
+		if r.Points < 0 {
+			resp.Issues = append(resp.Issues, blueprintIssue(i+1, domain.BlueprintSeverityError, blueprintIssueInvalidPoints,
+				"quy tắc %d: điểm mỗi câu không được âm", i+1))
+			ok = false
+		}
+		if !r.AnyDifficulty() {
+			if _, err := s.repo.GetDifficulty(ctx, r.Difficulty); err != nil {
+				resp.Issues = append(resp.Issues, blueprintIssue(i+1, domain.BlueprintSeverityError, blueprintIssueUnknownDifficulty,
+					"quy tắc %d: không có độ khó '%s'", i+1, r.Difficulty))
+				ok = false
+			}
+		}
+		if r.SectionID > 0 {
+			sec, err := s.repo.GetSectionByID(ctx, r.SectionID)
+			if err != nil || sec == nil {
+				resp.Issues = append(resp.Issues, blueprintIssue(i+1, domain.BlueprintSeverityError, blueprintIssueUnknownSection,
+					"quy tắc %d: không tìm thấy chương %d", i+1, r.SectionID))
+				ok = false
+			} else {
+				report.SectionName = sec.Name
+				if topicID > 0 && sec.TopicID != topicID {
+					resp.Issues = append(resp.Issues, blueprintIssue(i+1, domain.BlueprintSeverityError, blueprintIssueSectionOutsideTopic,
+						"quy tắc %d: chương '%s' không thuộc chủ đề của đề thi", i+1, sec.Name))
+					ok = false
+				}
+			}
+		}
+
+		key := ruleKey{r.SectionID, r.Difficulty}
+		if r.AnyDifficulty() {
+			key.difficulty = ""
+		}
+		if prev, dup := seen[key]; dup {
+			resp.Issues = append(resp.Issues, blueprintIssue(i+1, domain.BlueprintSeverityWarning, blueprintIssueDuplicateRule,
+				"quy tắc %d trùng điều kiện với quy tắc %d, nên gộp lại", i+1, prev))
+		} else {
+			seen[key] = i + 1
+		}
+
+		if !ok {
+			continue
+		}
+		checked[i] = true
+		ids, err := s.repo.GetQuestionIDsForSection(ctx, r.SectionID, r.Difficulty, topicID)
+		if err != nil {
+			return nil, fmt.Errorf("lỗi khi kiểm tra ngân hàng câu hỏi: %v", err)
+		}
+		for _, id := range ids {
+			if !fixedSet[id] {
+				pools[i] = append(pools[i], id)
+			}
+		}
+		report.PoolSize = int32(len(pools[i]))
+		if r.Count > 0 {
+			report.Coverage = float64(len(pools[i])) / float64(r.Count)
+		}
+	}
+
+	members := make([]map[int64]bool, len(rules))
+	for i, pool := range pools {
+		members[i] = make(map[int64]bool, len(pool))
+		for _, id := range pool {
+			members[i][id] = true
+		}
+	}
+	for j := range rules {
+		report := resp.Rules[j]
+		// Trường hợp xấu nhất: mỗi quy tắc trước đã lấy hết phần giao với quy tắc j (tối đa số câu của nó).
+		guaranteed := len(pools[j])
+		for i := 0; i < j; i++ {
+			shared := 0
+			for _, id := range pools[j] {
+				if members[i][id] {
+					shared++
+				}
+			}
+			if shared == 0 {
+				continue
+			}
+			resp.Overlaps = append(resp.Overlaps, &pb.BlueprintOverlap{RuleA: int32(i + 1), RuleB: int32(j + 1), Shared: int32(shared)})
+			guaranteed -= min(rules[i].Count, shared)
+		}
+		report.Guaranteed = int32(max(0, min(guaranteed, rules[j].Count)))
+
+		if !checked[j] {
+			continue
+		}
+		switch {
+		case len(pools[j]) < rules[j].Count:
+			resp.Issues = append(resp.Issues, blueprintIssue(j+1, domain.BlueprintSeverityError, blueprintIssueInsufficientPool,
+				"quy tắc %d: Không đủ câu hỏi trong ngân hàng. Yêu cầu %d câu (%s), nhưng chỉ có %d câu.",
+				j+1, rules[j].Count, blueprintRuleLabel(report), len(pools[j])))
+		case int(report.Guaranteed) < rules[j].Count:
+			resp.Issues = append(resp.Issues, blueprintIssue(j+1, domain.BlueprintSeverityError, blueprintIssueOverlapShortfall,
+				"quy tắc %d: ngân hàng giao với các quy tắc trước nên có thể chỉ rút được %d/%d câu (%s)",
+				j+1, report.Guaranteed, rules[j].Count, blueprintRuleLabel(report)))
+		case report.Coverage < float64(factor):
+			resp.Issues = append(resp.Issues, blueprintIssue(j+1, domain.BlueprintSeverityWarning, blueprintIssueLowCoverage,
+				"quy tắc %d: ngân hàng chỉ có %d câu cho %d câu yêu cầu (%s), chưa đạt %d lần nên đề của học sinh sẽ dễ trùng nhau",
+				j+1, len(pools[j]), rules[j].Count, blueprintRuleLabel(report), factor))
+		}
+	}
+
+	resp.DesignedLength = resp.FixedCount + int32(rules.QuestionCount())
+	if trials > 0 {
+		simulateBlueprint(resp, rules, pools, fixed, fixedPoints, trials)
+	}
+	resp.Valid = !hasBlueprintError(resp.Issues)
+	return resp, nil
+}
+
+// simulateBlueprint rút thử trials đề với seed cố định để kết quả xem trước ổn định giữa các lần gọi.
+func simulateBlueprint(resp *pb.ValidateExamBlueprintResponse, rules domain.Blueprint, pools [][]int64, fixed []DynamicQuestion, fixedPoints float64, trials int) {
+	rng := rand.New(rand.NewSource(1))
+
+	var totalLength, totalPoints float64
+	short := 0
+	resp.MinLength = -1
+	for t := 0; t < trials; t++ {
+		used := make(map[int64]bool, len(fixed))
+		for _, q := range fixed {
+			used[q.ID] = true
+		}
+		picked, _ := drawBlueprint(rng, used, rules, pools)
+		length := resp.FixedCount + int32(len(picked))
+		points := fixedPoints
+		for _, q := range picked {
+			points += q.Points
+		}
+
+		totalLength += float64(length)
+		totalPoints += points
+		if length < resp.DesignedLength {
+			short++
+		}
+		if resp.MinLength < 0 || length < resp.MinLength {
+			resp.MinLength = length
+		}
+		if length > resp.MaxLength {
+			resp.MaxLength = length
+		}
+	}
+	resp.ExpectedLength = totalLength / float64(trials)
+	resp.ExpectedPoints = totalPoints / float64(trials)
+	resp.ShortProbability = float64(short) / float64(trials)
+}
+
+func hasBlueprintError(issues []*pb.BlueprintIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == domain.BlueprintSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// checkBlueprint chặn lưu hoặc xuất bản đề sinh động có blueprint lỗi. Khi xuất bản (strict) thì độ phủ dưới N lần
+// và blueprint rỗng cũng là lỗi; lúc soạn đề chúng chỉ được ghi log cảnh báo.
+func (s *examService) checkBlueprint(ctx context.Context, topicID int64, rules domain.Blueprint, fixed []DynamicQuestion, strict bool) error {
+	report, err := s.analyzeBlueprint(ctx, topicID, rules, fixed, blueprintCoverageFactor(0), 0)
+	if err != nil {
+		return err
+	}
+	var problems []string
+	for _, issue := range report.Issues {
+		blocking := issue.Severity == domain.BlueprintSeverityError
+		if strict && (issue.Code == blueprintIssueLowCoverage || issue.Code == blueprintIssueEmpty) {
+			blocking = true
+		}
+		if blocking {
+			problems = append(problems, issue.Message)
+		} else {
+			log.Printf("⚠️ Blueprint: %s", issue.Message)
+		}
+	}
+	if len(problems) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "Cấu hình sinh đề chưa hợp lệ: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func (s *examService) ValidateExamBlueprint(ctx context.Context, req *pb.ValidateExamBlueprintRequest) (*pb.ValidateExamBlueprintResponse, error) {
+	topicID := req.TopicId
+	rules := blueprintFromProto(req.SectionConfigs)
+	var fixed []DynamicQuestion
+
+	if req.ExamId > 0 {
+		exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+		if err != nil {
+			return nil, err
+		}
+		if topicID == 0 {
+			topicID = exam.TopicID
+		}
+		if len(req.SectionConfigs) == 0 {
+			rules, err = domain.ParseBlueprint(exam.DynamicConfig)
+			if err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+		}
+		if len(req.FixedQuestionIds) == 0 {
+			for _, q := range exam.Questions {
+				fixed = append(fixed, DynamicQuestion{ID: q.Id, Points: q.Points})
+			}
+		}
+	}
+	// Câu cố định chỉ truyền mã câu thì tính 1 điểm mỗi câu.
+	for _, id := range req.FixedQuestionIds {
+		fixed = append(fixed, DynamicQuestion{ID: id, Points: 1})
+	}
+
+	return s.analyzeBlueprint(ctx, topicID, rules, fixed, blueprintCoverageFactor(req.CoverageFactor), blueprintSimulationTrials)
+}
+
+// checkPublishableBlueprint kiểm tra blueprint của đề sinh động trước khi xuất bản.
+func (s *examService) checkPublishableBlueprint(ctx context.Context, examID int64) error {
+	exam, err := s.repo.GetExamDetails(ctx, examID)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if !exam.IsDynamic {
+		return nil
+	}
+	rules, err := domain.ParseBlueprint(exam.DynamicConfig)
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	var fixed []DynamicQuestion
+	for _, q := range exam.Questions {
+		fixed = append(fixed, DynamicQuestion{ID: q.Id, Points: q.Points})
+	}
+	return s.checkBlueprint(ctx, exam.TopicID, rules, fixed, true)
+}
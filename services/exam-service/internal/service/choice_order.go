@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	"github.com/xuri/excelize/v2"
+)
+
+// choiceShuffleSeed sinh seed cố định cho một lượt làm bài nên tính lại luôn ra cùng thứ tự.
+func choiceShuffleSeed(examID, userID, submissionID int64) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d:%d:%d", examID, userID, submissionID)
+	return int64(h.Sum64() >> 1)
+}
+
+// shufflesChoices cho biết lựa chọn của câu hỏi có được xáo không. Câu sắp xếp luôn được xáo để không lộ thứ tự đúng.
+func shufflesChoices(exam *domain.ExamModel, qType string) bool {
+	switch qType {
+	case domain.QuestionTypeOrdering:
+		return true
+	case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice, domain.QuestionTypeMatching, "":
+		return exam.ShuffleChoices
+	}
+	return false
+}
+
+// shuffledChoiceOrder xáo các lựa chọn theo seed của lượt làm bài và mã câu hỏi.
+// Lựa chọn được ghim giữ nguyên thứ tự tương đối và luôn nằm cuối.
+func shuffledChoiceOrder(seed, questionID int64, ids []int64, pinned map[int64]bool) []int64 {
+	sorted := append([]int64(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var free, last []int64
+	for _, id := range sorted {
+		if pinned[id] {
+			last = append(last, id)
+		} else {
+			free = append(free, id)
+		}
+	}
+	rng := rand.New(rand.NewSource(seed ^ questionID*2654435761))
+	rng.Shuffle(len(free), func(i, j int) { free[i], free[j] = free[j], free[i] })
+	return append(free, last...)
+}
+
+// choiceRank trả về vị trí của lựa chọn theo thứ tự đã lưu; lựa chọn thêm sau khi xáo đứng sau cùng.
+func choiceRank(order []int64) func(id int64) int {
+	index := make(map[int64]int, len(order))
+	for i, id := range order {
+		index[id] = i
+	}
+	return func(id int64) int {
+		if i, ok := index[id]; ok {
+			return i
+		}
+		return len(order)
+	}
+}
+
+// ensureChoiceOrders trả về thứ tự lựa chọn của lượt làm bài, sinh và lưu thêm thứ tự cho các câu chưa có.
+// Thứ tự đã lưu không đổi khi tải lại trang, kể cả khi giáo viên sửa câu hỏi giữa chừng.
+func (s *examService) ensureChoiceOrders(ctx context.Context, exam *domain.ExamModel, sub *domain.ExamSubmissionModel, questions []*pb.QuestionDetails) map[int64][]int64 {
+	record, err := s.repo.GetSubmissionChoiceOrder(ctx, sub.Id)
+	if err != nil {
+		record = &domain.SubmissionChoiceOrderModel{
+			SubmissionID: sub.Id,
+			ExamID:       exam.Id,
+			UserID:       sub.UserID,
+			Seed:         choiceShuffleSeed(exam.Id, sub.UserID, sub.Id),
+		}
+	}
+	orders := domain.ParseChoiceOrders(record.Orders)
+
+	changed := false
+	for _, q := range questions {
+		if q == nil || len(q.Choices) < 2 || !shufflesChoices(exam, q.QuestionType) {
+			continue
+		}
+		if _, ok := orders[q.Id]; ok {
+			continue
+		}
+		ids := make([]int64, 0, len(q.Choices))
+		pinned := make(map[int64]bool)
+		for _, c := range q.Choices {
+			ids = append(ids, c.Id)
+			if c.PinLast && q.QuestionType != domain.QuestionTypeOrdering {
+				pinned[c.Id] = true
+			}
+		}
+		orders[q.Id] = shuffledChoiceOrder(record.Seed, q.Id, ids, pinned)
+		changed = true
+	}
+
+	if changed {
+		record.Orders = domain.FormatChoiceOrders(orders)
+		if err := s.repo.SaveSubmissionChoiceOrder(ctx, record); err != nil {
+			log.Printf("⚠️ Không lưu được thứ tự lựa chọn của bài %d: %v", sub.Id, err)
+		}
+	}
+	return orders
+}
+
+// applyChoiceOrder sắp xếp lựa chọn của câu hỏi gửi cho học sinh theo thứ tự đã lưu.
+func applyChoiceOrder(q *pb.QuestionDetails, order []int64) {
+	if len(order) == 0 {
+		return
+	}
+	rank := choiceRank(order)
+	sort.SliceStable(q.Choices, func(i, j int) bool { return rank(q.Choices[i].Id) < rank(q.Choices[j].Id) })
+}
+
+// submissionChoiceOrders đọc thứ tự lựa chọn đã lưu của bài làm; bài làm trước khi có tính năng xáo trả về rỗng.
+func (s *examService) submissionChoiceOrders(ctx context.Context, submissionID int64) map[int64][]int64 {
+	record, err := s.repo.GetSubmissionChoiceOrder(ctx, submissionID)
+	if err != nil {
+		return map[int64][]int64{}
+	}
+	return domain.ParseChoiceOrders(record.Orders)
+}
+
+// displayedChoices trả về lựa chọn theo thứ tự học sinh đã thấy.
+func displayedChoices(choices []domain.ChoiceModel, order []int64) []domain.ChoiceModel {
+	shown := orderedChoices(choices)
+	if len(order) > 0 {
+		rank := choiceRank(order)
+		sort.SliceStable(shown, func(i, j int) bool { return rank(shown[i].Id) < rank(shown[j].Id) })
+	}
+	return shown
+}
+
+// choiceLabels ánh xạ choice_id sang nhãn A/B/C/D theo thứ tự cho trước.
+func choiceLabels(choices []domain.ChoiceModel) map[int64]string {
+	labels := make(map[int64]string, len(choices))
+	for i, c := range choices {
+		labels[c.Id] = choiceLabel(i)
+	}
+	return labels
+}
+
+// joinChoiceLabels ghép nhãn của các lựa chọn đã chọn, ví dụ "A, C".
+func joinChoiceLabels(ids []int64, labels map[int64]string) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if l, ok := labels[id]; ok {
+			parts = append(parts, l)
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ", ")
+}
+
+// writeChoiceAnswersSheet ghi đáp án trắc nghiệm của từng bài làm theo cả nhãn học sinh đã thấy và nhãn gốc của đề,
+// để đối chiếu với phiếu hoặc khiếu nại khi lựa chọn đã bị xáo.
+func (s *examService) writeChoiceAnswersSheet(ctx context.Context, f *excelize.File, examID int64) error {
+	exam, err := s.repo.GetExamDetails(ctx, examID)
+	if err != nil {
+		return err
+	}
+	submissions, err := s.repo.GetExamSubmissionsWithAnswers(ctx, examID)
+	if err != nil {
+		return err
+	}
+	subIDs := make([]int64, 0, len(submissions))
+	for _, sub := range submissions {
+		subIDs = append(subIDs, sub.Id)
+	}
+	records, err := s.repo.GetSubmissionChoiceOrders(ctx, subIDs)
+	if err != nil {
+		return err
+	}
+	ordersBySub := make(map[int64]map[int64][]int64, len(records))
+	for _, r := range records {
+		ordersBySub[r.SubmissionID] = domain.ParseChoiceOrders(r.Orders)
+	}
+
+	sheet := "Choice Answers"
+	f.NewSheet(sheet)
+	headers := []string{"Submission ID", "User ID", "Question ID", "Question", "Chosen (as shown)", "Chosen (original)", "Correct (as shown)", "Correct (original)"}
+	for i, h := range headers {
+		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+		f.SetCellValue(sheet, cell, h)
+	}
+
+	row := 2
+	for _, sub := range submissions {
+		questions, _, err := s.getSubmissionQuestions(ctx, exam, sub)
+		if err != nil {
+			log.Printf("⚠️ Không lấy được câu hỏi của bài %d khi xuất đáp án: %v", sub.Id, err)
+			continue
+		}
+		chosen := make(map[int64][]int64)
+		for _, ua := range sub.UserAnswers {
+			if ua.ChosenChoiceID != nil {
+				chosen[ua.QuestionID] = append(chosen[ua.QuestionID], *ua.ChosenChoiceID)
+			}
+		}
+		for _, q := range questions {
+			switch q.Type.Type {
+			case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice, "":
+			default:
+				continue
+			}
+			original := choiceLabels(orderedChoices(q.Choices))
+			shown := choiceLabels(displayedChoices(q.Choices, ordersBySub[sub.Id][q.Id]))
+			var correct []int64
+			for _, c := range q.Choices {
+				if c.IsCorrect {
+					correct = append(correct, c.Id)
+				}
+			}
+			values := []interface{}{
+				sub.Id, sub.UserID, q.Id, stripHTML(q.Content),
+				joinChoiceLabels(chosen[q.Id], shown), joinChoiceLabels(chosen[q.Id], original),
+				joinChoiceLabels(correct, shown), joinChoiceLabels(correct, original),
+			}
+			for i, v := range values {
+				cell, _ := excelize.CoordinatesToCellName(i+1, row)
+				f.SetCellValue(sheet, cell, v)
+			}
+			row++
+		}
+	}
+	return nil
+}
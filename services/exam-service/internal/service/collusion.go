@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	defaultCollusionMinIdenticalWrong = 3
+	defaultCollusionZThreshold        = 3.0
+	defaultCollusionTimingWindow      = 60
+	defaultCollusionMaxPairs          = 50
+	maxCollusionEvidence              = 20
+)
+
+type collusionResponse struct {
+	key        string
+	correct    bool
+	answeredAt *time.Time
+}
+
+type collusionCandidate struct {
+	sub       *domain.ExamSubmissionModel
+	responses map[int64]collusionResponse
+}
+
+type collusionEvidence struct {
+	QuestionID   int64      `json:"question_id"`
+	Response     string     `json:"response"`
+	IsCorrect    bool       `json:"is_correct"`
+	ResponseRate float64    `json:"response_rate"`
+	AnsweredAtA  *time.Time `json:"answered_at_a"`
+	AnsweredAtB  *time.Time `json:"answered_at_b"`
+	SecondsApart int        `json:"seconds_apart"`
+}
+
+// AnalyzeCollusion so sánh từng cặp bài nộp (lượt mới nhất của mỗi học sinh) để tìm các cặp có số câu sai giống hệt nhau
+// cao bất thường so với kỳ vọng khi làm độc lập, kèm số câu trả lời trùng được lưu gần như cùng lúc.
+func (s *examService) AnalyzeCollusion(ctx context.Context, req *pb.AnalyzeCollusionRequest) (*pb.AnalyzeCollusionResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+
+	minWrong := int(req.MinIdenticalWrong)
+	if minWrong <= 0 {
+		minWrong = defaultCollusionMinIdenticalWrong
+	}
+	zThreshold := float64(req.ZThreshold)
+	if zThreshold <= 0 {
+		zThreshold = defaultCollusionZThreshold
+	}
+	window := int(req.TimingWindowSeconds)
+	if window <= 0 {
+		window = defaultCollusionTimingWindow
+	}
+	maxPairs := int(req.MaxPairs)
+	if maxPairs <= 0 {
+		maxPairs = defaultCollusionMaxPairs
+	}
+
+	candidates, err := s.collusionCandidates(ctx, exam)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách bài nộp: %v", err)
+	}
+
+	// Phân bố các đáp án sai của từng câu để ước lượng xác suất hai học sinh sai giống nhau một cách ngẫu nhiên
+	wrongCounts := make(map[int64]map[string]int)
+	wrongTotals := make(map[int64]int)
+	for _, c := range candidates {
+		for qID, r := range c.responses {
+			if r.correct {
+				continue
+			}
+			if wrongCounts[qID] == nil {
+				wrongCounts[qID] = make(map[string]int)
+			}
+			wrongCounts[qID][r.key]++
+			wrongTotals[qID]++
+		}
+	}
+
+	var pairs []domain.CollusionPairModel
+	for i := 0; i < len(candidates); i++ {
+		for j := i + 1; j < len(candidates); j++ {
+			pair, evidence := compareCollusionPair(candidates[i], candidates[j], wrongCounts, wrongTotals, time.Duration(window)*time.Second)
+			if pair.IdenticalWrong < minWrong {
+				continue
+			}
+			pair.Flagged = pair.ZScore >= zThreshold
+			evidenceJSON, _ := json.Marshal(evidence)
+			pair.Evidence = string(evidenceJSON)
+			pairs = append(pairs, pair)
+		}
+	}
+
+	sort.SliceStable(pairs, func(a, b int) bool {
+		if pairs[a].ZScore != pairs[b].ZScore {
+			return pairs[a].ZScore > pairs[b].ZScore
+		}
+		return pairs[a].IdenticalWrong > pairs[b].IdenticalWrong
+	})
+	if len(pairs) > maxPairs {
+		pairs = pairs[:maxPairs]
+	}
+	for i := range pairs {
+		pairs[i].Rank = i + 1
+	}
+
+	report := &domain.CollusionReportModel{
+		ExamID:              exam.Id,
+		RequestedBy:         req.InstructorId,
+		StudentCount:        len(candidates),
+		PairCount:           len(pairs),
+		MinIdenticalWrong:   minWrong,
+		ZThreshold:          zThreshold,
+		TimingWindowSeconds: window,
+		CreatedAt:           time.Now().UTC(),
+		Pairs:               pairs,
+	}
+	if err := s.repo.CreateCollusionReport(ctx, report); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu báo cáo thông đồng: %v", err)
+	}
+	log.Printf("🔍 Phân tích thông đồng đề %d: %d học sinh, %d cặp đáng chú ý", exam.Id, len(candidates), len(pairs))
+
+	return &pb.AnalyzeCollusionResponse{Report: collusionReportToProto(report)}, nil
+}
+
+func (s *examService) GetCollusionReport(ctx context.Context, req *pb.GetCollusionReportRequest) (*pb.GetCollusionReportResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	report, err := s.repo.GetLatestCollusionReport(ctx, req.ExamId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "Đề thi chưa được phân tích thông đồng")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy báo cáo thông đồng: %v", err)
+	}
+	return &pb.GetCollusionReportResponse{Report: collusionReportToProto(report)}, nil
+}
+
+func (s *examService) collusionCandidates(ctx context.Context, exam *domain.ExamModel) ([]collusionCandidate, error) {
+	subs, err := s.repo.GetExamSubmissionsWithAnswers(ctx, exam.Id)
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[int64]*domain.ExamSubmissionModel)
+	for _, sub := range subs {
+		latest[sub.UserID] = sub
+	}
+	userIDs := make([]int64, 0, len(latest))
+	for uid := range latest {
+		userIDs = append(userIDs, uid)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	engine, err := s.scoringEngineFor(ctx, exam)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi chấm bài: %v", err)
+	}
+	var candidates []collusionCandidate
+	for _, uid := range userIDs {
+		sub := latest[uid]
+		questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, sub)
+		if err != nil {
+			continue
+		}
+		answers := groupUserAnswers(sub.UserAnswers)
+		result := engine.ScoreSubmission(questions, qPointsMap, answers)
+
+		answeredAt := latestAnswerTimes(sub.UserAnswers)
+
+		c := collusionCandidate{sub: sub, responses: make(map[int64]collusionResponse)}
+		for _, q := range questions {
+			r := result.Results[q.Id]
+			key := collusionResponseKey(answers[q.Id])
+			if r.Pending || key == "" {
+				continue
+			}
+			c.responses[q.Id] = collusionResponse{key: key, correct: r.IsCorrect, answeredAt: answeredAt[q.Id]}
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, nil
+}
+
+// compareCollusionPair tính chỉ số tương đồng của hai bài. Với mỗi câu cả hai cùng sai, xác suất trùng được ước lượng
+// bằng tỉ lệ chọn đáp án sai của A trong các học sinh còn lại (làm trơn Laplace); tổng các xác suất là số câu sai trùng
+// kỳ vọng và phương sai theo phân phối Poisson-nhị thức.
+func compareCollusionPair(a, b collusionCandidate, wrongCounts map[int64]map[string]int, wrongTotals map[int64]int, window time.Duration) (domain.CollusionPairModel, []collusionEvidence) {
+	pair := domain.CollusionPairModel{
+		SubmissionA: a.sub.Id,
+		SubmissionB: b.sub.Id,
+		UserA:       a.sub.UserID,
+		UserB:       b.sub.UserID,
+	}
+	var expected, variance float64
+	var evidence []collusionEvidence
+
+	for qID, ra := range a.responses {
+		rb, ok := b.responses[qID]
+		if !ok {
+			continue
+		}
+		pair.SharedQuestions++
+
+		identical := ra.key == rb.key
+		secondsApart := -1
+		if ra.answeredAt != nil && rb.answeredAt != nil {
+			secondsApart = int(math.Abs(ra.answeredAt.Sub(*rb.answeredAt).Seconds()))
+		}
+		if identical {
+			pair.IdenticalAnswers++
+			if secondsApart >= 0 && time.Duration(secondsApart)*time.Second <= window {
+				pair.TimingMatches++
+			}
+		}
+		if ra.correct || rb.correct {
+			continue
+		}
+
+		others := wrongTotals[qID] - 2
+		matching := wrongCounts[qID][ra.key] - 1
+		if identical {
+			matching--
+		}
+		distinct := len(wrongCounts[qID])
+		p := float64(matching+1) / float64(others+distinct)
+		p = math.Min(math.Max(p, 0), 1)
+		expected += p
+		variance += p * (1 - p)
+
+		if identical {
+			pair.IdenticalWrong++
+			evidence = append(evidence, collusionEvidence{
+				QuestionID:   qID,
+				Response:     ra.key,
+				ResponseRate: p,
+				AnsweredAtA:  ra.answeredAt,
+				AnsweredAtB:  rb.answeredAt,
+				SecondsApart: secondsApart,
+			})
+		}
+	}
+
+	pair.ExpectedIdenticalWrong = expected
+	if variance > 0 {
+		pair.ZScore = (float64(pair.IdenticalWrong) - expected) / math.Sqrt(variance)
+	}
+	if a.sub.SubmittedAt != nil && b.sub.SubmittedAt != nil {
+		pair.SubmitSecondsApart = int(math.Abs(a.sub.SubmittedAt.Sub(*b.sub.SubmittedAt).Seconds()))
+	}
+
+	// Đáp án sai càng hiếm thì càng có giá trị làm bằng chứng
+	sort.Slice(evidence, func(i, j int) bool { return evidence[i].ResponseRate < evidence[j].ResponseRate })
+	if len(evidence) > maxCollusionEvidence {
+		evidence = evidence[:maxCollusionEvidence]
+	}
+	return pair, evidence
+}
+
+// collusionResponseKey chuẩn hóa câu trả lời để so sánh giữa hai bài; chuỗi rỗng nghĩa là bỏ trống.
+func collusionResponseKey(a AnswerResponse) string {
+	if data := a.Structured(); !data.IsEmpty() {
+		return data.JSON()
+	}
+	if len(a.ChoiceIDs) > 0 {
+		ids := append([]int64(nil), a.ChoiceIDs...)
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		parts := make([]string, len(ids))
+		for i, id := range ids {
+			parts[i] = strconv.FormatInt(id, 10)
+		}
+		return strings.Join(parts, ",")
+	}
+	if text := strings.ToLower(strings.Join(strings.Fields(a.Text), " ")); text != "" {
+		return "text:" + text
+	}
+	return ""
+}
+
+func collusionReportToProto(r *domain.CollusionReportModel) *pb.CollusionReport {
+	res := &pb.CollusionReport{
+		Id:                  r.Id,
+		ExamId:              r.ExamID,
+		StudentCount:        int32(r.StudentCount),
+		PairCount:           int32(r.PairCount),
+		MinIdenticalWrong:   int32(r.MinIdenticalWrong),
+		ZThreshold:          float32(r.ZThreshold),
+		TimingWindowSeconds: int32(r.TimingWindowSeconds),
+		CreatedAt:           r.CreatedAt.Format(time.RFC3339),
+		Pairs:               []*pb.CollusionPair{},
+	}
+	for _, p := range r.Pairs {
+		pp := &pb.CollusionPair{
+			Rank:                   int32(p.Rank),
+			UserA:                  p.UserA,
+			UserB:                  p.UserB,
+			SubmissionA:            p.SubmissionA,
+			SubmissionB:            p.SubmissionB,
+			SharedQuestions:        int32(p.SharedQuestions),
+			IdenticalAnswers:       int32(p.IdenticalAnswers),
+			IdenticalWrong:         int32(p.IdenticalWrong),
+			ExpectedIdenticalWrong: float32(p.ExpectedIdenticalWrong),
+			ZScore:                 float32(p.ZScore),
+			TimingMatches:          int32(p.TimingMatches),
+			SubmitSecondsApart:     int32(p.SubmitSecondsApart),
+			Flagged:                p.Flagged,
+		}
+		var evidence []collusionEvidence
+		_ = json.Unmarshal([]byte(p.Evidence), &evidence)
+		for _, e := range evidence {
+			pe := &pb.CollusionEvidence{
+				QuestionId:   e.QuestionID,
+				Response:     e.Response,
+				IsCorrect:    e.IsCorrect,
+				ResponseRate: float32(e.ResponseRate),
+				SecondsApart: int32(e.SecondsApart),
+			}
+			if e.AnsweredAtA != nil {
+				pe.AnsweredAtA = e.AnsweredAtA.Format(time.RFC3339)
+			}
+			if e.AnsweredAtB != nil {
+				pe.AnsweredAtB = e.AnsweredAtB.Format(time.RFC3339)
+			}
+			pp.Evidence = append(pp.Evidence, pe)
+		}
+		res.Pairs = append(res.Pairs, pp)
+	}
+	return res
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+)
+
+// Sau khi nộp bài, các dòng đáp án được tạo lại; thời điểm trả lời dùng cho bằng chứng thông đồng
+// phải vẫn là thời điểm SaveAnswer chứ không phải thời điểm nộp.
+func TestFinalizedAnswersKeepSaveAnswerTiming(t *testing.T) {
+	saveFirst := time.Date(2026, 5, 4, 8, 5, 0, 0, time.UTC)
+	saveLater := time.Date(2026, 5, 4, 8, 20, 0, 0, time.UTC)
+	essaySaved := time.Date(2026, 5, 4, 8, 12, 0, 0, time.UTC)
+	submittedAt := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	choiceA, choiceB := int64(11), int64(12)
+	essay := "Trả lời"
+
+	saved := []domain.UserAnswerModel{
+		{SubmissionID: 1, QuestionID: 100, ChosenChoiceID: &choiceA, AnsweredAt: &saveFirst, CreatedAt: saveFirst},
+		{SubmissionID: 1, QuestionID: 100, ChosenChoiceID: &choiceB, AnsweredAt: &saveLater, CreatedAt: saveFirst},
+		{SubmissionID: 1, QuestionID: 200, TextAnswer: &essay, AnsweredAt: &essaySaved, CreatedAt: essaySaved},
+	}
+	questions := []*domain.QuestionModel{
+		{Id: 100, Type: domain.QuestionTypeModel{Type: domain.QuestionTypeSingleChoice}},
+		{Id: 200, Type: domain.QuestionTypeModel{Type: domain.QuestionTypeEssay}},
+	}
+	answers := map[int64]AnswerResponse{
+		100: {ChoiceIDs: []int64{choiceB}},
+		200: {Text: essay},
+	}
+	result := SubmissionResult{Results: map[int64]ScoreResult{
+		100: {Earned: 1, IsCorrect: true},
+		200: {Pending: true},
+	}}
+
+	rows := buildUserAnswerModels(1, questions, answers, result, latestAnswerTimes(saved))
+	if len(rows) != 2 {
+		t.Fatalf("số dòng đáp án = %d, muốn 2", len(rows))
+	}
+
+	finalized := make([]domain.UserAnswerModel, 0, len(rows))
+	for _, r := range rows {
+		row := *r
+		row.CreatedAt = submittedAt
+		finalized = append(finalized, row)
+	}
+	got := latestAnswerTimes(finalized)
+
+	want := map[int64]time.Time{100: saveLater, 200: essaySaved}
+	for qID, at := range want {
+		if got[qID] == nil {
+			t.Fatalf("câu %d mất thời điểm trả lời sau khi nộp", qID)
+		}
+		if !got[qID].Equal(at) {
+			t.Errorf("câu %d: thời điểm trả lời = %v, muốn %v (thời điểm SaveAnswer)", qID, *got[qID], at)
+		}
+	}
+}
+
+func TestLatestAnswerTimesFallsBackToCreatedAt(t *testing.T) {
+	created := time.Date(2026, 5, 4, 8, 0, 0, 0, time.UTC)
+	got := latestAnswerTimes([]domain.UserAnswerModel{
+		{QuestionID: 1, CreatedAt: created},
+		{QuestionID: 2},
+	})
+	if got[1] == nil || !got[1].Equal(created) {
+		t.Errorf("dòng cũ không có AnsweredAt phải dùng CreatedAt, nhận %v", got[1])
+	}
+	if got[2] != nil {
+		t.Errorf("dòng không có thời điểm nào không được gán thời điểm, nhận %v", *got[2])
+	}
+}
@@ -0,0 +1,445 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+// CloneExam sao chép bài thi thành một bản nháp mới cho kỳ sau: cấu hình, danh sách câu hỏi kèm điểm, khung đề động,
+// cấu hình giám sát/chấm hai vòng/nguồn đối chiếu và (tuỳ chọn) các lớp được giao. Thời gian mở đề được dời theo
+// start_time mới hoặc shift_days. Phiên bản câu hỏi không được ghim lại để bản sao dùng nội dung mới nhất khi xuất bản.
+func (s *examService) CloneExam(ctx context.Context, req *pb.CloneExamRequest) (*pb.CloneExamResponse, error) {
+	src, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	startTime, endTime, err := shiftExamWindow(src, req.ShiftDays, req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	var owned map[int64]bool
+	if len(req.ClassIds) > 0 || req.CopyClasses {
+		if owned, err = s.teacherClassIDs(ctx, req.InstructorId); err != nil {
+			return nil, err
+		}
+		if err := checkClassesOwned(req.ClassIds, owned); err != nil {
+			return nil, err
+		}
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = src.Title + " (bản sao)"
+	}
+	clone := &domain.ExamModel{
+		Title:                 title,
+		Description:           src.Description,
+		DurationMinutes:       src.DurationMinutes,
+		StartTime:             startTime,
+		EndTime:               endTime,
+		MaxAttempts:           src.MaxAttempts,
+		Password:              src.Password,
+		ShuffleQuestions:      src.ShuffleQuestions,
+		ShuffleChoices:        src.ShuffleChoices,
+		ShowResultImmediately: src.ShowResultImmediately,
+		RequiresApproval:      src.RequiresApproval,
+		IsDynamic:             src.IsDynamic,
+		DynamicConfig:         firstNonEmpty(src.DynamicConfig, "{}"),
+		ScoringPolicy:         src.ScoringPolicy,
+		NegativeMarking:       src.NegativeMarking,
+		ScoreScale:            src.ScoreScale,
+		IsAdaptive:            src.IsAdaptive,
+		AdaptiveConfig:        firstNonEmpty(src.AdaptiveConfig, "{}"),
+		IsPractice:            src.IsPractice,
+		TopicID:               src.TopicID,
+		CreatorID:             src.CreatorID,
+		Status:                "draft",
+	}
+
+	links := make([]*domain.ExamQuestionModel, 0, len(src.Questions))
+	for i, q := range src.Questions {
+		links = append(links, &domain.ExamQuestionModel{QuestionID: q.Id, Sequence: i + 1, Points: q.Points})
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := s.repo.CreateExam(ctx, tx, clone); err != nil {
+			return err
+		}
+		return s.repo.LinkQuestionsToExam(ctx, tx, clone.Id, links)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi sao chép bài thi: %v", err)
+	}
+
+	s.cloneExamConfigs(ctx, src.Id, clone.Id)
+
+	classIDs := req.ClassIds
+	if len(classIDs) == 0 && req.CopyClasses {
+		classIDs, err = s.repo.GetExamClassIDs(ctx, src.Id)
+		if err != nil {
+			log.Printf("⚠️ Không lấy được danh sách lớp của bài thi %d: %v", src.Id, err)
+		}
+		// Lớp của đề gốc có thể do người khác giao, bản sao chỉ giữ các lớp giáo viên đang phụ trách.
+		classIDs = ownedClassIDs(classIDs, owned)
+	}
+	assigned := s.assignClasses(ctx, clone.Id, classIDs)
+
+	log.Printf("📋 Đã sao chép bài thi %d thành bài thi %d (%d câu, %d lớp)", src.Id, clone.Id, len(links), len(assigned))
+	resp := &pb.CloneExamResponse{
+		Id:            clone.Id,
+		Title:         clone.Title,
+		QuestionCount: int32(len(links)),
+		ClassIds:      assigned,
+	}
+	if clone.StartTime != nil {
+		resp.StartTime = clone.StartTime.Format(time.RFC3339)
+	}
+	if clone.EndTime != nil {
+		resp.EndTime = clone.EndTime.Format(time.RFC3339)
+	}
+	return resp, nil
+}
+
+// shiftExamWindow tính thời gian mở/đóng đề của bản sao. Có start_time thì cả khung giờ dời theo ngày bắt đầu mới,
+// nếu không thì dời shiftDays ngày.
+func shiftExamWindow(src *domain.ExamModel, shiftDays int32, startTime string) (*time.Time, *time.Time, error) {
+	delta := time.Duration(shiftDays) * 24 * time.Hour
+	if startTime != "" {
+		start, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "Thời gian bắt đầu không hợp lệ (cần định dạng RFC3339)")
+		}
+		start = start.UTC()
+		if src.StartTime == nil {
+			return &start, nil, nil
+		}
+		delta = start.Sub(*src.StartTime)
+	}
+	shift := func(t *time.Time) *time.Time {
+		if t == nil {
+			return nil
+		}
+		shifted := t.Add(delta).UTC()
+		return &shifted
+	}
+	return shift(src.StartTime), shift(src.EndTime), nil
+}
+
+// cloneExamConfigs chép các cấu hình gắn theo bài thi. Lỗi chỉ được ghi log vì bản sao vẫn dùng được với cấu hình mặc định.
+func (s *examService) cloneExamConfigs(ctx context.Context, srcID, dstID int64) {
+	if cfg, err := s.repo.GetSuspicionConfig(ctx, srcID); err == nil && cfg != nil {
+		copied := *cfg
+		copied.ExamID = dstID
+		if err := s.repo.SaveSuspicionConfig(ctx, &copied); err != nil {
+			log.Printf("⚠️ Không chép được cấu hình giám sát sang bài thi %d: %v", dstID, err)
+		}
+	}
+	if cfg, err := s.repo.GetMarkingConfig(ctx, srcID); err == nil && cfg != nil {
+		copied := *cfg
+		copied.ExamID = dstID
+		if err := s.repo.SaveMarkingConfig(ctx, &copied); err != nil {
+			log.Printf("⚠️ Không chép được cấu hình chấm hai vòng sang bài thi %d: %v", dstID, err)
+		}
+	}
+	if sources, err := s.repo.GetSimilaritySources(ctx, srcID); err == nil && len(sources) > 0 {
+		copied := make([]*domain.SimilaritySourceModel, 0, len(sources))
+		for _, src := range sources {
+			c := *src
+			c.Id = 0
+			c.ExamID = dstID
+			copied = append(copied, &c)
+		}
+		if err := s.repo.ReplaceSimilaritySources(ctx, dstID, copied); err != nil {
+			log.Printf("⚠️ Không chép được nguồn đối chiếu sang bài thi %d: %v", dstID, err)
+		}
+	}
+}
+
+// checkClassesOwned từ chối giao bài thi cho lớp mà giáo viên không phụ trách.
+func checkClassesOwned(classIDs []int64, owned map[int64]bool) error {
+	for _, classID := range classIDs {
+		if classID > 0 && !owned[classID] {
+			return status.Errorf(codes.PermissionDenied, "Bạn không có quyền giao bài thi cho lớp %d", classID)
+		}
+	}
+	return nil
+}
+
+func ownedClassIDs(classIDs []int64, owned map[int64]bool) []int64 {
+	result := make([]int64, 0, len(classIDs))
+	for _, classID := range classIDs {
+		if owned[classID] {
+			result = append(result, classID)
+		}
+	}
+	return result
+}
+
+// assignClasses giao bài thi cho các lớp qua AssignExamToClass để đề động vẫn được sinh sẵn cho học sinh.
+func (s *examService) assignClasses(ctx context.Context, examID int64, classIDs []int64) []int64 {
+	assigned := make([]int64, 0, len(classIDs))
+	seen := make(map[int64]bool, len(classIDs))
+	for _, classID := range classIDs {
+		if classID <= 0 || seen[classID] {
+			continue
+		}
+		seen[classID] = true
+		if _, err := s.AssignExamToClass(ctx, &pb.AssignExamToClassRequest{ExamId: examID, ClassId: classID}); err != nil {
+			log.Printf("⚠️ Không giao được bài thi %d cho lớp %d: %v", examID, classID, err)
+			continue
+		}
+		assigned = append(assigned, classID)
+	}
+	return assigned
+}
+
+// CreateExamTemplate lưu mẫu đề. Có source_exam_id thì cấu hình và khung đề được lấy từ bài thi đó.
+func (s *examService) CreateExamTemplate(ctx context.Context, req *pb.CreateExamTemplateRequest) (*pb.CreateExamTemplateResponse, error) {
+	tpl := &domain.ExamTemplateModel{
+		CreatorID:   req.InstructorId,
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		TopicID:     req.TopicId,
+	}
+	settings, blueprint := req.Settings, req.SectionConfigs
+	if req.SourceExamId > 0 {
+		exam, err := s.getOwnedExam(ctx, req.SourceExamId, req.InstructorId)
+		if err != nil {
+			return nil, err
+		}
+		settings, blueprint = examSettingsToProto(exam), examBlueprint(exam)
+		tpl.SourceExamID = exam.Id
+		tpl.TopicID = exam.TopicID
+		if tpl.Name == "" {
+			tpl.Name = exam.Title
+		}
+		if tpl.Description == "" {
+			tpl.Description = exam.Description
+		}
+	}
+	if tpl.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Mẫu đề cần có tên")
+	}
+	if err := validateScoringSettings(settings); err != nil {
+		return nil, err
+	}
+	if settings == nil || settings.DurationMinutes <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Mẫu đề cần thời lượng làm bài lớn hơn 0")
+	}
+	if tpl.TopicID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Mẫu đề cần thuộc một chủ đề")
+	}
+	if len(blueprint) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Khung đề cần ít nhất một phần")
+	}
+	for i, cfg := range blueprint {
+		if cfg.Count <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Phần %d của khung đề cần số câu lớn hơn 0", i+1)
+		}
+	}
+
+	// Mẫu đề không gắn với kỳ thi nào nên bỏ thời gian mở/đóng và cấu hình sinh đề cũ.
+	stored := proto.Clone(settings).(*pb.ExamSettings)
+	stored.StartTime, stored.EndTime, stored.DynamicConfig = "", "", ""
+	settingsJSON, _ := json.Marshal(stored)
+	blueprintJSON, _ := json.Marshal(blueprint)
+	tpl.Settings, tpl.Blueprint = string(settingsJSON), string(blueprintJSON)
+
+	if err := s.repo.CreateExamTemplate(ctx, tpl); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu mẫu đề: %v", err)
+	}
+	return &pb.CreateExamTemplateResponse{Template: templateToProto(tpl)}, nil
+}
+
+func (s *examService) GetExamTemplates(ctx context.Context, req *pb.GetExamTemplatesRequest) (*pb.GetExamTemplatesResponse, error) {
+	tpls, err := s.repo.GetExamTemplatesByCreator(ctx, req.InstructorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách mẫu đề: %v", err)
+	}
+	resp := &pb.GetExamTemplatesResponse{Templates: []*pb.ExamTemplate{}}
+	for _, tpl := range tpls {
+		resp.Templates = append(resp.Templates, templateToProto(tpl))
+	}
+	return resp, nil
+}
+
+func (s *examService) DeleteExamTemplate(ctx context.Context, req *pb.DeleteExamTemplateRequest) (*pb.DeleteExamTemplateResponse, error) {
+	if _, err := s.getOwnedTemplate(ctx, req.TemplateId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteExamTemplate(ctx, req.TemplateId); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi xoá mẫu đề: %v", err)
+	}
+	return &pb.DeleteExamTemplateResponse{Success: true}, nil
+}
+
+// InstantiateExamTemplate tạo bài thi nháp từ mẫu qua GenerateExam: đề cố định được bốc câu theo khung đề,
+// đề động giữ khung đề làm cấu hình sinh đề riêng cho từng học sinh.
+func (s *examService) InstantiateExamTemplate(ctx context.Context, req *pb.InstantiateExamTemplateRequest) (*pb.InstantiateExamTemplateResponse, error) {
+	tpl, err := s.getOwnedTemplate(ctx, req.TemplateId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	settings := &pb.ExamSettings{}
+	if err := json.Unmarshal([]byte(tpl.Settings), settings); err != nil {
+		return nil, status.Errorf(codes.Internal, "Cấu hình mẫu đề bị lỗi: %v", err)
+	}
+	var blueprint []*pb.SectionConfig
+	if err := json.Unmarshal([]byte(tpl.Blueprint), &blueprint); err != nil {
+		return nil, status.Errorf(codes.Internal, "Khung đề của mẫu bị lỗi: %v", err)
+	}
+	for _, t := range []string{req.StartTime, req.EndTime} {
+		if t == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, t); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Thời gian mở/đóng đề không hợp lệ (cần định dạng RFC3339)")
+		}
+	}
+	settings.StartTime, settings.EndTime = req.StartTime, req.EndTime
+	if len(req.ClassIds) > 0 {
+		owned, err := s.teacherClassIDs(ctx, req.InstructorId)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkClassesOwned(req.ClassIds, owned); err != nil {
+			return nil, err
+		}
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = tpl.Name
+	}
+	created, err := s.GenerateExam(ctx, &pb.GenerateExamRequest{
+		Title:          title,
+		Description:    tpl.Description,
+		TopicId:        tpl.TopicID,
+		Settings:       settings,
+		CreatorId:      req.InstructorId,
+		SectionConfigs: blueprint,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Không tạo được bài thi từ mẫu: %v", err)
+	}
+
+	count := 0
+	if exam, err := s.repo.GetExamDetails(ctx, created.Id); err == nil {
+		count = len(exam.Questions)
+		if exam.IsDynamic {
+			count = blueprintQuestionCount(blueprint)
+		}
+	}
+	assigned := s.assignClasses(ctx, created.Id, req.ClassIds)
+
+	log.Printf("📋 Đã tạo bài thi %d từ mẫu đề %d", created.Id, tpl.Id)
+	return &pb.InstantiateExamTemplateResponse{
+		Id:            created.Id,
+		Title:         created.Title,
+		QuestionCount: int32(count),
+		ClassIds:      assigned,
+	}, nil
+}
+
+func (s *examService) getOwnedTemplate(ctx context.Context, templateID, instructorID int64) (*domain.ExamTemplateModel, error) {
+	tpl, err := s.repo.GetExamTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy mẫu đề: %v", err)
+	}
+	if tpl.CreatorID != instructorID {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền dùng mẫu đề này")
+	}
+	return tpl, nil
+}
+
+func examSettingsToProto(exam *domain.ExamModel) *pb.ExamSettings {
+	password := exam.Password
+	return &pb.ExamSettings{
+		DurationMinutes:       int32(exam.DurationMinutes),
+		MaxAttempts:           int32(exam.MaxAttempts),
+		Password:              &password,
+		ShuffleQuestions:      exam.ShuffleQuestions,
+		ShuffleChoices:        exam.ShuffleChoices,
+		ShowResultImmediately: exam.ShowResultImmediately,
+		RequiresApproval:      exam.RequiresApproval,
+		IsDynamic:             exam.IsDynamic,
+		ScoringPolicy:         exam.ScoringPolicy,
+		NegativeMarking:       float32(exam.NegativeMarking),
+		ScoreScale:            exam.ScoreScale,
+		IsAdaptive:            exam.IsAdaptive,
+		AdaptiveConfig:        exam.AdaptiveConfig,
+		IsPractice:            exam.IsPractice,
+	}
+}
+
+// examBlueprint dựng khung đề từ bài thi: đề động dùng nguyên cấu hình sinh đề, đề cố định được gom số câu
+// theo chương và độ khó, điểm mỗi câu lấy trung bình của nhóm.
+func examBlueprint(exam *domain.ExamModel) []*pb.SectionConfig {
+	if exam.IsDynamic {
+		if rules, err := domain.ParseBlueprint(exam.DynamicConfig); err == nil && len(rules) > 0 {
+			return blueprintToProto(rules)
+		}
+	}
+
+	type groupKey struct {
+		sectionID  int64
+		difficulty string
+	}
+	var blueprint []*pb.SectionConfig
+	totals := make(map[groupKey]float64)
+	groups := make(map[groupKey]*pb.SectionConfig)
+	for _, q := range exam.Questions {
+		key := groupKey{q.SectionID, firstNonEmpty(q.Difficulty.Difficulty, "all")}
+		cfg, ok := groups[key]
+		if !ok {
+			cfg = &pb.SectionConfig{SectionId: key.sectionID, Difficulty: key.difficulty}
+			groups[key] = cfg
+			blueprint = append(blueprint, cfg)
+		}
+		cfg.Count++
+		totals[key] += q.Points
+	}
+	for key, cfg := range groups {
+		cfg.Points = float32(math.Round(totals[key]/float64(cfg.Count)*100) / 100)
+	}
+	return blueprint
+}
+
+func blueprintQuestionCount(blueprint []*pb.SectionConfig) int {
+	total := 0
+	for _, cfg := range blueprint {
+		total += int(cfg.Count)
+	}
+	return total
+}
+
+func templateToProto(tpl *domain.ExamTemplateModel) *pb.ExamTemplate {
+	settings := &pb.ExamSettings{}
+	_ = json.Unmarshal([]byte(tpl.Settings), settings)
+	var blueprint []*pb.SectionConfig
+	_ = json.Unmarshal([]byte(tpl.Blueprint), &blueprint)
+	return &pb.ExamTemplate{
+		Id:             tpl.Id,
+		Name:           tpl.Name,
+		Description:    tpl.Description,
+		TopicId:        tpl.TopicID,
+		Settings:       settings,
+		SectionConfigs: blueprint,
+		QuestionCount:  int32(blueprintQuestionCount(blueprint)),
+		SourceExamId:   tpl.SourceExamID,
+		CreatedAt:      tpl.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tỷ lệ nhóm điểm cao / điểm thấp theo quy ước 27% của Kelley.
+const itemAnalysisGroupRatio = 0.27
+
+type itemObservation struct {
+	studentIdx int
+	score      float64
+	earned     float64
+	choiceIDs  []int64
+}
+
+type studentTotal struct {
+	earned float64
+	score  float64
+}
+
+func (s *examService) GetItemAnalysis(ctx context.Context, req *pb.GetItemAnalysisRequest) (*pb.GetItemAnalysisResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := s.repo.GetExamSubmissionsWithAnswers(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách bài nộp: %v", err)
+	}
+
+	latest := make(map[int64]*domain.ExamSubmissionModel)
+	for _, sub := range subs {
+		latest[sub.UserID] = sub
+	}
+
+	engine, err := s.scoringEngineFor(ctx, exam)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi chấm bài: %v", err)
+	}
+	questionMeta := make(map[int64]*domain.QuestionModel)
+	var questionOrder []int64
+	observations := make(map[int64][]itemObservation)
+	var totals []studentTotal
+
+	userIDs := make([]int64, 0, len(latest))
+	for uid := range latest {
+		userIDs = append(userIDs, uid)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	for _, uid := range userIDs {
+		sub := latest[uid]
+		questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, sub)
+		if err != nil {
+			continue
+		}
+		answers := groupUserAnswers(sub.UserAnswers)
+		result := engine.ScoreSubmission(questions, qPointsMap, answers)
+
+		idx := len(totals)
+		totals = append(totals, studentTotal{earned: result.Earned, score: result.Score})
+
+		for _, q := range questions {
+			r := result.Results[q.Id]
+			if r.Pending {
+				continue
+			}
+			if _, ok := questionMeta[q.Id]; !ok {
+				questionMeta[q.Id] = q
+				questionOrder = append(questionOrder, q.Id)
+			}
+
+			points := 1.0
+			if pts, ok := qPointsMap[q.Id]; ok && pts > 0 {
+				points = pts
+			}
+			observations[q.Id] = append(observations[q.Id], itemObservation{
+				studentIdx: idx,
+				score:      clampRatio(r.Earned / points),
+				earned:     r.Earned,
+				choiceIDs:  answers[q.Id].ChoiceIDs,
+			})
+		}
+	}
+
+	n := len(totals)
+	resp := &pb.GetItemAnalysisResponse{
+		ExamId:       req.ExamId,
+		StudentCount: int32(n),
+		Items:        []*pb.ItemStat{},
+	}
+	if n == 0 {
+		return resp, nil
+	}
+
+	scores := make([]float64, n)
+	for i, t := range totals {
+		scores[i] = t.score
+	}
+	resp.MeanScore, resp.ScoreStdDev = meanStdDev(scores)
+
+	upper, lower := splitScoreGroups(totals)
+
+	for _, qID := range questionOrder {
+		resp.Items = append(resp.Items, buildItemStat(questionMeta[qID], observations[qID], totals, upper, lower))
+	}
+
+	resp.Kr20 = computeKR20(questionOrder, observations, n)
+
+	return resp, nil
+}
+
+func buildItemStat(q *domain.QuestionModel, obs []itemObservation, totals []studentTotal, upper, lower map[int]bool) *pb.ItemStat {
+	qType := "single_choice"
+	if q.Type.Type != "" {
+		qType = q.Type.Type
+	}
+
+	stat := &pb.ItemStat{
+		QuestionId:   q.Id,
+		Content:      q.Content,
+		QuestionType: qType,
+		Responses:    int32(len(obs)),
+		Distractors:  []*pb.DistractorStat{},
+	}
+	if len(obs) == 0 {
+		return stat
+	}
+
+	itemScores := make([]float64, len(obs))
+	restScores := make([]float64, len(obs))
+	var upperSum, lowerSum float64
+	var upperN, lowerN int
+	for i, o := range obs {
+		itemScores[i] = o.score
+		restScores[i] = totals[o.studentIdx].earned - o.earned
+		if upper[o.studentIdx] {
+			upperSum += o.score
+			upperN++
+		}
+		if lower[o.studentIdx] {
+			lowerSum += o.score
+			lowerN++
+		}
+	}
+
+	stat.PValue, _ = meanStdDev(itemScores)
+	stat.PointBiserial = pearson(itemScores, restScores)
+	if upperN > 0 && lowerN > 0 {
+		stat.DiscriminationIndex = upperSum/float64(upperN) - lowerSum/float64(lowerN)
+	}
+
+	for _, c := range q.Choices {
+		ds := &pb.DistractorStat{
+			ChoiceId:  c.Id,
+			Content:   c.Content,
+			IsCorrect: c.IsCorrect,
+		}
+		for _, o := range obs {
+			if !containsInt64(o.choiceIDs, c.Id) {
+				continue
+			}
+			ds.TotalCount++
+			if upper[o.studentIdx] {
+				ds.UpperCount++
+			}
+			if lower[o.studentIdx] {
+				ds.LowerCount++
+			}
+		}
+		ds.SelectionRate = float64(ds.TotalCount) / float64(len(obs))
+		stat.Distractors = append(stat.Distractors, ds)
+	}
+
+	return stat
+}
+
+// splitScoreGroups trả về chỉ số học sinh thuộc nhóm 27% cao nhất và thấp nhất.
+func splitScoreGroups(totals []studentTotal) (map[int]bool, map[int]bool) {
+	idx := make([]int, len(totals))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return totals[idx[a]].earned > totals[idx[b]].earned })
+
+	size := int(math.Round(float64(len(totals)) * itemAnalysisGroupRatio))
+	if size < 1 {
+		size = 1
+	}
+	if size*2 > len(totals) {
+		size = len(totals) / 2
+	}
+
+	upper := make(map[int]bool)
+	lower := make(map[int]bool)
+	for i := 0; i < size; i++ {
+		upper[idx[i]] = true
+		lower[idx[len(idx)-1-i]] = true
+	}
+	return upper, lower
+}
+
+// computeKR20 chỉ tính trên các câu mà mọi học sinh đều làm (đề động mỗi người một bộ câu khác nhau).
+func computeKR20(questionOrder []int64, observations map[int64][]itemObservation, n int) float64 {
+	if n < 2 {
+		return 0
+	}
+
+	var common []int64
+	for _, qID := range questionOrder {
+		if len(observations[qID]) == n {
+			common = append(common, qID)
+		}
+	}
+	k := len(common)
+	if k < 2 {
+		return 0
+	}
+
+	totals := make([]float64, n)
+	var sumPQ float64
+	for _, qID := range common {
+		correct := 0
+		for _, o := range observations[qID] {
+			if o.score >= 1 {
+				correct++
+				totals[o.studentIdx]++
+			}
+		}
+		p := float64(correct) / float64(n)
+		sumPQ += p * (1 - p)
+	}
+
+	_, sd := meanStdDev(totals)
+	variance := sd * sd
+	if variance == 0 {
+		return 0
+	}
+	return float64(k) / float64(k-1) * (1 - sumPQ/variance)
+}
+
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var sq float64
+	for _, v := range values {
+		sq += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(sq / float64(len(values)))
+}
+
+func pearson(x, y []float64) float64 {
+	if len(x) < 2 || len(x) != len(y) {
+		return 0
+	}
+	mx, sx := meanStdDev(x)
+	my, sy := meanStdDev(y)
+	if sx == 0 || sy == 0 {
+		return 0
+	}
+	var cov float64
+	for i := range x {
+		cov += (x[i] - mx) * (y[i] - my)
+	}
+	cov /= float64(len(x))
+	return cov / (sx * sy)
+}
+
+func clampRatio(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+func containsInt64(list []int64, v int64) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,651 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+// markingConfigFor trả về cấu hình chấm của đề, hoặc cấu hình mặc định (chấm một vòng) nếu chưa thiết lập.
+func (s *examService) markingConfigFor(ctx context.Context, examID int64) (*domain.MarkingConfigModel, error) {
+	cfg, err := s.repo.GetMarkingConfig(ctx, examID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		cfg = &domain.MarkingConfigModel{ExamID: examID, DiscrepancyThreshold: domain.DefaultDiscrepancyThreshold}
+	}
+	return cfg, nil
+}
+
+func (s *examService) ConfigureMarking(ctx context.Context, req *pb.ConfigureMarkingRequest) (*pb.ConfigureMarkingResponse, error) {
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if req.InstructorId <= 0 || exam.CreatorID != req.InstructorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền cấu hình chấm bài thi này")
+	}
+
+	threshold := float64(req.DiscrepancyThreshold)
+	if threshold <= 0 {
+		threshold = domain.DefaultDiscrepancyThreshold
+	} else if threshold > 1 {
+		return nil, status.Error(codes.InvalidArgument, "Ngưỡng chênh lệch phải nằm trong khoảng (0, 1]")
+	}
+
+	cfg := &domain.MarkingConfigModel{
+		ExamID:               exam.Id,
+		DoubleMarking:        req.DoubleMarking,
+		DiscrepancyThreshold: threshold,
+		ModeratorID:          req.ModeratorId,
+		UpdatedAt:            time.Now().UTC(),
+	}
+	if err := s.repo.SaveMarkingConfig(ctx, cfg); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu cấu hình chấm: %v", err)
+	}
+	return &pb.ConfigureMarkingResponse{Config: markingConfigToProto(cfg)}, nil
+}
+
+func (s *examService) AssignMarkers(ctx context.Context, req *pb.AssignMarkersRequest) (*pb.AssignMarkersResponse, error) {
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if req.InstructorId <= 0 || exam.CreatorID != req.InstructorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền phân công chấm bài thi này")
+	}
+	cfg, err := s.markingConfigFor(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy cấu hình chấm: %v", err)
+	}
+	if !cfg.DoubleMarking {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi chưa bật chấm hai vòng")
+	}
+
+	markers := uniqueInt64s(req.MarkerIds)
+	if len(markers) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "Cần ít nhất hai người chấm khác nhau")
+	}
+	// Khi chưa chỉ định người điều phối, người tạo đề sẽ điều phối nên cũng không được là người chấm.
+	moderatorID := cfg.ModeratorID
+	if moderatorID == 0 {
+		moderatorID = exam.CreatorID
+	}
+	for _, m := range markers {
+		if m == moderatorID {
+			return nil, status.Error(codes.InvalidArgument, "Người điều phối không được đồng thời là người chấm")
+		}
+	}
+
+	existing, err := s.repo.GetEssayMarkings(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách phân công: %v", err)
+	}
+	assigned := make(map[int64]bool, len(existing))
+	for _, m := range existing {
+		assigned[m.SubmissionID] = true
+	}
+
+	subs, err := s.repo.GetExamSubmissionsWithAnswers(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách bài nộp: %v", err)
+	}
+
+	resp := &pb.AssignMarkersResponse{}
+	next := 0
+	for _, sub := range subs {
+		if assigned[sub.Id] {
+			resp.SkippedCount++
+			continue
+		}
+		questions, _, err := s.getSubmissionQuestions(ctx, exam, sub)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lấy câu hỏi của bài nộp %d: %v", sub.Id, err)
+		}
+		if len(essayQuestions(questions)) == 0 {
+			resp.SkippedCount++
+			continue
+		}
+
+		// Chia vòng tròn để tải chấm đều giữa các người chấm; hai vòng luôn do hai người khác nhau.
+		first, second := markers[next%len(markers)], markers[(next+1)%len(markers)]
+		next++
+
+		err = database.DB.Transaction(func(tx *gorm.DB) error {
+			marking := &domain.EssayMarkingModel{
+				SubmissionID: sub.Id,
+				ExamID:       exam.Id,
+				UserID:       sub.UserID,
+				AnonymousID:  newAnonymousID(),
+				Status:       domain.MarkingStatusInProgress,
+			}
+			if err := s.repo.CreateEssayMarking(ctx, tx, marking); err != nil {
+				return err
+			}
+			for role, markerID := range map[string]int64{domain.MarkerRoleFirst: first, domain.MarkerRoleSecond: second} {
+				assignment := &domain.MarkerAssignmentModel{
+					SubmissionID: sub.Id,
+					Role:         role,
+					ExamID:       exam.Id,
+					MarkerID:     markerID,
+					Status:       domain.MarkerAssignmentPending,
+				}
+				if err := s.repo.CreateMarkerAssignment(ctx, tx, assignment); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi phân công chấm bài nộp %d: %v", sub.Id, err)
+		}
+		resp.AssignedCount++
+	}
+
+	log.Printf("🖊️ Đã phân công chấm hai vòng cho đề %d: %d bài mới, %d bài bỏ qua", exam.Id, resp.AssignedCount, resp.SkippedCount)
+	return resp, nil
+}
+
+func (s *examService) GetMarkingTasks(ctx context.Context, req *pb.GetMarkingTasksRequest) (*pb.GetMarkingTasksResponse, error) {
+	assignments, err := s.repo.GetMarkerAssignments(ctx, req.MarkerId, req.Status)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách bài cần chấm: %v", err)
+	}
+	resp := &pb.GetMarkingTasksResponse{Tasks: []*pb.MarkingTask{}}
+	for _, a := range assignments {
+		resp.Tasks = append(resp.Tasks, markingTaskToProto(a))
+	}
+	return resp, nil
+}
+
+func (s *examService) GetMarkingTask(ctx context.Context, req *pb.GetMarkingTaskRequest) (*pb.GetMarkingTaskResponse, error) {
+	assignment, err := s.repo.GetMarkerAssignmentByID(ctx, nil, req.AssignmentId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy phân công chấm: %v", err)
+	}
+	if assignment.MarkerID != req.MarkerId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không được phân công chấm bài này")
+	}
+
+	submission, err := s.repo.GetSubmissionByID(ctx, assignment.SubmissionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài nộp: %v", err)
+	}
+	exam, err := s.repo.GetExamDetails(ctx, assignment.ExamID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài thi: %v", err)
+	}
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, submission)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách câu hỏi: %v", err)
+	}
+
+	texts := make(map[int64]string)
+	for _, ua := range submission.UserAnswers {
+		if ua.TextAnswer != nil {
+			texts[ua.QuestionID] = *ua.TextAnswer
+		}
+	}
+	myMarks := make(map[int64]domain.EssayMarkModel, len(assignment.Marks))
+	for _, m := range assignment.Marks {
+		myMarks[m.QuestionID] = m
+	}
+
+	// Chỉ trả về nội dung câu tự luận và mã ẩn danh, không kèm danh tính học sinh hay điểm của người chấm khác.
+	similarity := s.similarityMatchesBySubmission(ctx, exam.Id, submission.Id)
+	resp := &pb.GetMarkingTaskResponse{Task: markingTaskToProto(assignment), Essays: []*pb.MarkingEssay{}}
+	for _, q := range essayQuestions(questions) {
+		essay := &pb.MarkingEssay{
+			QuestionId:      q.Id,
+			QuestionContent: q.Content,
+			AttachmentUrl:   q.AttachmentURL,
+			Points:          float32(questionPoints(qPointsMap, q.Id)),
+			TextAnswer:      texts[q.Id],
+		}
+		for _, m := range similarity[q.Id] {
+			// Ẩn danh tính cả hai phía, chỉ giữ đoạn trùng và văn bản tham chiếu
+			m.SubmissionId, m.UserId, m.MatchedSubmissionId, m.MatchedUserId = 0, 0, 0, 0
+			essay.SimilarityMatches = append(essay.SimilarityMatches, m)
+		}
+		if q.RubricID != nil {
+			essay.RubricId = *q.RubricID
+		}
+		if m, ok := myMarks[q.Id]; ok {
+			pts := float32(m.Points)
+			essay.MyPoints = &pts
+			essay.MyFeedback = m.Feedback
+		}
+		resp.Essays = append(resp.Essays, essay)
+	}
+	return resp, nil
+}
+
+func (s *examService) SubmitMarks(ctx context.Context, req *pb.SubmitMarksRequest) (*pb.SubmitMarksResponse, error) {
+	assignment, err := s.repo.GetMarkerAssignmentByID(ctx, nil, req.AssignmentId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy phân công chấm: %v", err)
+	}
+	if assignment.MarkerID != req.MarkerId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không được phân công chấm bài này")
+	}
+
+	submission, err := s.repo.GetSubmissionByID(ctx, assignment.SubmissionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài nộp: %v", err)
+	}
+	exam, err := s.repo.GetExamDetails(ctx, assignment.ExamID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài thi: %v", err)
+	}
+	cfg, err := s.markingConfigFor(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy cấu hình chấm: %v", err)
+	}
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, submission)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách câu hỏi: %v", err)
+	}
+
+	marks, err := s.buildEssayMarks(ctx, assignment, essayQuestions(questions), qPointsMap, req.Marks)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SubmitMarksResponse{}
+	var moderatorID int64
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		locked, err := s.repo.GetMarkerAssignmentByID(ctx, tx, assignment.Id)
+		if err != nil {
+			return err
+		}
+		if locked.Status == domain.MarkerAssignmentCompleted {
+			return status.Error(codes.FailedPrecondition, "Bạn đã nộp điểm cho bài này")
+		}
+		marking, err := s.repo.GetEssayMarking(ctx, tx, assignment.SubmissionID)
+		if err != nil {
+			return err
+		}
+		if marking.Status == domain.MarkingStatusReconciled {
+			return status.Error(codes.FailedPrecondition, "Bài nộp đã có điểm thống nhất")
+		}
+		if err := s.repo.CompleteMarkerAssignment(ctx, tx, assignment.Id, marks); err != nil {
+			return err
+		}
+
+		assignments, err := s.repo.GetSubmissionAssignments(ctx, tx, assignment.SubmissionID)
+		if err != nil {
+			return err
+		}
+		byRole := make(map[string]*domain.MarkerAssignmentModel, len(assignments))
+		for _, a := range assignments {
+			byRole[a.Role] = a
+		}
+
+		if assignment.Role == domain.MarkerRoleModerator {
+			resp.MarkingStatus = domain.MarkingStatusReconciled
+			return s.applyReconciledMarks(ctx, tx, exam, submission, questions, qPointsMap, moderatedMarks(byRole[domain.MarkerRoleModerator]))
+		}
+
+		first, second := byRole[domain.MarkerRoleFirst], byRole[domain.MarkerRoleSecond]
+		if first == nil || second == nil || first.Status != domain.MarkerAssignmentCompleted || second.Status != domain.MarkerAssignmentCompleted {
+			resp.MarkingStatus = domain.MarkingStatusInProgress
+			return nil
+		}
+
+		if marksDisagree(first, second, qPointsMap, cfg.DiscrepancyThreshold) {
+			moderatorID = cfg.ModeratorID
+			if moderatorID == 0 {
+				moderatorID = exam.CreatorID
+			}
+			if moderatorID == first.MarkerID || moderatorID == second.MarkerID {
+				return status.Error(codes.FailedPrecondition, "Người điều phối đang là người chấm của bài này, cần chỉ định người điều phối khác")
+			}
+			moderator := &domain.MarkerAssignmentModel{
+				SubmissionID: assignment.SubmissionID,
+				Role:         domain.MarkerRoleModerator,
+				ExamID:       exam.Id,
+				MarkerID:     moderatorID,
+				Status:       domain.MarkerAssignmentPending,
+			}
+			if err := s.repo.CreateMarkerAssignment(ctx, tx, moderator); err != nil {
+				return err
+			}
+			resp.MarkingStatus = domain.MarkingStatusEscalated
+			resp.Escalated = true
+			return s.repo.UpdateEssayMarking(ctx, tx, assignment.SubmissionID, map[string]interface{}{"status": domain.MarkingStatusEscalated})
+		}
+
+		resp.MarkingStatus = domain.MarkingStatusReconciled
+		return s.applyReconciledMarks(ctx, tx, exam, submission, questions, qPointsMap, averagedMarks(first, second))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu điểm chấm: %v", err)
+	}
+
+	if resp.Escalated {
+		log.Printf("⚖️ Bài nộp %d lệch điểm giữa hai người chấm, chuyển cho người điều phối %d", assignment.SubmissionID, moderatorID)
+		go s.notifyMarkingEscalated(context.Background(), exam, assignment.SubmissionID, moderatorID)
+	}
+	return resp, nil
+}
+
+// buildEssayMarks kiểm tra điểm người chấm nhập: mỗi câu tự luận của bài phải có đúng một điểm.
+func (s *examService) buildEssayMarks(ctx context.Context, assignment *domain.MarkerAssignmentModel, essays []*domain.QuestionModel, qPointsMap map[int64]float64, inputs []*pb.EssayMarkInput) ([]*domain.EssayMarkModel, error) {
+	byQuestion := make(map[int64]*pb.EssayMarkInput, len(inputs))
+	for _, in := range inputs {
+		if _, dup := byQuestion[in.QuestionId]; dup {
+			return nil, status.Errorf(codes.InvalidArgument, "Câu hỏi %d bị chấm hai lần", in.QuestionId)
+		}
+		byQuestion[in.QuestionId] = in
+	}
+	if len(byQuestion) != len(essays) {
+		return nil, status.Errorf(codes.InvalidArgument, "Cần chấm đủ %d câu tự luận", len(essays))
+	}
+
+	marks := make([]*domain.EssayMarkModel, 0, len(essays))
+	for _, q := range essays {
+		in, ok := byQuestion[q.Id]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Chưa chấm câu hỏi %d", q.Id)
+		}
+
+		ratio := math.Max(0, math.Min(float64(in.ScoreRatio), 1))
+		var rubricScores []*domain.RubricScoreModel
+		if len(in.RubricScores) > 0 {
+			if q.RubricID == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "Câu hỏi %d chưa được gắn thang chấm", q.Id)
+			}
+			rubric, err := s.repo.GetRubricByID(ctx, *q.RubricID)
+			if err != nil {
+				return nil, status.Errorf(codes.NotFound, "Không tìm thấy thang chấm: %v", err)
+			}
+			if ratio, rubricScores, err = scoreRubric(rubric, in.RubricScores); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Chấm theo thang chấm không hợp lệ: %v", err)
+			}
+			for _, sc := range rubricScores {
+				sc.SubmissionID, sc.QuestionID, sc.GraderID = assignment.SubmissionID, q.Id, assignment.MarkerID
+			}
+		}
+
+		marks = append(marks, &domain.EssayMarkModel{
+			AssignmentID: assignment.Id,
+			QuestionID:   q.Id,
+			SubmissionID: assignment.SubmissionID,
+			Points:       ratio * questionPoints(qPointsMap, q.Id),
+			Feedback:     strings.TrimSpace(in.Feedback),
+			RubricScores: domain.FormatRubricScores(rubricScores),
+			CreatedAt:    time.Now().UTC(),
+		})
+	}
+	return marks, nil
+}
+
+// usesDoubleMarking cho biết đề chấm tự luận hai vòng; khi đó chỉ điểm thống nhất mới được ghi vào điểm bài nộp.
+func (s *examService) usesDoubleMarking(ctx context.Context, examID int64) bool {
+	cfg, err := s.repo.GetMarkingConfig(ctx, examID)
+	return err == nil && cfg != nil && cfg.DoubleMarking
+}
+
+type reconciledMark struct {
+	points   float64
+	feedback string
+	rubric   []*domain.RubricScoreModel
+}
+
+// applyReconciledMarks ghi điểm thống nhất vào câu trả lời và là nơi duy nhất cập nhật điểm bài nộp khi chấm hai vòng.
+func (s *examService) applyReconciledMarks(ctx context.Context, tx *gorm.DB, exam *domain.ExamModel, sub *domain.ExamSubmissionModel, questions []*domain.QuestionModel, qPointsMap map[int64]float64, final map[int64]reconciledMark) error {
+	if err := s.repo.LockSubmission(ctx, tx, sub.Id); err != nil {
+		return err
+	}
+	rows, err := s.repo.GetSubmissionAnswers(ctx, tx, sub.Id)
+	if err != nil {
+		return err
+	}
+	answers := groupUserAnswers(rows)
+	for qID, m := range final {
+		updates := map[string]interface{}{
+			"is_correct":     m.points >= questionPoints(qPointsMap, qID),
+			"awarded_points": m.points,
+			"feedback":       m.feedback,
+		}
+		if err := s.repo.UpdateUserAnswer(ctx, tx, sub.Id, qID, updates); err != nil {
+			return err
+		}
+		if err := s.repo.SaveRubricScores(ctx, tx, sub.Id, qID, m.rubric); err != nil {
+			return err
+		}
+		ans := answers[qID]
+		pts := m.points
+		ans.ManualPoints = &pts
+		answers[qID] = ans
+	}
+
+	engine, err := s.scoringEngineFor(ctx, exam)
+	if err != nil {
+		return err
+	}
+	score := engine.ScoreSubmission(questions, qPointsMap, answers).Score
+	if err := s.repo.UpdateSubmissionScore(ctx, tx, sub.Id, score); err != nil {
+		return err
+	}
+	return s.repo.UpdateEssayMarking(ctx, tx, sub.Id, map[string]interface{}{
+		"status":        domain.MarkingStatusReconciled,
+		"final_score":   score,
+		"reconciled_at": time.Now().UTC(),
+	})
+}
+
+func marksDisagree(first, second *domain.MarkerAssignmentModel, qPointsMap map[int64]float64, threshold float64) bool {
+	secondPoints := make(map[int64]float64, len(second.Marks))
+	for _, m := range second.Marks {
+		secondPoints[m.QuestionID] = m.Points
+	}
+	for _, m := range first.Marks {
+		if math.Abs(m.Points-secondPoints[m.QuestionID]) > threshold*questionPoints(qPointsMap, m.QuestionID)+1e-9 {
+			return true
+		}
+	}
+	return false
+}
+
+// averagedMarks lấy trung bình điểm hai người chấm và gộp nhận xét của cả hai.
+func averagedMarks(first, second *domain.MarkerAssignmentModel) map[int64]reconciledMark {
+	final := make(map[int64]reconciledMark, len(first.Marks))
+	secondMarks := make(map[int64]domain.EssayMarkModel, len(second.Marks))
+	for _, m := range second.Marks {
+		secondMarks[m.QuestionID] = m
+	}
+	for _, m := range first.Marks {
+		other := secondMarks[m.QuestionID]
+		var feedback []string
+		for _, f := range []string{m.Feedback, other.Feedback} {
+			if f != "" {
+				feedback = append(feedback, f)
+			}
+		}
+		final[m.QuestionID] = reconciledMark{
+			points:   (m.Points + other.Points) / 2,
+			feedback: strings.Join(feedback, "\n\n"),
+			rubric:   averagedRubricScores(domain.ParseRubricScores(m.RubricScores), domain.ParseRubricScores(other.RubricScores)),
+		}
+	}
+	return final
+}
+
+// averagedRubricScores lấy trung bình điểm từng tiêu chí khi cả hai người chấm dùng thang chấm.
+// Nếu chỉ một người chấm theo thang chấm hoặc hai bên khác tiêu chí thì không có kết quả thang chấm chung.
+func averagedRubricScores(first, second []*domain.RubricScoreModel) []*domain.RubricScoreModel {
+	if len(first) == 0 || len(first) != len(second) {
+		return nil
+	}
+	byCriterion := make(map[int64]*domain.RubricScoreModel, len(second))
+	for _, sc := range second {
+		byCriterion[sc.CriterionID] = sc
+	}
+	result := make([]*domain.RubricScoreModel, 0, len(first))
+	for _, a := range first {
+		b, ok := byCriterion[a.CriterionID]
+		if !ok || a.RubricID != b.RubricID {
+			return nil
+		}
+		merged := *a
+		merged.Points = (a.Points + b.Points) / 2
+		merged.GraderID = 0
+		if a.LevelID != b.LevelID {
+			merged.LevelID = 0
+			merged.LevelName = a.LevelName + " / " + b.LevelName
+		}
+		var comments []string
+		for _, c := range []string{a.Comment, b.Comment} {
+			if c != "" {
+				comments = append(comments, c)
+			}
+		}
+		merged.Comment = strings.Join(comments, "\n\n")
+		result = append(result, &merged)
+	}
+	return result
+}
+
+func moderatedMarks(moderator *domain.MarkerAssignmentModel) map[int64]reconciledMark {
+	final := make(map[int64]reconciledMark, len(moderator.Marks))
+	for _, m := range moderator.Marks {
+		final[m.QuestionID] = reconciledMark{points: m.Points, feedback: m.Feedback, rubric: domain.ParseRubricScores(m.RubricScores)}
+	}
+	return final
+}
+
+func (s *examService) notifyMarkingEscalated(ctx context.Context, exam *domain.ExamModel, submissionID, moderatorID int64) {
+	if database.RedisClient == nil {
+		return
+	}
+	msg := map[string]interface{}{
+		"type":          "MARKING_ESCALATED",
+		"exam_id":       exam.Id,
+		"submission_id": submissionID,
+		"message":       fmt.Sprintf("Có bài tự luận của đề \"%s\" cần điều phối do hai người chấm lệch điểm", exam.Title),
+		"timestamp":     time.Now().UTC().Format(time.RFC3339),
+	}
+	jsonMsg, _ := json.Marshal(msg)
+	database.RedisClient.Publish(ctx, fmt.Sprintf("notifications:%d", moderatorID), string(jsonMsg))
+}
+
+func (s *examService) GetMarkingOverview(ctx context.Context, req *pb.GetMarkingOverviewRequest) (*pb.GetMarkingOverviewResponse, error) {
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if req.InstructorId <= 0 || exam.CreatorID != req.InstructorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền xem tiến độ chấm bài thi này")
+	}
+	cfg, err := s.markingConfigFor(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy cấu hình chấm: %v", err)
+	}
+	markings, err := s.repo.GetEssayMarkings(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy tiến độ chấm: %v", err)
+	}
+
+	resp := &pb.GetMarkingOverviewResponse{Config: markingConfigToProto(cfg), Submissions: []*pb.SubmissionMarking{}}
+	for _, m := range markings {
+		sm := &pb.SubmissionMarking{
+			SubmissionId: m.SubmissionID,
+			AnonymousId:  m.AnonymousID,
+			UserId:       m.UserID,
+			Status:       m.Status,
+		}
+		if m.FinalScore != nil {
+			score := float32(*m.FinalScore)
+			sm.FinalScore = &score
+		}
+		if m.ReconciledAt != nil {
+			sm.ReconciledAt = m.ReconciledAt.Format(time.RFC3339)
+		}
+		for _, a := range m.Assignments {
+			summary := &pb.MarkerAssignmentSummary{
+				AssignmentId: a.Id,
+				MarkerId:     a.MarkerID,
+				Role:         a.Role,
+				Status:       a.Status,
+				TotalPoints:  float32(a.TotalPoints()),
+			}
+			if a.CompletedAt != nil {
+				summary.CompletedAt = a.CompletedAt.Format(time.RFC3339)
+			}
+			sm.Assignments = append(sm.Assignments, summary)
+		}
+		resp.Submissions = append(resp.Submissions, sm)
+	}
+	return resp, nil
+}
+
+func essayQuestions(questions []*domain.QuestionModel) []*domain.QuestionModel {
+	var essays []*domain.QuestionModel
+	for _, q := range questions {
+		if q.Type.Type == domain.QuestionTypeEssay {
+			essays = append(essays, q)
+		}
+	}
+	return essays
+}
+
+func questionPoints(qPointsMap map[int64]float64, questionID int64) float64 {
+	if pts, ok := qPointsMap[questionID]; ok && pts > 0 {
+		return pts
+	}
+	return 1.0
+}
+
+func newAnonymousID() string {
+	b := make([]byte, 5)
+	_, _ = rand.Read(b)
+	return "AN-" + strings.ToUpper(hex.EncodeToString(b))
+}
+
+func markingConfigToProto(cfg *domain.MarkingConfigModel) *pb.MarkingConfig {
+	return &pb.MarkingConfig{
+		ExamId:               cfg.ExamID,
+		DoubleMarking:        cfg.DoubleMarking,
+		DiscrepancyThreshold: float32(cfg.DiscrepancyThreshold),
+		ModeratorId:          cfg.ModeratorID,
+	}
+}
+
+func markingTaskToProto(a *domain.MarkerAssignmentModel) *pb.MarkingTask {
+	task := &pb.MarkingTask{
+		AssignmentId: a.Id,
+		ExamId:       a.ExamID,
+		Role:         a.Role,
+		Status:       a.Status,
+		AssignedAt:   a.CreatedAt.Format(time.RFC3339),
+	}
+	if a.Exam != nil {
+		task.ExamTitle = a.Exam.Title
+	}
+	if a.Marking != nil {
+		task.AnonymousId = a.Marking.AnonymousID
+	}
+	if a.CompletedAt != nil {
+		task.CompletedAt = a.CompletedAt.Format(time.RFC3339)
+	}
+	return task
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+)
+
+func TestAveragedMarksKeepRubricScores(t *testing.T) {
+	first := &domain.MarkerAssignmentModel{MarkerID: 10, Marks: []domain.EssayMarkModel{{
+		QuestionID: 1, Points: 4,
+		RubricScores: domain.FormatRubricScores([]*domain.RubricScoreModel{
+			{RubricID: 3, CriterionID: 31, LevelID: 311, LevelName: "Tốt", Points: 3, MaxPoints: 3},
+			{RubricID: 3, CriterionID: 32, LevelID: 321, LevelName: "Đạt", Points: 1, MaxPoints: 2},
+		}),
+	}}}
+	second := &domain.MarkerAssignmentModel{MarkerID: 11, Marks: []domain.EssayMarkModel{{
+		QuestionID: 1, Points: 3,
+		RubricScores: domain.FormatRubricScores([]*domain.RubricScoreModel{
+			{RubricID: 3, CriterionID: 32, LevelID: 321, LevelName: "Đạt", Points: 1, MaxPoints: 2},
+			{RubricID: 3, CriterionID: 31, LevelID: 312, LevelName: "Khá", Points: 2, MaxPoints: 3},
+		}),
+	}}}
+
+	final := averagedMarks(first, second)[1]
+	if final.points != 3.5 {
+		t.Errorf("điểm thống nhất = %v, muốn 3.5", final.points)
+	}
+	if len(final.rubric) != 2 {
+		t.Fatalf("có %d tiêu chí, muốn 2", len(final.rubric))
+	}
+	c31, c32 := final.rubric[0], final.rubric[1]
+	if c31.Points != 2.5 || c31.LevelID != 0 || c31.LevelName != "Tốt / Khá" {
+		t.Errorf("tiêu chí 31 = %+v, muốn 2.5 điểm và mức \"Tốt / Khá\"", c31)
+	}
+	if c32.Points != 1 || c32.LevelID != 321 {
+		t.Errorf("tiêu chí 32 = %+v, muốn giữ mức 321", c32)
+	}
+}
+
+func TestAveragedMarksWithoutCommonRubric(t *testing.T) {
+	first := &domain.MarkerAssignmentModel{Marks: []domain.EssayMarkModel{{
+		QuestionID: 1, Points: 2,
+		RubricScores: domain.FormatRubricScores([]*domain.RubricScoreModel{{RubricID: 3, CriterionID: 31, Points: 2}}),
+	}}}
+	second := &domain.MarkerAssignmentModel{Marks: []domain.EssayMarkModel{{QuestionID: 1, Points: 1}}}
+	if rubric := averagedMarks(first, second)[1].rubric; len(rubric) != 0 {
+		t.Errorf("chỉ một người chấm theo thang chấm nhưng vẫn có kết quả: %+v", rubric)
+	}
+
+	moderator := &domain.MarkerAssignmentModel{Marks: first.Marks}
+	if rubric := moderatedMarks(moderator)[1].rubric; len(rubric) != 1 || rubric[0].Points != 2 {
+		t.Errorf("điểm người điều phối mất thang chấm: %+v", rubric)
+	}
+}
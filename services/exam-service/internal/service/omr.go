@@ -0,0 +1,578 @@
+package service
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+// Bố cục phiếu trả lời (mm, khổ A4 dọc). Phiếu in và bộ đọc ảnh quét dùng chung các hằng số này.
+const (
+	sheetWidth         = 210.0
+	sheetHeight        = 297.0
+	sheetMarkSize      = 8.0
+	sheetMarkInset     = 15.0
+	sheetCodeX         = 30.0
+	sheetCodeY         = 46.0
+	sheetCodePitch     = 5.0
+	sheetCodeCell      = 3.6
+	sheetCodeCols      = 32
+	sheetCodeBits      = 96
+	sheetGridX         = 22.0
+	sheetGridY         = 74.0
+	sheetColumnWidth   = 44.0
+	sheetRowPitch      = 7.2
+	sheetRowsPerColumn = 25
+	sheetColumns       = 4
+	sheetLabelWidth    = 9.0
+	sheetBubblePitch   = 6.6
+	sheetBubbleRadius  = 2.4
+	sheetMaxChoices    = 5
+	sheetMaxQuestions  = sheetRowsPerColumn * sheetColumns
+
+	// Tỉ lệ điểm ảnh tối trong ô tròn: từ omrMarkedRatio trở lên là đã tô, quanh omrAmbiguousRatio là không chắc chắn.
+	omrMarkedRatio    = 0.5
+	omrAmbiguousRatio = 0.35
+	omrAmbiguousBand  = 0.15
+	omrMaxScanWidth   = 1700
+	omrMinPDFImageW   = 500
+	// Giới hạn kích thước ảnh quét trước khi giải mã để file nhỏ không chiếm hết bộ nhớ.
+	omrMaxScanPixels = 60_000_000
+)
+
+// sheetCode là mã in trên phiếu để nhận diện bài thi, học sinh và mã đề khi quét.
+type sheetCode struct {
+	examID    int64
+	studentID int64
+	version   int
+}
+
+// validate kiểm tra mã vừa với dải mã trên phiếu (32 bit cho mã bài thi và học sinh, 16 bit cho mã đề);
+// mã vượt dải sẽ bị cắt bớt và phiếu bị chấm nhầm sang bài thi hoặc học sinh khác.
+func (c sheetCode) validate() error {
+	if c.examID <= 0 || c.examID > math.MaxUint32 {
+		return fmt.Errorf("mã bài thi %d vượt quá giới hạn của phiếu trả lời", c.examID)
+	}
+	if c.studentID < 0 || c.studentID > math.MaxUint32 {
+		return fmt.Errorf("mã học sinh %d vượt quá giới hạn của phiếu trả lời", c.studentID)
+	}
+	if c.version < 0 || c.version > math.MaxUint16 {
+		return fmt.Errorf("mã đề %d vượt quá giới hạn của phiếu trả lời", c.version)
+	}
+	return nil
+}
+
+func (c sheetCode) bits() []bool {
+	payload := make([]byte, 12)
+	binary.BigEndian.PutUint32(payload[0:], uint32(c.examID))
+	binary.BigEndian.PutUint32(payload[4:], uint32(c.studentID))
+	binary.BigEndian.PutUint16(payload[8:], uint16(c.version))
+	binary.BigEndian.PutUint16(payload[10:], uint16(crc32.ChecksumIEEE(payload[:10])))
+	bits := make([]bool, sheetCodeBits)
+	for i := range bits {
+		bits[i] = payload[i/8]&(0x80>>(i%8)) != 0
+	}
+	return bits
+}
+
+func parseSheetCode(bits []bool) (sheetCode, bool) {
+	payload := make([]byte, 12)
+	for i, b := range bits {
+		if b {
+			payload[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	if binary.BigEndian.Uint16(payload[10:]) != uint16(crc32.ChecksumIEEE(payload[:10])) {
+		return sheetCode{}, false
+	}
+	code := sheetCode{
+		examID:    int64(binary.BigEndian.Uint32(payload[0:])),
+		studentID: int64(binary.BigEndian.Uint32(payload[4:])),
+		version:   int(binary.BigEndian.Uint16(payload[8:])),
+	}
+	return code, code.examID > 0
+}
+
+func sheetCodeCellCenter(i int) (float64, float64) {
+	return sheetCodeX + float64(i%sheetCodeCols)*sheetCodePitch, sheetCodeY + float64(i/sheetCodeCols)*sheetCodePitch
+}
+
+// sheetMarkCenters là tâm bốn dấu định vị theo thứ tự trên trái, trên phải, dưới trái, dưới phải.
+func sheetMarkCenters() [4][2]float64 {
+	return [4][2]float64{
+		{sheetMarkInset, sheetMarkInset},
+		{sheetWidth - sheetMarkInset, sheetMarkInset},
+		{sheetMarkInset, sheetHeight - sheetMarkInset},
+		{sheetWidth - sheetMarkInset, sheetHeight - sheetMarkInset},
+	}
+}
+
+// sheetBubbleCenter trả về tâm ô tròn thứ choice của câu ở vị trí slot trên phiếu.
+func sheetBubbleCenter(slot, choice int) (float64, float64) {
+	col, row := slot/sheetRowsPerColumn, slot%sheetRowsPerColumn
+	x := sheetGridX + float64(col)*sheetColumnWidth + sheetLabelWidth + sheetBubbleRadius + float64(choice)*sheetBubblePitch
+	return x, sheetGridY + float64(row)*sheetRowPitch
+}
+
+// omrConfidence đo độ chắc chắn của một ô: 1 khi tỉ lệ tô rõ ràng là trống hoặc đã tô, 0 khi nằm giữa.
+func omrConfidence(ratio float64) float64 {
+	return math.Min(1, math.Abs(ratio-omrAmbiguousRatio)/omrAmbiguousBand)
+}
+
+type grayImage struct {
+	w, h int
+	pix  []uint8
+}
+
+func (g *grayImage) at(x, y int) uint8 {
+	if x < 0 || y < 0 || x >= g.w || y >= g.h {
+		return 255
+	}
+	return g.pix[y*g.w+x]
+}
+
+// toGrayImage chuyển ảnh quét sang thang xám, thu nhỏ theo hệ số nguyên (lấy trung bình) nếu ảnh quá lớn.
+func toGrayImage(img image.Image) *grayImage {
+	b := img.Bounds()
+	factor := 1
+	for b.Dx()/factor > omrMaxScanWidth {
+		factor++
+	}
+	g := &grayImage{w: b.Dx() / factor, h: b.Dy() / factor}
+	g.pix = make([]uint8, g.w*g.h)
+	for y := 0; y < g.h; y++ {
+		for x := 0; x < g.w; x++ {
+			sum := 0
+			for dy := 0; dy < factor; dy++ {
+				for dx := 0; dx < factor; dx++ {
+					sum += int(color.GrayModel.Convert(img.At(b.Min.X+x*factor+dx, b.Min.Y+y*factor+dy)).(color.Gray).Y)
+				}
+			}
+			g.pix[y*g.w+x] = uint8(sum / (factor * factor))
+		}
+	}
+	return g
+}
+
+// otsuLevels chọn ngưỡng tách nền và nét tô theo phương pháp Otsu, kèm độ sáng trung bình của nét mực và của nền giấy.
+func otsuLevels(pix []uint8) (uint8, float64, float64) {
+	var hist [256]int
+	for _, p := range pix {
+		hist[p]++
+	}
+	total := len(pix)
+	sum := 0.0
+	for i, c := range hist {
+		sum += float64(i * c)
+	}
+	var sumB, best float64
+	wB := 0
+	threshold, ink, paper := 128, 0.0, 255.0
+	for i, c := range hist {
+		wB += c
+		if wB == 0 {
+			continue
+		}
+		wF := total - wB
+		if wF == 0 {
+			break
+		}
+		sumB += float64(i * c)
+		mB := sumB / float64(wB)
+		mF := (sum - sumB) / float64(wF)
+		between := float64(wB) * float64(wF) * (mB - mF) * (mB - mF)
+		if between > best {
+			best = between
+			threshold, ink, paper = i, mB, mF
+		}
+	}
+	return uint8(threshold), ink, paper
+}
+
+// sheetScan là một trang phiếu đã định vị: phép biến đổi phối cảnh từ toạ độ phiếu (mm) sang điểm ảnh.
+type sheetScan struct {
+	img       *grayImage
+	threshold uint8
+	ink       float64
+	paper     float64
+	marks     [4][2]float64
+	h         [9]float64
+}
+
+func (s *sheetScan) project(x, y float64) (float64, float64) {
+	w := s.h[6]*x + s.h[7]*y + s.h[8]
+	return (s.h[0]*x + s.h[1]*y + s.h[2]) / w, (s.h[3]*x + s.h[4]*y + s.h[5]) / w
+}
+
+// fillRatio trả về độ tô trung bình (0 = giấy trắng, 1 = nét mực đậm) trong hình tròn bán kính radius (mm)
+// quanh (x, y) trên phiếu. Dùng độ xám thay vì ngưỡng nhị phân để vết tô nhạt hoặc tẩy chưa sạch rơi vào vùng không chắc chắn.
+func (s *sheetScan) fillRatio(x, y, radius float64) float64 {
+	const steps = 9
+	sum, total := 0.0, 0
+	span := math.Max(s.paper-s.ink, 1)
+	for i := 0; i < steps; i++ {
+		for j := 0; j < steps; j++ {
+			dx := radius * (2*float64(i)/(steps-1) - 1)
+			dy := radius * (2*float64(j)/(steps-1) - 1)
+			if dx*dx+dy*dy > radius*radius {
+				continue
+			}
+			px, py := s.project(x+dx, y+dy)
+			v := float64(s.img.at(int(math.Round(px)), int(math.Round(py))))
+			sum += math.Max(0, math.Min(1, (s.paper-v)/span))
+			total++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return sum / float64(total)
+}
+
+// rotated trả về cách đọc của cùng trang khi phiếu bị quét ngược 180 độ.
+func (s *sheetScan) rotated() (*sheetScan, bool) {
+	r := &sheetScan{img: s.img, threshold: s.threshold, ink: s.ink, paper: s.paper}
+	r.marks = [4][2]float64{s.marks[3], s.marks[2], s.marks[1], s.marks[0]}
+	h, ok := computeHomography(sheetMarkCenters(), r.marks)
+	r.h = h
+	return r, ok
+}
+
+func (s *sheetScan) readCode() (sheetCode, bool) {
+	bits := make([]bool, sheetCodeBits)
+	for i := range bits {
+		x, y := sheetCodeCellCenter(i)
+		bits[i] = s.fillRatio(x, y, sheetCodeCell*0.35) >= omrMarkedRatio
+	}
+	return parseSheetCode(bits)
+}
+
+// readAnswerSheet định vị phiếu qua bốn dấu góc rồi đọc mã phiếu, thử cả chiều quét ngược.
+func readAnswerSheet(img image.Image) (*sheetScan, sheetCode, error) {
+	g := toGrayImage(img)
+	if g.w < 200 || g.h < 200 {
+		return nil, sheetCode{}, errors.New("ảnh quét quá nhỏ")
+	}
+	scan := &sheetScan{img: g}
+	scan.threshold, scan.ink, scan.paper = otsuLevels(g.pix)
+
+	expected := sheetMarkSize * float64(g.w) / sheetWidth
+	rw, rh := int(float64(g.w)*0.25), int(float64(g.h)*0.18)
+	regions := [4]image.Rectangle{
+		image.Rect(0, 0, rw, rh),
+		image.Rect(g.w-rw, 0, g.w, rh),
+		image.Rect(0, g.h-rh, rw, g.h),
+		image.Rect(g.w-rw, g.h-rh, g.w, g.h),
+	}
+	corners := [4]image.Point{{0, 0}, {g.w, 0}, {0, g.h}, {g.w, g.h}}
+	for i := range regions {
+		x, y, ok := findRegistrationMark(g, scan.threshold, regions[i], corners[i], expected)
+		if !ok {
+			return nil, sheetCode{}, errors.New("không tìm thấy đủ 4 dấu định vị ở góc phiếu")
+		}
+		scan.marks[i] = [2]float64{x, y}
+	}
+	h, ok := computeHomography(sheetMarkCenters(), scan.marks)
+	if !ok {
+		return nil, sheetCode{}, errors.New("vị trí các dấu định vị không hợp lệ")
+	}
+	scan.h = h
+
+	if code, ok := scan.readCode(); ok {
+		return scan, code, nil
+	}
+	if r, ok := scan.rotated(); ok {
+		if code, ok := r.readCode(); ok {
+			return r, code, nil
+		}
+	}
+	return nil, sheetCode{}, errors.New("không đọc được mã phiếu trả lời")
+}
+
+// findRegistrationMark tìm vùng tối liền khối gần vuông, kích thước gần expected, gần góc corner nhất.
+func findRegistrationMark(g *grayImage, threshold uint8, region image.Rectangle, corner image.Point, expected float64) (float64, float64, bool) {
+	visited := make([]bool, region.Dx()*region.Dy())
+	idx := func(x, y int) int { return (y-region.Min.Y)*region.Dx() + (x - region.Min.X) }
+	bestDist := math.MaxFloat64
+	var bestX, bestY float64
+	found := false
+	var stack []image.Point
+
+	for y := region.Min.Y; y < region.Max.Y; y++ {
+		for x := region.Min.X; x < region.Max.X; x++ {
+			if visited[idx(x, y)] || g.at(x, y) >= threshold {
+				continue
+			}
+			visited[idx(x, y)] = true
+			stack = append(stack[:0], image.Pt(x, y))
+			area, sumX, sumY := 0, 0, 0
+			minX, minY, maxX, maxY := x, y, x, y
+			for len(stack) > 0 {
+				p := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				area++
+				sumX += p.X
+				sumY += p.Y
+				minX, minY = min(minX, p.X), min(minY, p.Y)
+				maxX, maxY = max(maxX, p.X), max(maxY, p.Y)
+				for _, n := range [4]image.Point{{p.X + 1, p.Y}, {p.X - 1, p.Y}, {p.X, p.Y + 1}, {p.X, p.Y - 1}} {
+					if !n.In(region) || visited[idx(n.X, n.Y)] || g.at(n.X, n.Y) >= threshold {
+						continue
+					}
+					visited[idx(n.X, n.Y)] = true
+					stack = append(stack, n)
+				}
+			}
+
+			bw, bh := float64(maxX-minX+1), float64(maxY-minY+1)
+			side := math.Max(bw, bh)
+			if side < expected*0.5 || side > expected*1.8 || math.Min(bw, bh)/side < 0.6 {
+				continue
+			}
+			if float64(area)/(bw*bh) < 0.7 {
+				continue
+			}
+			cx, cy := float64(sumX)/float64(area), float64(sumY)/float64(area)
+			if d := math.Hypot(cx-float64(corner.X), cy-float64(corner.Y)); d < bestDist {
+				bestDist, bestX, bestY, found = d, cx, cy, true
+			}
+		}
+	}
+	return bestX, bestY, found
+}
+
+// computeHomography giải phép biến đổi phối cảnh đưa 4 điểm src về 4 điểm dst (khử Gauss).
+func computeHomography(src, dst [4][2]float64) ([9]float64, bool) {
+	var a [8][9]float64
+	for i := 0; i < 4; i++ {
+		x, y, u, v := src[i][0], src[i][1], dst[i][0], dst[i][1]
+		a[2*i] = [9]float64{x, y, 1, 0, 0, 0, -u * x, -u * y, u}
+		a[2*i+1] = [9]float64{0, 0, 0, x, y, 1, -v * x, -v * y, v}
+	}
+	for col := 0; col < 8; col++ {
+		pivot := col
+		for r := col + 1; r < 8; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-9 {
+			return [9]float64{}, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for r := 0; r < 8; r++ {
+			if r == col {
+				continue
+			}
+			f := a[r][col] / a[col][col]
+			for c := col; c < 9; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+		}
+	}
+	var h [9]float64
+	for i := 0; i < 8; i++ {
+		h[i] = a[i][8] / a[i][i]
+	}
+	h[8] = 1
+	return h, true
+}
+
+// scanPage là một trang ảnh quét đã giải mã, kèm dữ liệu gốc để lưu lại cho giáo viên xem khi duyệt.
+type scanPage struct {
+	img         image.Image
+	data        []byte
+	contentType string
+}
+
+// decodeScanFile tách file tải lên (PNG, JPEG hoặc PDF gồm các trang ảnh quét) thành từng trang.
+func decodeScanFile(data []byte) ([]scanPage, error) {
+	switch ct := http.DetectContentType(data); ct {
+	case "image/png", "image/jpeg":
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("không giải mã được ảnh: %w", err)
+		}
+		if err := checkScanSize(cfg.Width, cfg.Height); err != nil {
+			return nil, err
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("không giải mã được ảnh: %w", err)
+		}
+		return []scanPage{{img: img, data: data, contentType: ct}}, nil
+	case "application/pdf":
+		return extractPDFScanPages(data)
+	default:
+		return nil, fmt.Errorf("định dạng %s không được hỗ trợ, chỉ nhận PNG, JPEG hoặc PDF", ct)
+	}
+}
+
+var (
+	pdfStreamPattern = regexp.MustCompile(`(?s)<<((?:[^<>]|<<(?:[^<>]|<<[^<>]*>>)*>>)*)>>\s*stream\r?\n`)
+	pdfImageSubtype  = regexp.MustCompile(`/Subtype\s*/Image\b`)
+	pdfWidthKey      = pdfIntKey("Width")
+	pdfHeightKey     = pdfIntKey("Height")
+	pdfBitsKey       = pdfIntKey("BitsPerComponent")
+	pdfLengthKey     = pdfIntKey("Length")
+	pdfFilterKey     = regexp.MustCompile(`/Filter\s*\[?\s*/(\w+)`)
+	pdfColorSpaceKey = regexp.MustCompile(`/ColorSpace\s*/(\w+)`)
+)
+
+// extractPDFScanPages lấy các ảnh quét nhúng trong PDF theo thứ tự xuất hiện trong file (máy quét thường
+// nhúng mỗi trang một ảnh). Hỗ trợ ảnh JPEG (DCTDecode) và ảnh thô nén Flate ở thang xám hoặc RGB.
+func extractPDFScanPages(data []byte) ([]scanPage, error) {
+	var pages []scanPage
+	for _, loc := range pdfStreamPattern.FindAllSubmatchIndex(data, -1) {
+		dict := data[loc[2]:loc[3]]
+		if !pdfImageSubtype.Match(dict) {
+			continue
+		}
+		width, height := pdfDictInt(dict, pdfWidthKey), pdfDictInt(dict, pdfHeightKey)
+		if width < omrMinPDFImageW {
+			continue
+		}
+		if height > 0 {
+			if err := checkScanSize(width, height); err != nil {
+				return nil, err
+			}
+		}
+		start := loc[1]
+		end := bytes.Index(data[start:], []byte("endstream"))
+		if end < 0 {
+			continue
+		}
+		raw := data[start : start+end]
+		// Length có thể là tham chiếu gián tiếp; khi đó dựa vào vị trí endstream
+		if m := pdfLengthKey.FindSubmatch(dict); m != nil && len(m[2]) == 0 {
+			if length, _ := strconv.Atoi(string(m[1])); length > 0 && length <= len(raw) {
+				raw = raw[:length]
+			}
+		}
+
+		filter := ""
+		if m := pdfFilterKey.FindSubmatch(dict); m != nil {
+			filter = string(m[1])
+		}
+		switch filter {
+		case "DCTDecode":
+			cfg, err := jpeg.DecodeConfig(bytes.NewReader(raw))
+			if err != nil {
+				continue
+			}
+			if err := checkScanSize(cfg.Width, cfg.Height); err != nil {
+				return nil, err
+			}
+			img, err := jpeg.Decode(bytes.NewReader(raw))
+			if err != nil {
+				continue
+			}
+			pages = append(pages, scanPage{img: img, data: raw, contentType: "image/jpeg"})
+		case "FlateDecode", "":
+			if height <= 0 {
+				continue
+			}
+			if filter == "FlateDecode" {
+				zr, err := zlib.NewReader(bytes.NewReader(raw))
+				if err != nil {
+					continue
+				}
+				// Ảnh thô tối đa 3 byte mỗi điểm ảnh; đọc thêm 1 byte để phát hiện luồng nén vượt giới hạn
+				limit := int64(width) * int64(height) * 3
+				inflated, err := io.ReadAll(io.LimitReader(zr, limit+1))
+				if err != nil {
+					continue
+				}
+				if int64(len(inflated)) > limit {
+					return nil, fmt.Errorf("ảnh trong PDF giải nén vượt quá %d byte", limit)
+				}
+				raw = inflated
+			}
+			colorSpace := ""
+			if m := pdfColorSpaceKey.FindSubmatch(dict); m != nil {
+				colorSpace = string(m[1])
+			}
+			img := rawPDFImage(raw, width, height, pdfDictInt(dict, pdfBitsKey), colorSpace)
+			if img == nil {
+				continue
+			}
+			var buf bytes.Buffer
+			if err := png.Encode(&buf, img); err != nil {
+				continue
+			}
+			pages = append(pages, scanPage{img: img, data: buf.Bytes(), contentType: "image/png"})
+		}
+	}
+	if len(pages) == 0 {
+		return nil, errors.New("PDF không chứa trang ảnh quét được hỗ trợ (JPEG hoặc ảnh thô thang xám/RGB)")
+	}
+	return pages, nil
+}
+
+// checkScanSize từ chối ảnh có kích thước không hợp lệ hoặc vượt omrMaxScanPixels.
+func checkScanSize(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("kích thước ảnh %dx%d không hợp lệ", width, height)
+	}
+	if int64(width)*int64(height) > omrMaxScanPixels {
+		return fmt.Errorf("ảnh %dx%d vượt quá giới hạn %d điểm ảnh", width, height, omrMaxScanPixels)
+	}
+	return nil
+}
+
+func pdfIntKey(key string) *regexp.Regexp {
+	return regexp.MustCompile(`/` + key + `\s+(\d+)(\s+\d+\s+R)?`)
+}
+
+func pdfDictInt(dict []byte, key *regexp.Regexp) int {
+	m := key.FindSubmatch(dict)
+	if m == nil {
+		return 0
+	}
+	v, _ := strconv.Atoi(string(m[1]))
+	return v
+}
+
+func rawPDFImage(raw []byte, width, height, bits int, colorSpace string) image.Image {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	switch {
+	case colorSpace == "DeviceGray" && bits == 8 && len(raw) >= width*height:
+		return &image.Gray{Pix: raw, Stride: width, Rect: image.Rect(0, 0, width, height)}
+	case colorSpace == "DeviceGray" && bits == 1:
+		stride := (width + 7) / 8
+		if len(raw) < stride*height {
+			return nil
+		}
+		img := image.NewGray(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				if raw[y*stride+x/8]&(0x80>>(x%8)) != 0 {
+					img.Pix[y*width+x] = 255
+				}
+			}
+		}
+		return img
+	case colorSpace == "DeviceRGB" && bits == 8 && len(raw) >= width*height*3:
+		img := image.NewRGBA(image.Rect(0, 0, width, height))
+		for i := 0; i < width*height; i++ {
+			copy(img.Pix[i*4:i*4+3], raw[i*3:i*3+3])
+			img.Pix[i*4+3] = 255
+		}
+		return img
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/png"
+	"math"
+	"testing"
+)
+
+func TestSheetCodeRoundTrip(t *testing.T) {
+	codes := []sheetCode{
+		{examID: 1, studentID: 0, version: 0},
+		{examID: 42, studentID: 1001, version: 3},
+		{examID: math.MaxUint32, studentID: math.MaxUint32, version: math.MaxUint16},
+	}
+	for _, c := range codes {
+		if err := c.validate(); err != nil {
+			t.Fatalf("validate(%+v) lỗi: %v", c, err)
+		}
+		bits := c.bits()
+		if len(bits) != sheetCodeBits {
+			t.Fatalf("bits() có %d bit, muốn %d", len(bits), sheetCodeBits)
+		}
+		got, ok := parseSheetCode(bits)
+		if !ok || got != c {
+			t.Errorf("parseSheetCode(bits(%+v)) = %+v, %v", c, got, ok)
+		}
+	}
+}
+
+func TestSheetCodeRejectsCorruptBits(t *testing.T) {
+	bits := sheetCode{examID: 42, studentID: 1001, version: 3}.bits()
+	bits[5] = !bits[5]
+	if _, ok := parseSheetCode(bits); ok {
+		t.Error("mã bị lật một bit vẫn được chấp nhận")
+	}
+	if _, ok := parseSheetCode(make([]bool, sheetCodeBits)); ok {
+		t.Error("dải mã trống vẫn được chấp nhận")
+	}
+}
+
+func TestSheetCodeValidateRange(t *testing.T) {
+	tests := []struct {
+		name string
+		code sheetCode
+	}{
+		{"mã bài thi 0", sheetCode{examID: 0, studentID: 1}},
+		{"mã bài thi vượt 32 bit", sheetCode{examID: math.MaxUint32 + 1, studentID: 1}},
+		{"mã học sinh vượt 32 bit", sheetCode{examID: 1, studentID: 1<<32 + 7}},
+		{"mã học sinh âm", sheetCode{examID: 1, studentID: -1}},
+		{"mã đề vượt 16 bit", sheetCode{examID: 1, studentID: 1, version: math.MaxUint16 + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.code.validate(); err == nil {
+				t.Errorf("validate(%+v) không báo lỗi", tt.code)
+			}
+		})
+	}
+}
+
+func TestDecodeScanFileRejectsOversizedImage(t *testing.T) {
+	// PNG 10000x10000 chỉ vài KB sau khi nén nhưng vượt giới hạn điểm ảnh
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 10000, 10000))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodeScanFile(buf.Bytes()); err == nil {
+		t.Error("ảnh vượt giới hạn điểm ảnh vẫn được giải mã")
+	}
+}
+
+func TestExtractPDFScanPagesLimitsInflatedStream(t *testing.T) {
+	pdf := func(width, height int, pixels []byte) []byte {
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		zw.Write(pixels)
+		zw.Close()
+		var b bytes.Buffer
+		fmt.Fprintf(&b, "%%PDF-1.4\n1 0 obj\n<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>\nstream\n", width, height, z.Len())
+		b.Write(z.Bytes())
+		b.WriteString("\nendstream\nendobj\n%%EOF\n")
+		return b.Bytes()
+	}
+
+	pages, err := extractPDFScanPages(pdf(600, 10, make([]byte, 600*10)))
+	if err != nil || len(pages) != 1 {
+		t.Fatalf("PDF hợp lệ: %d trang, lỗi %v", len(pages), err)
+	}
+	// Luồng nén giải ra nhiều hơn kích thước khai báo (bom nén) phải bị từ chối
+	if _, err := extractPDFScanPages(pdf(600, 10, make([]byte, 600*10*3+1))); err == nil {
+		t.Error("luồng giải nén vượt giới hạn vẫn được chấp nhận")
+	}
+	if _, err := extractPDFScanPages(pdf(20000, 20000, nil)); err == nil {
+		t.Error("ảnh PDF vượt giới hạn điểm ảnh vẫn được chấp nhận")
+	}
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/env"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	defaultPaperVersions = 4
+	maxPaperVersions     = 24
+	maxPaperStudents     = 200
+	firstPaperCode       = 101
+)
+
+// paperQuestion là một câu trong mã đề, với lựa chọn (và cột ghép cặp) đã xáo theo thứ tự in.
+type paperQuestion struct {
+	question *domain.QuestionModel
+	points   float64
+	choices  []domain.ChoiceModel
+	targets  []string
+}
+
+// paperVersion là một mã đề in giấy. Với đề động mỗi học sinh có một mã đề riêng.
+type paperVersion struct {
+	code        string
+	studentID   int64
+	studentName string
+	questions   []paperQuestion
+}
+
+func (v *paperVersion) totalPoints() float64 {
+	total := 0.0
+	for _, pq := range v.questions {
+		total += pq.points
+	}
+	return total
+}
+
+// GeneratePaperExam sinh các mã đề in giấy (PDF) kèm đáp án, đóng gói thành một file zip.
+// Thứ tự xáo được sinh từ mã bài thi và mã đề nên in lại sẽ cho đúng các mã đề cũ.
+func (s *examService) GeneratePaperExam(ctx context.Context, req *pb.GeneratePaperExamRequest) (*pb.GeneratePaperExamResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	if exam.IsAdaptive {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi thích ứng chọn câu theo từng lượt trả lời nên không thể in đề giấy")
+	}
+
+	var versions []*paperVersion
+	if exam.IsDynamic {
+		versions, err = s.dynamicPaperVersions(ctx, exam, req.StudentIds)
+	} else {
+		versions, err = s.staticPaperVersions(ctx, exam, int(req.VersionCount))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	renderer, err := newPaperRenderer(env.GetString("EXAM_PDF_FONT_DIR", "/usr/share/fonts/truetype/dejavu"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi khởi tạo trình in PDF: %v", err)
+	}
+	header := paperHeader{schoolName: req.SchoolName, examDate: req.ExamDate}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	resp := &pb.GeneratePaperExamResponse{Versions: []*pb.PaperExamVersion{}}
+	for _, v := range versions {
+		examPDF, err := renderer.renderExam(ctx, exam, v, header)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi in mã đề %s: %v", v.code, err)
+		}
+		keyPDF, err := renderer.renderAnswerKey(exam, v, header)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi in đáp án mã đề %s: %v", v.code, err)
+		}
+		if err := writeZipFile(zw, "de_"+v.code+".pdf", examPDF); err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi đóng gói file zip: %v", err)
+		}
+		if err := writeZipFile(zw, "dap_an_"+v.code+".pdf", keyPDF); err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi đóng gói file zip: %v", err)
+		}
+		resp.Versions = append(resp.Versions, &pb.PaperExamVersion{
+			Code:          v.code,
+			StudentId:     v.studentID,
+			StudentName:   v.studentName,
+			QuestionCount: int32(len(v.questions)),
+			TotalPoints:   float32(v.totalPoints()),
+		})
+	}
+	if err := writeZipFile(zw, "danh_sach_ma_de.csv", paperManifest(versions)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi đóng gói file zip: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi đóng gói file zip: %v", err)
+	}
+
+	log.Printf("🖨️ Đã sinh %d mã đề giấy cho bài thi %d", len(versions), exam.Id)
+	resp.ZipData = buf.Bytes()
+	resp.Filename = fmt.Sprintf("de_thi_%d.zip", exam.Id)
+	return resp, nil
+}
+
+// staticPaperVersions xáo thứ tự câu hỏi và lựa chọn của đề cố định thành nhiều mã đề.
+func (s *examService) staticPaperVersions(ctx context.Context, exam *domain.ExamModel, count int) ([]*paperVersion, error) {
+	if count <= 0 {
+		count = defaultPaperVersions
+	}
+	if count > maxPaperVersions {
+		return nil, status.Errorf(codes.InvalidArgument, "Chỉ có thể in tối đa %d mã đề", maxPaperVersions)
+	}
+	if len(exam.Questions) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi chưa có câu hỏi")
+	}
+	questions, err := s.applyQuestionVersions(ctx, exam.Questions, submissionQuestionVersions(exam, &domain.ExamSubmissionModel{}))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy phiên bản câu hỏi: %v", err)
+	}
+	points := make(map[int64]float64, len(questions))
+	for _, q := range exam.Questions {
+		points[q.Id] = q.Points
+	}
+
+	versions := make([]*paperVersion, 0, count)
+	for i := 0; i < count; i++ {
+		code := firstPaperCode + i
+		rng := rand.New(rand.NewSource(exam.Id*1000 + int64(code)))
+		versions = append(versions, &paperVersion{
+			code:      strconv.Itoa(code),
+			questions: shufflePaperQuestions(rng, questions, points),
+		})
+	}
+	return versions, nil
+}
+
+// dynamicPaperVersions in đề riêng đã sinh cho từng học sinh; học sinh chưa có đề sẽ được sinh đề trước.
+func (s *examService) dynamicPaperVersions(ctx context.Context, exam *domain.ExamModel, studentIDs []int64) ([]*paperVersion, error) {
+	if len(studentIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Đề động cần danh sách học sinh để in đề riêng cho từng em")
+	}
+	if len(studentIDs) > maxPaperStudents {
+		return nil, status.Errorf(codes.InvalidArgument, "Chỉ có thể in tối đa %d học sinh mỗi lần", maxPaperStudents)
+	}
+
+	var missing []int64
+	for _, id := range studentIDs {
+		if _, err := s.repo.GetStudentExam(ctx, exam.Id, id); err != nil {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		if err := s.GeneratePersonalizedExamForStudents(ctx, exam.Id, missing); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "Lỗi sinh đề cho học sinh: %v", err)
+		}
+	}
+
+	versions := make([]*paperVersion, 0, len(studentIDs))
+	for i, studentID := range studentIDs {
+		questions, points, err := s.getExamQuestionsForUser(ctx, exam, studentID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lấy đề của học sinh %d: %v", studentID, err)
+		}
+		questions, err = s.applyQuestionVersions(ctx, questions, submissionQuestionVersions(exam, &domain.ExamSubmissionModel{}))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lấy phiên bản câu hỏi: %v", err)
+		}
+		code := firstPaperCode + i
+		rng := rand.New(rand.NewSource(exam.Id*1000003 + studentID))
+		name, _ := s.lookupUser(ctx, studentID)
+		versions = append(versions, &paperVersion{
+			code:        strconv.Itoa(code),
+			studentID:   studentID,
+			studentName: name,
+			questions:   shufflePaperQuestions(rng, questions, points),
+		})
+	}
+	return versions, nil
+}
+
+func shufflePaperQuestions(rng *rand.Rand, questions []*domain.QuestionModel, points map[int64]float64) []paperQuestion {
+	result := make([]paperQuestion, 0, len(questions))
+	for _, q := range questions {
+		pq := paperQuestion{question: q, points: questionPoints(points, q.Id)}
+		switch q.Type.Type {
+		case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice, domain.QuestionTypeOrdering, domain.QuestionTypeMatching:
+			var pinned []domain.ChoiceModel
+			for _, c := range q.Choices {
+				if c.PinLast && q.Type.Type != domain.QuestionTypeOrdering {
+					pinned = append(pinned, c)
+				} else {
+					pq.choices = append(pq.choices, c)
+				}
+			}
+			rng.Shuffle(len(pq.choices), func(i, j int) { pq.choices[i], pq.choices[j] = pq.choices[j], pq.choices[i] })
+			pq.choices = append(pq.choices, pinned...)
+		}
+		if q.Type.Type == domain.QuestionTypeMatching {
+			seen := make(map[string]bool)
+			for _, c := range q.Choices {
+				if c.MatchTarget != "" && !seen[c.MatchTarget] {
+					seen[c.MatchTarget] = true
+					pq.targets = append(pq.targets, c.MatchTarget)
+				}
+			}
+			rng.Shuffle(len(pq.targets), func(i, j int) { pq.targets[i], pq.targets[j] = pq.targets[j], pq.targets[i] })
+		}
+		result = append(result, pq)
+	}
+	rng.Shuffle(len(result), func(i, j int) { result[i], result[j] = result[j], result[i] })
+	return result
+}
+
+func paperManifest(versions []*paperVersion) []byte {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"ma_de", "student_id", "ho_ten", "so_cau", "tong_diem"})
+	for _, v := range versions {
+		studentID := ""
+		if v.studentID > 0 {
+			studentID = strconv.FormatInt(v.studentID, 10)
+		}
+		_ = w.Write([]string{v.code, studentID, v.studentName, strconv.Itoa(len(v.questions)), formatPaperPoints(v.totalPoints())})
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+func writeZipFile(zw *zip.Writer, name string, data []byte) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	return err
+}
@@ -0,0 +1,453 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/env"
+)
+
+const (
+	paperFontFamily   = "DejaVu"
+	paperMargin       = 15.0
+	paperLineHeight   = 6.0
+	paperIndent       = 8.0
+	paperEssayLines   = 8
+	paperImageMaxW    = 110.0
+	paperImageMaxH    = 70.0
+	paperImageMaxSize = 5 << 20
+)
+
+// paperImage là ảnh đính kèm đã tải về, dùng chung cho mọi mã đề trong một lần in.
+type paperImage struct {
+	data      []byte
+	imageType string
+}
+
+// paperRenderer dựng PDF đề thi và đáp án. Phông Unicode được đọc một lần để in được tiếng Việt.
+type paperRenderer struct {
+	regular []byte
+	bold    []byte
+	client  *http.Client
+	images  map[string]*paperImage
+	// imageHost là tên miền công khai của R2; chỉ ảnh trên tên miền này mới được tải về để tránh gọi tới máy chủ tuỳ ý.
+	imageHost string
+}
+
+type paperHeader struct {
+	schoolName string
+	examDate   string
+}
+
+func newPaperRenderer(fontDir string) (*paperRenderer, error) {
+	regular, err := os.ReadFile(filepath.Join(fontDir, "DejaVuSans.ttf"))
+	if err != nil {
+		return nil, fmt.Errorf("không đọc được phông chữ: %w", err)
+	}
+	bold, err := os.ReadFile(filepath.Join(fontDir, "DejaVuSans-Bold.ttf"))
+	if err != nil {
+		return nil, fmt.Errorf("không đọc được phông chữ đậm: %w", err)
+	}
+	r := &paperRenderer{
+		regular:   regular,
+		bold:      bold,
+		images:    make(map[string]*paperImage),
+		imageHost: strings.ToLower(strings.Trim(env.GetString("R2_PUBLIC_DOMAIN", ""), "/")),
+	}
+	r.client = &http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if !r.allowedImageURL(req.URL) {
+				return fmt.Errorf("chuyển hướng tới máy chủ không được phép")
+			}
+			if len(via) >= 3 {
+				return fmt.Errorf("quá nhiều lần chuyển hướng")
+			}
+			return nil
+		},
+	}
+	return r, nil
+}
+
+func (r *paperRenderer) newDocument(footer string) *fpdf.Fpdf {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(paperFontFamily, "", r.regular)
+	pdf.AddUTF8FontFromBytes(paperFontFamily, "B", r.bold)
+	pdf.SetMargins(paperMargin, paperMargin, paperMargin)
+	pdf.SetAutoPageBreak(true, paperMargin)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-10)
+		pdf.SetFont(paperFontFamily, "", 8)
+		pdf.CellFormat(0, 5, fmt.Sprintf("%s - Trang %d/{nb}", footer, pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+	return pdf
+}
+
+func (r *paperRenderer) writeHeader(pdf *fpdf.Fpdf, exam *domain.ExamModel, v *paperVersion, header paperHeader, answerKey bool) {
+	pageW, _ := pdf.GetPageSize()
+	contentW := pageW - 2*paperMargin
+
+	pdf.SetFont(paperFontFamily, "B", 10)
+	school := strings.ToUpper(firstNonEmpty(header.schoolName, "Đơn vị tổ chức thi"))
+	pdf.CellFormat(contentW/2, 5, school, "", 0, "L", false, 0, "")
+	pdf.SetFont(paperFontFamily, "", 10)
+	pdf.CellFormat(contentW/2, 5, header.examDate, "", 1, "R", false, 0, "")
+	pdf.Ln(3)
+
+	title := exam.Title
+	if answerKey {
+		title = "ĐÁP ÁN - " + title
+	}
+	pdf.SetFont(paperFontFamily, "B", 14)
+	pdf.MultiCell(contentW, 7, strings.ToUpper(title), "", "C", false)
+	pdf.SetFont(paperFontFamily, "", 10)
+	pdf.CellFormat(contentW, 5, fmt.Sprintf("Thời gian làm bài: %d phút (không kể thời gian phát đề)", exam.DurationMinutes), "", 1, "C", false, 0, "")
+	pdf.Ln(3)
+
+	y := pdf.GetY()
+	pdf.SetFont(paperFontFamily, "", 10)
+	if answerKey {
+		pdf.CellFormat(contentW-35, 8, fmt.Sprintf("Số câu: %d - Tổng điểm: %s", len(v.questions), formatPaperPoints(v.totalPoints())), "", 0, "L", false, 0, "")
+	} else if v.studentName != "" {
+		pdf.CellFormat(contentW-35, 8, "Họ và tên: "+v.studentName, "", 0, "L", false, 0, "")
+	} else {
+		pdf.CellFormat(contentW-35, 8, "Họ và tên: ..................................................   Số báo danh: ..................", "", 0, "L", false, 0, "")
+	}
+	pdf.SetFont(paperFontFamily, "B", 11)
+	pdf.CellFormat(35, 8, "Mã đề: "+v.code, "1", 1, "C", false, 0, "")
+	pdf.Ln(2)
+	pdf.Line(paperMargin, pdf.GetY(), pageW-paperMargin, pdf.GetY())
+	pdf.SetY(y + 12)
+}
+
+// renderExam in đề của một mã đề: câu hỏi và lựa chọn theo thứ tự đã xáo, kèm ảnh đính kèm.
+func (r *paperRenderer) renderExam(ctx context.Context, exam *domain.ExamModel, v *paperVersion, header paperHeader) ([]byte, error) {
+	pdf := r.newDocument("Mã đề " + v.code)
+	r.writeHeader(pdf, exam, v, header, false)
+	pageW, _ := pdf.GetPageSize()
+	contentW := pageW - 2*paperMargin
+
+	for i, pq := range v.questions {
+		q := pq.question
+		pdf.SetFont(paperFontFamily, "B", 11)
+		label := fmt.Sprintf("Câu %d (%s điểm): ", i+1, formatPaperPoints(pq.points))
+		pdf.CellFormat(pdf.GetStringWidth(label), paperLineHeight, label, "", 0, "L", false, 0, "")
+		pdf.SetFont(paperFontFamily, "", 11)
+		content := stripHTML(q.Content)
+		if q.Type.Type == domain.QuestionTypeCloze {
+			content = clozePlaceholderPattern.ReplaceAllString(content, "($1) ..........")
+		}
+		pdf.MultiCell(0, paperLineHeight, content, "", "L", false)
+		r.writeImage(ctx, pdf, q.AttachmentURL, paperMargin+paperIndent)
+
+		switch q.Type.Type {
+		case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice:
+			if q.Type.Type == domain.QuestionTypeMultipleChoice {
+				r.writeNote(pdf, "(Chọn tất cả các đáp án đúng)")
+			}
+			for j, c := range pq.choices {
+				r.writeItem(ctx, pdf, choiceLabel(j)+".", stripHTML(c.Content), c.AttachmentURL)
+			}
+		case domain.QuestionTypeOrdering:
+			r.writeNote(pdf, "(Sắp xếp các mục sau theo thứ tự đúng)")
+			for j, c := range pq.choices {
+				r.writeItem(ctx, pdf, choiceLabel(j)+".", stripHTML(c.Content), c.AttachmentURL)
+			}
+			r.writeAnswerLine(pdf, "Thứ tự: ")
+		case domain.QuestionTypeMatching:
+			r.writeNote(pdf, "(Ghép mỗi mục ở cột trái với một mục phù hợp ở cột phải)")
+			for j, c := range pq.choices {
+				r.writeItem(ctx, pdf, fmt.Sprintf("%d.", j+1), stripHTML(c.Content), c.AttachmentURL)
+			}
+			for j, t := range pq.targets {
+				r.writeItem(ctx, pdf, strings.ToLower(choiceLabel(j))+")", t, "")
+			}
+			r.writeAnswerLine(pdf, "Ghép: ")
+		case domain.QuestionTypeCloze:
+			for j := range domain.ParseAnswerConfig(q.AnswerConfig).Blanks {
+				r.writeAnswerLine(pdf, fmt.Sprintf("(%d) ", j+1))
+			}
+		case domain.QuestionTypeNumeric:
+			prompt := "Trả lời: "
+			if n := domain.ParseAnswerConfig(q.AnswerConfig).Numeric; n != nil && n.Unit != "" {
+				prompt = fmt.Sprintf("Trả lời (đơn vị %s): ", n.Unit)
+			}
+			r.writeAnswerLine(pdf, prompt)
+		case domain.QuestionTypeEssay:
+			for j := 0; j < paperEssayLines; j++ {
+				pdf.SetX(paperMargin + paperIndent)
+				pdf.CellFormat(contentW-paperIndent, paperLineHeight+1, strings.Repeat(".", 95), "", 1, "L", false, 0, "")
+			}
+		default:
+			r.writeAnswerLine(pdf, "Trả lời: ")
+		}
+		pdf.Ln(3)
+	}
+
+	pdf.SetFont(paperFontFamily, "B", 10)
+	pdf.CellFormat(contentW, paperLineHeight, "------------- HẾT -------------", "", 1, "C", false, 0, "")
+	return outputPDF(pdf)
+}
+
+// renderAnswerKey in bảng đáp án của một mã đề theo đúng thứ tự câu và nhãn lựa chọn trong đề.
+func (r *paperRenderer) renderAnswerKey(exam *domain.ExamModel, v *paperVersion, header paperHeader) ([]byte, error) {
+	pdf := r.newDocument("Đáp án mã đề " + v.code)
+	r.writeHeader(pdf, exam, v, header, true)
+	pageW, _ := pdf.GetPageSize()
+	contentW := pageW - 2*paperMargin
+	colQ, colP := 18.0, 20.0
+	colA := contentW - colQ - colP
+
+	pdf.SetFont(paperFontFamily, "B", 10)
+	pdf.CellFormat(colQ, 7, "Câu", "1", 0, "C", false, 0, "")
+	pdf.CellFormat(colA, 7, "Đáp án", "1", 0, "C", false, 0, "")
+	pdf.CellFormat(colP, 7, "Điểm", "1", 1, "C", false, 0, "")
+
+	pdf.SetFont(paperFontFamily, "", 10)
+	for i, pq := range v.questions {
+		lines := pdf.SplitText(paperAnswerText(pq), colA-2)
+		h := float64(len(lines)) * paperLineHeight
+		if h < 7 {
+			h = 7
+		}
+		_, pageH := pdf.GetPageSize()
+		if pdf.GetY()+h > pageH-paperMargin {
+			pdf.AddPage()
+		}
+		x, y := pdf.GetX(), pdf.GetY()
+		pdf.CellFormat(colQ, h, fmt.Sprintf("%d", i+1), "1", 0, "C", false, 0, "")
+		pdf.Rect(x+colQ, y, colA, h, "D")
+		pdf.SetXY(x+colQ+1, y+(h-float64(len(lines))*paperLineHeight)/2)
+		pdf.MultiCell(colA-2, paperLineHeight, strings.Join(lines, "\n"), "", "L", false)
+		pdf.SetXY(x+colQ+colA, y)
+		pdf.CellFormat(colP, h, formatPaperPoints(pq.points), "1", 1, "C", false, 0, "")
+	}
+	return outputPDF(pdf)
+}
+
+// paperAnswerText trả về đáp án của câu theo nhãn đã in trong mã đề.
+func paperAnswerText(pq paperQuestion) string {
+	q := pq.question
+	cfg := domain.ParseAnswerConfig(q.AnswerConfig)
+	switch q.Type.Type {
+	case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice:
+		var labels []string
+		for j, c := range pq.choices {
+			if c.IsCorrect {
+				labels = append(labels, choiceLabel(j))
+			}
+		}
+		return strings.Join(labels, ", ")
+	case domain.QuestionTypeOrdering:
+		order := make([]int, len(pq.choices))
+		for j := range order {
+			order[j] = j
+		}
+		sort.SliceStable(order, func(a, b int) bool { return pq.choices[order[a]].Position < pq.choices[order[b]].Position })
+		labels := make([]string, 0, len(order))
+		for _, j := range order {
+			labels = append(labels, choiceLabel(j))
+		}
+		return strings.Join(labels, " - ")
+	case domain.QuestionTypeMatching:
+		targetLabels := make(map[string]string, len(pq.targets))
+		for j, t := range pq.targets {
+			targetLabels[t] = strings.ToLower(choiceLabel(j))
+		}
+		pairs := make([]string, 0, len(pq.choices))
+		for j, c := range pq.choices {
+			if label, ok := targetLabels[c.MatchTarget]; ok {
+				pairs = append(pairs, fmt.Sprintf("%d-%s", j+1, label))
+			}
+		}
+		return strings.Join(pairs, ", ")
+	case domain.QuestionTypeNumeric:
+		if cfg.Numeric == nil {
+			return ""
+		}
+		text := formatPaperPoints(cfg.Numeric.Value)
+		if cfg.Numeric.Tolerance > 0 {
+			if cfg.Numeric.ToleranceType == domain.ToleranceRelative {
+				text += fmt.Sprintf(" (sai số %s%%)", formatPaperPoints(cfg.Numeric.Tolerance*100))
+			} else {
+				text += " ± " + formatPaperPoints(cfg.Numeric.Tolerance)
+			}
+		}
+		if cfg.Numeric.Unit != "" {
+			text += " " + cfg.Numeric.Unit
+		}
+		return text
+	case domain.QuestionTypeCloze:
+		parts := make([]string, 0, len(cfg.Blanks))
+		for j, b := range cfg.Blanks {
+			parts = append(parts, fmt.Sprintf("(%d) %s", j+1, strings.Join(b.Answers, " / ")))
+		}
+		return strings.Join(parts, "; ")
+	case domain.QuestionTypeShortAnswer:
+		var accepted []string
+		for _, c := range q.Choices {
+			if c.IsCorrect {
+				accepted = append(accepted, stripHTML(c.Content))
+			}
+		}
+		return strings.Join(accepted, " / ")
+	case domain.QuestionTypeEssay:
+		if q.Explanation != "" {
+			return "Tự luận. Gợi ý: " + stripHTML(q.Explanation)
+		}
+		return "Tự luận - chấm theo hướng dẫn"
+	}
+	return ""
+}
+
+func (r *paperRenderer) writeNote(pdf *fpdf.Fpdf, note string) {
+	pdf.SetX(paperMargin + paperIndent)
+	pdf.SetFont(paperFontFamily, "", 9)
+	pdf.MultiCell(0, 5, note, "", "L", false)
+	pdf.SetFont(paperFontFamily, "", 11)
+}
+
+func (r *paperRenderer) writeItem(ctx context.Context, pdf *fpdf.Fpdf, label, content, imageURL string) {
+	pdf.SetX(paperMargin + paperIndent)
+	pdf.SetFont(paperFontFamily, "B", 11)
+	pdf.CellFormat(8, paperLineHeight, label, "", 0, "L", false, 0, "")
+	pdf.SetFont(paperFontFamily, "", 11)
+	pdf.MultiCell(0, paperLineHeight, content, "", "L", false)
+	r.writeImage(ctx, pdf, imageURL, paperMargin+paperIndent+8)
+}
+
+func (r *paperRenderer) writeAnswerLine(pdf *fpdf.Fpdf, prompt string) {
+	pdf.SetX(paperMargin + paperIndent)
+	pdf.MultiCell(0, paperLineHeight+1, prompt+strings.Repeat(".", 80), "", "L", false)
+}
+
+// writeImage chèn ảnh đính kèm vào vị trí hiện tại. Ảnh không tải được hoặc không hỗ trợ sẽ được thay bằng đường dẫn.
+func (r *paperRenderer) writeImage(ctx context.Context, pdf *fpdf.Fpdf, url string, x float64) {
+	if url == "" {
+		return
+	}
+	img := r.loadImage(ctx, url)
+	if img != nil {
+		opts := fpdf.ImageOptions{ImageType: img.imageType}
+		info := pdf.RegisterImageOptionsReader(url, opts, bytes.NewReader(img.data))
+		if pdf.Ok() && info != nil {
+			w, h := info.Extent()
+			if w > paperImageMaxW {
+				h, w = h*paperImageMaxW/w, paperImageMaxW
+			}
+			if h > paperImageMaxH {
+				w, h = w*paperImageMaxH/h, paperImageMaxH
+			}
+			_, pageH := pdf.GetPageSize()
+			if pdf.GetY()+h > pageH-paperMargin {
+				pdf.AddPage()
+			}
+			y := pdf.GetY() + 1
+			pdf.ImageOptions(url, x, y, w, h, false, opts, 0, "")
+			pdf.SetY(y + h + 2)
+			return
+		}
+		pdf.ClearError()
+	}
+	pdf.SetX(x)
+	pdf.SetFont(paperFontFamily, "", 9)
+	pdf.MultiCell(0, 5, "[Hình ảnh: "+url+"]", "", "L", false)
+	pdf.SetFont(paperFontFamily, "", 11)
+}
+
+func (r *paperRenderer) loadImage(ctx context.Context, url string) *paperImage {
+	if img, ok := r.images[url]; ok {
+		return img
+	}
+	img, err := r.fetchImage(ctx, url)
+	if err != nil {
+		log.Printf("⚠️ Không tải được ảnh đính kèm %s: %v", url, err)
+	}
+	r.images[url] = img
+	return img
+}
+
+// allowedImageURL chỉ chấp nhận ảnh https trên tên miền R2 đã cấu hình.
+func (r *paperRenderer) allowedImageURL(u *url.URL) bool {
+	return r.imageHost != "" && u.Scheme == "https" && u.User == nil && strings.ToLower(u.Host) == r.imageHost
+}
+
+func (r *paperRenderer) fetchImage(ctx context.Context, rawURL string) (*paperImage, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("đường dẫn không hợp lệ")
+	}
+	if !r.allowedImageURL(u) {
+		return nil, fmt.Errorf("chỉ tải ảnh từ kho lưu trữ của hệ thống")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("mã phản hồi %d", resp.StatusCode)
+	}
+	if resp.ContentLength > paperImageMaxSize {
+		return nil, fmt.Errorf("ảnh vượt quá %d MB", paperImageMaxSize>>20)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, paperImageMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > paperImageMaxSize {
+		return nil, fmt.Errorf("ảnh vượt quá %d MB", paperImageMaxSize>>20)
+	}
+	var imageType string
+	switch http.DetectContentType(data) {
+	case "image/png":
+		imageType = "PNG"
+	case "image/jpeg":
+		imageType = "JPG"
+	case "image/gif":
+		imageType = "GIF"
+	default:
+		return nil, fmt.Errorf("định dạng ảnh không hỗ trợ")
+	}
+	return &paperImage{data: data, imageType: imageType}, nil
+}
+
+func outputPDF(pdf *fpdf.Fpdf) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func choiceLabel(i int) string {
+	if i < 26 {
+		return string(rune('A' + i))
+	}
+	return fmt.Sprintf("%d", i+1)
+}
+
+func formatPaperPoints(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
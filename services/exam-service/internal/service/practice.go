@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+// scorePracticeAnswer chấm ngay một câu của lượt luyện tập theo cùng bộ chấm với lúc nộp bài.
+func (s *examService) scorePracticeAnswer(ctx context.Context, exam *domain.ExamModel, sub *domain.ExamSubmissionModel, questionID int64, answer AnswerResponse) (*domain.QuestionModel, float64, ScoreResult, error) {
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, sub)
+	if err != nil {
+		return nil, 0, ScoreResult{}, status.Errorf(codes.Internal, "Lỗi lấy danh sách câu hỏi: %v", err)
+	}
+	for _, q := range questions {
+		if q.Id != questionID {
+			continue
+		}
+		points := questionPoints(qPointsMap, q.Id)
+		engine, err := s.scoringEngineFor(ctx, exam)
+		if err != nil {
+			return nil, 0, ScoreResult{}, status.Errorf(codes.Internal, "Lỗi chấm bài: %v", err)
+		}
+		result := engine.ScoreSubmission([]*domain.QuestionModel{q}, map[int64]float64{q.Id: points}, map[int64]AnswerResponse{q.Id: answer})
+		return q, points, result.Results[q.Id], nil
+	}
+	return nil, 0, ScoreResult{}, status.Error(codes.InvalidArgument, "Câu hỏi không thuộc lượt luyện tập này")
+}
+
+// recordPracticeAttempt lưu lần trả lời và trả về phản hồi gồm đáp án đúng và lời giải.
+func (s *examService) recordPracticeAttempt(ctx context.Context, sub *domain.ExamSubmissionModel, q *domain.QuestionModel, points float64, result ScoreResult) *pb.PracticeFeedback {
+	feedback := &pb.PracticeFeedback{
+		QuestionId:   q.Id,
+		IsCorrect:    result.IsCorrect,
+		Pending:      result.Pending,
+		EarnedPoints: float32(result.Earned),
+		Points:       float32(points),
+		Explanation:  q.Explanation,
+		Choices:      answerKeyChoices(q),
+	}
+	cfg := domain.ParseAnswerConfig(q.AnswerConfig)
+	feedback.Numeric = numericToProto(cfg.Numeric)
+	feedback.CorrectBlanks = blanksToProto(cfg.Blanks)
+
+	// Câu tự luận chờ giáo viên chấm nên không tính vào báo cáo luyện tập
+	if !result.Pending {
+		attempt := &domain.PracticeAttemptModel{
+			SubmissionID: sub.Id,
+			ExamID:       sub.ExamID,
+			UserID:       sub.UserID,
+			QuestionID:   q.Id,
+			IsCorrect:    result.IsCorrect,
+			Earned:       result.Earned,
+			Points:       points,
+			CreatedAt:    time.Now().UTC(),
+		}
+		if err := s.repo.CreatePracticeAttempt(ctx, attempt); err != nil {
+			log.Printf("⚠️ Không lưu được lần luyện tập câu %d của bài %d: %v", q.Id, sub.Id, err)
+		}
+	}
+	if count, err := s.repo.CountPracticeAttempts(ctx, sub.Id, q.Id); err == nil {
+		feedback.AttemptCount = int32(count)
+	}
+	return feedback
+}
+
+// practiceWrongQuestions trả về các câu mà lần trả lời gần nhất của học sinh vẫn sai.
+func (s *examService) practiceWrongQuestions(ctx context.Context, examID, userID int64) ([]int64, error) {
+	attempts, err := s.repo.GetPracticeAttempts(ctx, userID, examID)
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[int64]bool)
+	var order []int64
+	for _, a := range attempts {
+		if _, seen := latest[a.QuestionID]; !seen {
+			order = append(order, a.QuestionID)
+		}
+		latest[a.QuestionID] = a.IsCorrect
+	}
+	var wrong []int64
+	for _, id := range order {
+		if !latest[id] {
+			wrong = append(wrong, id)
+		}
+	}
+	return wrong, nil
+}
+
+func filterQuestionIDs(ids, allowed []int64) []int64 {
+	keep := make(map[int64]bool, len(allowed))
+	for _, id := range allowed {
+		keep[id] = true
+	}
+	var filtered []int64
+	for _, id := range ids {
+		if keep[id] {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
+}
+
+type practiceAreaStats struct {
+	name      string
+	areaID    int64
+	attempts  int
+	correct   int
+	latest    map[int64]bool
+	questions []int64
+}
+
+func (a *practiceAreaStats) add(attempt *domain.PracticeAttemptModel) {
+	if _, seen := a.latest[attempt.QuestionID]; !seen {
+		a.questions = append(a.questions, attempt.QuestionID)
+	}
+	a.latest[attempt.QuestionID] = attempt.IsCorrect
+	a.attempts++
+	if attempt.IsCorrect {
+		a.correct++
+	}
+}
+
+func (a *practiceAreaStats) toProto() *pb.PracticeArea {
+	area := &pb.PracticeArea{
+		Id:              a.areaID,
+		Name:            a.name,
+		Questions:       int32(len(a.questions)),
+		Attempts:        int32(a.attempts),
+		CorrectAttempts: int32(a.correct),
+		WeakQuestionIds: []int64{},
+	}
+	if a.attempts > 0 {
+		area.Accuracy = float32(a.correct) / float32(a.attempts)
+	}
+	for _, id := range a.questions {
+		if a.latest[id] {
+			area.MasteredQuestions++
+		} else {
+			area.WeakQuestionIds = append(area.WeakQuestionIds, id)
+		}
+	}
+	return area
+}
+
+// GetPracticeReport tổng hợp kết quả luyện tập của học sinh theo chương và chủ đề, phần yếu nhất đứng trước.
+func (s *examService) GetPracticeReport(ctx context.Context, req *pb.GetPracticeReportRequest) (*pb.GetPracticeReportResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Thiếu mã học sinh")
+	}
+	attempts, err := s.repo.GetPracticeAttempts(ctx, req.UserId, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy lịch sử luyện tập: %v", err)
+	}
+
+	seen := make(map[int64]bool)
+	var questionIDs []int64
+	for _, a := range attempts {
+		if !seen[a.QuestionID] {
+			seen[a.QuestionID] = true
+			questionIDs = append(questionIDs, a.QuestionID)
+		}
+	}
+	questions, err := s.repo.GetQuestionsByIDs(ctx, questionIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin câu hỏi: %v", err)
+	}
+	byID := make(map[int64]*domain.QuestionModel, len(questions))
+	for _, q := range questions {
+		byID[q.Id] = q
+	}
+
+	sections := make(map[int64]*practiceAreaStats)
+	topics := make(map[int64]*practiceAreaStats)
+	resp := &pb.GetPracticeReportResponse{Sections: []*pb.PracticeArea{}, Topics: []*pb.PracticeArea{}}
+	for _, a := range attempts {
+		resp.TotalAttempts++
+		if a.IsCorrect {
+			resp.CorrectAttempts++
+		}
+		q, ok := byID[a.QuestionID]
+		if !ok || q.Section == nil {
+			continue
+		}
+		sec, ok := sections[q.SectionID]
+		if !ok {
+			sec = &practiceAreaStats{areaID: q.SectionID, name: q.Section.Name, latest: make(map[int64]bool)}
+			sections[q.SectionID] = sec
+		}
+		sec.add(a)
+		if q.Section.Topic != nil {
+			topic, ok := topics[q.Section.TopicID]
+			if !ok {
+				topic = &practiceAreaStats{areaID: q.Section.TopicID, name: q.Section.Topic.Name, latest: make(map[int64]bool)}
+				topics[q.Section.TopicID] = topic
+			}
+			topic.add(a)
+		}
+	}
+	if resp.TotalAttempts > 0 {
+		resp.Accuracy = float32(resp.CorrectAttempts) / float32(resp.TotalAttempts)
+	}
+
+	for _, sec := range sections {
+		resp.Sections = append(resp.Sections, sec.toProto())
+	}
+	for _, topic := range topics {
+		resp.Topics = append(resp.Topics, topic.toProto())
+	}
+	sortPracticeAreas(resp.Sections)
+	sortPracticeAreas(resp.Topics)
+	return resp, nil
+}
+
+func sortPracticeAreas(areas []*pb.PracticeArea) {
+	sort.Slice(areas, func(i, j int) bool {
+		if areas[i].Accuracy != areas[j].Accuracy {
+			return areas[i].Accuracy < areas[j].Accuracy
+		}
+		if areas[i].Attempts != areas[j].Attempts {
+			return areas[i].Attempts > areas[j].Attempts
+		}
+		return areas[i].Id < areas[j].Id
+	})
+}
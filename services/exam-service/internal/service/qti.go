@@ -0,0 +1,153 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/env"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const defaultQTIExamTitle = "Đề thi nhập từ QTI"
+
+func (s *examService) ImportQTIPackage(ctx context.Context, req *pb.ImportQTIPackageRequest) (*pb.ImportQTIPackageResponse, error) {
+	if len(req.FileContent) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "File gói QTI trống")
+	}
+
+	var client *s3.Client
+	upload := func(name string, data []byte) (string, error) {
+		if client == nil {
+			var err error
+			if client, err = s.createR2ClientForUpload(ctx); err != nil {
+				return "", err
+			}
+		}
+		key := fmt.Sprintf("exams/qti/%d_%d_%s", req.CreatorId, time.Now().UnixNano(), name)
+		return s.putR2Object(ctx, client, key, mime.TypeByExtension(path.Ext(name)), data)
+	}
+
+	pkg, itemErrors, err := parseQTIPackage(req.FileContent, upload)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Gói QTI không hợp lệ: %v", err)
+	}
+
+	catalog := newImportCatalog(s, req.CreatorId)
+	resp := &pb.ImportQTIPackageResponse{Title: pkg.Title, Version: pkg.Version, Errors: itemErrors}
+	var links []*domain.ExamQuestionModel
+	var topicID int64
+
+	for _, item := range pkg.Items {
+		topicName := firstNonEmpty(item.Topic, req.DefaultTopic, defaultImportTopic)
+		sectionName := firstNonEmpty(item.Section, req.DefaultSection, defaultImportSection)
+
+		q, err := s.createBankQuestion(ctx, catalog, req.CreatorId, &item.bankQuestion, topicName, sectionName)
+		if err != nil {
+			resp.Errors = append(resp.Errors, bankError(item.Index, item.Name, err))
+			continue
+		}
+		if topicID == 0 {
+			topicID = q.TopicID
+		}
+		links = append(links, &domain.ExamQuestionModel{QuestionID: q.Id, Points: item.Points, Sequence: len(links)})
+	}
+	resp.SuccessCount = int32(len(links))
+	resp.ErrorCount = int32(len(resp.Errors))
+	if len(links) == 0 {
+		return resp, nil
+	}
+
+	duration := pkg.DurationMinutes
+	if duration <= 0 {
+		duration = 60
+	}
+	exam := &domain.ExamModel{
+		Title: firstNonEmpty(pkg.Title, defaultQTIExamTitle), Description: pkg.Description,
+		DurationMinutes: duration, MaxAttempts: 1, ShuffleQuestions: pkg.ShuffleQuestions,
+		DynamicConfig: "{}", TopicID: topicID, CreatorID: req.CreatorId, Status: "draft",
+	}
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		created, err := s.repo.CreateExam(ctx, tx, exam)
+		if err != nil {
+			return err
+		}
+		return s.repo.LinkQuestionsToExam(ctx, tx, created.Id, links)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi tạo đề thi từ gói QTI: %v", err)
+	}
+
+	log.Printf("📦 Đã nhập gói QTI %s thành đề %d (%d câu, %d lỗi)", pkg.Version, exam.Id, resp.SuccessCount, resp.ErrorCount)
+	resp.ExamId = exam.Id
+	resp.Title = exam.Title
+	return resp, nil
+}
+
+func (s *examService) ExportQTIPackage(ctx context.Context, req *pb.ExportQTIPackageRequest) (*pb.ExportQTIPackageResponse, error) {
+	version := normalizeQTIVersion(req.Version)
+	if version == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Phiên bản QTI %q không được hỗ trợ (chỉ hỗ trợ 2.1 và 3.0)", req.Version)
+	}
+
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if req.CreatorId <= 0 || exam.CreatorID != req.CreatorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền xuất bài thi này")
+	}
+	if len(exam.Questions) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi chưa có câu hỏi cố định để xuất QTI")
+	}
+
+	content, exportErrors, err := buildQTIPackage(exam, version)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi đóng gói QTI: %v", err)
+	}
+
+	client, err := s.createR2ClientForUpload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("exports/qti_%d_v%s_%d.zip", exam.Id, strings.ReplaceAll(version, ".", ""), time.Now().Unix())
+	fileURL, err := s.putR2Object(ctx, client, key, "application/zip", content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportQTIPackageResponse{
+		FileUrl:       fileURL,
+		ExportedCount: int32(len(exam.Questions) - len(exportErrors)),
+		Errors:        exportErrors,
+	}, nil
+}
+
+func (s *examService) putR2Object(ctx context.Context, client *s3.Client, key, contentType string, data []byte) (string, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	_, err := client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(env.GetString("R2_BUCKET_NAME", "")),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s/%s", env.GetString("R2_PUBLIC_DOMAIN", ""), key), nil
+}
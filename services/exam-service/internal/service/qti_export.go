@@ -0,0 +1,486 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	QTIVersion21 = "2.1"
+	QTIVersion30 = "3.0"
+
+	qtiManifestFile = "imsmanifest.xml"
+	qtiTestFile     = "assessment.xml"
+	qtiXSINamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// qtiProfile gom các namespace, schema và loại resource khác nhau giữa QTI 2.1 và 3.0.
+type qtiProfile struct {
+	Version       string
+	ItemNS        string
+	ItemSchema    string
+	ManifestNS    string
+	ItemType      string
+	TestType      string
+	Schema        string
+	SchemaVersion string
+	TemplateURL   func(name string) string
+}
+
+var qtiProfiles = map[string]qtiProfile{
+	QTIVersion21: {
+		Version:       QTIVersion21,
+		ItemNS:        "http://www.imsglobal.org/xsd/imsqti_v2p1",
+		ItemSchema:    "http://www.imsglobal.org/xsd/qti/qtiv2p1/imsqti_v2p1.xsd",
+		ManifestNS:    "http://www.imsglobal.org/xsd/imscp_v1p1",
+		ItemType:      "imsqti_item_xmlv2p1",
+		TestType:      "imsqti_test_xmlv2p1",
+		Schema:        "QTIv2.1 Package",
+		SchemaVersion: "1.0.0",
+		TemplateURL: func(name string) string {
+			return "http://www.imsglobal.org/question/qti_v2p1/rptemplates/" + name
+		},
+	},
+	QTIVersion30: {
+		Version:       QTIVersion30,
+		ItemNS:        "http://www.imsglobal.org/xsd/imsqtiasi_v3p0",
+		ItemSchema:    "https://purl.imsglobal.org/spec/qti/v3p0/schema/xsd/imsqti_asiv3p0_v1p0.xsd",
+		ManifestNS:    "http://www.imsglobal.org/xsd/qti/qtiv3p0/imscp_v1p1",
+		ItemType:      "imsqti_item_xmlv3p0",
+		TestType:      "imsqti_test_xmlv3p0",
+		Schema:        "QTI Package",
+		SchemaVersion: "3.0.0",
+		TemplateURL: func(name string) string {
+			return "https://purl.imsglobal.org/spec/qti/v3p0/rptemplates/" + name + ".xml"
+		},
+	},
+}
+
+func normalizeQTIVersion(version string) string {
+	switch strings.ToLower(strings.TrimSpace(version)) {
+	case "", "2", "2.1", "21", "v2p1":
+		return QTIVersion21
+	case "3", "3.0", "30", "v3p0":
+		return QTIVersion30
+	}
+	return ""
+}
+
+type qtiExportFile struct {
+	Name    string
+	Content []byte
+}
+
+// buildQTIPackage đóng gói đề thi thành file zip QTI gồm imsmanifest.xml, assessment.xml và mỗi câu hỏi một file item.
+// Câu hỏi không xuất được được bỏ qua và trả về trong danh sách lỗi.
+func buildQTIPackage(exam *domain.ExamModel, version string) ([]byte, []*pb.QuestionBankError, error) {
+	files, errs := buildQTIFiles(exam, version)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.Create(f.Name)
+		if err != nil {
+			return nil, errs, err
+		}
+		if _, err := w.Write(f.Content); err != nil {
+			return nil, errs, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errs, err
+	}
+	return buf.Bytes(), errs, nil
+}
+
+func buildQTIFiles(exam *domain.ExamModel, version string) ([]qtiExportFile, []*pb.QuestionBankError) {
+	p := qtiProfiles[version]
+	v3 := version == QTIVersion30
+	testID := fmt.Sprintf("EXAM%d", exam.Id)
+
+	var errs []*pb.QuestionBankError
+	var itemFiles []qtiExportFile
+	var itemIDs []string
+
+	testPart := qtiEl("testPart", "identifier", "P1", "navigationMode", "nonlinear", "submissionMode", "simultaneous")
+	var topicSec, sectionSec *qtiNode
+	lastTopic, lastSection := "", ""
+
+	for i, q := range exam.Questions {
+		item, err := buildQTIItem(q, p)
+		if err != nil {
+			errs = append(errs, &pb.QuestionBankError{Index: int32(i + 1), Name: bankQuestionName(q), Message: err.Error(), QuestionId: q.Id})
+			continue
+		}
+		ident := qtiItemIdentifier(q)
+		href := "items/" + ident + ".xml"
+		itemFiles = append(itemFiles, qtiExportFile{Name: href, Content: renderQTIDocument(item, v3)})
+		itemIDs = append(itemIDs, ident)
+
+		topic, section := questionTopicSection(q)
+		if topicSec == nil || topic != lastTopic {
+			topicSec = qtiEl("assessmentSection", "identifier", fmt.Sprintf("S%d", len(testPart.Children)+1), "title", topic, "visible", "true")
+			if len(testPart.Children) == 0 {
+				if exam.ShuffleQuestions {
+					topicSec.add(qtiEl("ordering", "shuffle", "true"))
+				}
+				if exam.Description != "" {
+					rubric := qtiEl("rubricBlock", "view", "candidate")
+					if v3 {
+						rubric.setAttr("use", "instructions")
+					}
+					topicSec.add(qtiContentBlock(rubric, exam.Description, v3))
+				}
+			}
+			testPart.add(topicSec)
+			lastTopic, sectionSec = topic, nil
+		}
+		if sectionSec == nil || section != lastSection {
+			sectionSec = qtiEl("assessmentSection", "identifier", fmt.Sprintf("%s_%d", topicSec.attr("identifier"), len(topicSec.childrenNamed("assessmentSection"))+1), "title", section, "visible", "true")
+			topicSec.add(sectionSec)
+			lastSection = section
+		}
+		sectionSec.add(qtiEl("assessmentItemRef", "identifier", ident, "href", href).
+			add(qtiEl("weight", "identifier", "W", "value", formatQTIFloat(q.Points))))
+	}
+
+	test := qtiEl("assessmentTest",
+		"xmlns", p.ItemNS, "xmlns:xsi", qtiXSINamespace, "xsi:schemaLocation", p.ItemNS+" "+p.ItemSchema,
+		"identifier", testID, "title", exam.Title)
+	test.add(qtiEl("outcomeDeclaration", "identifier", "SCORE", "cardinality", "single", "baseType", "float"))
+	if exam.DurationMinutes > 0 {
+		test.add(qtiEl("timeLimits", "maxTime", strconv.Itoa(exam.DurationMinutes*60)))
+	}
+	test.add(testPart)
+	test.add(qtiEl("outcomeProcessing").add(
+		qtiEl("setOutcomeValue", "identifier", "SCORE").add(
+			qtiEl("sum").add(qtiEl("testVariables", "variableIdentifier", "SCORE", "weightIdentifier", "W")))))
+
+	files := []qtiExportFile{
+		{Name: qtiManifestFile, Content: renderQTIDocument(buildQTIManifest(p, testID, itemIDs), v3)},
+		{Name: qtiTestFile, Content: renderQTIDocument(test, v3)},
+	}
+	return append(files, itemFiles...), errs
+}
+
+func buildQTIManifest(p qtiProfile, testID string, itemIDs []string) *qtiNode {
+	cp := func(name string, attrs ...string) *qtiNode {
+		n := qtiEl(name, attrs...)
+		n.Verbatim = true
+		return n
+	}
+
+	testRes := cp("resource", "identifier", testID, "type", p.TestType, "href", qtiTestFile).
+		add(cp("file", "href", qtiTestFile))
+	resources := cp("resources").add(testRes)
+	for _, id := range itemIDs {
+		href := "items/" + id + ".xml"
+		testRes.add(cp("dependency", "identifierref", id))
+		resources.add(cp("resource", "identifier", id, "type", p.ItemType, "href", href).add(cp("file", "href", href)))
+	}
+
+	return cp("manifest", "xmlns", p.ManifestNS, "identifier", "MANIFEST_"+testID).add(
+		cp("metadata").add(
+			cp("schema").add(qtiText(p.Schema)),
+			cp("schemaversion").add(qtiText(p.SchemaVersion)),
+		),
+		cp("organizations"),
+		resources,
+	)
+}
+
+func qtiItemIdentifier(q *domain.QuestionModel) string {
+	return fmt.Sprintf("Q%d", q.Id)
+}
+
+// qtiContentBlock ghi nội dung tự do (mô tả đề, giải thích) vào một phần tử khối;
+// QTI 3.0 yêu cầu nội dung nằm trong qti-content-body.
+func qtiContentBlock(block *qtiNode, content string, v3 bool) *qtiNode {
+	div := htmlEl("div").add(qtiContentNodes(content)...)
+	if v3 {
+		body := qtiEl("contentBody")
+		body.Inline = true
+		return block.add(body.add(div))
+	}
+	block.Inline = true
+	return block.add(div)
+}
+
+func buildQTIItem(q *domain.QuestionModel, p qtiProfile) (*qtiNode, error) {
+	v3 := p.Version == QTIVersion30
+	item := qtiEl("assessmentItem",
+		"xmlns", p.ItemNS, "xmlns:xsi", qtiXSINamespace, "xsi:schemaLocation", p.ItemNS+" "+p.ItemSchema,
+		"identifier", qtiItemIdentifier(q), "title", bankQuestionName(q))
+	if q.Difficulty.Difficulty != "" {
+		item.setAttr("label", q.Difficulty.Difficulty)
+	}
+	item.setAttr("adaptive", "false")
+	item.setAttr("timeDependent", "false")
+
+	cfg := domain.ParseAnswerConfig(q.AnswerConfig)
+	stem := htmlEl("div", "class", "qti-stem")
+	body := qtiEl("itemBody").add(stem)
+	var declarations []*qtiNode
+	var processing *qtiNode
+
+	if q.Type.Type == domain.QuestionTypeCloze {
+		stem.add(qtiClozeNodes(q.Content, cfg.Blanks)...)
+	} else {
+		stem.add(qtiContentNodes(q.Content)...)
+	}
+	if q.AttachmentURL != "" {
+		body.add(htmlEl("p").add(qtiAttachment(q.AttachmentURL)))
+	}
+
+	switch q.Type.Type {
+	case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice, "":
+		cardinality, maxChoices := "single", "1"
+		if q.Type.Type == domain.QuestionTypeMultipleChoice {
+			cardinality, maxChoices = "multiple", "0"
+		}
+		interaction := qtiEl("choiceInteraction", "responseIdentifier", "RESPONSE", "shuffle", "true", "maxChoices", maxChoices)
+		var correct []string
+		for i, c := range q.Choices {
+			id := fmt.Sprintf("C%d", i+1)
+			if c.IsCorrect {
+				correct = append(correct, id)
+			}
+			choice := qtiChoiceNode("simpleChoice", id, c)
+			if c.PinLast {
+				choice.setAttr("fixed", "true")
+			}
+			interaction.add(choice)
+		}
+		declarations = append(declarations, qtiResponseDeclaration("RESPONSE", cardinality, "identifier", correct, nil))
+		body.add(interaction)
+		processing = qtiTemplateProcessing(p, "match_correct")
+	case domain.QuestionTypeShortAnswer:
+		var answers []string
+		for _, c := range q.Choices {
+			if c.IsCorrect {
+				answers = append(answers, c.Content)
+			}
+		}
+		if len(answers) == 0 {
+			return nil, fmt.Errorf("câu trả lời ngắn chưa có đáp án")
+		}
+		declarations = append(declarations, qtiResponseDeclaration("RESPONSE", "single", "string", answers[:1], qtiMapping(answers, false)))
+		body.add(htmlEl("p").add(qtiEl("textEntryInteraction", "responseIdentifier", "RESPONSE", "expectedLength", "20")))
+		processing = qtiTemplateProcessing(p, "map_response")
+	case domain.QuestionTypeEssay:
+		declarations = append(declarations, qtiResponseDeclaration("RESPONSE", "single", "string", nil, nil))
+		body.add(qtiEl("extendedTextInteraction", "responseIdentifier", "RESPONSE"))
+	case domain.QuestionTypeNumeric:
+		n := cfg.Numeric
+		if n == nil {
+			return nil, fmt.Errorf("câu hỏi dạng số chưa có đáp án")
+		}
+		declarations = append(declarations, qtiResponseDeclaration("RESPONSE", "single", "float", []string{formatQTIFloat(n.Value)}, nil))
+		answer := htmlEl("p").add(qtiEl("textEntryInteraction", "responseIdentifier", "RESPONSE", "expectedLength", "10"))
+		if n.Unit != "" {
+			unit := htmlEl("span", "class", "qti-unit").add(qtiText(n.Unit))
+			if n.RequireUnit {
+				unit.setAttr("class", "qti-unit qti-unit-required")
+			}
+			if len(n.AcceptedUnits) > 0 {
+				unit.setAttr("title", strings.Join(n.AcceptedUnits, "|"))
+			}
+			answer.add(qtiText(" "), unit)
+		}
+		body.add(answer)
+
+		equal := qtiEl("equal", "toleranceMode", "exact")
+		if n.Tolerance > 0 {
+			if n.ToleranceType == domain.ToleranceRelative {
+				equal = qtiEl("equal", "toleranceMode", "relative", "tolerance", formatQTIFloat(n.Tolerance*100))
+			} else {
+				equal = qtiEl("equal", "toleranceMode", "absolute", "tolerance", formatQTIFloat(n.Tolerance))
+			}
+		}
+		equal.add(qtiEl("variable", "identifier", "RESPONSE"), qtiEl("correct", "identifier", "RESPONSE"))
+		processing = qtiEl("responseProcessing").add(
+			qtiEl("responseCondition").add(
+				qtiEl("responseIf").add(
+					equal,
+					qtiEl("setOutcomeValue", "identifier", "SCORE").add(qtiEl("variable", "identifier", "MAXSCORE")),
+				)))
+	case domain.QuestionTypeMatching:
+		premises := qtiEl("simpleMatchSet")
+		targets := qtiEl("simpleMatchSet")
+		targetIDs := make(map[string]string)
+		var pairs []string
+		for i, c := range q.Choices {
+			premiseID := fmt.Sprintf("P%d", i+1)
+			premise := qtiChoiceNode("simpleAssociableChoice", premiseID, c)
+			premise.setAttr("matchMax", "1")
+			premises.add(premise)
+			if c.MatchTarget == "" {
+				continue
+			}
+			targetID, ok := targetIDs[c.MatchTarget]
+			if !ok {
+				targetID = fmt.Sprintf("T%d", len(targetIDs)+1)
+				targetIDs[c.MatchTarget] = targetID
+				target := qtiEl("simpleAssociableChoice", "identifier", targetID, "matchMax", "0")
+				target.Inline = true
+				targets.add(target.add(qtiContentNodes(c.MatchTarget)...))
+			}
+			pairs = append(pairs, premiseID+" "+targetID)
+		}
+		declarations = append(declarations, qtiResponseDeclaration("RESPONSE", "multiple", "directedPair", pairs, nil))
+		body.add(qtiEl("matchInteraction", "responseIdentifier", "RESPONSE", "shuffle", "true", "maxAssociations", strconv.Itoa(len(q.Choices))).add(premises, targets))
+		processing = qtiTemplateProcessing(p, "match_correct")
+	case domain.QuestionTypeOrdering:
+		interaction := qtiEl("orderInteraction", "responseIdentifier", "RESPONSE", "shuffle", "true")
+		var order []string
+		for i, c := range orderedChoices(q.Choices) {
+			id := fmt.Sprintf("C%d", i+1)
+			order = append(order, id)
+			interaction.add(qtiChoiceNode("simpleChoice", id, c))
+		}
+		declarations = append(declarations, qtiResponseDeclaration("RESPONSE", "ordered", "identifier", order, nil))
+		body.add(interaction)
+		processing = qtiTemplateProcessing(p, "match_correct")
+	case domain.QuestionTypeCloze:
+		if len(cfg.Blanks) == 0 {
+			return nil, fmt.Errorf("câu điền khuyết chưa có chỗ trống")
+		}
+		sum := qtiEl("sum")
+		for i, b := range cfg.Blanks {
+			id := fmt.Sprintf("RESPONSE_%d", i+1)
+			var first []string
+			if len(b.Answers) > 0 {
+				first = b.Answers[:1]
+			}
+			declarations = append(declarations, qtiResponseDeclaration(id, "single", "string", first, qtiMapping(b.Answers, b.CaseSensitive)))
+			sum.add(qtiEl("mapResponse", "identifier", id))
+		}
+		processing = qtiEl("responseProcessing").add(
+			qtiEl("setOutcomeValue", "identifier", "SCORE").add(
+				qtiEl("divide").add(sum, qtiBaseValue("float", strconv.Itoa(len(cfg.Blanks))))))
+	default:
+		return nil, fmt.Errorf("loại câu hỏi %q không xuất được sang QTI", q.Type.Type)
+	}
+
+	item.add(declarations...)
+	item.add(qtiEl("outcomeDeclaration", "identifier", "SCORE", "cardinality", "single", "baseType", "float"))
+	item.add(qtiEl("outcomeDeclaration", "identifier", "MAXSCORE", "cardinality", "single", "baseType", "float").
+		add(qtiEl("defaultValue").add(qtiValue("1"))))
+	if q.Explanation != "" {
+		item.add(qtiEl("outcomeDeclaration", "identifier", "FEEDBACK", "cardinality", "single", "baseType", "identifier"))
+	}
+	item.add(body)
+	if processing != nil {
+		item.add(processing)
+	}
+	if q.Explanation != "" {
+		feedback := qtiEl("modalFeedback", "outcomeIdentifier", "FEEDBACK", "showHide", "hide", "identifier", "EXPLANATION")
+		item.add(qtiContentBlock(feedback, q.Explanation, v3))
+	}
+	return item, nil
+}
+
+func qtiResponseDeclaration(id, cardinality, baseType string, correct []string, mapping *qtiNode) *qtiNode {
+	decl := qtiEl("responseDeclaration", "identifier", id, "cardinality", cardinality, "baseType", baseType)
+	if len(correct) > 0 {
+		cr := qtiEl("correctResponse")
+		for _, v := range correct {
+			cr.add(qtiValue(v))
+		}
+		decl.add(cr)
+	}
+	if mapping != nil {
+		decl.add(mapping)
+	}
+	return decl
+}
+
+func qtiMapping(answers []string, caseSensitive bool) *qtiNode {
+	mapping := qtiEl("mapping", "defaultValue", "0")
+	for _, a := range answers {
+		mapping.add(qtiEl("mapEntry", "mapKey", a, "mappedValue", "1", "caseSensitive", strconv.FormatBool(caseSensitive)))
+	}
+	return mapping
+}
+
+func qtiTemplateProcessing(p qtiProfile, name string) *qtiNode {
+	return qtiEl("responseProcessing", "template", p.TemplateURL(name))
+}
+
+func qtiValue(v string) *qtiNode {
+	return qtiEl("value").add(qtiText(v))
+}
+
+func qtiBaseValue(baseType, v string) *qtiNode {
+	return qtiEl("baseValue", "baseType", baseType).add(qtiText(v))
+}
+
+func qtiAttachment(url string) *qtiNode {
+	return htmlEl("img", "class", "qti-attachment", "src", url, "alt", "")
+}
+
+func qtiChoiceNode(name, id string, c domain.ChoiceModel) *qtiNode {
+	n := qtiEl(name, "identifier", id)
+	n.Inline = true
+	n.add(qtiContentNodes(c.Content)...)
+	if c.AttachmentURL != "" {
+		n.add(qtiAttachment(c.AttachmentURL))
+	}
+	return n
+}
+
+// qtiClozeNodes thay {{i}} trong nội dung bằng textEntryInteraction RESPONSE_i; chỗ trống không xuất hiện được nối vào cuối.
+func qtiClozeNodes(content string, blanks []domain.ClozeBlank) []*qtiNode {
+	used := make(map[int]bool)
+	entry := func(i int) *qtiNode {
+		used[i] = true
+		return qtiEl("textEntryInteraction", "responseIdentifier", fmt.Sprintf("RESPONSE_%d", i), "expectedLength", "15")
+	}
+
+	var split func([]*qtiNode) []*qtiNode
+	split = func(nodes []*qtiNode) []*qtiNode {
+		var res []*qtiNode
+		for _, n := range nodes {
+			if !n.isText() {
+				n.Children = split(n.Children)
+				res = append(res, n)
+				continue
+			}
+			last := 0
+			for _, loc := range clozePlaceholderPattern.FindAllStringSubmatchIndex(n.Text, -1) {
+				idx, _ := strconv.Atoi(n.Text[loc[2]:loc[3]])
+				if idx < 1 || idx > len(blanks) {
+					continue
+				}
+				if loc[0] > last {
+					res = append(res, qtiText(n.Text[last:loc[0]]))
+				}
+				res = append(res, entry(idx))
+				last = loc[1]
+			}
+			if last < len(n.Text) {
+				res = append(res, qtiText(n.Text[last:]))
+			}
+		}
+		return res
+	}
+	nodes := split(qtiContentNodes(content))
+
+	for i := range blanks {
+		if !used[i+1] {
+			nodes = append(nodes, qtiText(" "), entry(i+1))
+		}
+	}
+	return nodes
+}
+
+func formatQTIFloat(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e9)/1e9, 'f', -1, 64)
+}
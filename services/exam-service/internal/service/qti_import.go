@@ -0,0 +1,573 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+// qtiAssetUploader tải file đính kèm trong gói lên kho lưu trữ và trả về URL công khai.
+type qtiAssetUploader func(name string, data []byte) (string, error)
+
+type qtiItem struct {
+	bankQuestion
+	Points float64
+}
+
+type qtiPackage struct {
+	Version          string
+	Title            string
+	Description      string
+	DurationMinutes  int
+	ShuffleQuestions bool
+	Items            []*qtiItem
+}
+
+type qtiItemRef struct {
+	Href    string
+	Weight  *float64
+	Topic   string
+	Section string
+}
+
+type qtiReader struct {
+	files  map[string]*zip.File
+	upload qtiAssetUploader
+	assets map[string]string
+	pkg    *qtiPackage
+}
+
+// parseQTIPackage đọc gói zip QTI 2.1 hoặc 3.0. Lỗi của từng item được trả về riêng,
+// lỗi cấu trúc gói (không phải zip, thiếu manifest) trả về qua error.
+func parseQTIPackage(content []byte, upload qtiAssetUploader) (*qtiPackage, []*pb.QuestionBankError, error) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("file không phải gói zip: %v", err)
+	}
+
+	r := &qtiReader{
+		files:  make(map[string]*zip.File),
+		upload: upload,
+		assets: make(map[string]string),
+		pkg:    &qtiPackage{Version: QTIVersion21},
+	}
+	manifestPath := ""
+	for _, f := range zr.File {
+		name := path.Clean(f.Name)
+		r.files[name] = f
+		if path.Base(name) == qtiManifestFile && (manifestPath == "" || len(name) < len(manifestPath)) {
+			manifestPath = name
+		}
+	}
+	if manifestPath == "" {
+		return nil, nil, fmt.Errorf("gói thiếu file %s", qtiManifestFile)
+	}
+
+	manifest, _, err := r.readXML(manifestPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	base := path.Dir(manifestPath)
+
+	var testHref string
+	var itemHrefs []string
+	for _, res := range manifest.findNamed("resource") {
+		resType := res.attr("type")
+		if strings.Contains(resType, "v3p0") {
+			r.pkg.Version = QTIVersion30
+		}
+		href := path.Join(base, res.attr("href"))
+		switch {
+		case strings.HasPrefix(resType, "imsqti_test") && testHref == "":
+			testHref = href
+		case strings.HasPrefix(resType, "imsqti_item"):
+			itemHrefs = append(itemHrefs, href)
+		}
+	}
+
+	var refs []qtiItemRef
+	if testHref != "" {
+		if refs, err = r.readTest(testHref); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		for _, href := range itemHrefs {
+			refs = append(refs, qtiItemRef{Href: href})
+		}
+	}
+	if len(refs) == 0 {
+		return nil, nil, fmt.Errorf("gói không có câu hỏi nào")
+	}
+
+	var errs []*pb.QuestionBankError
+	for i, ref := range refs {
+		item, name, err := r.readItem(ref)
+		if err != nil {
+			errs = append(errs, bankError(i+1, firstNonEmpty(name, ref.Href), err))
+			continue
+		}
+		item.Index = i + 1
+		r.pkg.Items = append(r.pkg.Items, item)
+	}
+	return r.pkg, errs, nil
+}
+
+func (r *qtiReader) readFile(name string) ([]byte, error) {
+	f, ok := r.files[path.Clean(name)]
+	if !ok {
+		return nil, fmt.Errorf("không tìm thấy file %s trong gói", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func (r *qtiReader) readXML(name string) (*qtiNode, bool, error) {
+	data, err := r.readFile(name)
+	if err != nil {
+		return nil, false, err
+	}
+	root, v3, err := parseQTIXML(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("file %s không phải XML hợp lệ: %v", name, err)
+	}
+	if v3 {
+		r.pkg.Version = QTIVersion30
+	}
+	return root, v3, nil
+}
+
+// readTest lấy thông tin đề và danh sách item theo thứ tự trong assessmentTest.
+// Section cấp 1 là chủ đề, các cấp sâu hơn là chương; test chỉ có một cấp section thì coi đó là chương.
+func (r *qtiReader) readTest(href string) ([]qtiItemRef, error) {
+	test, _, err := r.readXML(href)
+	if err != nil {
+		return nil, err
+	}
+	if test.Name != "assessmentTest" {
+		return nil, fmt.Errorf("file %s không phải assessmentTest", href)
+	}
+	r.pkg.Title = test.attr("title")
+	if limits := test.child("timeLimits"); limits != nil {
+		if secs, err := strconv.ParseFloat(limits.attr("maxTime"), 64); err == nil && secs > 0 {
+			r.pkg.DurationMinutes = int((secs + 59) / 60)
+		}
+	}
+
+	dir := path.Dir(href)
+	var refs []qtiItemRef
+	var walk func(n *qtiNode, titles []string)
+	walk = func(n *qtiNode, titles []string) {
+		for _, c := range n.elements() {
+			switch c.Name {
+			case "assessmentSection":
+				if ordering := c.child("ordering"); ordering != nil && ordering.attr("shuffle") == "true" {
+					r.pkg.ShuffleQuestions = true
+				}
+				if rubric := c.child("rubricBlock"); rubric != nil && r.pkg.Description == "" {
+					r.pkg.Description = qtiInnerContent(qtiBlockContent(rubric))
+				}
+				walk(c, append(append([]string{}, titles...), strings.TrimSpace(c.attr("title"))))
+			case "assessmentItemRef":
+				ref := qtiItemRef{Href: path.Join(dir, c.attr("href"))}
+				if w := c.child("weight"); w != nil {
+					if v, err := strconv.ParseFloat(w.attr("value"), 64); err == nil {
+						ref.Weight = &v
+					}
+				}
+				switch {
+				case len(titles) >= 2:
+					ref.Topic, ref.Section = titles[0], strings.Join(titles[1:], " / ")
+				case len(titles) == 1:
+					ref.Section = titles[0]
+				}
+				refs = append(refs, ref)
+			case "testPart":
+				walk(c, titles)
+			}
+		}
+	}
+	walk(test, nil)
+	return refs, nil
+}
+
+// qtiBlockContent bỏ lớp qti-content-body và thẻ div bao ngoài mà bản xuất thêm vào.
+func qtiBlockContent(n *qtiNode) []*qtiNode {
+	if body := n.child("contentBody"); body != nil {
+		n = body
+	}
+	if elems := n.elements(); len(elems) == 1 && elems[0].Name == "div" && len(elems[0].Attrs) == 0 && strings.TrimSpace(n.textContent()) == strings.TrimSpace(elems[0].textContent()) {
+		return elems[0].Children
+	}
+	return n.Children
+}
+
+func (r *qtiReader) readItem(ref qtiItemRef) (*qtiItem, string, error) {
+	root, _, err := r.readXML(ref.Href)
+	if err != nil {
+		return nil, "", err
+	}
+	if root.Name != "assessmentItem" {
+		return nil, "", fmt.Errorf("file %s không phải assessmentItem", ref.Href)
+	}
+	name := root.attr("title")
+
+	if err := r.resolveAssets(root, path.Dir(ref.Href)); err != nil {
+		return nil, name, err
+	}
+	item, err := convertQTIItem(root)
+	if err != nil {
+		return nil, name, err
+	}
+	item.Name = firstNonEmpty(name, bankQuestionName(&domain.QuestionModel{Content: item.Content}))
+	item.Topic, item.Section = ref.Topic, ref.Section
+	if ref.Weight != nil {
+		item.Points = *ref.Weight
+	}
+	return item, name, nil
+}
+
+// resolveAssets tải ảnh nằm trong gói lên kho lưu trữ và thay src bằng URL công khai.
+func (r *qtiReader) resolveAssets(root *qtiNode, dir string) error {
+	for _, img := range root.findNamed("img") {
+		src := strings.TrimSpace(img.attr("src"))
+		if src == "" || strings.Contains(src, "://") || strings.HasPrefix(src, "//") || strings.HasPrefix(src, "data:") {
+			continue
+		}
+		if unescaped, err := url.PathUnescape(src); err == nil {
+			src = unescaped
+		}
+		name := path.Join(dir, src)
+		if uploaded, ok := r.assets[name]; ok {
+			img.setAttr("src", uploaded)
+			continue
+		}
+		data, err := r.readFile(name)
+		if err != nil {
+			return err
+		}
+		if r.upload == nil {
+			return fmt.Errorf("không tải được file đính kèm %s", src)
+		}
+		uploaded, err := r.upload(path.Base(name), data)
+		if err != nil {
+			return fmt.Errorf("không tải được file đính kèm %s: %v", src, err)
+		}
+		r.assets[name] = uploaded
+		img.setAttr("src", uploaded)
+	}
+	return nil
+}
+
+func convertQTIItem(root *qtiNode) (*qtiItem, error) {
+	item := &qtiItem{Points: 1}
+
+	declarations := make(map[string]*qtiNode)
+	for _, d := range root.childrenNamed("responseDeclaration") {
+		declarations[d.attr("identifier")] = d
+	}
+	for _, o := range root.childrenNamed("outcomeDeclaration") {
+		if o.attr("identifier") != "MAXSCORE" {
+			continue
+		}
+		if v, err := strconv.ParseFloat(strings.TrimSpace(qtiDefaultValue(o)), 64); err == nil {
+			item.Points = v
+		}
+	}
+	switch label := strings.ToLower(root.attr("label")); label {
+	case "easy", "medium", "hard":
+		item.Difficulty = label
+	}
+	if feedback := root.child("modalFeedback"); feedback != nil {
+		item.Explanation = qtiInnerContent(qtiBlockContent(feedback))
+	}
+
+	body := root.child("itemBody")
+	if body == nil {
+		return nil, fmt.Errorf("câu hỏi không có itemBody")
+	}
+	interactions := body.find(func(n *qtiNode) bool { return strings.HasSuffix(n.Name, "Interaction") })
+	if len(interactions) == 0 {
+		return nil, fmt.Errorf("câu hỏi không có tương tác nào")
+	}
+	textEntries := 0
+	for _, in := range interactions {
+		if in.Name == "textEntryInteraction" {
+			textEntries++
+		}
+	}
+	if textEntries != len(interactions) && len(interactions) > 1 {
+		return nil, fmt.Errorf("chưa hỗ trợ câu hỏi có nhiều tương tác khác loại")
+	}
+
+	main := interactions[0]
+	decl := declarations[main.attr("responseIdentifier")]
+	if decl == nil && main.Name != "extendedTextInteraction" {
+		return nil, fmt.Errorf("thiếu responseDeclaration cho %s", main.attr("responseIdentifier"))
+	}
+
+	isCloze := main.Name == "textEntryInteraction" && (textEntries > 1 || main.attr("responseIdentifier") != "RESPONSE")
+	blankIndex := make(map[*qtiNode]int)
+	if isCloze {
+		for i, in := range interactions {
+			blankIndex[in] = i + 1
+		}
+	}
+
+	contentNodes := rewriteQTINodes(body.Children, func(n *qtiNode) ([]*qtiNode, bool) {
+		switch {
+		case n.Name == "img" && n.hasClass("qti-attachment"):
+			if item.Attachment == "" {
+				item.Attachment = n.attr("src")
+			}
+			return nil, true
+		case n.Name == "span" && n.hasClass("qti-unit"):
+			return nil, true
+		case strings.HasSuffix(n.Name, "Interaction"):
+			if idx, ok := blankIndex[n]; ok {
+				return []*qtiNode{qtiText(fmt.Sprintf("{{%d}}", idx))}, true
+			}
+			return nil, true
+		case n.Name == "rubricBlock" || strings.HasPrefix(n.Name, "feedback"):
+			return nil, true
+		}
+		return nil, false
+	})
+	contentNodes = dropEmptyQTIParagraphs(contentNodes)
+	for _, n := range contentNodes {
+		if n.Name == "div" && n.hasClass("qti-stem") {
+			contentNodes = n.Children
+			break
+		}
+	}
+	item.Content = qtiInnerContent(contentNodes)
+	if prompt := main.child("prompt"); prompt != nil {
+		if p := qtiInnerContent(prompt.Children); p != "" {
+			item.Content = strings.TrimSpace(item.Content + "\n" + p)
+		}
+	}
+
+	switch main.Name {
+	case "choiceInteraction":
+		item.Type = domain.QuestionTypeSingleChoice
+		if decl.attr("cardinality") == "multiple" || (main.attr("maxChoices") != "1" && main.attr("maxChoices") != "") {
+			item.Type = domain.QuestionTypeMultipleChoice
+		}
+		correct := qtiValueSet(qtiCorrectValues(decl))
+		for _, sc := range main.childrenNamed("simpleChoice") {
+			c := qtiChoiceModel(sc)
+			c.IsCorrect = correct[sc.attr("identifier")]
+			c.PinLast = sc.attr("fixed") == "true"
+			item.Choices = append(item.Choices, c)
+		}
+	case "orderInteraction":
+		item.Type = domain.QuestionTypeOrdering
+		byID := make(map[string]*domain.ChoiceModel)
+		var ids []string
+		for _, sc := range main.childrenNamed("simpleChoice") {
+			byID[sc.attr("identifier")] = qtiChoiceModel(sc)
+			ids = append(ids, sc.attr("identifier"))
+		}
+		used := make(map[string]bool)
+		for _, id := range append(qtiCorrectValues(decl), ids...) {
+			c, ok := byID[id]
+			if !ok || used[id] {
+				continue
+			}
+			used[id] = true
+			c.Position = len(item.Choices) + 1
+			item.Choices = append(item.Choices, c)
+		}
+	case "matchInteraction":
+		item.Type = domain.QuestionTypeMatching
+		sets := main.childrenNamed("simpleMatchSet")
+		if len(sets) != 2 {
+			return nil, fmt.Errorf("matchInteraction cần đúng 2 simpleMatchSet")
+		}
+		targets := make(map[string]string)
+		for _, t := range sets[1].childrenNamed("simpleAssociableChoice") {
+			targets[t.attr("identifier")] = qtiInnerContent(t.Children)
+		}
+		pairs := make(map[string]string)
+		for _, v := range qtiCorrectValues(decl) {
+			parts := strings.Fields(v)
+			if len(parts) == 2 && pairs[parts[0]] == "" {
+				pairs[parts[0]] = targets[parts[1]]
+			}
+		}
+		for _, p := range sets[0].childrenNamed("simpleAssociableChoice") {
+			c := qtiChoiceModel(p)
+			c.MatchTarget = pairs[p.attr("identifier")]
+			item.Choices = append(item.Choices, c)
+		}
+	case "extendedTextInteraction":
+		item.Type = domain.QuestionTypeEssay
+	case "textEntryInteraction":
+		if isCloze {
+			item.Type = domain.QuestionTypeCloze
+			for _, in := range interactions {
+				d := declarations[in.attr("responseIdentifier")]
+				if d == nil {
+					return nil, fmt.Errorf("thiếu responseDeclaration cho %s", in.attr("responseIdentifier"))
+				}
+				answers, caseSensitive := qtiAcceptedAnswers(d)
+				item.AnswerConfig.Blanks = append(item.AnswerConfig.Blanks, domain.ClozeBlank{Answers: answers, CaseSensitive: caseSensitive})
+			}
+			break
+		}
+		switch decl.attr("baseType") {
+		case "float", "integer":
+			item.Type = domain.QuestionTypeNumeric
+			numeric, err := qtiNumericAnswer(decl, root.child("responseProcessing"), body)
+			if err != nil {
+				return nil, err
+			}
+			item.AnswerConfig.Numeric = numeric
+		default:
+			item.Type = domain.QuestionTypeShortAnswer
+			answers, _ := qtiAcceptedAnswers(decl)
+			for _, a := range answers {
+				item.Choices = append(item.Choices, &domain.ChoiceModel{Content: a, IsCorrect: true})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("tương tác %s chưa được hỗ trợ", main.Name)
+	}
+	return item, nil
+}
+
+func qtiDefaultValue(decl *qtiNode) string {
+	if dv := decl.child("defaultValue"); dv != nil {
+		if v := dv.child("value"); v != nil {
+			return v.textContent()
+		}
+	}
+	return ""
+}
+
+func qtiCorrectValues(decl *qtiNode) []string {
+	var values []string
+	if decl == nil {
+		return values
+	}
+	if cr := decl.child("correctResponse"); cr != nil {
+		for _, v := range cr.childrenNamed("value") {
+			values = append(values, strings.TrimSpace(v.textContent()))
+		}
+	}
+	return values
+}
+
+func qtiValueSet(values []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// qtiAcceptedAnswers lấy các đáp án được điểm từ mapping, không có mapping thì dùng correctResponse.
+func qtiAcceptedAnswers(decl *qtiNode) ([]string, bool) {
+	var answers []string
+	caseSensitive := false
+	if mapping := decl.child("mapping"); mapping != nil {
+		for _, e := range mapping.childrenNamed("mapEntry") {
+			if v, err := strconv.ParseFloat(e.attr("mappedValue"), 64); err == nil && v <= 0 {
+				continue
+			}
+			answers = append(answers, e.attr("mapKey"))
+			if e.attr("caseSensitive") == "true" {
+				caseSensitive = true
+			}
+		}
+	}
+	if len(answers) == 0 {
+		answers = qtiCorrectValues(decl)
+	}
+	return answers, caseSensitive
+}
+
+func qtiNumericAnswer(decl, processing, body *qtiNode) (*domain.NumericAnswer, error) {
+	values := qtiCorrectValues(decl)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("câu hỏi dạng số thiếu correctResponse")
+	}
+	value, err := strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("đáp án số %q không hợp lệ", values[0])
+	}
+	n := &domain.NumericAnswer{Value: value, ToleranceType: domain.ToleranceAbsolute}
+
+	if processing != nil {
+		for _, eq := range processing.findNamed("equal") {
+			fields := strings.Fields(eq.attr("tolerance"))
+			if len(fields) == 0 {
+				continue
+			}
+			tolerance, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				continue
+			}
+			switch eq.attr("toleranceMode") {
+			case "absolute":
+				n.Tolerance = tolerance
+			case "relative":
+				n.Tolerance, n.ToleranceType = tolerance/100, domain.ToleranceRelative
+			}
+			break
+		}
+	}
+
+	for _, span := range body.findNamed("span") {
+		if !span.hasClass("qti-unit") {
+			continue
+		}
+		n.Unit = strings.TrimSpace(span.textContent())
+		n.RequireUnit = span.hasClass("qti-unit-required")
+		for _, u := range strings.Split(span.attr("title"), "|") {
+			if u = strings.TrimSpace(u); u != "" {
+				n.AcceptedUnits = append(n.AcceptedUnits, u)
+			}
+		}
+		break
+	}
+	return n, nil
+}
+
+func qtiChoiceModel(n *qtiNode) *domain.ChoiceModel {
+	c := &domain.ChoiceModel{}
+	nodes := rewriteQTINodes(n.Children, func(child *qtiNode) ([]*qtiNode, bool) {
+		if child.Name == "img" && child.hasClass("qti-attachment") {
+			if c.AttachmentURL == "" {
+				c.AttachmentURL = child.attr("src")
+			}
+			return nil, true
+		}
+		return nil, false
+	})
+	c.Content = qtiInnerContent(nodes)
+	return c
+}
+
+// dropEmptyQTIParagraphs bỏ các đoạn chỉ còn khoảng trắng sau khi đã gỡ tương tác và ảnh đính kèm.
+func dropEmptyQTIParagraphs(nodes []*qtiNode) []*qtiNode {
+	var res []*qtiNode
+	for _, n := range nodes {
+		if n.Name == "p" && len(n.elements()) == 0 && strings.TrimSpace(n.textContent()) == "" {
+			continue
+		}
+		res = append(res, n)
+	}
+	return res
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+var updateGolden = flag.Bool("update", false, "ghi lại các file golden trong testdata")
+
+func qtiGoldenDir(version string) string {
+	return filepath.Join("testdata", "qti", "v"+strings.ReplaceAll(version, ".", "_"))
+}
+
+func qtiFixtureQuestion(id int64, topic, section, qType, difficulty, content string) *domain.QuestionModel {
+	return &domain.QuestionModel{
+		Id:         id,
+		Content:    content,
+		Section:    &domain.SectionModel{Name: section, Topic: &domain.TopicModel{Name: topic}},
+		Type:       domain.QuestionTypeModel{Type: qType},
+		Difficulty: domain.QuestionDifficultyModel{Difficulty: difficulty},
+		Points:     1,
+	}
+}
+
+func qtiFixtureExam() *domain.ExamModel {
+	single := qtiFixtureQuestion(101, "Kiến thức chung", "Địa lý", domain.QuestionTypeSingleChoice, "easy", "Thủ đô của Việt Nam là gì?")
+	single.AttachmentURL = "https://cdn.example.com/exams/ban-do.png"
+	single.Explanation = "Hà Nội là thủ đô từ năm 1010."
+	single.Points = 2
+	single.Choices = []domain.ChoiceModel{
+		{Content: "Hà Nội", IsCorrect: true},
+		{Content: "Huế", AttachmentURL: "https://cdn.example.com/exams/hue.png"},
+		{Content: "Đà Nẵng"},
+	}
+
+	multiple := qtiFixtureQuestion(102, "Kiến thức chung", "Toán", domain.QuestionTypeMultipleChoice, "medium", "<p>Chọn các số <strong>nguyên tố</strong>:</p>")
+	multiple.Points = 1.5
+	multiple.Choices = []domain.ChoiceModel{
+		{Content: "2", IsCorrect: true},
+		{Content: "3", IsCorrect: true},
+		{Content: "4"},
+		{Content: "9"},
+	}
+
+	short := qtiFixtureQuestion(103, "Khoa học", "Hoá học", domain.QuestionTypeShortAnswer, "easy", "Kí hiệu hoá học của sắt là gì?")
+	short.Choices = []domain.ChoiceModel{{Content: "Fe", IsCorrect: true}, {Content: "Ferrum", IsCorrect: true}}
+
+	essay := qtiFixtureQuestion(104, "Khoa học", "Hoá học", domain.QuestionTypeEssay, "hard", "So sánh a < b & b > c: nêu nhận xét.")
+	essay.Points = 3
+
+	numeric := qtiFixtureQuestion(105, "Khoa học", "Vật lý", domain.QuestionTypeNumeric, "medium", "Gia tốc trọng trường gần đúng bằng bao nhiêu?")
+	numeric.AnswerConfig = domain.AnswerConfig{Numeric: &domain.NumericAnswer{
+		Value: 9.8, Tolerance: 0.1, ToleranceType: domain.ToleranceAbsolute,
+		Unit: "m/s2", AcceptedUnits: []string{"m/s^2"}, RequireUnit: true,
+	}}.JSON()
+
+	relative := qtiFixtureQuestion(106, "Khoa học", "Vật lý", domain.QuestionTypeNumeric, "medium", "Nhiệt độ sôi của nước ở áp suất tiêu chuẩn (độ C)?")
+	relative.AnswerConfig = domain.AnswerConfig{Numeric: &domain.NumericAnswer{
+		Value: 100, Tolerance: 0.05, ToleranceType: domain.ToleranceRelative,
+	}}.JSON()
+
+	matching := qtiFixtureQuestion(107, "Kiến thức chung", "Địa lý", domain.QuestionTypeMatching, "medium", "Ghép quốc gia với thủ đô.")
+	matching.Choices = []domain.ChoiceModel{
+		{Content: "Việt Nam", MatchTarget: "Hà Nội"},
+		{Content: "Nhật Bản", MatchTarget: "Tokyo"},
+		{Content: "Pháp", MatchTarget: "Paris"},
+	}
+
+	ordering := qtiFixtureQuestion(108, "Kiến thức chung", "Lịch sử", domain.QuestionTypeOrdering, "hard", "Sắp xếp các triều đại theo thứ tự thời gian.")
+	ordering.Choices = []domain.ChoiceModel{
+		{Content: "Nhà Trần", Position: 2},
+		{Content: "Nhà Lý", Position: 1},
+		{Content: "Nhà Lê sơ", Position: 3},
+	}
+
+	cloze := qtiFixtureQuestion(109, "Khoa học", "Vật lý", domain.QuestionTypeCloze, "easy", "Nước sôi ở {{1}} độ C và đóng băng ở {{2}} độ C.")
+	cloze.AnswerConfig = domain.AnswerConfig{Blanks: []domain.ClozeBlank{
+		{Answers: []string{"100"}},
+		{Answers: []string{"0", "không"}, CaseSensitive: true},
+	}}.JSON()
+
+	return &domain.ExamModel{
+		Id:               7,
+		Title:            "Kiểm tra tổng hợp",
+		Description:      "Làm bài trong 45 phút, không sử dụng tài liệu.",
+		DurationMinutes:  45,
+		ShuffleQuestions: true,
+		Questions:        []*domain.QuestionModel{single, multiple, matching, ordering, short, essay, numeric, relative, cloze},
+	}
+}
+
+func TestBuildQTIPackageGolden(t *testing.T) {
+	for _, version := range []string{QTIVersion21, QTIVersion30} {
+		t.Run(version, func(t *testing.T) {
+			files, errs := buildQTIFiles(qtiFixtureExam(), version)
+			if len(errs) > 0 {
+				t.Fatalf("xuất QTI lỗi: %v", errs)
+			}
+
+			dir := qtiGoldenDir(version)
+			for _, f := range files {
+				golden := filepath.Join(dir, filepath.FromSlash(f.Name))
+				if *updateGolden {
+					if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(golden, f.Content, 0o644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("thiếu file golden %s (chạy go test -update): %v", golden, err)
+				}
+				if !bytes.Equal(f.Content, want) {
+					t.Errorf("%s khác file golden:\n%s", f.Name, f.Content)
+				}
+			}
+		})
+	}
+}
+
+func TestQTIPackageRoundTrip(t *testing.T) {
+	for _, version := range []string{QTIVersion21, QTIVersion30} {
+		t.Run(version, func(t *testing.T) {
+			exam := qtiFixtureExam()
+			content, errs, err := buildQTIPackage(exam, version)
+			if err != nil || len(errs) > 0 {
+				t.Fatalf("xuất QTI lỗi: %v %v", err, errs)
+			}
+
+			pkg, itemErrs, err := parseQTIPackage(content, nil)
+			if err != nil {
+				t.Fatalf("đọc gói QTI lỗi: %v", err)
+			}
+			if len(itemErrs) > 0 {
+				t.Fatalf("lỗi item: %v", itemErrs)
+			}
+			if pkg.Version != version || pkg.Title != exam.Title || pkg.Description != exam.Description ||
+				pkg.DurationMinutes != exam.DurationMinutes || pkg.ShuffleQuestions != exam.ShuffleQuestions {
+				t.Fatalf("thông tin đề sai: %+v", pkg)
+			}
+			if len(pkg.Items) != len(exam.Questions) {
+				t.Fatalf("đọc được %d câu, muốn %d", len(pkg.Items), len(exam.Questions))
+			}
+
+			rebuilt := &domain.ExamModel{
+				Id: exam.Id, Title: pkg.Title, Description: pkg.Description,
+				DurationMinutes: pkg.DurationMinutes, ShuffleQuestions: pkg.ShuffleQuestions,
+			}
+			for i, item := range pkg.Items {
+				if err := validateQuestionAnswers(item.Type, item.Choices, item.AnswerConfig); err != nil {
+					t.Fatalf("câu %d không hợp lệ: %v", i+1, err)
+				}
+				q := qtiFixtureQuestion(exam.Questions[i].Id, item.Topic, item.Section, item.Type, item.Difficulty, item.Content)
+				q.Explanation, q.AttachmentURL, q.Points = item.Explanation, item.Attachment, item.Points
+				q.AnswerConfig = item.AnswerConfig.JSON()
+				for _, c := range item.Choices {
+					q.Choices = append(q.Choices, *c)
+				}
+				rebuilt.Questions = append(rebuilt.Questions, q)
+			}
+
+			want, _ := buildQTIFiles(exam, version)
+			got, _ := buildQTIFiles(rebuilt, version)
+			if len(got) != len(want) {
+				t.Fatalf("số file sau khi xuất lại: %d, muốn %d", len(got), len(want))
+			}
+			for i := range want {
+				if got[i].Name != want[i].Name || !bytes.Equal(got[i].Content, want[i].Content) {
+					t.Errorf("%s thay đổi sau khi nhập rồi xuất lại:\n%s", want[i].Name, got[i].Content)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildQTIItemUnsupportedType(t *testing.T) {
+	exam := qtiFixtureExam()
+	exam.Questions = append(exam.Questions, qtiFixtureQuestion(110, "Khoa học", "Vật lý", "hotspot", "easy", "Chọn vị trí trên hình."))
+
+	files, errs := buildQTIFiles(exam, QTIVersion21)
+	if len(errs) != 1 || errs[0].QuestionId != 110 || errs[0].Index != 10 {
+		t.Fatalf("lỗi xuất không đúng: %v", errs)
+	}
+	if len(files) != 2+len(exam.Questions)-1 {
+		t.Fatalf("số file: %d", len(files))
+	}
+}
+
+// TestParseQTIPackageThirdParty đọc gói viết tay theo kiểu các hệ thống khác hay xuất:
+// câu hỏi nằm trong prompt, ảnh đính kèm là file cục bộ trong gói, không có assessmentTest.
+func TestParseQTIPackageThirdParty(t *testing.T) {
+	for _, name := range []string{"third_party_v2_1", "third_party_v3_0"} {
+		t.Run(name, func(t *testing.T) {
+			src := filepath.Join("testdata", "qti", name)
+			content := zipQTIDir(t, src)
+
+			var uploaded []string
+			upload := func(file string, data []byte) (string, error) {
+				uploaded = append(uploaded, file)
+				return "https://cdn.example.com/qti/" + file, nil
+			}
+			pkg, itemErrs, err := parseQTIPackage(content, upload)
+			if err != nil {
+				t.Fatalf("đọc gói QTI lỗi: %v", err)
+			}
+
+			type choiceView struct {
+				Content     string `json:"content"`
+				IsCorrect   bool   `json:"is_correct,omitempty"`
+				MatchTarget string `json:"match_target,omitempty"`
+				Position    int    `json:"position,omitempty"`
+				Attachment  string `json:"attachment,omitempty"`
+			}
+			type itemView struct {
+				Index        int                 `json:"index"`
+				Name         string              `json:"name"`
+				Topic        string              `json:"topic,omitempty"`
+				Section      string              `json:"section,omitempty"`
+				Type         string              `json:"type"`
+				Difficulty   string              `json:"difficulty,omitempty"`
+				Points       float64             `json:"points"`
+				Content      string              `json:"content"`
+				Explanation  string              `json:"explanation,omitempty"`
+				Attachment   string              `json:"attachment,omitempty"`
+				Choices      []choiceView        `json:"choices,omitempty"`
+				AnswerConfig domain.AnswerConfig `json:"answer_config"`
+			}
+			view := struct {
+				Version  string     `json:"version"`
+				Title    string     `json:"title,omitempty"`
+				Duration int        `json:"duration_minutes,omitempty"`
+				Uploaded []string   `json:"uploaded"`
+				Items    []itemView `json:"items"`
+				Errors   []string   `json:"errors"`
+			}{Version: pkg.Version, Title: pkg.Title, Duration: pkg.DurationMinutes, Uploaded: uploaded, Errors: qtiErrorMessages(itemErrs)}
+			for _, item := range pkg.Items {
+				iv := itemView{
+					Index: item.Index, Name: item.Name, Topic: item.Topic, Section: item.Section,
+					Type: item.Type, Difficulty: item.Difficulty, Points: item.Points,
+					Content: item.Content, Explanation: item.Explanation, Attachment: item.Attachment,
+					AnswerConfig: item.AnswerConfig,
+				}
+				for _, c := range item.Choices {
+					iv.Choices = append(iv.Choices, choiceView{c.Content, c.IsCorrect, c.MatchTarget, c.Position, c.AttachmentURL})
+				}
+				view.Items = append(view.Items, iv)
+			}
+
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(view); err != nil {
+				t.Fatal(err)
+			}
+			got := buf.Bytes()
+
+			golden := src + ".golden.json"
+			if *updateGolden {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("thiếu file golden %s (chạy go test -update): %v", golden, err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("kết quả đọc gói khác file golden:\n%s", got)
+			}
+		})
+	}
+}
+
+func qtiErrorMessages(errs []*pb.QuestionBankError) []string {
+	var res []string
+	for _, e := range errs {
+		res = append(res, fmt.Sprintf("%d %s: %s", e.Index, e.Name, e.Message))
+	}
+	return res
+}
+
+func zipQTIDir(t *testing.T, dir string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, p)
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		w, err := zw.Create(filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+type qtiAttr struct {
+	Name  string
+	Value string
+}
+
+// qtiNode là cây XML dùng chung khi đọc và ghi gói QTI. Tên phần tử QTI luôn giữ dạng 2.1 (camelCase),
+// khi ghi bản 3.0 mới đổi sang dạng qti-kebab-case. Nút văn bản có Name rỗng.
+type qtiNode struct {
+	Name     string
+	Attrs    []qtiAttr
+	Children []*qtiNode
+	Text     string
+	Verbatim bool // HTML hoặc manifest: giữ nguyên tên khi ghi bản 3.0
+	Inline   bool // ghi các con trên cùng dòng để không chèn khoảng trắng vào nội dung
+}
+
+func qtiEl(name string, attrs ...string) *qtiNode {
+	n := &qtiNode{Name: name}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		n.Attrs = append(n.Attrs, qtiAttr{Name: attrs[i], Value: attrs[i+1]})
+	}
+	return n
+}
+
+func htmlEl(name string, attrs ...string) *qtiNode {
+	n := qtiEl(name, attrs...)
+	n.Verbatim, n.Inline = true, true
+	return n
+}
+
+func qtiText(s string) *qtiNode {
+	return &qtiNode{Text: s}
+}
+
+func (n *qtiNode) add(children ...*qtiNode) *qtiNode {
+	n.Children = append(n.Children, children...)
+	return n
+}
+
+func (n *qtiNode) isText() bool {
+	return n.Name == ""
+}
+
+func (n *qtiNode) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (n *qtiNode) setAttr(name, value string) {
+	for i := range n.Attrs {
+		if n.Attrs[i].Name == name {
+			n.Attrs[i].Value = value
+			return
+		}
+	}
+	n.Attrs = append(n.Attrs, qtiAttr{Name: name, Value: value})
+}
+
+func (n *qtiNode) hasClass(class string) bool {
+	for _, c := range strings.Fields(n.attr("class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *qtiNode) elements() []*qtiNode {
+	var res []*qtiNode
+	for _, c := range n.Children {
+		if !c.isText() {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func (n *qtiNode) child(name string) *qtiNode {
+	for _, c := range n.Children {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (n *qtiNode) childrenNamed(name string) []*qtiNode {
+	var res []*qtiNode
+	for _, c := range n.Children {
+		if c.Name == name {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// find trả về mọi phần tử con cháu thoả điều kiện, theo thứ tự xuất hiện trong tài liệu.
+func (n *qtiNode) find(match func(*qtiNode) bool) []*qtiNode {
+	var res []*qtiNode
+	for _, c := range n.Children {
+		if c.isText() {
+			continue
+		}
+		if match(c) {
+			res = append(res, c)
+		}
+		res = append(res, c.find(match)...)
+	}
+	return res
+}
+
+func (n *qtiNode) findNamed(name string) []*qtiNode {
+	return n.find(func(c *qtiNode) bool { return c.Name == name })
+}
+
+func (n *qtiNode) textContent() string {
+	if n.isText() {
+		return n.Text
+	}
+	var sb strings.Builder
+	for _, c := range n.Children {
+		sb.WriteString(c.textContent())
+	}
+	return sb.String()
+}
+
+func (n *qtiNode) hasTextChild() bool {
+	for _, c := range n.Children {
+		if c.isText() {
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteQTINodes sao chép danh sách nút; fn trả về handled=true để thay một phần tử bằng repl (có thể rỗng).
+func rewriteQTINodes(nodes []*qtiNode, fn func(*qtiNode) ([]*qtiNode, bool)) []*qtiNode {
+	var res []*qtiNode
+	for _, n := range nodes {
+		if n.isText() {
+			res = append(res, n)
+			continue
+		}
+		if repl, handled := fn(n); handled {
+			res = append(res, repl...)
+			continue
+		}
+		cp := *n
+		cp.Children = rewriteQTINodes(n.Children, fn)
+		res = append(res, &cp)
+	}
+	return res
+}
+
+var (
+	qtiTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	qtiAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "\n", "&#xA;", "\t", "&#x9;")
+)
+
+func renderQTIDocument(root *qtiNode, v3 bool) []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	writeQTINode(&b, root, v3, 0, false)
+	b.WriteString("\n")
+	return b.Bytes()
+}
+
+func writeQTINode(b *bytes.Buffer, n *qtiNode, v3 bool, depth int, inline bool) {
+	if n.isText() {
+		b.WriteString(qtiTextEscaper.Replace(n.Text))
+		return
+	}
+	rename := v3 && !n.Verbatim
+	name := n.Name
+	if rename {
+		name = qtiV3Name(name)
+	}
+	b.WriteString("<" + name)
+	for _, a := range n.Attrs {
+		attrName := a.Name
+		if rename {
+			attrName = qtiV3AttrName(attrName)
+		}
+		fmt.Fprintf(b, ` %s="%s"`, attrName, qtiAttrEscaper.Replace(a.Value))
+	}
+	if len(n.Children) == 0 {
+		b.WriteString("/>")
+		return
+	}
+	b.WriteString(">")
+
+	childInline := inline || n.Inline || n.hasTextChild()
+	indent := "\n" + strings.Repeat("  ", depth+1)
+	for _, c := range n.Children {
+		if !childInline {
+			b.WriteString(indent)
+		}
+		writeQTINode(b, c, v3, depth+1, childInline)
+	}
+	if !childInline {
+		b.WriteString("\n" + strings.Repeat("  ", depth))
+	}
+	b.WriteString("</" + name + ">")
+}
+
+// qtiV3Name đổi tên QTI 2.1 sang 3.0: choiceInteraction -> qti-choice-interaction.
+func qtiV3Name(name string) string {
+	return "qti-" + kebabCase(name)
+}
+
+func qtiV3AttrName(name string) string {
+	if strings.Contains(name, ":") || strings.HasPrefix(name, "xmlns") || strings.HasPrefix(name, "data-") {
+		return name
+	}
+	return kebabCase(name)
+}
+
+func kebabCase(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func camelCase(s string) string {
+	parts := strings.Split(s, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// parseQTIXML đọc một tài liệu XML thành cây qtiNode, đưa tên phần tử QTI 3.0 về dạng 2.1.
+// v3 cho biết tài liệu có dùng tên phần tử QTI 3.0 hay không.
+func parseQTIXML(data []byte) (root *qtiNode, v3 bool, err error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Entity = xml.HTMLEntity
+
+	doc := &qtiNode{}
+	stack := []*qtiNode{doc}
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		parent := stack[len(stack)-1]
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &qtiNode{Name: t.Name.Local, Verbatim: true, Inline: true}
+			prefixed := strings.HasPrefix(n.Name, "qti-")
+			if prefixed {
+				v3 = true
+				n.Name = camelCase(strings.TrimPrefix(n.Name, "qti-"))
+				n.Verbatim, n.Inline = false, false
+			}
+			for _, a := range t.Attr {
+				if a.Name.Space != "" || a.Name.Local == "xmlns" {
+					continue
+				}
+				attrName := a.Name.Local
+				if prefixed && !strings.HasPrefix(attrName, "data-") {
+					attrName = camelCase(attrName)
+				}
+				n.Attrs = append(n.Attrs, qtiAttr{Name: attrName, Value: a.Value})
+			}
+			parent.add(n)
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			parent.add(qtiText(string(t)))
+		}
+	}
+
+	elems := doc.elements()
+	if len(elems) == 0 {
+		return nil, false, fmt.Errorf("tài liệu XML trống")
+	}
+	return elems[0], v3, nil
+}
+
+// qtiContentNodes chuyển nội dung câu hỏi sang nút XML: HTML hợp lệ được giữ cấu trúc, còn lại coi là văn bản thuần.
+func qtiContentNodes(content string) []*qtiNode {
+	if !strings.ContainsAny(content, "<&") {
+		return []*qtiNode{qtiText(content)}
+	}
+	root, _, err := parseQTIXML([]byte("<content>" + content + "</content>"))
+	if err != nil {
+		return []*qtiNode{qtiText(content)}
+	}
+	return root.Children
+}
+
+// qtiInnerContent ghép các nút thành nội dung lưu trong DB: chỉ có văn bản thì trả về văn bản thuần, ngược lại là HTML.
+func qtiInnerContent(nodes []*qtiNode) string {
+	var b bytes.Buffer
+	plain := true
+	for _, n := range nodes {
+		if !n.isText() {
+			plain = false
+			break
+		}
+	}
+	for _, n := range nodes {
+		if plain {
+			b.WriteString(n.Text)
+			continue
+		}
+		writeQTINode(&b, n, false, 0, true)
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	"gorm.io/gorm"
+)
+
+const (
+	BankFormatExcel     = "excel"
+	BankFormatMoodleXML = "moodle_xml"
+	BankFormatGIFT      = "gift"
+	BankFormatAiken     = "aiken"
+
+	defaultImportTopic   = "Ngân hàng câu hỏi"
+	defaultImportSection = "Chung"
+)
+
+// bankQuestion là dạng trung gian giữa các định dạng ngân hàng câu hỏi (Moodle XML, GIFT, Aiken) và QuestionModel.
+type bankQuestion struct {
+	Index        int
+	Name         string
+	Topic        string
+	Section      string
+	Content      string
+	Type         string
+	Difficulty   string
+	Explanation  string
+	Attachment   string
+	Choices      []*domain.ChoiceModel
+	AnswerConfig domain.AnswerConfig
+}
+
+func bankError(index int, name string, err error) *pb.QuestionBankError {
+	return &pb.QuestionBankError{Index: int32(index), Name: name, Message: err.Error()}
+}
+
+func normalizeBankFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "excel", "xlsx":
+		return BankFormatExcel
+	case "moodle", "moodle_xml", "xml":
+		return BankFormatMoodleXML
+	case "gift":
+		return BankFormatGIFT
+	case "aiken":
+		return BankFormatAiken
+	}
+	return ""
+}
+
+// detectBankFormat ưu tiên format client gửi lên, sau đó đến đuôi file, cuối cùng là nội dung file.
+func detectBankFormat(format, fileName string, content []byte) string {
+	if f := normalizeBankFormat(format); f != "" {
+		return f
+	}
+
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".xlsx", ".xls":
+		return BankFormatExcel
+	case ".xml":
+		return BankFormatMoodleXML
+	case ".gift":
+		return BankFormatGIFT
+	}
+
+	if bytes.HasPrefix(content, []byte("PK")) {
+		return BankFormatExcel
+	}
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")))
+	if bytes.HasPrefix(trimmed, []byte("<")) {
+		return BankFormatMoodleXML
+	}
+	if aikenAnswerPattern.Match(content) {
+		return BankFormatAiken
+	}
+	return BankFormatGIFT
+}
+
+// importCatalog cache topic/section/type/difficulty theo tên trong một lần import.
+type importCatalog struct {
+	s         *examService
+	creatorID int64
+	topics    map[string]int64
+	sections  map[string]int64
+	types     map[string]int64
+	diffs     map[string]int64
+}
+
+func newImportCatalog(s *examService, creatorID int64) *importCatalog {
+	return &importCatalog{
+		s:         s,
+		creatorID: creatorID,
+		topics:    make(map[string]int64),
+		sections:  make(map[string]int64),
+		types:     make(map[string]int64),
+		diffs:     make(map[string]int64),
+	}
+}
+
+func (c *importCatalog) topicID(ctx context.Context, name string) (int64, error) {
+	name = strings.TrimSpace(name)
+	if id, ok := c.topics[name]; ok {
+		return id, nil
+	}
+
+	t, _ := c.s.repo.GetTopicByName(ctx, name)
+	if t != nil {
+		c.topics[name] = t.Id
+		return t.Id, nil
+	}
+
+	newTopic := &domain.TopicModel{Name: name, Description: "Auto imported", CreatorID: c.creatorID}
+	created, err := c.s.repo.CreateTopic(ctx, database.DB, newTopic)
+	if err != nil {
+		return 0, err
+	}
+	c.topics[name] = created.Id
+	return created.Id, nil
+}
+
+func (c *importCatalog) sectionID(ctx context.Context, topicID int64, name string) (int64, error) {
+	name = strings.TrimSpace(name)
+	cacheKey := fmt.Sprintf("%d_%s", topicID, name)
+	if id, ok := c.sections[cacheKey]; ok {
+		return id, nil
+	}
+
+	sections, _ := c.s.repo.GetSectionsByTopic(ctx, topicID)
+	for _, sec := range sections {
+		if strings.EqualFold(sec.Name, name) {
+			c.sections[cacheKey] = sec.Id
+			return sec.Id, nil
+		}
+	}
+
+	newSec := &domain.SectionModel{Name: name, Description: "Auto imported", TopicID: topicID}
+	created, err := c.s.repo.CreateSection(ctx, database.DB, newSec)
+	if err != nil {
+		return 0, err
+	}
+	c.sections[cacheKey] = created.Id
+	return created.Id, nil
+}
+
+func (c *importCatalog) typeID(ctx context.Context, name string) int64 {
+	if id, ok := c.types[name]; ok {
+		return id
+	}
+	t, _ := c.s.repo.GetQuestionType(ctx, name)
+	if t != nil {
+		c.types[name] = t.Id
+		return t.Id
+	}
+	return 1
+}
+
+func (c *importCatalog) difficultyID(ctx context.Context, name string) int64 {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if id, ok := c.diffs[name]; ok {
+		return id
+	}
+	d, _ := c.s.repo.GetDifficulty(ctx, name)
+	if d != nil {
+		c.diffs[name] = d.Id
+		return d.Id
+	}
+	return 1
+}
+
+func (s *examService) importBankQuestions(ctx context.Context, req *pb.ImportQuestionsRequest, format string, questions []*bankQuestion, parseErrors []*pb.QuestionBankError) (*pb.ImportQuestionsResponse, error) {
+	catalog := newImportCatalog(s, req.CreatorId)
+	resp := &pb.ImportQuestionsResponse{Format: format, Errors: parseErrors}
+
+	for _, bq := range questions {
+		topicName := firstNonEmpty(bq.Topic, req.DefaultTopic, defaultImportTopic)
+		sectionName := firstNonEmpty(bq.Section, req.DefaultSection, defaultImportSection)
+
+		_, err := s.createBankQuestion(ctx, catalog, req.CreatorId, bq, topicName, sectionName)
+		if err != nil {
+			resp.Errors = append(resp.Errors, bankError(bq.Index, bq.Name, err))
+			continue
+		}
+		resp.SuccessCount++
+	}
+
+	resp.ErrorCount = int32(len(resp.Errors))
+	return resp, nil
+}
+
+func (s *examService) createBankQuestion(ctx context.Context, catalog *importCatalog, creatorID int64, bq *bankQuestion, topicName, sectionName string) (*domain.QuestionModel, error) {
+	if strings.TrimSpace(bq.Content) == "" {
+		return nil, fmt.Errorf("nội dung câu hỏi trống")
+	}
+	if err := validateQuestionAnswers(bq.Type, bq.Choices, bq.AnswerConfig); err != nil {
+		return nil, err
+	}
+	tID, err := catalog.topicID(ctx, topicName)
+	if err != nil {
+		return nil, fmt.Errorf("không tạo được chủ đề %q: %v", topicName, err)
+	}
+	sID, err := catalog.sectionID(ctx, tID, sectionName)
+	if err != nil {
+		return nil, fmt.Errorf("không tạo được chương %q: %v", sectionName, err)
+	}
+
+	var createdQ *domain.QuestionModel
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		q := &domain.QuestionModel{
+			SectionID: sID, TopicID: tID, CreatorID: creatorID,
+			Content: bq.Content, TypeID: catalog.typeID(ctx, bq.Type),
+			DifficultyID:  catalog.difficultyID(ctx, firstNonEmpty(bq.Difficulty, "medium")),
+			Explanation:   bq.Explanation,
+			AttachmentURL: bq.Attachment,
+			AnswerConfig:  bq.AnswerConfig.JSON(),
+			Points:        1.0,
+		}
+		var err error
+		createdQ, err = s.repo.CreateQuestion(ctx, tx, q)
+		if err != nil {
+			return err
+		}
+		for _, c := range bq.Choices {
+			c.QuestionID = createdQ.Id
+		}
+		if len(bq.Choices) > 0 {
+			if err := s.repo.CreateChoices(ctx, tx, bq.Choices); err != nil {
+				return err
+			}
+		}
+		return s.recordQuestionVersion(ctx, tx, createdQ.Id, creatorID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return createdQ, nil
+}
+
+func questionTopicSection(q *domain.QuestionModel) (string, string) {
+	topic, section := defaultImportTopic, defaultImportSection
+	if q.Section != nil {
+		section = q.Section.Name
+		if q.Section.Topic != nil {
+			topic = q.Section.Topic.Name
+		}
+	}
+	return topic, section
+}
+
+// bankCategoryPath ghép topic/section thành đường dẫn category kiểu Moodle ("//" là dấu "/" trong tên).
+func bankCategoryPath(q *domain.QuestionModel) string {
+	escape := func(s string) string { return strings.ReplaceAll(s, "/", "//") }
+	topic, section := questionTopicSection(q)
+	return "$course$/top/" + escape(topic) + "/" + escape(section)
+}
+
+// parseBankCategoryPath tách đường dẫn category thành topic (cấp đầu) và section (các cấp còn lại).
+func parseBankCategoryPath(path string) (string, string) {
+	const slash = "\x00"
+	path = strings.ReplaceAll(strings.TrimSpace(path), "//", slash)
+	var segs []string
+	for i, seg := range strings.Split(path, "/") {
+		seg = strings.TrimSpace(strings.ReplaceAll(seg, slash, "/"))
+		if seg == "" || strings.HasPrefix(seg, "$") || (i <= 1 && seg == "top") {
+			continue
+		}
+		segs = append(segs, seg)
+	}
+	switch len(segs) {
+	case 0:
+		return "", ""
+	case 1:
+		return segs[0], ""
+	default:
+		return segs[0], strings.Join(segs[1:], " / ")
+	}
+}
+
+var htmlTagPattern = regexp.MustCompile(`(?s)<[^>]*>`)
+
+func stripHTML(s string) string {
+	return strings.TrimSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(s, "")))
+}
+
+func bankQuestionName(q *domain.QuestionModel) string {
+	name := strings.Join(strings.Fields(stripHTML(q.Content)), " ")
+	if utf8.RuneCountInString(name) > 60 {
+		name = string([]rune(name)[:60]) + "..."
+	}
+	if name == "" {
+		name = fmt.Sprintf("Câu hỏi %d", q.Id)
+	}
+	return name
+}
+
+var clozePlaceholderPattern = regexp.MustCompile(`\{\{(\d+)\}\}`)
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+func trueFalseChoices(correct bool) []*domain.ChoiceModel {
+	return []*domain.ChoiceModel{
+		{Content: "Đúng", IsCorrect: correct},
+		{Content: "Sai", IsCorrect: !correct},
+	}
+}
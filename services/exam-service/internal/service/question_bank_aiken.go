@@ -0,0 +1,133 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+var (
+	aikenOptionPattern = regexp.MustCompile(`^([A-Z])[.)]\s+(.*)$`)
+	aikenAnswerPattern = regexp.MustCompile(`(?m)^ANSWER:\s*([A-Z])\s*$`)
+)
+
+// parseAiken đọc định dạng Aiken: chỉ có câu một đáp án, không có category.
+func parseAiken(content []byte) ([]*bankQuestion, []*pb.QuestionBankError) {
+	text := strings.ReplaceAll(strings.TrimPrefix(string(content), "\ufeff"), "\r\n", "\n")
+
+	var questions []*bankQuestion
+	var errs []*pb.QuestionBankError
+	var stem []string
+	var options []string
+	index := 0
+
+	reset := func() {
+		stem, options = nil, nil
+	}
+	fail := func(err error) {
+		errs = append(errs, bankError(index, strings.Join(stem, " "), err))
+		reset()
+	}
+
+	for lineNo, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if len(stem) == 0 && len(options) == 0 {
+			index++
+		}
+
+		if m := aikenAnswerPattern.FindStringSubmatch(line); m != nil {
+			if len(stem) == 0 {
+				fail(fmt.Errorf("dòng %d: ANSWER không thuộc câu hỏi nào", lineNo+1))
+				continue
+			}
+			correct := int(m[1][0] - 'A')
+			if len(options) < 2 {
+				fail(fmt.Errorf("dòng %d: cần ít nhất 2 lựa chọn", lineNo+1))
+				continue
+			}
+			if correct >= len(options) {
+				fail(fmt.Errorf("dòng %d: đáp án %s không có trong danh sách lựa chọn", lineNo+1, m[1]))
+				continue
+			}
+			bq := &bankQuestion{
+				Index:   index,
+				Name:    stem[0],
+				Content: strings.Join(stem, "\n"),
+				Type:    domain.QuestionTypeSingleChoice,
+			}
+			for i, opt := range options {
+				bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: opt, IsCorrect: i == correct})
+			}
+			questions = append(questions, bq)
+			reset()
+			continue
+		}
+
+		if m := aikenOptionPattern.FindStringSubmatch(line); m != nil && len(stem) > 0 {
+			if int(m[1][0]-'A') != len(options) {
+				fail(fmt.Errorf("dòng %d: lựa chọn %s không đúng thứ tự", lineNo+1, m[1]))
+				continue
+			}
+			options = append(options, strings.TrimSpace(m[2]))
+			continue
+		}
+
+		if len(options) > 0 {
+			fail(fmt.Errorf("dòng %d: thiếu dòng ANSWER sau các lựa chọn", lineNo+1))
+			index++
+		}
+		stem = append(stem, line)
+	}
+
+	if len(stem) > 0 {
+		fail(fmt.Errorf("câu hỏi cuối file thiếu dòng ANSWER"))
+	}
+	return questions, errs
+}
+
+func exportAiken(questions []*domain.QuestionModel) ([]byte, []*pb.QuestionBankError) {
+	var sb strings.Builder
+	var errs []*pb.QuestionBankError
+
+	for i, q := range questions {
+		fail := func(msg string) {
+			errs = append(errs, &pb.QuestionBankError{Index: int32(i + 1), Name: bankQuestionName(q), Message: msg, QuestionId: q.Id})
+		}
+		if q.Type.Type != domain.QuestionTypeSingleChoice && q.Type.Type != "" {
+			fail(fmt.Sprintf("Aiken chỉ hỗ trợ câu một đáp án, bỏ qua loại %q", q.Type.Type))
+			continue
+		}
+		if len(q.Choices) < 2 || len(q.Choices) > 26 {
+			fail("số lựa chọn phải từ 2 đến 26")
+			continue
+		}
+		answer := -1
+		for j, c := range q.Choices {
+			if c.IsCorrect {
+				answer = j
+				break
+			}
+		}
+		if answer < 0 {
+			fail("câu hỏi chưa có đáp án đúng")
+			continue
+		}
+
+		sb.WriteString(singleLine(q.Content) + "\n")
+		for j, c := range q.Choices {
+			sb.WriteString(fmt.Sprintf("%c. %s\n", 'A'+j, singleLine(c.Content)))
+		}
+		sb.WriteString(fmt.Sprintf("ANSWER: %c\n\n", 'A'+answer))
+	}
+	return []byte(sb.String()), errs
+}
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
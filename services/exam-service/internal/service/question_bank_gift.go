@@ -0,0 +1,472 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const giftSpecialChars = "~=#{}:"
+
+var giftFormatPrefix = regexp.MustCompile(`^\[(html|moodle|plain|markdown)\]`)
+
+type giftItem struct {
+	kind byte
+	text string
+}
+
+func parseGIFT(content []byte) ([]*bankQuestion, []*pb.QuestionBankError) {
+	text := strings.ReplaceAll(strings.TrimPrefix(string(content), "\ufeff"), "\r\n", "\n")
+
+	var blocks [][]string
+	var current []string
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "//") {
+			continue
+		}
+		if trimmed == "" {
+			if len(current) > 0 {
+				blocks = append(blocks, current)
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		blocks = append(blocks, current)
+	}
+
+	var questions []*bankQuestion
+	var errs []*pb.QuestionBankError
+	topic, section := "", ""
+	index := 0
+
+	for _, block := range blocks {
+		for len(block) > 0 && strings.HasPrefix(strings.TrimSpace(block[0]), "$CATEGORY:") {
+			topic, section = parseBankCategoryPath(strings.TrimPrefix(strings.TrimSpace(block[0]), "$CATEGORY:"))
+			block = block[1:]
+		}
+		if len(block) == 0 {
+			continue
+		}
+		index++
+
+		bq, err := parseGIFTQuestion(strings.Join(block, "\n"))
+		if err != nil {
+			name := ""
+			if bq != nil {
+				name = bq.Name
+			}
+			errs = append(errs, bankError(index, name, err))
+			continue
+		}
+		bq.Index = index
+		bq.Topic = topic
+		bq.Section = section
+		questions = append(questions, bq)
+	}
+	return questions, errs
+}
+
+func parseGIFTQuestion(raw string) (*bankQuestion, error) {
+	bq := &bankQuestion{}
+	raw = strings.TrimSpace(raw)
+
+	if strings.HasPrefix(raw, "::") {
+		end := indexUnescaped(raw[2:], "::")
+		if end < 0 {
+			return bq, fmt.Errorf("tiêu đề câu hỏi thiếu dấu '::' đóng")
+		}
+		bq.Name = strings.TrimSpace(unescapeBank(raw[2 : 2+end]))
+		raw = strings.TrimSpace(raw[4+end:])
+	}
+	raw = giftFormatPrefix.ReplaceAllString(raw, "")
+
+	open := indexUnescaped(raw, "{")
+	if open < 0 {
+		return bq, fmt.Errorf("không tìm thấy phần đáp án {...}")
+	}
+	closing := indexUnescaped(raw[open:], "}")
+	if closing < 0 {
+		return bq, fmt.Errorf("phần đáp án thiếu dấu '}' đóng")
+	}
+	closing += open
+
+	before := strings.TrimSpace(unescapeBank(raw[:open]))
+	after := strings.TrimSpace(unescapeBank(raw[closing+1:]))
+	answer := strings.TrimSpace(raw[open+1 : closing])
+
+	if idx := indexUnescaped(answer, "####"); idx >= 0 {
+		bq.Explanation = strings.TrimSpace(unescapeBank(answer[idx+4:]))
+		answer = strings.TrimSpace(answer[:idx])
+	}
+	if bq.Name == "" {
+		bq.Name = before
+	}
+
+	// Dạng "missing word": phần đáp án nằm giữa câu.
+	missingWord := after != ""
+	bq.Content = before
+	if missingWord {
+		bq.Content = joinMissingWord(before, "_____", after)
+	}
+
+	switch {
+	case answer == "":
+		bq.Type = domain.QuestionTypeEssay
+	case strings.HasPrefix(answer, "#"):
+		numeric, err := parseGIFTNumeric(answer[1:])
+		if err != nil {
+			return bq, err
+		}
+		bq.Type = domain.QuestionTypeNumeric
+		bq.AnswerConfig.Numeric = numeric
+	case isGIFTTrueFalse(answer):
+		bq.Type = domain.QuestionTypeSingleChoice
+		value := strings.ToUpper(strings.TrimSpace(cutUnescaped(answer, '#')))
+		bq.Choices = trueFalseChoices(value == "T" || value == "TRUE")
+	default:
+		items := splitGIFTItems(answer)
+		if len(items) == 0 {
+			return bq, fmt.Errorf("phần đáp án không hợp lệ: %q", answer)
+		}
+		hasWrong, hasMatch := false, false
+		for _, it := range items {
+			if it.kind == '~' {
+				hasWrong = true
+			}
+			if it.kind == '=' && indexUnescaped(it.text, "->") >= 0 {
+				hasMatch = true
+			}
+		}
+
+		switch {
+		case hasMatch:
+			bq.Type = domain.QuestionTypeMatching
+			for _, it := range items {
+				idx := indexUnescaped(it.text, "->")
+				if idx < 0 {
+					return bq, fmt.Errorf("cặp ghép %q thiếu '->'", it.text)
+				}
+				premise := strings.TrimSpace(unescapeBank(it.text[:idx]))
+				target := strings.TrimSpace(unescapeBank(cutUnescaped(it.text[idx+2:], '#')))
+				if premise == "" {
+					continue
+				}
+				bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: premise, MatchTarget: target})
+			}
+		case hasWrong:
+			correct := 0
+			for _, it := range items {
+				text := strings.TrimSpace(cutUnescaped(it.text, '#'))
+				isCorrect := it.kind == '='
+				if w, rest, ok := cutWeight(text); ok {
+					isCorrect, text = w > 0, rest
+				}
+				if isCorrect {
+					correct++
+				}
+				bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: strings.TrimSpace(unescapeBank(text)), IsCorrect: isCorrect})
+			}
+			bq.Type = domain.QuestionTypeSingleChoice
+			if correct > 1 {
+				bq.Type = domain.QuestionTypeMultipleChoice
+			}
+		default:
+			var answers []string
+			for _, it := range items {
+				text := strings.TrimSpace(cutUnescaped(it.text, '#'))
+				if w, rest, ok := cutWeight(text); ok {
+					if w <= 0 {
+						continue
+					}
+					text = rest
+				}
+				if text = strings.TrimSpace(unescapeBank(text)); text != "" {
+					answers = append(answers, text)
+				}
+			}
+			if missingWord {
+				bq.Type = domain.QuestionTypeCloze
+				bq.Content = joinMissingWord(before, "{{1}}", after)
+				bq.AnswerConfig.Blanks = []domain.ClozeBlank{{Answers: answers}}
+			} else {
+				bq.Type = domain.QuestionTypeShortAnswer
+				for _, a := range answers {
+					bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: a, IsCorrect: true})
+				}
+			}
+		}
+	}
+	bq.Content = strings.TrimSpace(bq.Content)
+	return bq, nil
+}
+
+func joinMissingWord(before, blank, after string) string {
+	if strings.ContainsAny(after[:1], ".,;:!?)") {
+		return before + " " + blank + after
+	}
+	return before + " " + blank + " " + after
+}
+
+func isGIFTTrueFalse(answer string) bool {
+	switch strings.ToUpper(strings.TrimSpace(cutUnescaped(answer, '#'))) {
+	case "T", "F", "TRUE", "FALSE":
+		return true
+	}
+	return false
+}
+
+// parseGIFTNumeric hỗ trợ "3.14:0.01", "1..5" và danh sách "=3.14:0.01 =%50%3.1:0.1" (lấy đáp án 100%).
+func parseGIFTNumeric(spec string) (*domain.NumericAnswer, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "=") {
+		for _, it := range splitGIFTItems(spec) {
+			text := strings.TrimSpace(cutUnescaped(it.text, '#'))
+			if w, rest, ok := cutWeight(text); ok {
+				if w < 100 {
+					continue
+				}
+				text = rest
+			}
+			return parseGIFTNumeric(text)
+		}
+		return nil, fmt.Errorf("không có đáp án số đúng 100%%")
+	}
+	spec = strings.TrimSpace(cutUnescaped(spec, '#'))
+
+	if lo, hi, ok := strings.Cut(spec, ".."); ok {
+		min, err1 := strconv.ParseFloat(strings.TrimSpace(lo), 64)
+		max, err2 := strconv.ParseFloat(strings.TrimSpace(hi), 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("khoảng giá trị không hợp lệ: %q", spec)
+		}
+		return &domain.NumericAnswer{Value: (min + max) / 2, Tolerance: math.Abs(max-min) / 2, ToleranceType: domain.ToleranceAbsolute}, nil
+	}
+
+	valueStr, tolStr, _ := strings.Cut(spec, ":")
+	value, err := strconv.ParseFloat(strings.TrimSpace(valueStr), 64)
+	if err != nil {
+		return nil, fmt.Errorf("đáp án số không hợp lệ: %q", spec)
+	}
+	numeric := &domain.NumericAnswer{Value: value, ToleranceType: domain.ToleranceAbsolute}
+	if strings.TrimSpace(tolStr) != "" {
+		tol, err := strconv.ParseFloat(strings.TrimSpace(tolStr), 64)
+		if err != nil {
+			return nil, fmt.Errorf("sai số không hợp lệ: %q", spec)
+		}
+		numeric.Tolerance = math.Abs(tol)
+	}
+	return numeric, nil
+}
+
+func splitGIFTItems(answer string) []giftItem {
+	var items []giftItem
+	var sb strings.Builder
+	var kind byte
+	flush := func() {
+		if kind != 0 {
+			items = append(items, giftItem{kind: kind, text: strings.TrimSpace(sb.String())})
+		}
+		sb.Reset()
+	}
+	for i := 0; i < len(answer); i++ {
+		ch := answer[i]
+		if ch == '\\' && i+1 < len(answer) {
+			sb.WriteByte(ch)
+			sb.WriteByte(answer[i+1])
+			i++
+			continue
+		}
+		if ch == '=' || ch == '~' {
+			flush()
+			kind = ch
+			continue
+		}
+		sb.WriteByte(ch)
+	}
+	flush()
+	return items
+}
+
+func exportGIFT(questions []*domain.QuestionModel) ([]byte, []*pb.QuestionBankError) {
+	var sb strings.Builder
+	var errs []*pb.QuestionBankError
+	lastCategory := ""
+
+	for i, q := range questions {
+		body, err := buildGIFTQuestion(q)
+		if err != nil {
+			errs = append(errs, &pb.QuestionBankError{Index: int32(i + 1), Name: bankQuestionName(q), Message: err.Error(), QuestionId: q.Id})
+			continue
+		}
+		if category := bankCategoryPath(q); category != lastCategory {
+			sb.WriteString("$CATEGORY: " + category + "\n\n")
+			lastCategory = category
+		}
+		sb.WriteString(fmt.Sprintf("// question: %d\n", q.Id))
+		sb.WriteString("::" + escapeBank(bankQuestionName(q)) + "::")
+		sb.WriteString(body)
+		sb.WriteString("\n\n")
+	}
+	return []byte(sb.String()), errs
+}
+
+func buildGIFTQuestion(q *domain.QuestionModel) (string, error) {
+	cfg := domain.ParseAnswerConfig(q.AnswerConfig)
+	content := "[html]" + escapeBank(q.Content)
+	feedback := ""
+	if q.Explanation != "" {
+		feedback = "\n\t####" + escapeBank(q.Explanation)
+	}
+	block := func(lines []string) string {
+		if len(lines) == 0 {
+			return "{" + strings.TrimPrefix(feedback, "\n\t") + "}"
+		}
+		return "{\n\t" + strings.Join(lines, "\n\t") + feedback + "\n}"
+	}
+
+	var lines []string
+	switch q.Type.Type {
+	case domain.QuestionTypeSingleChoice, "":
+		for _, c := range q.Choices {
+			prefix := "~"
+			if c.IsCorrect {
+				prefix = "="
+			}
+			lines = append(lines, prefix+escapeBank(c.Content))
+		}
+	case domain.QuestionTypeMultipleChoice:
+		correct := 0
+		for _, c := range q.Choices {
+			if c.IsCorrect {
+				correct++
+			}
+		}
+		if correct == 0 {
+			return "", fmt.Errorf("câu nhiều đáp án chưa có đáp án đúng")
+		}
+		for _, c := range q.Choices {
+			weight := "-100"
+			if c.IsCorrect {
+				weight = formatMoodleFraction(100 / float64(correct))
+			}
+			lines = append(lines, "~%"+weight+"%"+escapeBank(c.Content))
+		}
+	case domain.QuestionTypeShortAnswer:
+		for _, c := range q.Choices {
+			if c.IsCorrect {
+				lines = append(lines, "="+escapeBank(c.Content))
+			}
+		}
+	case domain.QuestionTypeEssay:
+	case domain.QuestionTypeNumeric:
+		if cfg.Numeric == nil {
+			return "", fmt.Errorf("câu hỏi dạng số chưa có đáp án")
+		}
+		tolerance := cfg.Numeric.Tolerance
+		if cfg.Numeric.ToleranceType == domain.ToleranceRelative {
+			tolerance = math.Abs(cfg.Numeric.Value) * tolerance
+		}
+		lines = append(lines, "#"+strconv.FormatFloat(cfg.Numeric.Value, 'f', -1, 64)+":"+strconv.FormatFloat(tolerance, 'f', -1, 64))
+	case domain.QuestionTypeMatching:
+		for _, c := range q.Choices {
+			if c.MatchTarget != "" {
+				lines = append(lines, "="+escapeBank(c.Content)+" -> "+escapeBank(c.MatchTarget))
+			}
+		}
+	case domain.QuestionTypeCloze:
+		if len(cfg.Blanks) != 1 {
+			return "", fmt.Errorf("GIFT chỉ hỗ trợ câu điền khuyết có đúng 1 chỗ trống")
+		}
+		const marker = "\x00"
+		var opts []string
+		for _, a := range cfg.Blanks[0].Answers {
+			opts = append(opts, "="+escapeBank(a))
+		}
+		body := escapeBank(renderClozeContent(q.Content, cfg.Blanks, func(domain.ClozeBlank) string { return marker }))
+		return "[html]" + strings.Replace(body, marker, "{"+strings.Join(opts, " ")+"}", 1), nil
+	default:
+		return "", fmt.Errorf("loại câu hỏi %q không xuất được sang GIFT", q.Type.Type)
+	}
+	return content + block(lines), nil
+}
+
+func escapeBank(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(giftSpecialChars, r) || r == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func unescapeBank(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+func indexUnescaped(s, sep string) int {
+	for i := 0; i+len(sep) <= len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i:i+len(sep)] == sep {
+			return i
+		}
+	}
+	return -1
+}
+
+// cutUnescaped bỏ phần từ ký tự sep (không bị escape) trở đi, ví dụ phần feedback sau '#'.
+func cutUnescaped(s string, sep byte) string {
+	if idx := indexUnescaped(s, string(sep)); idx >= 0 {
+		return s[:idx]
+	}
+	return s
+}
+
+func splitUnescaped(s string, sep byte) []string {
+	var parts []string
+	for {
+		idx := indexUnescaped(s, string(sep))
+		if idx < 0 {
+			return append(parts, s)
+		}
+		parts = append(parts, s[:idx])
+		s = s[idx+1:]
+	}
+}
+
+// cutWeight tách trọng số dạng "%50%đáp án".
+func cutWeight(s string) (float64, string, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "%") {
+		return 0, s, false
+	}
+	end := strings.Index(s[1:], "%")
+	if end < 0 {
+		return 0, s, false
+	}
+	w, err := strconv.ParseFloat(s[1:1+end], 64)
+	if err != nil {
+		return 0, s, false
+	}
+	return w, s[end+2:], true
+}
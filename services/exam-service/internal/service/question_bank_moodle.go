@@ -0,0 +1,377 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+type moodleQuiz struct {
+	XMLName   xml.Name          `xml:"quiz"`
+	Questions []*moodleQuestion `xml:"question"`
+}
+
+type moodleText struct {
+	Format string `xml:"format,attr,omitempty"`
+	Text   string `xml:"text"`
+}
+
+type moodleQuestion struct {
+	Type            string               `xml:"type,attr"`
+	Category        *moodleText          `xml:"category"`
+	Name            *moodleText          `xml:"name"`
+	QuestionText    *moodleText          `xml:"questiontext"`
+	GeneralFeedback *moodleText          `xml:"generalfeedback"`
+	DefaultGrade    string               `xml:"defaultgrade,omitempty"`
+	Single          string               `xml:"single,omitempty"`
+	ShuffleAnswers  string               `xml:"shuffleanswers,omitempty"`
+	UseCase         string               `xml:"usecase,omitempty"`
+	Answers         []*moodleAnswer      `xml:"answer"`
+	Subquestions    []*moodleSubquestion `xml:"subquestion"`
+	Units           *moodleUnits         `xml:"units"`
+}
+
+type moodleUnits struct {
+	Unit []*moodleUnit `xml:"unit"`
+}
+
+type moodleAnswer struct {
+	Fraction  string      `xml:"fraction,attr"`
+	Format    string      `xml:"format,attr,omitempty"`
+	Text      string      `xml:"text"`
+	Tolerance string      `xml:"tolerance,omitempty"`
+	Feedback  *moodleText `xml:"feedback"`
+}
+
+type moodleSubquestion struct {
+	Format string      `xml:"format,attr,omitempty"`
+	Text   string      `xml:"text"`
+	Answer *moodleText `xml:"answer"`
+}
+
+type moodleUnit struct {
+	Multiplier string `xml:"multiplier"`
+	Name       string `xml:"unit_name"`
+}
+
+func (t *moodleText) value() string {
+	if t == nil {
+		return ""
+	}
+	return strings.TrimSpace(t.Text)
+}
+
+func parseMoodleXML(content []byte) ([]*bankQuestion, []*pb.QuestionBankError) {
+	var quiz moodleQuiz
+	if err := xml.Unmarshal(content, &quiz); err != nil {
+		return nil, []*pb.QuestionBankError{bankError(0, "", fmt.Errorf("file Moodle XML không hợp lệ: %v", err))}
+	}
+
+	var questions []*bankQuestion
+	var errs []*pb.QuestionBankError
+	topic, section := "", ""
+	index := 0
+
+	for _, mq := range quiz.Questions {
+		if mq.Type == "category" {
+			topic, section = parseBankCategoryPath(mq.Category.value())
+			continue
+		}
+		index++
+		name := mq.Name.value()
+
+		bq, err := convertMoodleQuestion(mq)
+		if err != nil {
+			errs = append(errs, bankError(index, name, err))
+			continue
+		}
+		bq.Index = index
+		bq.Name = name
+		bq.Topic = topic
+		bq.Section = section
+		questions = append(questions, bq)
+	}
+	return questions, errs
+}
+
+func convertMoodleQuestion(mq *moodleQuestion) (*bankQuestion, error) {
+	bq := &bankQuestion{
+		Content:     mq.QuestionText.value(),
+		Explanation: mq.GeneralFeedback.value(),
+	}
+
+	switch mq.Type {
+	case "multichoice":
+		bq.Type = domain.QuestionTypeSingleChoice
+		if mq.Single == "false" || mq.Single == "0" {
+			bq.Type = domain.QuestionTypeMultipleChoice
+		}
+		for _, a := range mq.Answers {
+			bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: strings.TrimSpace(a.Text), IsCorrect: moodleFraction(a.Fraction) > 0})
+		}
+	case "truefalse":
+		bq.Type = domain.QuestionTypeSingleChoice
+		correct := true
+		for _, a := range mq.Answers {
+			if moodleFraction(a.Fraction) > 0 {
+				correct = strings.EqualFold(stripHTML(a.Text), "true")
+			}
+		}
+		bq.Choices = trueFalseChoices(correct)
+	case "shortanswer":
+		bq.Type = domain.QuestionTypeShortAnswer
+		for _, a := range mq.Answers {
+			if moodleFraction(a.Fraction) > 0 {
+				bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: stripHTML(a.Text), IsCorrect: true})
+			}
+		}
+	case "numerical":
+		bq.Type = domain.QuestionTypeNumeric
+		for _, a := range mq.Answers {
+			if moodleFraction(a.Fraction) < 100 {
+				continue
+			}
+			value, err := strconv.ParseFloat(stripHTML(a.Text), 64)
+			if err != nil {
+				return nil, fmt.Errorf("đáp án số không hợp lệ: %q", a.Text)
+			}
+			tolerance, _ := strconv.ParseFloat(strings.TrimSpace(a.Tolerance), 64)
+			numeric := &domain.NumericAnswer{Value: value, Tolerance: math.Abs(tolerance), ToleranceType: domain.ToleranceAbsolute}
+			var units []*moodleUnit
+			if mq.Units != nil {
+				units = mq.Units.Unit
+			}
+			for _, u := range units {
+				if m, err := strconv.ParseFloat(strings.TrimSpace(u.Multiplier), 64); err == nil && m == 1 {
+					if numeric.Unit == "" {
+						numeric.Unit = strings.TrimSpace(u.Name)
+					} else {
+						numeric.AcceptedUnits = append(numeric.AcceptedUnits, strings.TrimSpace(u.Name))
+					}
+				}
+			}
+			bq.AnswerConfig.Numeric = numeric
+			break
+		}
+	case "essay":
+		bq.Type = domain.QuestionTypeEssay
+	case "matching":
+		bq.Type = domain.QuestionTypeMatching
+		for _, sq := range mq.Subquestions {
+			premise := strings.TrimSpace(sq.Text)
+			if premise == "" {
+				continue
+			}
+			bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: premise, MatchTarget: stripHTML(sq.Answer.value())})
+		}
+	case "ordering":
+		bq.Type = domain.QuestionTypeOrdering
+		for i, a := range mq.Answers {
+			bq.Choices = append(bq.Choices, &domain.ChoiceModel{Content: strings.TrimSpace(a.Text), Position: i + 1})
+		}
+	case "multianswer":
+		bq.Type = domain.QuestionTypeCloze
+		bq.Content, bq.AnswerConfig.Blanks = parseMoodleCloze(bq.Content)
+	default:
+		return nil, fmt.Errorf("loại câu hỏi Moodle %q chưa được hỗ trợ", mq.Type)
+	}
+	return bq, nil
+}
+
+func moodleFraction(s string) float64 {
+	v, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return v
+}
+
+var moodleClozePattern = regexp.MustCompile(`\{(\d*):([A-Za-z_]+):((?:\\.|[^}])*)\}`)
+
+// parseMoodleCloze thay các chỗ trống nhúng ({1:SHORTANSWER:=Hà Nội~%50%Hanoi}) bằng {{i}} và lấy đáp án đúng.
+func parseMoodleCloze(content string) (string, []domain.ClozeBlank) {
+	var blanks []domain.ClozeBlank
+	replaced := moodleClozePattern.ReplaceAllStringFunc(content, func(m string) string {
+		parts := moodleClozePattern.FindStringSubmatch(m)
+		subtype := strings.ToUpper(parts[2])
+		blank := domain.ClozeBlank{CaseSensitive: strings.HasSuffix(subtype, "_C") || subtype == "SAC" || subtype == "MWC"}
+		for _, opt := range splitUnescaped(parts[3], '~') {
+			opt = cutUnescaped(opt, '#')
+			correct := false
+			if strings.HasPrefix(opt, "=") {
+				correct, opt = true, opt[1:]
+			} else if w, rest, ok := cutWeight(opt); ok {
+				correct, opt = w >= 100, rest
+			}
+			if !correct {
+				continue
+			}
+			if subtype == "NUMERICAL" || subtype == "NM" {
+				opt, _, _ = strings.Cut(opt, ":")
+			}
+			if ans := strings.TrimSpace(unescapeBank(opt)); ans != "" {
+				blank.Answers = append(blank.Answers, ans)
+			}
+		}
+		blanks = append(blanks, blank)
+		return fmt.Sprintf("{{%d}}", len(blanks))
+	})
+	return replaced, blanks
+}
+
+func exportMoodleXML(questions []*domain.QuestionModel) ([]byte, []*pb.QuestionBankError) {
+	quiz := moodleQuiz{}
+	var errs []*pb.QuestionBankError
+	lastCategory := ""
+
+	for i, q := range questions {
+		mq, err := buildMoodleQuestion(q)
+		if err != nil {
+			errs = append(errs, &pb.QuestionBankError{Index: int32(i + 1), Name: bankQuestionName(q), Message: err.Error(), QuestionId: q.Id})
+			continue
+		}
+		if category := bankCategoryPath(q); category != lastCategory {
+			quiz.Questions = append(quiz.Questions, &moodleQuestion{Type: "category", Category: &moodleText{Text: category}})
+			lastCategory = category
+		}
+		quiz.Questions = append(quiz.Questions, mq)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(quiz); err != nil {
+		return nil, append(errs, bankError(0, "", err))
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), errs
+}
+
+func buildMoodleQuestion(q *domain.QuestionModel) (*moodleQuestion, error) {
+	mq := &moodleQuestion{
+		Name:         &moodleText{Text: bankQuestionName(q)},
+		QuestionText: &moodleText{Format: "html", Text: q.Content},
+		DefaultGrade: "1",
+	}
+	if q.Explanation != "" {
+		mq.GeneralFeedback = &moodleText{Format: "html", Text: q.Explanation}
+	}
+	cfg := domain.ParseAnswerConfig(q.AnswerConfig)
+
+	switch q.Type.Type {
+	case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice, "":
+		mq.Type = "multichoice"
+		mq.Single = "true"
+		mq.ShuffleAnswers = "true"
+		correct := 0
+		for _, c := range q.Choices {
+			if c.IsCorrect {
+				correct++
+			}
+		}
+		if q.Type.Type == domain.QuestionTypeMultipleChoice {
+			mq.Single = "false"
+		}
+		for _, c := range q.Choices {
+			fraction := "0"
+			if c.IsCorrect {
+				fraction = "100"
+				if mq.Single == "false" && correct > 0 {
+					fraction = formatMoodleFraction(100 / float64(correct))
+				}
+			} else if mq.Single == "false" {
+				fraction = "-100"
+			}
+			mq.Answers = append(mq.Answers, &moodleAnswer{Fraction: fraction, Format: "html", Text: c.Content})
+		}
+	case domain.QuestionTypeShortAnswer:
+		mq.Type = "shortanswer"
+		mq.UseCase = "0"
+		for _, c := range q.Choices {
+			if c.IsCorrect {
+				mq.Answers = append(mq.Answers, &moodleAnswer{Fraction: "100", Format: "moodle_auto_format", Text: c.Content})
+			}
+		}
+	case domain.QuestionTypeEssay:
+		mq.Type = "essay"
+	case domain.QuestionTypeNumeric:
+		if cfg.Numeric == nil {
+			return nil, fmt.Errorf("câu hỏi dạng số chưa có đáp án")
+		}
+		mq.Type = "numerical"
+		tolerance := cfg.Numeric.Tolerance
+		if cfg.Numeric.ToleranceType == domain.ToleranceRelative {
+			tolerance = math.Abs(cfg.Numeric.Value) * tolerance
+		}
+		mq.Answers = []*moodleAnswer{{
+			Fraction:  "100",
+			Format:    "moodle_auto_format",
+			Text:      strconv.FormatFloat(cfg.Numeric.Value, 'f', -1, 64),
+			Tolerance: strconv.FormatFloat(tolerance, 'f', -1, 64),
+		}}
+		if cfg.Numeric.Unit != "" {
+			mq.Units = &moodleUnits{Unit: []*moodleUnit{{Multiplier: "1", Name: cfg.Numeric.Unit}}}
+			for _, u := range cfg.Numeric.AcceptedUnits {
+				mq.Units.Unit = append(mq.Units.Unit, &moodleUnit{Multiplier: "1", Name: u})
+			}
+		}
+	case domain.QuestionTypeMatching:
+		mq.Type = "matching"
+		mq.ShuffleAnswers = "true"
+		for _, c := range q.Choices {
+			if c.MatchTarget == "" {
+				continue
+			}
+			mq.Subquestions = append(mq.Subquestions, &moodleSubquestion{Format: "html", Text: c.Content, Answer: &moodleText{Text: c.MatchTarget}})
+		}
+	case domain.QuestionTypeOrdering:
+		mq.Type = "ordering"
+		for _, c := range orderedChoices(q.Choices) {
+			mq.Answers = append(mq.Answers, &moodleAnswer{Fraction: "0", Format: "html", Text: c.Content})
+		}
+	case domain.QuestionTypeCloze:
+		mq.Type = "multianswer"
+		mq.QuestionText.Text = renderClozeContent(q.Content, cfg.Blanks, func(b domain.ClozeBlank) string {
+			subtype := "SHORTANSWER"
+			if b.CaseSensitive {
+				subtype = "SHORTANSWER_C"
+			}
+			var opts []string
+			for _, a := range b.Answers {
+				opts = append(opts, "="+escapeBank(a))
+			}
+			return fmt.Sprintf("{1:%s:%s}", subtype, strings.Join(opts, "~"))
+		})
+	default:
+		return nil, fmt.Errorf("loại câu hỏi %q không xuất được sang Moodle XML", q.Type.Type)
+	}
+	return mq, nil
+}
+
+func formatMoodleFraction(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100000)/100000, 'f', -1, 64)
+}
+
+// renderClozeContent thay {{i}} bằng cú pháp chỗ trống của định dạng đích; chỗ trống không có trong nội dung được nối vào cuối.
+func renderClozeContent(content string, blanks []domain.ClozeBlank, render func(domain.ClozeBlank) string) string {
+	used := make(map[int]bool)
+	out := clozePlaceholderPattern.ReplaceAllStringFunc(content, func(m string) string {
+		n, _ := strconv.Atoi(clozePlaceholderPattern.FindStringSubmatch(m)[1])
+		if n < 1 || n > len(blanks) {
+			return m
+		}
+		used[n] = true
+		return render(blanks[n-1])
+	})
+	for i, b := range blanks {
+		if !used[i+1] {
+			out += " " + render(b)
+		}
+	}
+	return out
+}
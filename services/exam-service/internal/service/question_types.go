@@ -0,0 +1,258 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func choiceModelsFromInput(questionID int64, qType string, inputs []*pb.ChoiceInput) []*domain.ChoiceModel {
+	var choices []*domain.ChoiceModel
+	for i, c := range inputs {
+		position := int(c.Position)
+		if position == 0 && qType == domain.QuestionTypeOrdering {
+			position = i + 1
+		}
+		choices = append(choices, &domain.ChoiceModel{
+			QuestionID:    questionID,
+			Content:       c.Content,
+			IsCorrect:     c.IsCorrect,
+			AttachmentURL: c.AttachmentUrl,
+			MatchTarget:   strings.TrimSpace(c.MatchTarget),
+			Position:      position,
+			PinLast:       c.PinLast,
+		})
+	}
+	return choices
+}
+
+func answerConfigFromProto(numeric *pb.NumericAnswerConfig, blanks []*pb.ClozeBlank) domain.AnswerConfig {
+	var cfg domain.AnswerConfig
+	if numeric != nil {
+		toleranceType := numeric.ToleranceType
+		if toleranceType != domain.ToleranceRelative {
+			toleranceType = domain.ToleranceAbsolute
+		}
+		cfg.Numeric = &domain.NumericAnswer{
+			Value:         numeric.Value,
+			Tolerance:     math.Abs(numeric.Tolerance),
+			ToleranceType: toleranceType,
+			Unit:          strings.TrimSpace(numeric.Unit),
+			AcceptedUnits: numeric.AcceptedUnits,
+			RequireUnit:   numeric.RequireUnit,
+		}
+	}
+	for _, b := range blanks {
+		var answers []string
+		for _, a := range b.Answers {
+			if a = strings.TrimSpace(a); a != "" {
+				answers = append(answers, a)
+			}
+		}
+		cfg.Blanks = append(cfg.Blanks, domain.ClozeBlank{Answers: answers, CaseSensitive: b.CaseSensitive})
+	}
+	return cfg
+}
+
+func numericToProto(n *domain.NumericAnswer) *pb.NumericAnswerConfig {
+	if n == nil {
+		return nil
+	}
+	return &pb.NumericAnswerConfig{
+		Value:         n.Value,
+		Tolerance:     n.Tolerance,
+		ToleranceType: n.ToleranceType,
+		Unit:          n.Unit,
+		AcceptedUnits: n.AcceptedUnits,
+		RequireUnit:   n.RequireUnit,
+	}
+}
+
+func blanksToProto(blanks []domain.ClozeBlank) []*pb.ClozeBlank {
+	var res []*pb.ClozeBlank
+	for _, b := range blanks {
+		res = append(res, &pb.ClozeBlank{Answers: b.Answers, CaseSensitive: b.CaseSensitive})
+	}
+	return res
+}
+
+// validateQuestionAnswers kiểm tra đáp án tối thiểu theo từng loại câu hỏi.
+func validateQuestionAnswers(qType string, choices []*domain.ChoiceModel, cfg domain.AnswerConfig) error {
+	switch qType {
+	case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice:
+		if len(choices) < 2 {
+			return status.Error(codes.InvalidArgument, "Cần ít nhất 2 lựa chọn cho câu hỏi trắc nghiệm")
+		}
+	case domain.QuestionTypeNumeric:
+		if cfg.Numeric == nil {
+			return status.Error(codes.InvalidArgument, "Câu hỏi dạng số cần có đáp án số")
+		}
+	case domain.QuestionTypeOrdering:
+		if len(choices) < 2 {
+			return status.Error(codes.InvalidArgument, "Câu hỏi sắp xếp cần ít nhất 2 mục")
+		}
+	case domain.QuestionTypeMatching:
+		premises := 0
+		for _, c := range choices {
+			if c.MatchTarget != "" {
+				premises++
+			}
+		}
+		if premises < 2 {
+			return status.Error(codes.InvalidArgument, "Câu hỏi ghép cặp cần ít nhất 2 cặp có vế phải")
+		}
+	case domain.QuestionTypeCloze:
+		if len(cfg.Blanks) == 0 {
+			return status.Error(codes.InvalidArgument, "Câu hỏi điền khuyết cần ít nhất 1 chỗ trống")
+		}
+		for i, b := range cfg.Blanks {
+			if len(b.Answers) == 0 {
+				return status.Errorf(codes.InvalidArgument, "Chỗ trống %d chưa có đáp án", i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// applyStudentQuestionView bổ sung thông tin làm bài cho các loại câu mới mà không lộ đáp án.
+func applyStudentQuestionView(q *domain.QuestionModel, pbQ *pb.QuestionDetails) {
+	switch q.Type.Type {
+	case domain.QuestionTypeMatching:
+		seen := make(map[string]bool)
+		var options []string
+		for _, c := range q.Choices {
+			if c.MatchTarget != "" && !seen[c.MatchTarget] {
+				seen[c.MatchTarget] = true
+				options = append(options, c.MatchTarget)
+			}
+		}
+		sort.Strings(options)
+		pbQ.MatchOptions = options
+	case domain.QuestionTypeCloze:
+		pbQ.BlankCount = int32(len(domain.ParseAnswerConfig(q.AnswerConfig).Blanks))
+	}
+}
+
+// answerKeyChoices trả về các lựa chọn kèm đáp án đúng, vị trí và cặp ghép để hiển thị lời giải.
+func answerKeyChoices(q *domain.QuestionModel) []*pb.ChoiceReview {
+	var choices []*pb.ChoiceReview
+	for _, c := range q.Choices {
+		choices = append(choices, &pb.ChoiceReview{
+			Id:            c.Id,
+			Content:       c.Content,
+			IsCorrect:     c.IsCorrect,
+			AttachmentUrl: c.AttachmentURL,
+			MatchTarget:   c.MatchTarget,
+			Position:      int32(c.Position),
+			PinLast:       c.PinLast,
+		})
+	}
+	return choices
+}
+
+// applyAnswerKeyView bổ sung đáp án cho giáo viên.
+func applyAnswerKeyView(q *domain.QuestionModel, pbQ *pb.QuestionDetails) {
+	cfg := domain.ParseAnswerConfig(q.AnswerConfig)
+	pbQ.Numeric = numericToProto(cfg.Numeric)
+	pbQ.Blanks = blanksToProto(cfg.Blanks)
+	pbQ.BlankCount = int32(len(cfg.Blanks))
+}
+
+func mergeStructuredAnswer(a *AnswerResponse, ordered []int64, matches []*pb.MatchAnswer, blanks []string) {
+	if len(ordered) > 0 {
+		a.Order = ordered
+	}
+	if len(matches) > 0 {
+		a.Matches = make(map[int64]string, len(matches))
+		for _, m := range matches {
+			a.Matches[m.ChoiceId] = m.Target
+		}
+	}
+	if len(blanks) > 0 {
+		a.Blanks = blanks
+	}
+}
+
+func matchesToProto(matches map[int64]string) []*pb.MatchAnswer {
+	var res []*pb.MatchAnswer
+	for id, target := range matches {
+		res = append(res, &pb.MatchAnswer{ChoiceId: id, Target: target})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ChoiceId < res[j].ChoiceId })
+	return res
+}
+
+var numericSpecPattern = regexp.MustCompile(`^([-+]?[0-9]*[.,]?[0-9]+(?:[eE][-+]?[0-9]+)?)\s*(?:(?:±|\+-|\+/-)\s*([0-9]*[.,]?[0-9]+)\s*(%)?)?\s*(.*)$`)
+
+// parseNumericSpec đọc đáp án số trong file Excel, ví dụ "9.8 ± 0.1 m/s2" hoặc "100 ±5%".
+func parseNumericSpec(spec string) (*domain.NumericAnswer, error) {
+	m := numericSpecPattern.FindStringSubmatch(strings.TrimSpace(spec))
+	if m == nil {
+		return nil, fmt.Errorf("đáp án số không hợp lệ: %q", spec)
+	}
+	value, err := strconv.ParseFloat(strings.Replace(m[1], ",", ".", 1), 64)
+	if err != nil {
+		return nil, fmt.Errorf("đáp án số không hợp lệ: %q", spec)
+	}
+	ans := &domain.NumericAnswer{Value: value, ToleranceType: domain.ToleranceAbsolute, Unit: strings.TrimSpace(m[4])}
+	if m[2] != "" {
+		tol, err := strconv.ParseFloat(strings.Replace(m[2], ",", ".", 1), 64)
+		if err != nil {
+			return nil, fmt.Errorf("sai số không hợp lệ: %q", spec)
+		}
+		ans.Tolerance = tol
+		if m[3] == "%" {
+			ans.ToleranceType = domain.ToleranceRelative
+			ans.Tolerance = tol / 100
+		}
+	}
+	return ans, nil
+}
+
+func formatNumericSpec(n *domain.NumericAnswer) string {
+	if n == nil {
+		return ""
+	}
+	spec := strconv.FormatFloat(n.Value, 'f', -1, 64)
+	if n.Tolerance > 0 {
+		if n.ToleranceType == domain.ToleranceRelative {
+			spec += " ±" + strconv.FormatFloat(n.Tolerance*100, 'f', -1, 64) + "%"
+		} else {
+			spec += " ±" + strconv.FormatFloat(n.Tolerance, 'f', -1, 64)
+		}
+	}
+	if n.Unit != "" {
+		spec += " " + n.Unit
+	}
+	return spec
+}
+
+// normalizeImportType chuyển tên loại câu hỏi trong file Excel về tên chuẩn.
+func normalizeImportType(name string) string {
+	switch strings.TrimSpace(strings.ToLower(name)) {
+	case "multiple", "multiple_choice":
+		return domain.QuestionTypeMultipleChoice
+	case "short", "short_answer":
+		return domain.QuestionTypeShortAnswer
+	case "essay":
+		return domain.QuestionTypeEssay
+	case "numeric", "number":
+		return domain.QuestionTypeNumeric
+	case "matching", "match":
+		return domain.QuestionTypeMatching
+	case "ordering", "order":
+		return domain.QuestionTypeOrdering
+	case "cloze", "fill_blank", "fill":
+		return domain.QuestionTypeCloze
+	default:
+		return domain.QuestionTypeSingleChoice
+	}
+}
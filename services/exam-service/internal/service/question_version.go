@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const examStatusPublished = "published"
+
+// loadQuestionTx đọc câu hỏi trong transaction (repo.GetQuestionByID dùng kết nối riêng nên không thấy dữ liệu chưa commit).
+func loadQuestionTx(ctx context.Context, tx *gorm.DB, questionID int64, lock bool) (*domain.QuestionModel, error) {
+	query := tx.WithContext(ctx)
+	if lock {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var q domain.QuestionModel
+	err := query.Preload("Choices", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC, id ASC")
+	}).Preload("Type").Preload("Difficulty").First(&q, questionID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// snapshotQuestion lưu ảnh chụp phiên bản hiện tại của câu hỏi; gọi lại nhiều lần không tạo bản trùng.
+func (s *examService) snapshotQuestion(ctx context.Context, tx *gorm.DB, q *domain.QuestionModel, editorID int64) error {
+	version := q.Version
+	if version <= 0 {
+		version = 1
+	}
+	return s.repo.CreateQuestionVersion(ctx, tx, &domain.QuestionVersionModel{
+		QuestionID: q.Id,
+		Version:    version,
+		Snapshot:   domain.NewQuestionSnapshot(q).JSON(),
+		EditorID:   editorID,
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (s *examService) recordQuestionVersion(ctx context.Context, tx *gorm.DB, questionID, editorID int64) error {
+	q, err := loadQuestionTx(ctx, tx, questionID, false)
+	if err != nil {
+		return err
+	}
+	return s.snapshotQuestion(ctx, tx, q, editorID)
+}
+
+// pinExamQuestionVersions ghim phiên bản hiện tại của các câu hỏi cố định chưa được ghim vào exam_questions
+// khi xuất bản đề. Câu đã ghim giữ nguyên phiên bản để bài thi mới không nhận nội dung đã sửa sau khi xuất bản.
+func (s *examService) pinExamQuestionVersions(ctx context.Context, tx *gorm.DB, examID int64) error {
+	var questionIDs []int64
+	if err := tx.WithContext(ctx).Model(&domain.ExamQuestionModel{}).
+		Where("exam_id = ? AND question_version = 0", examID).
+		Pluck("question_id", &questionIDs).Error; err != nil {
+		return err
+	}
+	for _, id := range questionIDs {
+		q, err := loadQuestionTx(ctx, tx, id, false)
+		if err != nil {
+			return err
+		}
+		if err := s.snapshotQuestion(ctx, tx, q, q.CreatorID); err != nil {
+			return err
+		}
+	}
+	return s.repo.PinExamQuestionVersions(ctx, tx, examID)
+}
+
+// pinIfPublished ghim phiên bản câu hỏi khi đề đang ở trạng thái xuất bản; mọi đường đổi trạng thái hoặc
+// sửa danh sách câu hỏi của đề đều phải gọi hàm này trong cùng transaction.
+func (s *examService) pinIfPublished(ctx context.Context, tx *gorm.DB, examID int64) error {
+	var statuses []string
+	if err := tx.WithContext(ctx).Model(&domain.ExamModel{}).Where("id = ?", examID).Pluck("status", &statuses).Error; err != nil {
+		return err
+	}
+	if len(statuses) == 0 || statuses[0] != examStatusPublished {
+		return nil
+	}
+	return s.pinExamQuestionVersions(ctx, tx, examID)
+}
+
+// submissionQuestionVersions trả về phiên bản câu hỏi áp dụng cho bài làm:
+// ưu tiên phiên bản đã ghi trên bài làm, sau đó tới phiên bản được ghim khi xuất bản đề.
+func submissionQuestionVersions(exam *domain.ExamModel, sub *domain.ExamSubmissionModel) map[int64]int {
+	versions := domain.ParseQuestionVersions(sub.QuestionVersions)
+	if exam == nil {
+		return versions
+	}
+	for _, q := range exam.Questions {
+		if _, ok := versions[q.Id]; !ok && q.PinnedVersion > 0 {
+			versions[q.Id] = q.PinnedVersion
+		}
+	}
+	return versions
+}
+
+func questionVersionMap(questions []*domain.QuestionModel) map[int64]int {
+	versions := make(map[int64]int, len(questions))
+	for _, q := range questions {
+		if q.Version > 0 {
+			versions[q.Id] = q.Version
+		}
+	}
+	return versions
+}
+
+// applyQuestionVersions thay nội dung câu hỏi bằng ảnh chụp của phiên bản yêu cầu.
+// Câu hỏi gốc không bị sửa vì exam.Questions được dùng chung giữa nhiều bài làm.
+func (s *examService) applyQuestionVersions(ctx context.Context, questions []*domain.QuestionModel, versions map[int64]int) ([]*domain.QuestionModel, error) {
+	need := make(map[int64]int)
+	for _, q := range questions {
+		if v, ok := versions[q.Id]; ok && v > 0 && v != q.Version {
+			need[q.Id] = v
+		}
+	}
+	if len(need) == 0 {
+		return questions, nil
+	}
+
+	rows, err := s.repo.GetQuestionVersionsByKeys(ctx, need)
+	if err != nil {
+		return nil, err
+	}
+	byQuestion := make(map[int64]*domain.QuestionVersionModel, len(rows))
+	for _, row := range rows {
+		byQuestion[row.QuestionID] = row
+	}
+
+	result := make([]*domain.QuestionModel, len(questions))
+	for i, q := range questions {
+		result[i] = q
+		row, ok := byQuestion[q.Id]
+		if !ok {
+			continue
+		}
+		snap, err := domain.ParseQuestionSnapshot(row.Snapshot)
+		if err != nil {
+			log.Printf("⚠️ Ảnh chụp phiên bản %d của câu hỏi %d bị lỗi: %v", row.Version, q.Id, err)
+			continue
+		}
+		versioned := *q
+		snap.Apply(&versioned)
+		versioned.Version = row.Version
+		result[i] = &versioned
+	}
+	return result, nil
+}
+
+// getSubmissionQuestions là getExamQuestionsForUser kèm phiên bản câu hỏi mà bài làm được chấm theo.
+func (s *examService) getSubmissionQuestions(ctx context.Context, exam *domain.ExamModel, sub *domain.ExamSubmissionModel) ([]*domain.QuestionModel, map[int64]float64, error) {
+	questions, qPointsMap, err := s.getExamQuestionsForUser(ctx, exam, sub.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if retryIDs := domain.ParseQuestionIDs(sub.PracticeQuestionIDs); len(retryIDs) > 0 {
+		keep := make(map[int64]bool, len(retryIDs))
+		for _, id := range retryIDs {
+			keep[id] = true
+		}
+		var filtered []*domain.QuestionModel
+		for _, q := range questions {
+			if keep[q.Id] {
+				filtered = append(filtered, q)
+			}
+		}
+		questions = filtered
+	}
+	questions, err = s.applyQuestionVersions(ctx, questions, submissionQuestionVersions(exam, sub))
+	if err != nil {
+		return nil, nil, err
+	}
+	return questions, qPointsMap, nil
+}
+
+// ensureSubmissionVersions ghi lại phiên bản của các câu hỏi được phát cho bài làm ngay khi bắt đầu thi.
+func (s *examService) ensureSubmissionVersions(ctx context.Context, exam *domain.ExamModel, sub *domain.ExamSubmissionModel, questionIDs []int64) (map[int64]int, error) {
+	versions := submissionQuestionVersions(exam, sub)
+	recorded := domain.ParseQuestionVersions(sub.QuestionVersions)
+
+	var missing []int64
+	for _, id := range questionIDs {
+		if _, ok := versions[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		current, err := s.repo.GetCurrentQuestionVersions(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for id, v := range current {
+			versions[id] = v
+		}
+	}
+
+	changed := false
+	for _, id := range questionIDs {
+		if v, ok := versions[id]; ok && recorded[id] != v {
+			recorded[id] = v
+			changed = true
+		}
+	}
+	if changed {
+		sub.QuestionVersions = domain.FormatQuestionVersions(recorded)
+		if err := s.repo.UpdateSubmissionQuestionVersions(ctx, nil, sub.Id, sub.QuestionVersions); err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+func (s *examService) GetQuestionHistory(ctx context.Context, req *pb.GetQuestionHistoryRequest) (*pb.GetQuestionHistoryResponse, error) {
+	q, err := s.repo.GetQuestionByID(ctx, req.QuestionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy câu hỏi: %v", err)
+	}
+	versions, err := s.repo.GetQuestionVersions(ctx, req.QuestionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy lịch sử câu hỏi: %v", err)
+	}
+
+	resp := &pb.GetQuestionHistoryResponse{QuestionId: q.Id, CurrentVersion: int32(q.Version)}
+	for _, v := range versions {
+		snap, err := domain.ParseQuestionSnapshot(v.Snapshot)
+		if err != nil {
+			continue
+		}
+		resp.Versions = append(resp.Versions, &pb.QuestionVersionSummary{
+			Version:     int32(v.Version),
+			EditorId:    v.EditorID,
+			CreatedAt:   v.CreatedAt.Format(time.RFC3339),
+			Content:     snap.Content,
+			ChoiceCount: int32(len(snap.Choices)),
+		})
+	}
+	return resp, nil
+}
+
+func (s *examService) GetQuestionVersion(ctx context.Context, req *pb.GetQuestionVersionRequest) (*pb.GetQuestionVersionResponse, error) {
+	q, snap, err := s.loadQuestionVersion(ctx, req.QuestionId, int(req.Version))
+	if err != nil {
+		return nil, err
+	}
+	snap.Apply(q)
+	q.Version = int(req.Version)
+
+	return &pb.GetQuestionVersionResponse{Question: questionDetailsWithAnswers(q), Version: req.Version}, nil
+}
+
+func (s *examService) DiffQuestionVersions(ctx context.Context, req *pb.DiffQuestionVersionsRequest) (*pb.DiffQuestionVersionsResponse, error) {
+	if req.FromVersion <= 0 || req.ToVersion <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Phiên bản cần so sánh phải lớn hơn 0")
+	}
+	_, from, err := s.loadQuestionVersion(ctx, req.QuestionId, int(req.FromVersion))
+	if err != nil {
+		return nil, err
+	}
+	_, to, err := s.loadQuestionVersion(ctx, req.QuestionId, int(req.ToVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiffQuestionVersionsResponse{
+		QuestionId:    req.QuestionId,
+		FromVersion:   req.FromVersion,
+		ToVersion:     req.ToVersion,
+		Changes:       diffQuestionSnapshots(from, to),
+		ChoiceChanges: diffChoiceSnapshots(from.Choices, to.Choices),
+	}, nil
+}
+
+func (s *examService) loadQuestionVersion(ctx context.Context, questionID int64, version int) (*domain.QuestionModel, domain.QuestionSnapshot, error) {
+	q, err := s.repo.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		return nil, domain.QuestionSnapshot{}, status.Errorf(codes.NotFound, "Không tìm thấy câu hỏi: %v", err)
+	}
+	row, err := s.repo.GetQuestionVersion(ctx, questionID, version)
+	if errors.Is(err, gorm.ErrRecordNotFound) && version == q.Version {
+		return q, domain.NewQuestionSnapshot(q), nil
+	}
+	if err != nil {
+		return nil, domain.QuestionSnapshot{}, status.Errorf(codes.NotFound, "Không tìm thấy phiên bản %d của câu hỏi %d", version, questionID)
+	}
+	snap, err := domain.ParseQuestionSnapshot(row.Snapshot)
+	if err != nil {
+		return nil, domain.QuestionSnapshot{}, status.Errorf(codes.Internal, "Ảnh chụp phiên bản %d bị lỗi: %v", version, err)
+	}
+	return q, snap, nil
+}
+
+func diffQuestionSnapshots(from, to domain.QuestionSnapshot) []*pb.QuestionFieldChange {
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"content", from.Content, to.Content},
+		{"explanation", from.Explanation, to.Explanation},
+		{"question_type", from.Type, to.Type},
+		{"difficulty", from.Difficulty, to.Difficulty},
+		{"attachment_url", from.AttachmentURL, to.AttachmentURL},
+		{"answer_config", from.AnswerConfig.JSON(), to.AnswerConfig.JSON()},
+	}
+	var changes []*pb.QuestionFieldChange
+	for _, f := range fields {
+		if f.old != f.new {
+			changes = append(changes, &pb.QuestionFieldChange{Field: f.name, OldValue: f.old, NewValue: f.new})
+		}
+	}
+	return changes
+}
+
+// diffChoiceSnapshots ghép lựa chọn theo vị trí vì mỗi phiên bản tạo lại lựa chọn với Id mới.
+func diffChoiceSnapshots(from, to []domain.ChoiceSnapshot) []*pb.ChoiceChange {
+	var changes []*pb.ChoiceChange
+	for i := 0; i < len(from) || i < len(to); i++ {
+		change := &pb.ChoiceChange{Index: int32(i)}
+		switch {
+		case i >= len(from):
+			change.Kind = "added"
+			change.NewContent, change.NewIsCorrect = to[i].Content, to[i].IsCorrect
+			change.NewMatchTarget = to[i].MatchTarget
+		case i >= len(to):
+			change.Kind = "removed"
+			change.OldContent, change.OldIsCorrect = from[i].Content, from[i].IsCorrect
+			change.OldMatchTarget = from[i].MatchTarget
+		default:
+			a, b := from[i], to[i]
+			if a.Content == b.Content && a.IsCorrect == b.IsCorrect && a.MatchTarget == b.MatchTarget && a.AttachmentURL == b.AttachmentURL {
+				continue
+			}
+			change.Kind = "modified"
+			change.OldContent, change.OldIsCorrect, change.OldMatchTarget = a.Content, a.IsCorrect, a.MatchTarget
+			change.NewContent, change.NewIsCorrect, change.NewMatchTarget = b.Content, b.IsCorrect, b.MatchTarget
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func questionVersionCacheKey(questionID int64, version int) string {
+	return fmt.Sprintf("question:%d:v%d", questionID, version)
+}
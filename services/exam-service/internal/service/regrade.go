@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/contracts"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const examRegradedTopic = "exam_regraded"
+
+// scoringEngineFor tạo ScoringEngine kèm các điều chỉnh chấm lại đang có hiệu lực của đề.
+// Lỗi đọc điều chỉnh được trả về vì chấm thiếu điều chỉnh sẽ cho ra điểm sai.
+func (s *examService) scoringEngineFor(ctx context.Context, exam *domain.ExamModel) (*ScoringEngine, error) {
+	engine := NewScoringEngine(exam)
+	if exam == nil {
+		return engine, nil
+	}
+	regrades, err := s.repo.GetQuestionRegrades(ctx, exam.Id)
+	if err != nil {
+		return nil, fmt.Errorf("không lấy được điều chỉnh chấm lại của đề %d: %w", exam.Id, err)
+	}
+	return engine.WithRegrades(regrades), nil
+}
+
+type regradedSubmission struct {
+	sub       *domain.ExamSubmissionModel
+	questions []*domain.QuestionModel
+	result    SubmissionResult
+}
+
+func (s *examService) RegradeExam(ctx context.Context, req *pb.RegradeExamRequest) (*pb.RegradeExamResponse, error) {
+	switch req.Policy {
+	case domain.RegradePolicyAcceptMultiple, domain.RegradePolicyFullCredit, domain.RegradePolicyDrop:
+		if req.QuestionId == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Chính sách %s cần chỉ định câu hỏi", req.Policy)
+		}
+	case domain.RegradePolicyRescore:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Chính sách chấm lại %q không hợp lệ", req.Policy)
+	}
+
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if req.InstructorId <= 0 || exam.CreatorID != req.InstructorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền chấm lại bài thi này")
+	}
+
+	current, err := s.repo.GetQuestionRegrades(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy điều chỉnh chấm lại: %v", err)
+	}
+	adjustments := make(map[int64]*domain.QuestionRegradeModel, len(current))
+	for _, r := range current {
+		adjustments[r.QuestionID] = r
+	}
+
+	var adjustment *domain.QuestionRegradeModel
+	if req.QuestionId > 0 {
+		adjustment, err = s.buildQuestionRegrade(ctx, exam.Id, req)
+		if err != nil {
+			return nil, err
+		}
+		delete(adjustments, req.QuestionId)
+		if adjustment != nil {
+			adjustments[req.QuestionId] = adjustment
+		}
+	}
+	engineRegrades := make([]*domain.QuestionRegradeModel, 0, len(adjustments))
+	for _, r := range adjustments {
+		engineRegrades = append(engineRegrades, r)
+	}
+	engine := NewScoringEngine(exam).WithRegrades(engineRegrades)
+
+	subs, err := s.repo.GetExamSubmissionsWithAnswers(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách bài nộp: %v", err)
+	}
+
+	var candidates []regradedSubmission
+	for _, sub := range subs {
+		questions, _, err := s.getSubmissionQuestions(ctx, exam, sub)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lấy câu hỏi của bài nộp %d: %v", sub.Id, err)
+		}
+		if req.QuestionId > 0 && !containsQuestion(questions, req.QuestionId) {
+			continue
+		}
+		candidates = append(candidates, regradedSubmission{sub: sub})
+	}
+
+	regrade := &domain.RegradeModel{
+		ExamID:       exam.Id,
+		QuestionID:   req.QuestionId,
+		Policy:       req.Policy,
+		InstructorID: req.InstructorId,
+		Reason:       strings.TrimSpace(req.Reason),
+		CreatedAt:    time.Now().UTC(),
+	}
+	var evaluated []regradedSubmission
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		// Mỗi bài nộp được khoá rồi đọc lại điểm và câu trả lời trong transaction để không ghi đè
+		// điểm chấm tự luận hoặc phúc khảo diễn ra cùng lúc.
+		for _, c := range candidates {
+			e, err := s.regradeSubmission(ctx, tx, exam, engine, c.sub)
+			if err != nil {
+				return err
+			}
+			if err := s.syncRegradedAnswers(ctx, tx, e, req.QuestionId); err != nil {
+				return err
+			}
+			if scoreChanged(e.sub.Score, e.result.Score) {
+				if err := s.repo.UpdateSubmissionScore(ctx, tx, e.sub.Id, e.result.Score); err != nil {
+					return err
+				}
+				regrade.Changes = append(regrade.Changes, &domain.RegradeScoreChangeModel{
+					SubmissionID: e.sub.Id,
+					UserID:       e.sub.UserID,
+					OldScore:     e.sub.Score,
+					NewScore:     e.result.Score,
+				})
+			}
+			evaluated = append(evaluated, e)
+		}
+		regrade.AffectedCount = len(regrade.Changes)
+
+		if err := s.repo.CreateRegrade(ctx, tx, regrade); err != nil {
+			return err
+		}
+		if req.QuestionId > 0 {
+			if adjustment != nil {
+				adjustment.RegradeID = regrade.Id
+				if err := s.repo.SaveQuestionRegrade(ctx, tx, adjustment); err != nil {
+					return err
+				}
+			} else if err := s.repo.DeleteQuestionRegrade(ctx, tx, exam.Id, req.QuestionId); err != nil {
+				return err
+			}
+		}
+		for _, c := range regrade.Changes {
+			c.RegradeID = regrade.Id
+		}
+		return s.repo.CreateRegradeScoreChanges(ctx, tx, regrade.Changes)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi chấm lại bài thi: %v", err)
+	}
+
+	log.Printf("♻️ Đã chấm lại đề %d (câu %d, chính sách %s): %d/%d bài thay đổi điểm", exam.Id, req.QuestionId, req.Policy, regrade.AffectedCount, len(evaluated))
+	go s.notifyRegraded(context.Background(), exam, regrade)
+
+	return &pb.RegradeExamResponse{
+		RegradeId:      regrade.Id,
+		AffectedCount:  int32(regrade.AffectedCount),
+		EvaluatedCount: int32(len(evaluated)),
+		Changes:        regradeChangesToProto(regrade.Changes),
+	}, nil
+}
+
+// regradeSubmission khoá bài nộp, đọc lại điểm hiện tại và câu trả lời trong tx rồi chấm lại.
+func (s *examService) regradeSubmission(ctx context.Context, tx *gorm.DB, exam *domain.ExamModel, engine *ScoringEngine, sub *domain.ExamSubmissionModel) (regradedSubmission, error) {
+	if err := s.repo.LockSubmission(ctx, tx, sub.Id); err != nil {
+		return regradedSubmission{}, err
+	}
+	var scores []float64
+	if err := tx.WithContext(ctx).Model(&domain.ExamSubmissionModel{}).Where("id = ?", sub.Id).Pluck("score", &scores).Error; err != nil {
+		return regradedSubmission{}, err
+	}
+	rows, err := s.repo.GetSubmissionAnswers(ctx, tx, sub.Id)
+	if err != nil {
+		return regradedSubmission{}, err
+	}
+	fresh := *sub
+	if len(scores) > 0 {
+		fresh.Score = scores[0]
+	}
+	fresh.UserAnswers = rows
+
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, &fresh)
+	if err != nil {
+		return regradedSubmission{}, err
+	}
+	return regradedSubmission{
+		sub:       &fresh,
+		questions: questions,
+		result:    engine.ScoreSubmission(questions, qPointsMap, groupUserAnswers(rows)),
+	}, nil
+}
+
+// buildQuestionRegrade trả về điều chỉnh mới cho câu hỏi, hoặc nil với chính sách rescore (gỡ điều chỉnh cũ).
+func (s *examService) buildQuestionRegrade(ctx context.Context, examID int64, req *pb.RegradeExamRequest) (*domain.QuestionRegradeModel, error) {
+	q, err := s.repo.GetQuestionByID(ctx, req.QuestionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy câu hỏi: %v", err)
+	}
+	if req.Policy == domain.RegradePolicyRescore {
+		return nil, nil
+	}
+	if req.Policy == domain.RegradePolicyFullCredit && q.Type.Type == domain.QuestionTypeEssay && s.usesDoubleMarking(ctx, examID) {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi dùng chấm hai vòng, điểm câu tự luận chỉ được cập nhật qua phân công chấm")
+	}
+
+	adjustment := &domain.QuestionRegradeModel{
+		ExamID:          examID,
+		QuestionID:      q.Id,
+		Policy:          req.Policy,
+		AcceptedAnswers: "[]",
+		UpdatedAt:       time.Now().UTC(),
+	}
+	if req.Policy != domain.RegradePolicyAcceptMultiple {
+		return adjustment, nil
+	}
+
+	switch q.Type.Type {
+	case domain.QuestionTypeSingleChoice, domain.QuestionTypeMultipleChoice, domain.QuestionTypeShortAnswer:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Không thể chấp nhận thêm đáp án cho câu hỏi loại %s", q.Type.Type)
+	}
+
+	accepted, err := s.repo.GetChoiceContents(ctx, q.Id, req.AcceptedChoiceIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy lựa chọn: %v", err)
+	}
+	if len(accepted) != len(uniqueInt64s(req.AcceptedChoiceIds)) {
+		return nil, status.Error(codes.InvalidArgument, "Có lựa chọn không thuộc câu hỏi này")
+	}
+	for _, a := range req.AcceptedAnswers {
+		if a = strings.TrimSpace(a); a != "" {
+			accepted = append(accepted, a)
+		}
+	}
+	if len(accepted) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Cần ít nhất một đáp án được chấp nhận thêm")
+	}
+	b, _ := json.Marshal(accepted)
+	adjustment.AcceptedAnswers = string(b)
+	return adjustment, nil
+}
+
+// syncRegradedAnswers cập nhật is_correct/awarded_points của câu trả lời để trang xem lại bài khớp với điểm mới.
+// Câu tự luận giữ nguyên vì awarded_points là điểm giáo viên chấm tay.
+func (s *examService) syncRegradedAnswers(ctx context.Context, tx *gorm.DB, e regradedSubmission, questionID int64) error {
+	for _, q := range e.questions {
+		if questionID > 0 && q.Id != questionID {
+			continue
+		}
+		if q.Type.Type == domain.QuestionTypeEssay {
+			continue
+		}
+		r, ok := e.result.Results[q.Id]
+		if !ok || r.Pending {
+			continue
+		}
+		updates := map[string]interface{}{"is_correct": r.IsCorrect, "awarded_points": r.Earned}
+		if err := s.repo.UpdateUserAnswer(ctx, tx, e.sub.Id, q.Id, updates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *examService) notifyRegraded(ctx context.Context, exam *domain.ExamModel, regrade *domain.RegradeModel) {
+	for _, c := range regrade.Changes {
+		fullName, email := s.lookupUser(ctx, c.UserID)
+		event := contracts.ExamRegradedEvent{
+			UserID:       c.UserID,
+			ExamID:       exam.Id,
+			SubmissionID: c.SubmissionID,
+			RegradeID:    regrade.Id,
+			ExamTitle:    exam.Title,
+			OldScore:     c.OldScore,
+			NewScore:     c.NewScore,
+			Reason:       regrade.Reason,
+			Email:        email,
+			FullName:     fullName,
+		}
+		eventBytes, _ := json.Marshal(event)
+		if err := s.producer.Produce(examRegradedTopic, []byte(strconv.FormatInt(c.SubmissionID, 10)), eventBytes); err != nil {
+			log.Printf("❌ Lỗi gửi sự kiện chấm lại cho bài nộp %d: %v", c.SubmissionID, err)
+		}
+
+		if database.RedisClient != nil {
+			msg := map[string]interface{}{
+				"type":          "EXAM_REGRADED",
+				"exam_id":       exam.Id,
+				"submission_id": c.SubmissionID,
+				"old_score":     c.OldScore,
+				"score":         c.NewScore,
+				"message":       fmt.Sprintf("Bài thi \"%s\" đã được chấm lại: %.2f → %.2f", exam.Title, c.OldScore, c.NewScore),
+				"timestamp":     time.Now().UTC().Format(time.RFC3339),
+			}
+			jsonMsg, _ := json.Marshal(msg)
+			database.RedisClient.Publish(ctx, fmt.Sprintf("notifications:%d", c.UserID), string(jsonMsg))
+		}
+	}
+}
+
+func (s *examService) GetRegradeHistory(ctx context.Context, req *pb.GetRegradeHistoryRequest) (*pb.GetRegradeHistoryResponse, error) {
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	if req.InstructorId <= 0 || exam.CreatorID != req.InstructorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền xem lịch sử chấm lại của bài thi này")
+	}
+
+	regrades, err := s.repo.GetRegrades(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy lịch sử chấm lại: %v", err)
+	}
+
+	resp := &pb.GetRegradeHistoryResponse{Regrades: []*pb.RegradeRecord{}}
+	for _, r := range regrades {
+		resp.Regrades = append(resp.Regrades, &pb.RegradeRecord{
+			Id:            r.Id,
+			ExamId:        r.ExamID,
+			QuestionId:    r.QuestionID,
+			Policy:        r.Policy,
+			InstructorId:  r.InstructorID,
+			Reason:        r.Reason,
+			AffectedCount: int32(r.AffectedCount),
+			CreatedAt:     r.CreatedAt.Format(time.RFC3339),
+			Changes:       regradeChangesToProto(r.Changes),
+		})
+	}
+	return resp, nil
+}
+
+func regradeChangesToProto(changes []*domain.RegradeScoreChangeModel) []*pb.RegradeScoreChange {
+	result := make([]*pb.RegradeScoreChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &pb.RegradeScoreChange{
+			SubmissionId: c.SubmissionID,
+			UserId:       c.UserID,
+			OldScore:     float32(c.OldScore),
+			NewScore:     float32(c.NewScore),
+		})
+	}
+	return result
+}
+
+func scoreChanged(oldScore, newScore float64) bool {
+	return math.Abs(oldScore-newScore) > 1e-9
+}
+
+func containsQuestion(questions []*domain.QuestionModel, questionID int64) bool {
+	for _, q := range questions {
+		if q.Id == questionID {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueInt64s(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	var result []int64
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
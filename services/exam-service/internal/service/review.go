@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	defaultReviewLimit = 20
+	maxReviewLimit     = 100
+)
+
+// GetDueReviews trả về các thẻ ôn tập đã đến hạn của học sinh, có thể lọc theo chủ đề hoặc lớp.
+// Trước khi lấy, các câu làm sai trong những bài mới nộp được đưa vào hàng đợi ôn tập.
+func (s *examService) GetDueReviews(ctx context.Context, req *pb.GetDueReviewsRequest) (*pb.GetDueReviewsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Thiếu mã học sinh")
+	}
+	if err := s.syncReviewCards(ctx, req.UserId); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi cập nhật hàng đợi ôn tập: %v", err)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultReviewLimit
+	}
+	if limit > maxReviewLimit {
+		limit = maxReviewLimit
+	}
+
+	var examIDs []int64
+	if req.ClassId > 0 {
+		exams, err := s.repo.GetExamsByClass(ctx, req.ClassId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách bài thi của lớp: %v", err)
+		}
+		examIDs = make([]int64, 0, len(exams))
+		for _, e := range exams {
+			examIDs = append(examIDs, e.Id)
+		}
+	}
+
+	cards, dueCount, err := s.repo.GetDueReviewCards(ctx, req.UserId, req.TopicId, examIDs, time.Now().UTC(), limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thẻ ôn tập: %v", err)
+	}
+	total, err := s.repo.CountReviewCards(ctx, req.UserId, req.TopicId, examIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi đếm thẻ ôn tập: %v", err)
+	}
+
+	questionIDs := make([]int64, 0, len(cards))
+	for _, c := range cards {
+		questionIDs = append(questionIDs, c.QuestionID)
+	}
+	questions, err := s.repo.GetQuestionsByIDs(ctx, questionIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy nội dung câu hỏi: %v", err)
+	}
+	byID := make(map[int64]*domain.QuestionModel, len(questions))
+	for _, q := range questions {
+		byID[q.Id] = q
+	}
+	released, err := s.releasedReviewExams(ctx, cards)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài thi: %v", err)
+	}
+
+	resp := &pb.GetDueReviewsResponse{Cards: []*pb.ReviewCard{}, DueCount: dueCount, TotalCards: total}
+	for _, c := range cards {
+		q, ok := byID[c.QuestionID]
+		if !ok {
+			continue
+		}
+		card := reviewCardToProto(c)
+		card.Question = s.convertToPBQuestion(q)
+		if !released[c.ExamID] {
+			card.Question.Explanation = ""
+			resp.Cards = append(resp.Cards, card)
+			continue
+		}
+		cfg := domain.ParseAnswerConfig(q.AnswerConfig)
+		card.Answer = &pb.ReviewAnswer{
+			Explanation:   q.Explanation,
+			Choices:       answerKeyChoices(q),
+			Numeric:       numericToProto(cfg.Numeric),
+			CorrectBlanks: blanksToProto(cfg.Blanks),
+		}
+		resp.Cards = append(resp.Cards, card)
+	}
+	return resp, nil
+}
+
+// releasedReviewExams cho biết bài thi nào đã công bố đáp án: bài luyện tập, hoặc bài cho xem kết quả ngay
+// và đã hết hạn làm bài. Thẻ của bài chưa công bố chỉ có câu hỏi, giống GetSubmission ẩn chi tiết bài làm.
+func (s *examService) releasedReviewExams(ctx context.Context, cards []*domain.ReviewCardModel) (map[int64]bool, error) {
+	seen := make(map[int64]bool)
+	var examIDs []int64
+	for _, c := range cards {
+		if !seen[c.ExamID] {
+			seen[c.ExamID] = true
+			examIDs = append(examIDs, c.ExamID)
+		}
+	}
+	exams, err := s.repo.GetExamsByIDs(ctx, examIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	released := make(map[int64]bool, len(exams))
+	for _, e := range exams {
+		released[e.Id] = e.IsPractice || (e.ShowResultImmediately && (e.EndTime == nil || now.After(*e.EndTime)))
+	}
+	return released, nil
+}
+
+// RecordReview ghi nhận mức nhớ (0-5) học sinh tự đánh giá và lập lịch ôn tiếp theo.
+func (s *examService) RecordReview(ctx context.Context, req *pb.RecordReviewRequest) (*pb.RecordReviewResponse, error) {
+	if req.Rating < 0 || req.Rating > domain.MaxRecallRating {
+		return nil, status.Errorf(codes.InvalidArgument, "Mức nhớ phải nằm trong khoảng 0 - %d", domain.MaxRecallRating)
+	}
+	card, err := s.repo.GetReviewCardByID(ctx, req.CardId)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && card.UserID != req.UserId) {
+		return nil, status.Error(codes.NotFound, "Không tìm thấy thẻ ôn tập")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thẻ ôn tập: %v", err)
+	}
+
+	now := time.Now().UTC()
+	card.Review(int(req.Rating), now)
+	if err := s.repo.SaveReviewCard(ctx, card); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu thẻ ôn tập: %v", err)
+	}
+	entry := &domain.ReviewLogModel{
+		CardID:       card.Id,
+		UserID:       card.UserID,
+		QuestionID:   card.QuestionID,
+		Rating:       card.LastRating,
+		IntervalDays: card.IntervalDays,
+		EaseFactor:   card.EaseFactor,
+		ReviewedAt:   now,
+	}
+	if err := s.repo.CreateReviewLog(ctx, entry); err != nil {
+		log.Printf("⚠️ Không ghi được lịch sử ôn tập thẻ %d: %v", card.Id, err)
+	}
+
+	return &pb.RecordReviewResponse{Card: reviewCardToProto(card)}, nil
+}
+
+// syncReviewCards đưa các câu làm sai trong những bài nộp sau lần đồng bộ trước vào hàng đợi: tạo thẻ mới
+// hoặc đưa thẻ đã có về trạng thái cần ôn ngay.
+func (s *examService) syncReviewCards(ctx context.Context, userID int64) error {
+	cards, err := s.repo.GetReviewCardsByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var since time.Time
+	byQuestion := make(map[int64]*domain.ReviewCardModel, len(cards))
+	for _, c := range cards {
+		byQuestion[c.QuestionID] = c
+		if c.LastWrongAt.After(since) {
+			since = c.LastWrongAt
+		}
+	}
+
+	records, err := s.repo.GetWrongAnswerRecords(ctx, userID, since)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	var newIDs []int64
+	for _, r := range records {
+		if _, ok := byQuestion[r.QuestionID]; !ok {
+			newIDs = append(newIDs, r.QuestionID)
+		}
+	}
+	questions, err := s.repo.GetQuestionsByIDs(ctx, newIDs)
+	if err != nil {
+		return err
+	}
+	topics := make(map[int64]int64, len(questions))
+	for _, q := range questions {
+		if q.Section != nil {
+			topics[q.Id] = q.Section.TopicID
+		}
+	}
+
+	changed := make(map[int64]*domain.ReviewCardModel)
+	for _, r := range records {
+		card, ok := byQuestion[r.QuestionID]
+		if !ok {
+			card = domain.NewReviewCard(userID, r.QuestionID, r.SubmittedAt)
+			card.TopicID = topics[r.QuestionID]
+			byQuestion[r.QuestionID] = card
+		} else if r.SubmittedAt.After(card.LastWrongAt) {
+			card.Relapse(r.SubmittedAt)
+		} else {
+			continue
+		}
+		card.ExamID = r.ExamID
+		changed[r.QuestionID] = card
+	}
+
+	for _, card := range changed {
+		if err := s.repo.SaveReviewCard(ctx, card); err != nil {
+			log.Printf("⚠️ Không lưu được thẻ ôn tập câu %d của user %d: %v", card.QuestionID, userID, err)
+		}
+	}
+	return nil
+}
+
+func reviewCardToProto(c *domain.ReviewCardModel) *pb.ReviewCard {
+	card := &pb.ReviewCard{
+		Id:           c.Id,
+		QuestionId:   c.QuestionID,
+		ExamId:       c.ExamID,
+		TopicId:      c.TopicID,
+		EaseFactor:   float32(c.EaseFactor),
+		IntervalDays: int32(c.IntervalDays),
+		Repetitions:  int32(c.Repetitions),
+		Lapses:       int32(c.Lapses),
+		DueAt:        c.DueAt.Format(time.RFC3339),
+		LastRating:   int32(c.LastRating),
+	}
+	if c.LastReviewedAt != nil {
+		card.LastReviewedAt = c.LastReviewedAt.Format(time.RFC3339)
+	}
+	return card
+}
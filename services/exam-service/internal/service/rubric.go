@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+func (s *examService) CreateRubric(ctx context.Context, req *pb.CreateRubricRequest) (*pb.CreateRubricResponse, error) {
+	rubric, err := buildRubric(req.Name, req.Description, req.Criteria)
+	if err != nil {
+		return nil, err
+	}
+	rubric.CreatorID = req.CreatorId
+	if err := s.repo.CreateRubric(ctx, rubric); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi tạo thang chấm: %v", err)
+	}
+
+	created, err := s.repo.GetRubricByID(ctx, rubric.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thang chấm: %v", err)
+	}
+	return &pb.CreateRubricResponse{Rubric: rubricToProto(created)}, nil
+}
+
+func (s *examService) UpdateRubric(ctx context.Context, req *pb.UpdateRubricRequest) (*pb.UpdateRubricResponse, error) {
+	existing, err := s.getOwnedRubric(ctx, req.RubricId, req.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+	rubric, err := buildRubric(req.Name, req.Description, req.Criteria)
+	if err != nil {
+		return nil, err
+	}
+	rubric.Id = existing.Id
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		return s.repo.ReplaceRubric(ctx, tx, rubric)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi cập nhật thang chấm: %v", err)
+	}
+
+	updated, err := s.repo.GetRubricByID(ctx, rubric.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thang chấm: %v", err)
+	}
+	return &pb.UpdateRubricResponse{Rubric: rubricToProto(updated)}, nil
+}
+
+func (s *examService) GetRubric(ctx context.Context, req *pb.GetRubricRequest) (*pb.GetRubricResponse, error) {
+	rubric, err := s.repo.GetRubricByID(ctx, req.RubricId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy thang chấm: %v", err)
+	}
+	return &pb.GetRubricResponse{Rubric: rubricToProto(rubric)}, nil
+}
+
+func (s *examService) GetRubrics(ctx context.Context, req *pb.GetRubricsRequest) (*pb.GetRubricsResponse, error) {
+	rubrics, err := s.repo.GetRubricsByCreator(ctx, req.CreatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách thang chấm: %v", err)
+	}
+	resp := &pb.GetRubricsResponse{Rubrics: []*pb.Rubric{}}
+	for _, r := range rubrics {
+		resp.Rubrics = append(resp.Rubrics, rubricToProto(r))
+	}
+	return resp, nil
+}
+
+func (s *examService) DeleteRubric(ctx context.Context, req *pb.DeleteRubricRequest) (*pb.DeleteRubricResponse, error) {
+	if _, err := s.getOwnedRubric(ctx, req.RubricId, req.CreatorId); err != nil {
+		return nil, err
+	}
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		return s.repo.DeleteRubric(ctx, tx, req.RubricId)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi xóa thang chấm: %v", err)
+	}
+	return &pb.DeleteRubricResponse{Success: true}, nil
+}
+
+func (s *examService) SetQuestionRubric(ctx context.Context, req *pb.SetQuestionRubricRequest) (*pb.SetQuestionRubricResponse, error) {
+	q, err := s.repo.GetQuestionByID(ctx, req.QuestionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy câu hỏi: %v", err)
+	}
+	if req.CreatorId <= 0 || q.CreatorID != req.CreatorId {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền sửa câu hỏi này")
+	}
+
+	var rubricID *int64
+	if req.RubricId > 0 {
+		if q.Type.Type != domain.QuestionTypeEssay {
+			return nil, status.Error(codes.InvalidArgument, "Chỉ có thể gắn thang chấm cho câu hỏi tự luận")
+		}
+		if _, err := s.repo.GetRubricByID(ctx, req.RubricId); err != nil {
+			return nil, status.Errorf(codes.NotFound, "Không tìm thấy thang chấm: %v", err)
+		}
+		rubricID = &req.RubricId
+	}
+
+	if err := s.repo.SetQuestionRubric(ctx, q.Id, rubricID); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi gắn thang chấm: %v", err)
+	}
+	return &pb.SetQuestionRubricResponse{Success: true}, nil
+}
+
+func (s *examService) getOwnedRubric(ctx context.Context, rubricID, creatorID int64) (*domain.RubricModel, error) {
+	rubric, err := s.repo.GetRubricByID(ctx, rubricID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy thang chấm: %v", err)
+	}
+	if creatorID <= 0 || rubric.CreatorID != creatorID {
+		return nil, status.Error(codes.PermissionDenied, "Bạn không có quyền sửa thang chấm này")
+	}
+	return rubric, nil
+}
+
+func buildRubric(name, description string, criteria []*pb.RubricCriterion) (*domain.RubricModel, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Tên thang chấm không được để trống")
+	}
+	if len(criteria) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Thang chấm cần ít nhất một tiêu chí")
+	}
+
+	rubric := &domain.RubricModel{Name: name, Description: strings.TrimSpace(description)}
+	for i, c := range criteria {
+		cName := strings.TrimSpace(c.Name)
+		if cName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Tiêu chí %d chưa có tên", i+1)
+		}
+		if len(c.Levels) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Tiêu chí %q cần ít nhất một mức điểm", cName)
+		}
+		criterion := domain.RubricCriterionModel{Name: cName, Description: strings.TrimSpace(c.Description), Position: i}
+		for j, l := range c.Levels {
+			lName := strings.TrimSpace(l.Name)
+			if lName == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "Mức %d của tiêu chí %q chưa có tên", j+1, cName)
+			}
+			if l.Points < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "Điểm của mức %q không được âm", lName)
+			}
+			criterion.Levels = append(criterion.Levels, domain.RubricLevelModel{
+				Name:        lName,
+				Description: strings.TrimSpace(l.Description),
+				Points:      float64(l.Points),
+				Position:    j,
+			})
+		}
+		rubric.Criteria = append(rubric.Criteria, criterion)
+	}
+	if rubric.MaxPoints() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Tổng điểm tối đa của thang chấm phải lớn hơn 0")
+	}
+	return rubric, nil
+}
+
+// scoreRubric đối chiếu các mức được chọn với thang chấm, trả về tỉ lệ điểm đạt được và các dòng điểm để lưu.
+// Mỗi tiêu chí phải được chọn đúng một mức.
+func scoreRubric(rubric *domain.RubricModel, selections []*pb.RubricSelection) (float64, []*domain.RubricScoreModel, error) {
+	selected := make(map[int64]*pb.RubricSelection, len(selections))
+	for _, sel := range selections {
+		if _, dup := selected[sel.CriterionId]; dup {
+			return 0, nil, errors.New("mỗi tiêu chí chỉ được chọn một mức")
+		}
+		selected[sel.CriterionId] = sel
+	}
+
+	var total float64
+	var scores []*domain.RubricScoreModel
+	for i, c := range rubric.Criteria {
+		sel, ok := selected[c.Id]
+		if !ok {
+			return 0, nil, fmt.Errorf("chưa chọn mức cho tiêu chí %q", c.Name)
+		}
+		delete(selected, c.Id)
+
+		var level *domain.RubricLevelModel
+		for j := range c.Levels {
+			if c.Levels[j].Id == sel.LevelId {
+				level = &c.Levels[j]
+				break
+			}
+		}
+		if level == nil {
+			return 0, nil, fmt.Errorf("mức điểm không thuộc tiêu chí %q", c.Name)
+		}
+
+		total += level.Points
+		scores = append(scores, &domain.RubricScoreModel{
+			RubricID:      rubric.Id,
+			CriterionID:   c.Id,
+			CriterionName: c.Name,
+			LevelID:       level.Id,
+			LevelName:     level.Name,
+			Points:        level.Points,
+			MaxPoints:     c.MaxPoints(),
+			Comment:       strings.TrimSpace(sel.Comment),
+			Position:      i,
+			CreatedAt:     time.Now().UTC(),
+		})
+	}
+	if len(selected) > 0 {
+		return 0, nil, errors.New("có tiêu chí không thuộc thang chấm của câu hỏi")
+	}
+
+	maxPoints := rubric.MaxPoints()
+	if maxPoints <= 0 {
+		return 0, scores, nil
+	}
+	return total / maxPoints, scores, nil
+}
+
+func rubricToProto(r *domain.RubricModel) *pb.Rubric {
+	res := &pb.Rubric{
+		Id:          r.Id,
+		CreatorId:   r.CreatorID,
+		Name:        r.Name,
+		Description: r.Description,
+		Criteria:    []*pb.RubricCriterion{},
+		MaxPoints:   float32(r.MaxPoints()),
+		CreatedAt:   r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   r.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, c := range r.Criteria {
+		pc := &pb.RubricCriterion{
+			Id:          c.Id,
+			Name:        c.Name,
+			Description: c.Description,
+			MaxPoints:   float32(c.MaxPoints()),
+		}
+		for _, l := range c.Levels {
+			pc.Levels = append(pc.Levels, &pb.RubricLevel{
+				Id:          l.Id,
+				Name:        l.Name,
+				Description: l.Description,
+				Points:      float32(l.Points),
+			})
+		}
+		res.Criteria = append(res.Criteria, pc)
+	}
+	return res
+}
+
+func rubricResultToProto(scores []*domain.RubricScoreModel) *pb.RubricResult {
+	if len(scores) == 0 {
+		return nil
+	}
+	res := &pb.RubricResult{RubricId: scores[0].RubricID}
+	for _, sc := range scores {
+		res.Criteria = append(res.Criteria, &pb.RubricCriterionScore{
+			CriterionId:   sc.CriterionID,
+			CriterionName: sc.CriterionName,
+			LevelId:       sc.LevelID,
+			LevelName:     sc.LevelName,
+			Points:        float32(sc.Points),
+			MaxPoints:     float32(sc.MaxPoints),
+			Comment:       sc.Comment,
+		})
+		res.TotalPoints += float32(sc.Points)
+		res.MaxPoints += float32(sc.MaxPoints)
+	}
+	return res
+}
@@ -0,0 +1,531 @@
+package service
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	ScoringAllOrNothing = "all_or_nothing"
+	ScoringPartial      = "partial_credit"
+
+	ScoreScale10         = "10"
+	ScoreScale100        = "100"
+	ScoreScalePercentage = "percentage"
+)
+
+// AnswerResponse gom toàn bộ câu trả lời của học sinh cho một câu hỏi.
+type AnswerResponse struct {
+	ChoiceIDs    []int64
+	Text         string
+	Order        []int64
+	Matches      map[int64]string
+	Blanks       []string
+	ManualPoints *float64
+	// OverridePoints là điểm giáo viên ấn định cho câu hỏi, thay thế kết quả chấm tự động.
+	OverridePoints *float64
+}
+
+func (a AnswerResponse) Structured() domain.StructuredAnswer {
+	return domain.StructuredAnswer{Order: a.Order, Matches: a.Matches, Blanks: a.Blanks}
+}
+
+type ScoreResult struct {
+	Earned    float64
+	IsCorrect bool
+	Pending   bool
+}
+
+// Scorer chấm điểm một câu hỏi theo loại câu hỏi.
+type Scorer interface {
+	Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult
+}
+
+type SubmissionResult struct {
+	Earned       float64
+	MaxPoints    float64
+	Score        float64
+	CorrectCount int32
+	Results      map[int64]ScoreResult
+}
+
+type ScoringEngine struct {
+	scorers  map[string]Scorer
+	scale    string
+	regrades map[int64]*domain.QuestionRegradeModel
+}
+
+func NewScoringEngine(exam *domain.ExamModel) *ScoringEngine {
+	policy := ScoringAllOrNothing
+	negative := 0.0
+	scale := ScoreScale10
+	if exam != nil {
+		if exam.ScoringPolicy != "" {
+			policy = exam.ScoringPolicy
+		}
+		if exam.NegativeMarking > 0 {
+			negative = exam.NegativeMarking
+		}
+		if exam.ScoreScale != "" {
+			scale = exam.ScoreScale
+		}
+	}
+
+	// Điểm âm chỉ áp dụng cho câu trắc nghiệm, các loại còn lại sai thì 0 điểm.
+	partial := policy == ScoringPartial
+	return &ScoringEngine{
+		scorers: map[string]Scorer{
+			domain.QuestionTypeSingleChoice:   &singleChoiceScorer{negative: negative},
+			domain.QuestionTypeMultipleChoice: &multipleChoiceScorer{partial: partial, negative: negative},
+			domain.QuestionTypeShortAnswer:    &shortAnswerScorer{},
+			domain.QuestionTypeEssay:          &manualScorer{},
+			domain.QuestionTypeNumeric:        &numericScorer{},
+			domain.QuestionTypeMatching:       &matchingScorer{partial: partial},
+			domain.QuestionTypeOrdering:       &orderingScorer{partial: partial},
+			domain.QuestionTypeCloze:          &clozeScorer{partial: partial},
+		},
+		scale: scale,
+	}
+}
+
+func (e *ScoringEngine) Register(questionType string, scorer Scorer) {
+	e.scorers[questionType] = scorer
+}
+
+// WithRegrades áp dụng các điều chỉnh sau khi chấm lại: chấp nhận thêm đáp án, cho trọn điểm hoặc bỏ câu.
+func (e *ScoringEngine) WithRegrades(regrades []*domain.QuestionRegradeModel) *ScoringEngine {
+	e.regrades = make(map[int64]*domain.QuestionRegradeModel, len(regrades))
+	for _, r := range regrades {
+		e.regrades[r.QuestionID] = r
+	}
+	return e
+}
+
+func (e *ScoringEngine) ScoreQuestion(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	qType := "single_choice"
+	if q.Type.Type != "" {
+		qType = q.Type.Type
+	}
+	scorer, ok := e.scorers[qType]
+	if !ok {
+		scorer = e.scorers["single_choice"]
+	}
+	return scorer.Score(q, ans, points)
+}
+
+func (e *ScoringEngine) ScoreSubmission(questions []*domain.QuestionModel, qPoints map[int64]float64, answers map[int64]AnswerResponse) SubmissionResult {
+	res := SubmissionResult{Results: make(map[int64]ScoreResult)}
+
+	for _, q := range questions {
+		points := 1.0
+		if pts, ok := qPoints[q.Id]; ok && pts > 0 {
+			points = pts
+		}
+		regrade := e.regrades[q.Id]
+		if regrade != nil && regrade.Policy == domain.RegradePolicyDrop {
+			res.Results[q.Id] = ScoreResult{}
+			continue
+		}
+		res.MaxPoints += points
+
+		var r ScoreResult
+		switch ans := answers[q.Id]; {
+		case ans.OverridePoints != nil:
+			earned := math.Min(*ans.OverridePoints, points)
+			r = ScoreResult{Earned: earned, IsCorrect: earned >= points}
+		case regrade != nil && regrade.Policy == domain.RegradePolicyFullCredit:
+			r = ScoreResult{Earned: points, IsCorrect: true}
+		case regrade != nil && regrade.Policy == domain.RegradePolicyAcceptMultiple:
+			r = e.ScoreQuestion(withAcceptedAnswers(q, regrade.Answers()), ans, points)
+		default:
+			r = e.ScoreQuestion(q, ans, points)
+		}
+		res.Results[q.Id] = r
+		res.Earned += r.Earned
+		if r.IsCorrect {
+			res.CorrectCount++
+		}
+	}
+
+	res.Score = e.Scale(res.Earned, res.MaxPoints)
+	return res
+}
+
+func (e *ScoringEngine) Scale(earned, maxPoints float64) float64 {
+	if maxPoints <= 0 || earned <= 0 {
+		return 0
+	}
+	ratio := earned / maxPoints
+	if ratio > 1 {
+		ratio = 1
+	}
+	return ratio * ScaleMax(e.scale)
+}
+
+// validateScoringSettings từ chối chính sách chấm, thang điểm không hỗ trợ và hệ số trừ điểm ngoài [0, 1].
+// Giá trị rỗng nghĩa là dùng mặc định.
+func validateScoringSettings(settings *pb.ExamSettings) error {
+	if settings == nil {
+		return nil
+	}
+	switch settings.ScoringPolicy {
+	case "", ScoringAllOrNothing, ScoringPartial:
+	default:
+		return status.Errorf(codes.InvalidArgument, "Chính sách chấm điểm %q không hợp lệ", settings.ScoringPolicy)
+	}
+	switch settings.ScoreScale {
+	case "", ScoreScale10, ScoreScale100, ScoreScalePercentage:
+	default:
+		return status.Errorf(codes.InvalidArgument, "Thang điểm %q không hợp lệ", settings.ScoreScale)
+	}
+	if settings.NegativeMarking < 0 || settings.NegativeMarking > 1 {
+		return status.Errorf(codes.InvalidArgument, "Hệ số trừ điểm phải nằm trong khoảng 0 - 1, nhận %v", settings.NegativeMarking)
+	}
+	return nil
+}
+
+func ScaleMax(scale string) float64 {
+	switch scale {
+	case ScoreScale100, ScoreScalePercentage:
+		return 100.0
+	default:
+		return 10.0
+	}
+}
+
+// groupUserAnswers gom các dòng UserAnswerModel (mỗi lựa chọn là một dòng) theo câu hỏi.
+func groupUserAnswers(rows []domain.UserAnswerModel) map[int64]AnswerResponse {
+	grouped := make(map[int64]AnswerResponse)
+	for _, ua := range rows {
+		ans := grouped[ua.QuestionID]
+		if ua.ChosenChoiceID != nil {
+			ans.ChoiceIDs = append(ans.ChoiceIDs, *ua.ChosenChoiceID)
+		}
+		if ua.TextAnswer != nil && *ua.TextAnswer != "" {
+			ans.Text = *ua.TextAnswer
+		}
+		if ua.AnswerData != nil && *ua.AnswerData != "" {
+			var data domain.StructuredAnswer
+			if err := json.Unmarshal([]byte(*ua.AnswerData), &data); err == nil {
+				ans.Order = data.Order
+				ans.Matches = data.Matches
+				ans.Blanks = data.Blanks
+			}
+		}
+		if ua.AwardedPoints != nil && ans.ManualPoints == nil {
+			pts := *ua.AwardedPoints
+			ans.ManualPoints = &pts
+		}
+		if ua.PointsOverridden && ua.AwardedPoints != nil && ans.OverridePoints == nil {
+			pts := *ua.AwardedPoints
+			ans.OverridePoints = &pts
+		}
+		grouped[ua.QuestionID] = ans
+	}
+	return grouped
+}
+
+type singleChoiceScorer struct {
+	negative float64
+}
+
+func (s *singleChoiceScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	if len(ans.ChoiceIDs) == 0 {
+		return ScoreResult{}
+	}
+	if len(ans.ChoiceIDs) == 1 && isCorrectChoice(q, ans.ChoiceIDs[0]) {
+		return ScoreResult{Earned: points, IsCorrect: true}
+	}
+	return ScoreResult{Earned: -s.negative * points}
+}
+
+type multipleChoiceScorer struct {
+	partial  bool
+	negative float64
+}
+
+func (s *multipleChoiceScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	if len(ans.ChoiceIDs) == 0 {
+		return ScoreResult{}
+	}
+
+	totalCorrect := 0
+	for _, c := range q.Choices {
+		if c.IsCorrect {
+			totalCorrect++
+		}
+	}
+
+	picked := make(map[int64]bool)
+	correctPicked, wrongPicked := 0, 0
+	for _, cID := range ans.ChoiceIDs {
+		if picked[cID] {
+			continue
+		}
+		picked[cID] = true
+		if isCorrectChoice(q, cID) {
+			correctPicked++
+		} else {
+			wrongPicked++
+		}
+	}
+
+	if totalCorrect > 0 && correctPicked == totalCorrect && wrongPicked == 0 {
+		return ScoreResult{Earned: points, IsCorrect: true}
+	}
+
+	if !s.partial || totalCorrect == 0 {
+		return ScoreResult{Earned: -s.negative * points}
+	}
+
+	earned := float64(correctPicked-wrongPicked) / float64(totalCorrect) * points
+	if floor := -s.negative * points; earned < floor {
+		earned = floor
+	}
+	return ScoreResult{Earned: earned}
+}
+
+type shortAnswerScorer struct{}
+
+func (s *shortAnswerScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	text := strings.TrimSpace(ans.Text)
+	for _, c := range q.Choices {
+		if !c.IsCorrect {
+			continue
+		}
+		if text != "" && strings.EqualFold(strings.TrimSpace(c.Content), text) {
+			return ScoreResult{Earned: points, IsCorrect: true}
+		}
+		for _, cID := range ans.ChoiceIDs {
+			if cID == c.Id {
+				return ScoreResult{Earned: points, IsCorrect: true}
+			}
+		}
+	}
+	return ScoreResult{}
+}
+
+// manualScorer dùng cho câu tự luận: điểm do giáo viên chấm qua GradeEssay.
+type manualScorer struct{}
+
+func (s *manualScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	if ans.ManualPoints == nil {
+		return ScoreResult{Pending: strings.TrimSpace(ans.Text) != ""}
+	}
+	earned := *ans.ManualPoints
+	if earned > points {
+		earned = points
+	}
+	return ScoreResult{Earned: earned, IsCorrect: earned >= points}
+}
+
+type numericScorer struct{}
+
+func (s *numericScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	cfg := domain.ParseAnswerConfig(q.AnswerConfig).Numeric
+	if cfg == nil {
+		return ScoreResult{}
+	}
+	value, unit, ok := parseNumericAnswer(ans.Text)
+	if !ok || !numericUnitAccepted(cfg, unit) {
+		return ScoreResult{}
+	}
+
+	tolerance := math.Abs(cfg.Tolerance)
+	if cfg.ToleranceType == domain.ToleranceRelative {
+		tolerance = math.Abs(cfg.Value) * tolerance
+	}
+	if math.Abs(value-cfg.Value) <= tolerance+1e-9 {
+		return ScoreResult{Earned: points, IsCorrect: true}
+	}
+	return ScoreResult{}
+}
+
+var numericAnswerPattern = regexp.MustCompile(`^([-+]?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?)\s*(.*)$`)
+
+// parseNumericAnswer tách giá trị và đơn vị, chấp nhận cả dấu phẩy thập phân ("9,8 m/s2").
+func parseNumericAnswer(text string) (float64, string, bool) {
+	text = strings.TrimSpace(text)
+	if strings.Contains(text, ".") {
+		text = strings.ReplaceAll(text, ",", "")
+	} else {
+		text = strings.Replace(text, ",", ".", 1)
+	}
+	m := numericAnswerPattern.FindStringSubmatch(text)
+	if m == nil {
+		return 0, "", false
+	}
+	value, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return value, strings.TrimSpace(m[2]), true
+}
+
+func numericUnitAccepted(cfg *domain.NumericAnswer, unit string) bool {
+	if unit == "" {
+		return !cfg.RequireUnit
+	}
+	if cfg.Unit == "" && len(cfg.AcceptedUnits) == 0 {
+		return true
+	}
+	normalized := normalizeUnit(unit)
+	if normalized == normalizeUnit(cfg.Unit) {
+		return true
+	}
+	for _, u := range cfg.AcceptedUnits {
+		if normalized == normalizeUnit(u) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeUnit(unit string) string {
+	return strings.ToLower(strings.Join(strings.Fields(unit), ""))
+}
+
+type matchingScorer struct {
+	partial bool
+}
+
+func (s *matchingScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	if len(ans.Matches) == 0 {
+		return ScoreResult{}
+	}
+	total, correct := 0, 0
+	for _, c := range q.Choices {
+		if c.MatchTarget == "" {
+			continue
+		}
+		total++
+		if got, ok := ans.Matches[c.Id]; ok && normalizeText(got, false) == normalizeText(c.MatchTarget, false) {
+			correct++
+		}
+	}
+	return proportionalResult(correct, total, points, s.partial)
+}
+
+type orderingScorer struct {
+	partial bool
+}
+
+func (s *orderingScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	if len(ans.Order) == 0 {
+		return ScoreResult{}
+	}
+	expected := orderedChoices(q.Choices)
+	correct := 0
+	for i, c := range expected {
+		if i < len(ans.Order) && ans.Order[i] == c.Id {
+			correct++
+		}
+	}
+	return proportionalResult(correct, len(expected), points, s.partial)
+}
+
+type clozeScorer struct {
+	partial bool
+}
+
+func (s *clozeScorer) Score(q *domain.QuestionModel, ans AnswerResponse, points float64) ScoreResult {
+	if len(ans.Blanks) == 0 {
+		return ScoreResult{}
+	}
+	blanks := domain.ParseAnswerConfig(q.AnswerConfig).Blanks
+	correct := 0
+	for i, b := range blanks {
+		if i >= len(ans.Blanks) {
+			break
+		}
+		given := normalizeText(ans.Blanks[i], b.CaseSensitive)
+		for _, accepted := range b.Answers {
+			if given != "" && given == normalizeText(accepted, b.CaseSensitive) {
+				correct++
+				break
+			}
+		}
+	}
+	return proportionalResult(correct, len(blanks), points, s.partial)
+}
+
+func proportionalResult(correct, total int, points float64, partial bool) ScoreResult {
+	if total == 0 {
+		return ScoreResult{}
+	}
+	if correct == total {
+		return ScoreResult{Earned: points, IsCorrect: true}
+	}
+	if !partial {
+		return ScoreResult{}
+	}
+	return ScoreResult{Earned: float64(correct) / float64(total) * points}
+}
+
+func normalizeText(text string, caseSensitive bool) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if caseSensitive {
+		return text
+	}
+	return strings.ToLower(text)
+}
+
+// orderedChoices trả về các lựa chọn theo đúng thứ tự đáp án (Position, rồi Id).
+func orderedChoices(choices []domain.ChoiceModel) []domain.ChoiceModel {
+	sorted := make([]domain.ChoiceModel, len(choices))
+	copy(sorted, choices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position < sorted[j].Position
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+	return sorted
+}
+
+// withAcceptedAnswers trả về bản sao câu hỏi coi các lựa chọn có nội dung thuộc accepted là đáp án đúng.
+// So khớp theo nội dung để áp dụng được cho mọi phiên bản của câu hỏi.
+func withAcceptedAnswers(q *domain.QuestionModel, accepted []string) *domain.QuestionModel {
+	acceptedSet := make(map[string]bool, len(accepted))
+	for _, a := range accepted {
+		acceptedSet[normalizeText(a, false)] = true
+	}
+
+	adjusted := *q
+	adjusted.Choices = make([]domain.ChoiceModel, len(q.Choices))
+	for i, c := range q.Choices {
+		key := normalizeText(c.Content, false)
+		if acceptedSet[key] {
+			c.IsCorrect = true
+			delete(acceptedSet, key)
+		}
+		adjusted.Choices[i] = c
+	}
+	if q.Type.Type == domain.QuestionTypeShortAnswer {
+		for _, a := range accepted {
+			if acceptedSet[normalizeText(a, false)] {
+				adjusted.Choices = append(adjusted.Choices, domain.ChoiceModel{QuestionID: q.Id, Content: a, IsCorrect: true})
+			}
+		}
+	}
+	return &adjusted
+}
+
+func isCorrectChoice(q *domain.QuestionModel, choiceID int64) bool {
+	for _, c := range q.Choices {
+		if c.Id == choiceID {
+			return c.IsCorrect
+		}
+	}
+	return false
+}
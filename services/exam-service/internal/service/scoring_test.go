@@ -0,0 +1,262 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+func scoringFixtureQuestion(id int64, qType string, choices ...domain.ChoiceModel) *domain.QuestionModel {
+	for i := range choices {
+		choices[i].QuestionID = id
+	}
+	return &domain.QuestionModel{Id: id, Type: domain.QuestionTypeModel{Type: qType}, Choices: choices}
+}
+
+func choice(id int64, content string, correct bool) domain.ChoiceModel {
+	return domain.ChoiceModel{Id: id, Content: content, IsCorrect: correct}
+}
+
+func floatPtr(v float64) *float64 { return &v }
+
+func almostEqual(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+func TestScoreQuestionByType(t *testing.T) {
+	single := scoringFixtureQuestion(1, domain.QuestionTypeSingleChoice,
+		choice(11, "Hà Nội", true), choice(12, "Huế", false), choice(13, "Đà Nẵng", false))
+	multiple := scoringFixtureQuestion(2, domain.QuestionTypeMultipleChoice,
+		choice(21, "2", true), choice(22, "3", true), choice(23, "4", false), choice(24, "5", true))
+	short := scoringFixtureQuestion(3, domain.QuestionTypeShortAnswer, choice(31, "Fe", true))
+	numeric := scoringFixtureQuestion(4, domain.QuestionTypeNumeric)
+	numeric.AnswerConfig = domain.AnswerConfig{Numeric: &domain.NumericAnswer{
+		Value: 9.8, Tolerance: 0.1, ToleranceType: domain.ToleranceAbsolute, Unit: "m/s2", AcceptedUnits: []string{"m/s^2"},
+	}}.JSON()
+	relative := scoringFixtureQuestion(5, domain.QuestionTypeNumeric)
+	relative.AnswerConfig = domain.AnswerConfig{Numeric: &domain.NumericAnswer{
+		Value: 100, Tolerance: 0.05, ToleranceType: domain.ToleranceRelative, RequireUnit: true, Unit: "C",
+	}}.JSON()
+	matching := scoringFixtureQuestion(6, domain.QuestionTypeMatching,
+		domain.ChoiceModel{Id: 61, Content: "Pháp", MatchTarget: "Paris"},
+		domain.ChoiceModel{Id: 62, Content: "Nhật", MatchTarget: "Tokyo"},
+		domain.ChoiceModel{Id: 63, Content: "Ý", MatchTarget: "Rome"},
+		domain.ChoiceModel{Id: 64, Content: "Đức", MatchTarget: "Berlin"})
+	ordering := scoringFixtureQuestion(7, domain.QuestionTypeOrdering,
+		domain.ChoiceModel{Id: 71, Position: 1}, domain.ChoiceModel{Id: 72, Position: 2},
+		domain.ChoiceModel{Id: 73, Position: 3}, domain.ChoiceModel{Id: 74, Position: 4})
+	cloze := scoringFixtureQuestion(8, domain.QuestionTypeCloze)
+	cloze.AnswerConfig = domain.AnswerConfig{Blanks: []domain.ClozeBlank{
+		{Answers: []string{"100", "một trăm"}},
+		{Answers: []string{"0"}},
+		{Answers: []string{"H2O"}, CaseSensitive: true},
+	}}.JSON()
+	essay := scoringFixtureQuestion(9, domain.QuestionTypeEssay)
+
+	tests := []struct {
+		name     string
+		q        *domain.QuestionModel
+		policy   string
+		negative float64
+		ans      AnswerResponse
+		want     ScoreResult
+	}{
+		{"một lựa chọn đúng", single, ScoringAllOrNothing, 0, AnswerResponse{ChoiceIDs: []int64{11}}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"một lựa chọn sai không trừ điểm", single, ScoringAllOrNothing, 0, AnswerResponse{ChoiceIDs: []int64{12}}, ScoreResult{}},
+		{"một lựa chọn sai bị trừ điểm", single, ScoringAllOrNothing, 0.25, AnswerResponse{ChoiceIDs: []int64{12}}, ScoreResult{Earned: -0.5}},
+		{"một lựa chọn chọn hai đáp án bị trừ điểm", single, ScoringAllOrNothing, 0.25, AnswerResponse{ChoiceIDs: []int64{11, 12}}, ScoreResult{Earned: -0.5}},
+		{"bỏ trống không bị trừ điểm", single, ScoringAllOrNothing, 0.25, AnswerResponse{}, ScoreResult{}},
+
+		{"nhiều lựa chọn đúng hết", multiple, ScoringAllOrNothing, 0, AnswerResponse{ChoiceIDs: []int64{24, 21, 22}}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"nhiều lựa chọn chọn trùng vẫn đúng", multiple, ScoringAllOrNothing, 0, AnswerResponse{ChoiceIDs: []int64{21, 22, 22, 24}}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"nhiều lựa chọn thiếu, chấm tất cả hoặc không", multiple, ScoringAllOrNothing, 0, AnswerResponse{ChoiceIDs: []int64{21, 22}}, ScoreResult{}},
+		{"nhiều lựa chọn thiếu, tính điểm từng phần", multiple, ScoringPartial, 0, AnswerResponse{ChoiceIDs: []int64{21, 22}}, ScoreResult{Earned: 2 * 2.0 / 3}},
+		{"nhiều lựa chọn có đáp án sai bị trừ trong điểm từng phần", multiple, ScoringPartial, 0, AnswerResponse{ChoiceIDs: []int64{21, 22, 23}}, ScoreResult{Earned: 2 * 1.0 / 3}},
+		{"điểm từng phần âm bị chặn ở mức trừ điểm", multiple, ScoringPartial, 0.5, AnswerResponse{ChoiceIDs: []int64{23}}, ScoreResult{Earned: -1.0 / 3 * 2}},
+		{"điểm từng phần âm không vượt mức trừ điểm", multiple, ScoringPartial, 0.1, AnswerResponse{ChoiceIDs: []int64{23}}, ScoreResult{Earned: -0.2}},
+
+		{"trả lời ngắn không phân biệt hoa thường", short, ScoringAllOrNothing, 0, AnswerResponse{Text: "  fe "}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"trả lời ngắn sai", short, ScoringAllOrNothing, 0.5, AnswerResponse{Text: "Cu"}, ScoreResult{}},
+
+		{"số trong sai số, dấu phẩy thập phân", numeric, ScoringAllOrNothing, 0, AnswerResponse{Text: "9,75 m/s^2"}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"số ngoài sai số", numeric, ScoringAllOrNothing, 0, AnswerResponse{Text: "9.6"}, ScoreResult{}},
+		{"số sai đơn vị", numeric, ScoringAllOrNothing, 0, AnswerResponse{Text: "9.8 km"}, ScoreResult{}},
+		{"số sai số tương đối", relative, ScoringAllOrNothing, 0, AnswerResponse{Text: "104 C"}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"số thiếu đơn vị bắt buộc", relative, ScoringAllOrNothing, 0, AnswerResponse{Text: "100"}, ScoreResult{}},
+
+		{"ghép cặp đúng hết", matching, ScoringAllOrNothing, 0, AnswerResponse{Matches: map[int64]string{61: "paris", 62: "Tokyo", 63: "Rome", 64: " Berlin "}}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"ghép cặp đúng một nửa, tất cả hoặc không", matching, ScoringAllOrNothing, 0, AnswerResponse{Matches: map[int64]string{61: "Paris", 62: "Tokyo", 63: "Berlin", 64: "Rome"}}, ScoreResult{}},
+		{"ghép cặp đúng một nửa, từng phần", matching, ScoringPartial, 0, AnswerResponse{Matches: map[int64]string{61: "Paris", 62: "Tokyo", 63: "Berlin", 64: "Rome"}}, ScoreResult{Earned: 1}},
+
+		{"sắp xếp đúng", ordering, ScoringAllOrNothing, 0, AnswerResponse{Order: []int64{71, 72, 73, 74}}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"sắp xếp đúng ba vị trí, từng phần", ordering, ScoringPartial, 0, AnswerResponse{Order: []int64{71, 72, 74}}, ScoreResult{Earned: 1}},
+
+		{"điền khuyết đúng hết", cloze, ScoringAllOrNothing, 0, AnswerResponse{Blanks: []string{"Một  trăm", "0", "H2O"}}, ScoreResult{Earned: 2, IsCorrect: true}},
+		{"điền khuyết phân biệt hoa thường, từng phần", cloze, ScoringPartial, 0, AnswerResponse{Blanks: []string{"100", "0", "h2o"}}, ScoreResult{Earned: 2 * 2.0 / 3}},
+
+		{"tự luận chưa chấm", essay, ScoringAllOrNothing, 0, AnswerResponse{Text: "Bài làm"}, ScoreResult{Pending: true}},
+		{"tự luận bỏ trống không chờ chấm", essay, ScoringAllOrNothing, 0, AnswerResponse{}, ScoreResult{}},
+		{"tự luận đã chấm theo điểm thực", essay, ScoringAllOrNothing, 0.5, AnswerResponse{Text: "Bài làm", ManualPoints: floatPtr(1.5)}, ScoreResult{Earned: 1.5}},
+		{"tự luận điểm chấm bị chặn ở điểm tối đa", essay, ScoringAllOrNothing, 0, AnswerResponse{Text: "Bài làm", ManualPoints: floatPtr(3)}, ScoreResult{Earned: 2, IsCorrect: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewScoringEngine(&domain.ExamModel{ScoringPolicy: tt.policy, NegativeMarking: tt.negative})
+			got := engine.ScoreQuestion(tt.q, tt.ans, 2)
+			if !almostEqual(got.Earned, tt.want.Earned) || got.IsCorrect != tt.want.IsCorrect || got.Pending != tt.want.Pending {
+				t.Errorf("ScoreQuestion = %+v, muốn %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreSubmissionScaleAndRegrades(t *testing.T) {
+	q1 := scoringFixtureQuestion(1, domain.QuestionTypeSingleChoice, choice(11, "A", true), choice(12, "B", false))
+	q2 := scoringFixtureQuestion(2, domain.QuestionTypeSingleChoice, choice(21, "A", true), choice(22, "B", false))
+	q3 := scoringFixtureQuestion(3, domain.QuestionTypeSingleChoice, choice(31, "A", true), choice(32, "B", false))
+	questions := []*domain.QuestionModel{q1, q2, q3}
+	points := map[int64]float64{1: 2, 2: 1}
+	answers := map[int64]AnswerResponse{
+		1: {ChoiceIDs: []int64{11}},
+		2: {ChoiceIDs: []int64{22}},
+		3: {ChoiceIDs: []int64{32}},
+	}
+
+	tests := []struct {
+		name     string
+		exam     *domain.ExamModel
+		regrades []*domain.QuestionRegradeModel
+		answers  map[int64]AnswerResponse
+		want     SubmissionResult
+	}{
+		{
+			name: "thang 10 mặc định, câu không khai báo điểm tính 1 điểm",
+			exam: &domain.ExamModel{},
+			want: SubmissionResult{Earned: 2, MaxPoints: 4, Score: 5, CorrectCount: 1},
+		},
+		{
+			name: "thang 100",
+			exam: &domain.ExamModel{ScoreScale: ScoreScale100},
+			want: SubmissionResult{Earned: 2, MaxPoints: 4, Score: 50, CorrectCount: 1},
+		},
+		{
+			name: "tổng điểm âm được làm tròn về 0",
+			exam: &domain.ExamModel{NegativeMarking: 1},
+			answers: map[int64]AnswerResponse{
+				1: {ChoiceIDs: []int64{12}},
+				2: {ChoiceIDs: []int64{22}},
+			},
+			want: SubmissionResult{Earned: -3, MaxPoints: 4, Score: 0},
+		},
+		{
+			name:     "bỏ câu khỏi đề giảm điểm tối đa",
+			exam:     &domain.ExamModel{},
+			regrades: []*domain.QuestionRegradeModel{{QuestionID: 2, Policy: domain.RegradePolicyDrop}},
+			want:     SubmissionResult{Earned: 2, MaxPoints: 3, Score: 20.0 / 3, CorrectCount: 1},
+		},
+		{
+			name:     "cho trọn điểm",
+			exam:     &domain.ExamModel{},
+			regrades: []*domain.QuestionRegradeModel{{QuestionID: 3, Policy: domain.RegradePolicyFullCredit}},
+			want:     SubmissionResult{Earned: 3, MaxPoints: 4, Score: 7.5, CorrectCount: 2},
+		},
+		{
+			name:     "chấp nhận thêm đáp án theo nội dung",
+			exam:     &domain.ExamModel{},
+			regrades: []*domain.QuestionRegradeModel{{QuestionID: 2, Policy: domain.RegradePolicyAcceptMultiple, AcceptedAnswers: `["b"]`}},
+			want:     SubmissionResult{Earned: 3, MaxPoints: 4, Score: 7.5, CorrectCount: 2},
+		},
+		{
+			name:     "điểm ấn định được ưu tiên hơn điều chỉnh chấm lại",
+			exam:     &domain.ExamModel{},
+			regrades: []*domain.QuestionRegradeModel{{QuestionID: 3, Policy: domain.RegradePolicyFullCredit}},
+			answers: map[int64]AnswerResponse{
+				1: {ChoiceIDs: []int64{11}},
+				3: {ChoiceIDs: []int64{32}, OverridePoints: floatPtr(0.5)},
+			},
+			want: SubmissionResult{Earned: 2.5, MaxPoints: 4, Score: 6.25, CorrectCount: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ans := tt.answers
+			if ans == nil {
+				ans = answers
+			}
+			got := NewScoringEngine(tt.exam).WithRegrades(tt.regrades).ScoreSubmission(questions, points, ans)
+			if !almostEqual(got.Earned, tt.want.Earned) || !almostEqual(got.MaxPoints, tt.want.MaxPoints) ||
+				!almostEqual(got.Score, tt.want.Score) || got.CorrectCount != tt.want.CorrectCount {
+				t.Errorf("ScoreSubmission = {Earned:%v MaxPoints:%v Score:%v CorrectCount:%v}, muốn %+v",
+					got.Earned, got.MaxPoints, got.Score, got.CorrectCount, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupUserAnswersOverridePrecedence(t *testing.T) {
+	c1, c2 := int64(11), int64(12)
+	text := "Bài làm"
+	data := domain.StructuredAnswer{Order: []int64{3, 1, 2}}.JSON()
+
+	rows := []domain.UserAnswerModel{
+		{QuestionID: 1, ChosenChoiceID: &c1, AwardedPoints: floatPtr(1)},
+		{QuestionID: 1, ChosenChoiceID: &c2, AwardedPoints: floatPtr(1)},
+		{QuestionID: 2, TextAnswer: &text, AwardedPoints: floatPtr(0.75), PointsOverridden: true},
+		{QuestionID: 3, AnswerData: &data},
+		{QuestionID: 4, TextAnswer: &text, AwardedPoints: floatPtr(0.5)},
+	}
+	got := groupUserAnswers(rows)
+
+	if ids := got[1].ChoiceIDs; len(ids) != 2 || ids[0] != c1 || ids[1] != c2 {
+		t.Errorf("câu 1: ChoiceIDs = %v, muốn [%d %d]", ids, c1, c2)
+	}
+	if got[1].OverridePoints != nil {
+		t.Errorf("câu 1: điểm tự chấm không được coi là điểm ấn định")
+	}
+	if got[2].OverridePoints == nil || *got[2].OverridePoints != 0.75 {
+		t.Errorf("câu 2: OverridePoints = %v, muốn 0.75", got[2].OverridePoints)
+	}
+	if got[2].Text != text {
+		t.Errorf("câu 2: Text = %q, muốn %q", got[2].Text, text)
+	}
+	if order := got[3].Order; len(order) != 3 || order[0] != 3 {
+		t.Errorf("câu 3: Order = %v, muốn [3 1 2]", order)
+	}
+	if got[4].ManualPoints == nil || *got[4].ManualPoints != 0.5 || got[4].OverridePoints != nil {
+		t.Errorf("câu 4: ManualPoints = %v, OverridePoints = %v; muốn 0.5 và nil", got[4].ManualPoints, got[4].OverridePoints)
+	}
+
+	// Điểm ấn định thắng cả kết quả tự động lẫn điểm chấm tay khi tính điểm.
+	essay := scoringFixtureQuestion(2, domain.QuestionTypeEssay)
+	r := NewScoringEngine(nil).ScoreSubmission([]*domain.QuestionModel{essay}, map[int64]float64{2: 1}, got)
+	if !almostEqual(r.Results[2].Earned, 0.75) {
+		t.Errorf("điểm câu ấn định = %v, muốn 0.75", r.Results[2].Earned)
+	}
+}
+
+func TestValidateScoringSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *pb.ExamSettings
+		wantErr  bool
+	}{
+		{"mặc định", &pb.ExamSettings{}, false},
+		{"không có cài đặt", nil, false},
+		{"hợp lệ", &pb.ExamSettings{ScoringPolicy: ScoringPartial, ScoreScale: ScoreScalePercentage, NegativeMarking: 0.25}, false},
+		{"trừ điểm tối đa", &pb.ExamSettings{NegativeMarking: 1}, false},
+		{"chính sách lạ", &pb.ExamSettings{ScoringPolicy: "best_effort"}, true},
+		{"thang điểm lạ", &pb.ExamSettings{ScoreScale: "20"}, true},
+		{"trừ điểm âm", &pb.ExamSettings{NegativeMarking: -0.5}, true},
+		{"trừ điểm quá 1", &pb.ExamSettings{NegativeMarking: 1.5}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScoringSettings(tt.settings)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateScoringSettings() lỗi = %v, muốn lỗi = %v", err, tt.wantErr)
+			}
+		})
+	}
+}
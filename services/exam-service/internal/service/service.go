@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -28,17 +29,19 @@ import (
 	"github.com/06babyshark06/JQKStudy/shared/contracts"
 	"github.com/06babyshark06/JQKStudy/shared/env"
 	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+	pbUser "github.com/06babyshark06/JQKStudy/shared/proto/user"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type examService struct {
-	repo     domain.ExamRepository
-	producer domain.EventProducer
+	repo       domain.ExamRepository
+	producer   domain.EventProducer
+	userClient pbUser.UserServiceClient
 }
 
-func NewExamService(repo domain.ExamRepository, producer domain.EventProducer) domain.ExamService {
-	return &examService{repo: repo, producer: producer}
+func NewExamService(repo domain.ExamRepository, producer domain.EventProducer, userClient pbUser.UserServiceClient) domain.ExamService {
+	return &examService{repo: repo, producer: producer, userClient: userClient}
 }
 
 func (s *examService) createR2Client(ctx context.Context) (*s3.PresignClient, error) {
@@ -139,10 +142,16 @@ func (s *examService) CreateQuestion(ctx context.Context, req *pb.CreateQuestion
 		diff, _ := s.repo.GetDifficulty(ctx, req.Difficulty)
 		qType, _ := s.repo.GetQuestionType(ctx, req.QuestionType)
 
+		answerConfig := answerConfigFromProto(req.Numeric, req.Blanks)
+		if err := validateQuestionAnswers(qType.Type, choiceModelsFromInput(0, qType.Type, req.Choices), answerConfig); err != nil {
+			return err
+		}
+
 		question := &domain.QuestionModel{
 			SectionID: req.SectionId, TopicID: section.TopicID, CreatorID: req.CreatorId,
 			Content: req.Content, TypeID: qType.Id, DifficultyID: diff.Id,
 			Explanation: req.Explanation, AttachmentURL: req.AttachmentUrl,
+			AnswerConfig: answerConfig.JSON(),
 			Points: 1.0,
 		}
 		createdQ, err := s.repo.CreateQuestion(ctx, tx, question)
@@ -151,14 +160,13 @@ func (s *examService) CreateQuestion(ctx context.Context, req *pb.CreateQuestion
 		}
 		qID = createdQ.Id
 
-		var choices []*domain.ChoiceModel
-		for _, c := range req.Choices {
-			choices = append(choices, &domain.ChoiceModel{QuestionID: qID, Content: c.Content, IsCorrect: c.IsCorrect, AttachmentURL: c.AttachmentUrl})
-		}
+		choices := choiceModelsFromInput(qID, qType.Type, req.Choices)
 		if len(choices) > 0 {
-			return s.repo.CreateChoices(ctx, tx, choices)
+			if err := s.repo.CreateChoices(ctx, tx, choices); err != nil {
+				return err
+			}
 		}
-		return nil
+		return s.recordQuestionVersion(ctx, tx, qID, req.CreatorId)
 	})
 	if err != nil {
 		return nil, err
@@ -181,10 +189,16 @@ func (s *examService) CreateBulkQuestions(ctx context.Context, req *pb.CreateBul
 			diff, _ := s.repo.GetDifficulty(ctx, qReq.Difficulty)
 			qType, _ := s.repo.GetQuestionType(ctx, qReq.QuestionType)
 
+			answerConfig := answerConfigFromProto(qReq.Numeric, qReq.Blanks)
+			if err := validateQuestionAnswers(qType.Type, choiceModelsFromInput(0, qType.Type, qReq.Choices), answerConfig); err != nil {
+				return err
+			}
+
 			question := &domain.QuestionModel{
 				SectionID: qReq.SectionId, TopicID: section.TopicID, CreatorID: qReq.CreatorId,
 				Content: qReq.Content, TypeID: qType.Id, DifficultyID: diff.Id,
 				Explanation: qReq.Explanation, AttachmentURL: qReq.AttachmentUrl,
+				AnswerConfig: answerConfig.JSON(),
 				Points: 1.0,
 			}
 			createdQ, err := s.repo.CreateQuestion(ctx, tx, question)
@@ -192,17 +206,15 @@ func (s *examService) CreateBulkQuestions(ctx context.Context, req *pb.CreateBul
 				return err
 			}
 
-			var choices []*domain.ChoiceModel
-			for _, c := range qReq.Choices {
-				choices = append(choices, &domain.ChoiceModel{
-					QuestionID: createdQ.Id, Content: c.Content, IsCorrect: c.IsCorrect, AttachmentURL: c.AttachmentUrl,
-				})
-			}
+			choices := choiceModelsFromInput(createdQ.Id, qType.Type, qReq.Choices)
 			if len(choices) > 0 {
 				if err := s.repo.CreateChoices(ctx, tx, choices); err != nil {
 					return err
 				}
 			}
+			if err := s.recordQuestionVersion(ctx, tx, createdQ.Id, qReq.CreatorId); err != nil {
+				return err
+			}
 			successCount++
 		}
 		return nil
@@ -217,8 +229,31 @@ func (s *examService) CreateBulkQuestions(ctx context.Context, req *pb.CreateBul
 
 func (s *examService) ImportQuestions(ctx context.Context, req *pb.ImportQuestionsRequest) (*pb.ImportQuestionsResponse, error) {
 	if len(req.FileContent) == 0 {
-		return nil, errors.New("file excel rỗng")
+		return nil, errors.New("file import rỗng")
 	}
+
+	if req.Format != "" && normalizeBankFormat(req.Format) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Định dạng import %q không được hỗ trợ", req.Format)
+	}
+	format := detectBankFormat(req.Format, req.FileName, req.FileContent)
+	log.Printf("📥 Import ngân hàng câu hỏi định dạng %s", format)
+
+	var questions []*bankQuestion
+	var parseErrors []*pb.QuestionBankError
+	switch format {
+	case BankFormatExcel:
+		return s.importExcelQuestions(ctx, req)
+	case BankFormatMoodleXML:
+		questions, parseErrors = parseMoodleXML(req.FileContent)
+	case BankFormatGIFT:
+		questions, parseErrors = parseGIFT(req.FileContent)
+	case BankFormatAiken:
+		questions, parseErrors = parseAiken(req.FileContent)
+	}
+	return s.importBankQuestions(ctx, req, format, questions, parseErrors)
+}
+
+func (s *examService) importExcelQuestions(ctx context.Context, req *pb.ImportQuestionsRequest) (*pb.ImportQuestionsResponse, error) {
 	reader := bytes.NewReader(req.FileContent)
 	f, err := excelize.OpenReader(reader)
 	if err != nil {
@@ -249,99 +284,17 @@ func (s *examService) ImportQuestions(ctx context.Context, req *pb.ImportQuestio
 		return nil, fmt.Errorf("không thể đọc dữ liệu từ sheet %s: %v", sheetName, err)
 	}
 
-	topicCache := make(map[string]int64)
-	sectionCache := make(map[string]int64)
-	typeCache := make(map[string]int64)
-	diffCache := make(map[string]int64)
-
-	getOrCreateTopic := func(name string) (int64, error) {
-		name = strings.TrimSpace(name)
-		if id, ok := topicCache[name]; ok {
-			return id, nil
-		}
-
-		t, _ := s.repo.GetTopicByName(ctx, name)
-		if t != nil {
-			topicCache[name] = t.Id
-			return t.Id, nil
-		}
-
-		newTopic := &domain.TopicModel{Name: name, Description: "Auto imported", CreatorID: req.CreatorId}
-		created, err := s.repo.CreateTopic(ctx, database.DB, newTopic)
-		if err != nil {
-			return 0, err
-		}
-		topicCache[name] = created.Id
-		return created.Id, nil
-	}
-
-	getOrCreateSection := func(topicID int64, name string) (int64, error) {
-		name = strings.TrimSpace(name)
-		cacheKey := fmt.Sprintf("%d_%s", topicID, name)
-		if id, ok := sectionCache[cacheKey]; ok {
-			return id, nil
-		}
-
-		sections, _ := s.repo.GetSectionsByTopic(ctx, topicID)
-		for _, sec := range sections {
-			if strings.EqualFold(sec.Name, name) {
-				sectionCache[cacheKey] = sec.Id
-				return sec.Id, nil
-			}
-		}
-
-		newSec := &domain.SectionModel{Name: name, Description: "Auto imported", TopicID: topicID}
-		created, err := s.repo.CreateSection(ctx, database.DB, newSec)
-		if err != nil {
-			return 0, err
-		}
-		sectionCache[cacheKey] = created.Id
-		return created.Id, nil
-	}
-
-	getTypeID := func(name string) int64 {
-		name = strings.TrimSpace(strings.ToLower(name))
-		if name == "multiple" || name == "multiple_choice" {
-			name = "multiple_choice"
-		} else if name == "short" || name == "short_answer" {
-			name = "short_answer"
-		} else if name == "essay" {
-			name = "essay"
-		} else {
-			name = "single_choice"
-		}
-		if id, ok := typeCache[name]; ok {
-			return id
-		}
-		t, _ := s.repo.GetQuestionType(ctx, name)
-		if t != nil {
-			typeCache[name] = t.Id
-			return t.Id
-		}
-		return 1
-	}
-	getDiffID := func(name string) int64 {
-		name = strings.TrimSpace(strings.ToLower(name))
-		if id, ok := diffCache[name]; ok {
-			return id
-		}
-		d, _ := s.repo.GetDifficulty(ctx, name)
-		if d != nil {
-			diffCache[name] = d.Id
-			return d.Id
-		}
-		return 1
-	}
+	catalog := newImportCatalog(s, req.CreatorId)
+	var importErrors []*pb.QuestionBankError
 
 	successCount := 0
-	errorCount := 0
 
 	for i, row := range rows {
 		if i == 0 {
 			continue
 		}
 		if len(row) < 3 {
-			errorCount++
+			importErrors = append(importErrors, bankError(i+1, "", errors.New("dòng thiếu cột bắt buộc")))
 			continue
 		}
 
@@ -353,27 +306,39 @@ func (s *examService) ImportQuestions(ctx context.Context, req *pb.ImportQuestio
 		sectionName := row[1]
 		content := strings.TrimSpace(row[2])
 		if topicName == "" || sectionName == "" || content == "" {
-			errorCount++
+			importErrors = append(importErrors, bankError(i+1, content, errors.New("thiếu chủ đề, chương hoặc nội dung câu hỏi")))
 			continue
 		}
 
-		tID, err := getOrCreateTopic(topicName)
+		tID, err := catalog.topicID(ctx, topicName)
 		if err != nil {
-			errorCount++
+			importErrors = append(importErrors, bankError(i+1, content, fmt.Errorf("không tạo được chủ đề %q: %v", topicName, err)))
 			continue
 		}
 
-		sID, err := getOrCreateSection(tID, sectionName)
+		sID, err := catalog.sectionID(ctx, tID, sectionName)
 		if err != nil {
-			errorCount++
+			importErrors = append(importErrors, bankError(i+1, content, fmt.Errorf("không tạo được chương %q: %v", sectionName, err)))
 			continue
 		}
-		qTypeID := getTypeID(row[3])
-		diffID := getDiffID(row[4])
+		qTypeName := normalizeImportType(row[3])
+		qTypeID := catalog.typeID(ctx, qTypeName)
+		diffID := catalog.difficultyID(ctx, row[4])
 		explanation := row[5]
 		imageURL := strings.TrimSpace(row[6])
 		correctStr := strings.ToUpper(strings.TrimSpace(row[7]))
 
+		var answerConfig domain.AnswerConfig
+		if qTypeName == domain.QuestionTypeNumeric {
+			numeric, err := parseNumericSpec(row[7])
+			if err != nil {
+				log.Printf("❌ Lỗi import dòng %d (%s): %v", i+1, content, err)
+				importErrors = append(importErrors, bankError(i+1, content, err))
+				continue
+			}
+			answerConfig.Numeric = numeric
+		}
+
 		correctMap := make(map[int]bool)
 		parts := strings.Split(correctStr, ",")
 		for _, p := range parts {
@@ -387,51 +352,87 @@ func (s *examService) ImportQuestions(ctx context.Context, req *pb.ImportQuestio
 		}
 
 		err = database.DB.Transaction(func(tx *gorm.DB) error {
+			var choices []*domain.ChoiceModel
+			for cIdx := 8; cIdx < len(row); cIdx++ {
+				val := strings.TrimSpace(row[cIdx])
+				if val == "" {
+					continue
+				}
+				choice := &domain.ChoiceModel{Content: val, IsCorrect: correctMap[cIdx-8], AttachmentURL: ""}
+				switch qTypeName {
+				case domain.QuestionTypeOrdering:
+					choice.IsCorrect = false
+					choice.Position = len(choices) + 1
+				case domain.QuestionTypeMatching:
+					premise, target, ok := strings.Cut(val, "=>")
+					if !ok {
+						return fmt.Errorf("cặp ghép %q phải có dạng \"vế trái => vế phải\"", val)
+					}
+					choice.Content = strings.TrimSpace(premise)
+					choice.MatchTarget = strings.TrimSpace(target)
+					choice.IsCorrect = false
+				case domain.QuestionTypeCloze:
+					var answers []string
+					for _, a := range strings.Split(val, "|") {
+						if a = strings.TrimSpace(a); a != "" {
+							answers = append(answers, a)
+						}
+					}
+					answerConfig.Blanks = append(answerConfig.Blanks, domain.ClozeBlank{Answers: answers})
+					continue
+				case domain.QuestionTypeNumeric:
+					continue
+				}
+				choices = append(choices, choice)
+			}
+
+			if err := validateQuestionAnswers(qTypeName, choices, answerConfig); err != nil {
+				return err
+			}
+
 			q := &domain.QuestionModel{
 				SectionID: sID, TopicID: tID, CreatorID: req.CreatorId,
 				Content: content, TypeID: qTypeID, DifficultyID: diffID, Explanation: explanation,
 				AttachmentURL: imageURL,
+				AnswerConfig: answerConfig.JSON(),
 				Points: 1.0,
 			}
 			createdQ, err := s.repo.CreateQuestion(ctx, tx, q)
 			if err != nil {
 				return err
 			}
-
-			var choices []*domain.ChoiceModel
-			for cIdx := 8; cIdx < len(row); cIdx++ {
-				val := strings.TrimSpace(row[cIdx])
-				if val == "" {
-					continue
-				}
-				isCorrect := correctMap[cIdx-8]
-				choices = append(choices, &domain.ChoiceModel{
-					QuestionID: createdQ.Id, Content: val, IsCorrect: isCorrect, AttachmentURL: "",
-				})
+			for _, c := range choices {
+				c.QuestionID = createdQ.Id
 			}
 
-			isObjective := row[3] == "single_choice" || row[3] == "multiple_choice" || row[3] == "multiple"
-			if isObjective && len(choices) < 2 {
-				return errors.New("cần ít nhất 2 lựa chọn cho câu hỏi trắc nghiệm")
-			}
 			if len(choices) > 0 {
-				return s.repo.CreateChoices(ctx, tx, choices)
+				if err := s.repo.CreateChoices(ctx, tx, choices); err != nil {
+					return err
+				}
 			}
-			return nil
+			return s.recordQuestionVersion(ctx, tx, createdQ.Id, req.CreatorId)
 		})
 
 		if err == nil {
 			successCount++
 		} else {
 			log.Printf("❌ Lỗi import dòng %d (%s): %v", i+1, content, err)
-			errorCount++
+			importErrors = append(importErrors, bankError(i+1, content, err))
 		}
 	}
 
-	return &pb.ImportQuestionsResponse{SuccessCount: int32(successCount), ErrorCount: int32(errorCount)}, nil
+	return &pb.ImportQuestionsResponse{
+		SuccessCount: int32(successCount),
+		ErrorCount:   int32(len(importErrors)),
+		Errors:       importErrors,
+		Format:       BankFormatExcel,
+	}, nil
 }
 
 func (s *examService) GenerateExam(ctx context.Context, req *pb.GenerateExamRequest) (*pb.CreateExamResponse, error) {
+	if err := validateScoringSettings(req.Settings); err != nil {
+		return nil, err
+	}
 	var examID int64
 
 	err := database.DB.Transaction(func(tx *gorm.DB) error {
@@ -441,22 +442,26 @@ func (s *examService) GenerateExam(ctx context.Context, req *pb.GenerateExamRequ
 
 		if req.Settings != nil && req.Settings.IsDynamic {
 			isDynamic = true
-			if len(req.SectionConfigs) > 0 {
-				b, _ := json.Marshal(req.SectionConfigs)
-				dynamicConfig = string(b)
-			}
+			rules := blueprintFromProto(req.SectionConfigs)
+			dynamicConfig = domain.FormatBlueprint(rules)
 
+			var fixed []DynamicQuestion
 			for i, q := range req.FixedQuestions {
 				examQuestions = append(examQuestions, &domain.ExamQuestionModel{
 					QuestionID: q.QuestionId,
 					Points:     float64(q.Points),
 					Sequence:   i + 1,
 				})
+				fixed = append(fixed, DynamicQuestion{ID: q.QuestionId, Points: float64(q.Points)})
+			}
+			if err := s.checkBlueprint(ctx, req.TopicId, rules, fixed, false); err != nil {
+				return err
 			}
 		} else {
 
 			allQuestionIDs := []int64{}
 			uniqueMap := make(map[int64]bool)
+			pointsMap := make(map[int64]float64)
 
 			for _, cfg := range req.SectionConfigs {
 				ids, err := s.repo.GetRandomQuestionsBySection(ctx, cfg.SectionId, cfg.Difficulty, int(cfg.Count), req.TopicId)
@@ -467,6 +472,9 @@ func (s *examService) GenerateExam(ctx context.Context, req *pb.GenerateExamRequ
 					if !uniqueMap[id] {
 						uniqueMap[id] = true
 						allQuestionIDs = append(allQuestionIDs, id)
+						if cfg.Points > 0 {
+							pointsMap[id] = float64(cfg.Points)
+						}
 					}
 				}
 			}
@@ -476,10 +484,14 @@ func (s *examService) GenerateExam(ctx context.Context, req *pb.GenerateExamRequ
 			}
 
 			for i, qID := range allQuestionIDs {
+				points := 1.0
+				if p, ok := pointsMap[qID]; ok {
+					points = p
+				}
 				examQuestions = append(examQuestions, &domain.ExamQuestionModel{
 					QuestionID: qID,
 					Sequence:   i + 1,
-					Points:     1.0,
+					Points:     points,
 				})
 			}
 
@@ -501,10 +513,14 @@ func (s *examService) GenerateExam(ctx context.Context, req *pb.GenerateExamRequ
 					return *req.Settings.Password
 				}
 				return ""
-			}(), ShuffleQuestions: req.Settings.ShuffleQuestions,
+			}(), ShuffleQuestions: req.Settings.ShuffleQuestions, ShuffleChoices: req.Settings.ShuffleChoices,
 			ShowResultImmediately: req.Settings.ShowResultImmediately, RequiresApproval: req.Settings.RequiresApproval,
 			TopicID: req.TopicId, CreatorID: req.CreatorId,
 			IsDynamic: isDynamic, DynamicConfig: dynamicConfig,
+			ScoringPolicy: req.Settings.ScoringPolicy, NegativeMarking: float64(req.Settings.NegativeMarking),
+			ScoreScale: req.Settings.ScoreScale,
+			IsAdaptive: req.Settings.IsAdaptive, AdaptiveConfig: req.Settings.AdaptiveConfig,
+			IsPractice: req.Settings.IsPractice,
 		}
 
 		if req.Settings.StartTime != "" {
@@ -579,24 +595,48 @@ func (s *examService) CheckExamAccess(ctx context.Context, req *pb.CheckExamAcce
 		}
 	}
 
+	terms := s.accommodationFor(ctx, exam, req.UserId)
+	endTime := terms.EndTime(exam)
+	maxAttempts := terms.MaxAttempts(exam)
+	if exam.IsPractice {
+		maxAttempts = 0
+	}
+	count, _ := s.repo.CountSubmissionsForExam(ctx, req.ExamId, req.UserId)
+
+	resp := &pb.CheckExamAccessResponse{
+		MaxAttempts:     int32(maxAttempts),
+		AttemptsUsed:    int32(count),
+		DurationMinutes: int32(math.Ceil(terms.Duration(exam).Minutes())),
+		Accommodation:   termsToProto(terms),
+	}
+	if endTime != nil {
+		resp.EndTime = endTime.Format(time.RFC3339)
+	}
+
 	now := time.Now()
 	if exam.StartTime != nil && now.Before(*exam.StartTime) {
-		return &pb.CheckExamAccessResponse{CanAccess: false, Message: "not_started"}, nil
+		resp.Message = "not_started"
+		return resp, nil
 	}
-	if exam.EndTime != nil && now.After(*exam.EndTime) {
-		return &pb.CheckExamAccessResponse{CanAccess: false, Message: "ended"}, nil
+	if endTime != nil && now.After(*endTime) {
+		resp.Message = "ended"
+		return resp, nil
 	}
-
-	count, _ := s.repo.CountSubmissionsForExam(ctx, req.ExamId, req.UserId)
-	if exam.MaxAttempts > 0 && int(count) >= exam.MaxAttempts {
-		return &pb.CheckExamAccessResponse{CanAccess: false, Message: "max_attempts"}, nil
+	if maxAttempts > 0 && int(count) >= maxAttempts {
+		resp.Message = "max_attempts"
+		return resp, nil
 	}
 
-	return &pb.CheckExamAccessResponse{CanAccess: true, Message: "ok"}, nil
+	resp.CanAccess = true
+	resp.Message = "ok"
+	return resp, nil
 }
 
 func (s *examService) CreateExam(ctx context.Context, req *pb.CreateExamRequest) (*pb.CreateExamResponse, error) {
 	log.Printf("RECEIVING CreateExam request: Title=%s, IsDynamic=%v", req.Title, req.Settings.IsDynamic)
+	if err := validateScoringSettings(req.Settings); err != nil {
+		return nil, err
+	}
 	var createdExam *domain.ExamModel
 	err := database.DB.Transaction(func(tx *gorm.DB) error {
 		exam := &domain.ExamModel{
@@ -607,9 +647,13 @@ func (s *examService) CreateExam(ctx context.Context, req *pb.CreateExamRequest)
 					return *req.Settings.Password
 				}
 				return ""
-			}(), ShuffleQuestions: req.Settings.ShuffleQuestions,
+			}(), ShuffleQuestions: req.Settings.ShuffleQuestions, ShuffleChoices: req.Settings.ShuffleChoices,
 			ShowResultImmediately: req.Settings.ShowResultImmediately, RequiresApproval: req.Settings.RequiresApproval,
 			IsDynamic: req.Settings.IsDynamic,
+			ScoringPolicy: req.Settings.ScoringPolicy, NegativeMarking: float64(req.Settings.NegativeMarking),
+			ScoreScale: req.Settings.ScoreScale,
+			IsAdaptive: req.Settings.IsAdaptive, AdaptiveConfig: req.Settings.AdaptiveConfig,
+			IsPractice: req.Settings.IsPractice,
 			TopicID: req.TopicId, CreatorID: req.CreatorId, Status: req.Status,
 		}
 		if req.Settings.DynamicConfig == "" {
@@ -619,37 +663,14 @@ func (s *examService) CreateExam(ctx context.Context, req *pb.CreateExamRequest)
 		}
 
 		if req.Settings.IsDynamic {
-			var configs []struct {
-				SectionId  int64   `json:"section_id"`
-				Count      int     `json:"count"`
-				Difficulty string  `json:"difficulty"`
-				Points     float64 `json:"points"`
-			}
-			if req.Settings.DynamicConfig != "" && req.Settings.DynamicConfig != "{}" {
-				if err := json.Unmarshal([]byte(req.Settings.DynamicConfig), &configs); err != nil {
-					return fmt.Errorf("cấu hình sinh đề không hợp lệ: %v", err)
-				}
-				for i, cfg := range configs {
-					ids, err := s.repo.GetQuestionIDsForSection(ctx, cfg.SectionId, cfg.Difficulty, req.TopicId)
-					if err != nil {
-						return fmt.Errorf("lỗi khi kiểm tra ngân hàng câu hỏi: %v", err)
-					}
-					if len(ids) < cfg.Count {
-						sectionName := "Tất cả chương"
-						if cfg.SectionId > 0 {
-							sec, errSec := s.repo.GetSectionByID(ctx, cfg.SectionId)
-							if errSec == nil && sec != nil {
-								sectionName = fmt.Sprintf("chương '%s'", sec.Name)
-							}
-						}
-						diffLabel := cfg.Difficulty
-						if diffLabel == "all" || diffLabel == "" {
-							diffLabel = "tất cả độ khó"
-						}
-						return fmt.Errorf("quy tắc %d: Không đủ câu hỏi trong ngân hàng. Yêu cầu %d câu (%s, %s), nhưng chỉ có %d câu.", i+1, cfg.Count, sectionName, diffLabel, len(ids))
-					}
-				}
+			rules, err := domain.ParseBlueprint(req.Settings.DynamicConfig)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			if err := s.checkBlueprint(ctx, req.TopicId, rules, nil, req.Status == examStatusPublished); err != nil {
+				return err
 			}
+			exam.DynamicConfig = domain.FormatBlueprint(rules)
 		}
 		if req.Settings.StartTime != "" {
 			t, _ := time.Parse(time.RFC3339, req.Settings.StartTime)
@@ -674,9 +695,11 @@ func (s *examService) CreateExam(ctx context.Context, req *pb.CreateExamRequest)
 					Sequence:   i,
 				})
 			}
-			return s.repo.LinkQuestionsToExam(ctx, tx, createdExam.Id, examQuestions)
+			if err := s.repo.LinkQuestionsToExam(ctx, tx, createdExam.Id, examQuestions); err != nil {
+				return err
+			}
 		}
-		return nil
+		return s.pinIfPublished(ctx, tx, createdExam.Id)
 	})
 	if err != nil {
 		return nil, err
@@ -716,10 +739,17 @@ func (s *examService) GetExamDetails(ctx context.Context, req *pb.GetExamDetails
 			StartTime:             startTime,
 			EndTime:               endTime,
 			ShuffleQuestions:      examModel.ShuffleQuestions,
+			ShuffleChoices:        examModel.ShuffleChoices,
 			ShowResultImmediately: examModel.ShowResultImmediately,
 			RequiresApproval:      examModel.RequiresApproval,
 			IsDynamic:             examModel.IsDynamic,
 			DynamicConfig:         examModel.DynamicConfig,
+			ScoringPolicy:         examModel.ScoringPolicy,
+			NegativeMarking:       float32(examModel.NegativeMarking),
+			ScoreScale:            examModel.ScoreScale,
+			IsAdaptive:            examModel.IsAdaptive,
+			IsPractice:            examModel.IsPractice,
+			AdaptiveConfig:        examModel.AdaptiveConfig,
 		},
 		Questions: pbQuestions,
 		TopicId:   examModel.TopicID,
@@ -745,19 +775,13 @@ func (s *examService) GetExamPreview(ctx context.Context, req *pb.GetExamPreview
 			}
 		}
 
-		var configs []struct {
-			SectionId  int64  `json:"section_id"`
-			Count      int    `json:"count"`
-			Difficulty string `json:"difficulty"`
-		}
-		if examModel.DynamicConfig != "" {
-			if err := json.Unmarshal([]byte(examModel.DynamicConfig), &configs); err != nil {
-				log.Printf("Lỗi parse DynamicConfig: %v", err)
-			}
+		rules, err := domain.ParseBlueprint(examModel.DynamicConfig)
+		if err != nil {
+			log.Printf("Lỗi parse DynamicConfig: %v", err)
 		}
 
-		for _, cfg := range configs {
-			questionIDs, err := s.repo.GetRandomQuestionsBySection(ctx, cfg.SectionId, cfg.Difficulty, cfg.Count, examModel.TopicID)
+		for _, cfg := range rules {
+			questionIDs, err := s.repo.GetRandomQuestionsBySection(ctx, cfg.SectionID, cfg.Difficulty, cfg.Count, examModel.TopicID)
 			if err != nil {
 				continue
 			}
@@ -801,6 +825,7 @@ func (s *examService) convertToPBQuestion(q *domain.QuestionModel) *pb.QuestionD
 			Id:            c.Id,
 			Content:       c.Content,
 			AttachmentUrl: c.AttachmentURL,
+			PinLast:       c.PinLast,
 		})
 	}
 	qType := "single_choice"
@@ -818,7 +843,7 @@ func (s *examService) convertToPBQuestion(q *domain.QuestionModel) *pb.QuestionD
 		sectionID = q.Section.Id
 	}
 
-	return &pb.QuestionDetails{
+	pbQ := &pb.QuestionDetails{
 		Id:            q.Id,
 		Content:       q.Content,
 		Choices:       pbChoices,
@@ -829,7 +854,15 @@ func (s *examService) convertToPBQuestion(q *domain.QuestionModel) *pb.QuestionD
 		SectionName:   sectionName,
 		SectionId:     sectionID,
 		Points:        float32(q.Points),
+		Version:       int32(q.Version),
+	}
+	applyStudentQuestionView(q, pbQ)
+	if qType == domain.QuestionTypeOrdering {
+		rand.Shuffle(len(pbQ.Choices), func(i, j int) {
+			pbQ.Choices[i], pbQ.Choices[j] = pbQ.Choices[j], pbQ.Choices[i]
+		})
 	}
+	return pbQ
 }
 
 func (s *examService) SubmitExam(ctx context.Context, req *pb.SubmitExamRequest) (*pb.SubmitExamResponse, error) {
@@ -845,234 +878,193 @@ func (s *examService) SubmitExam(ctx context.Context, req *pb.SubmitExamRequest)
 		return nil, errors.New("không tìm thấy bài làm đang diễn ra (hoặc đã nộp rồi)")
 	}
 
-	var correctCount int32 = 0
-	var finalScore float64 = 0
-
 	examModel, _ := s.repo.GetExamDetails(ctx, req.ExamId)
-	var questions []*domain.QuestionModel
-	qPointsMap := make(map[int64]float64)
-
-	if examModel != nil && examModel.IsDynamic {
-		sExam, err := s.repo.GetStudentExam(ctx, req.ExamId, req.UserId)
-		if err != nil {
-			return nil, errors.New("lỗi lấy đề thi cá nhân hóa")
-		}
-
-		dynamicQs := parseStudentExamQuestions(sExam.QuestionIDs)
-		for _, dq := range dynamicQs {
-			q, err := s.repo.GetQuestionByID(ctx, dq.ID)
-			if err == nil {
-				qPointsMap[dq.ID] = dq.Points
-				questions = append(questions, q)
-			}
-		}
-	} else if examModel != nil {
-		questions = examModel.Questions
-		for _, eq := range examModel.Questions {
-			qPointsMap[eq.Id] = eq.Points
-		}
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, examModel, &submission)
+	if err != nil {
+		return nil, err
 	}
 
 	totalQuestions := int32(len(questions))
 
-	userAnswerMap := make(map[int64][]int64)
-	userTextAnswerMap := make(map[int64]string)
+	answers := make(map[int64]AnswerResponse)
 	for _, ans := range req.Answers {
+		a := answers[ans.QuestionId]
 		if ans.ChosenChoiceId != 0 {
-			userAnswerMap[ans.QuestionId] = append(userAnswerMap[ans.QuestionId], ans.ChosenChoiceId)
+			a.ChoiceIDs = append(a.ChoiceIDs, ans.ChosenChoiceId)
 		}
 		if ans.TextAnswer != "" {
-			userTextAnswerMap[ans.QuestionId] = ans.TextAnswer
+			a.Text = ans.TextAnswer
 		}
+		mergeStructuredAnswer(&a, ans.OrderedChoiceIds, ans.Matches, ans.Blanks)
+		answers[ans.QuestionId] = a
 	}
 
+	var result SubmissionResult
 	err = database.DB.Transaction(func(tx *gorm.DB) error {
-
-		var userAnswerModels []*domain.UserAnswerModel
-		var totalMaxPoints float64 = 0
-		var totalEarnedPoints float64 = 0
-
-		for _, q := range questions {
-			userChoices := userAnswerMap[q.Id]
-			textAnswer := userTextAnswerMap[q.Id]
-
-			qPoints := 1.0
-			if pts, ok := qPointsMap[q.Id]; ok {
-				qPoints = pts
-			}
-
-			totalMaxPoints += qPoints
-
-			isCorrect := false
-
-			qType := ""
-			if q.Type.Type != "" {
-				qType = q.Type.Type
-			}
-
-			if qType == "short_answer" {
-
-				for _, c := range q.Choices {
-					if c.IsCorrect && strings.EqualFold(strings.TrimSpace(c.Content), strings.TrimSpace(textAnswer)) {
-						isCorrect = true
-						break
-					}
-				}
-
-				if !isCorrect {
-					for _, cID := range userChoices {
-						for _, c := range q.Choices {
-							if c.Id == cID && c.IsCorrect {
-								isCorrect = true
-								break
-							}
-						}
-						if isCorrect { break }
-					}
-				}
-
-				if textAnswer != "" {
-					correctVal := isCorrect
-					val := textAnswer
-					userAnswerModels = append(userAnswerModels, &domain.UserAnswerModel{
-						SubmissionID:   submission.Id,
-						QuestionID:     q.Id,
-						TextAnswer:     &val,
-						IsCorrect:      &correctVal,
-					})
-				}
-
-				for _, cID := range userChoices {
-					choiceIDVal := cID
-					correctVal := isCorrect
-					userAnswerModels = append(userAnswerModels, &domain.UserAnswerModel{
-						SubmissionID:   submission.Id,
-						QuestionID:     q.Id,
-						ChosenChoiceID: &choiceIDVal,
-						IsCorrect:      &correctVal,
-					})
-				}
-			} else if qType == "essay" {
-				if textAnswer != "" {
-					val := textAnswer
-					userAnswerModels = append(userAnswerModels, &domain.UserAnswerModel{
-						SubmissionID:   submission.Id,
-						QuestionID:     q.Id,
-						TextAnswer:     &val,
-					})
-				}
-			} else {
-				var correctChoices []int64
-				for _, c := range q.Choices {
-					if c.IsCorrect {
-						correctChoices = append(correctChoices, c.Id)
-					}
-				}
-				isCorrect = compareInt64Slices(userChoices, correctChoices)
-
-				for _, cID := range userChoices {
-					choiceIDVal := cID
-					correctVal := isCorrect
-
-					userAnswerModels = append(userAnswerModels, &domain.UserAnswerModel{
-						SubmissionID:   submission.Id,
-						QuestionID:     q.Id,
-						ChosenChoiceID: &choiceIDVal,
-						IsCorrect:      &correctVal,
-					})
-				}
-			}
-
-			if isCorrect {
-				correctCount++
-				totalEarnedPoints += qPoints
-			}
+		locked, err := s.repo.LockInProgressSubmission(ctx, tx, submission.Id)
+		if err != nil {
+			return errors.New("không tìm thấy bài làm đang diễn ra (hoặc đã nộp rồi)")
+		}
+		if locked.PausedAt != nil {
+			return status.Error(codes.FailedPrecondition, "Bài thi đang được giám thị tạm dừng")
 		}
+		if examModel != nil && examModel.IsAdaptive {
+			answers = groupUserAnswers(locked.UserAnswers)
+		} else if locked.Deadline != nil && time.Now().UTC().After(locked.Deadline.Add(autoSubmitGracePeriod)) {
+			// Nộp muộn quá thời gian ân hạn: chỉ chấm các câu đã lưu trước hạn
+			log.Printf("⏰ Bài %d nộp sau hạn %s, chỉ chấm đáp án đã lưu", locked.Id, locked.Deadline.Format(time.RFC3339))
+			answers = groupUserAnswers(locked.UserAnswers)
+		}
+		result, err = s.finalizeSubmission(ctx, tx, examModel, locked, questions, qPointsMap, answers)
+		return err
+	})
 
-		tx.Where("submission_id = ?", submission.Id).Delete(&domain.UserAnswerModel{})
+	if err != nil {
+		return nil, err
+	}
 
-		if len(userAnswerModels) > 0 {
-			if err := s.repo.CreateUserAnswers(ctx, tx, userAnswerModels); err != nil {
-				return err
-			}
-		}
+	s.publishExamSubmitted(examModel, &submission, result.Score, req.FullName, req.Email)
+	go s.checkSubmissionSimilarity(context.Background(), submission.ExamID, submission.Id)
 
-		if totalMaxPoints > 0 {
-			finalScore = (totalEarnedPoints / totalMaxPoints) * 10.0
-		} else if totalQuestions > 0 {
-			finalScore = (float64(correctCount) / float64(totalQuestions)) * 10.0
-		}
+	return &pb.SubmitExamResponse{
+		SubmissionId:   submission.Id,
+		Score:          float32(result.Score),
+		CorrectCount:   result.CorrectCount,
+		TotalQuestions: totalQuestions,
+	}, nil
+}
 
-		var completedStatus domain.SubmissionStatusModel
-		if err := tx.WithContext(ctx).Where("status = ?", "completed").First(&completedStatus).Error; err != nil {
-			return errors.New("không tìm thấy status 'completed' trong DB")
-		}
+// finalizeSubmission chấm điểm, ghi lại câu trả lời và chuyển bài nộp sang completed.
+func (s *examService) finalizeSubmission(ctx context.Context, tx *gorm.DB, exam *domain.ExamModel, submission *domain.ExamSubmissionModel, questions []*domain.QuestionModel, qPointsMap map[int64]float64, answers map[int64]AnswerResponse) (SubmissionResult, error) {
+	engine, err := s.scoringEngineFor(ctx, exam)
+	if err != nil {
+		return SubmissionResult{}, err
+	}
+	result := engine.ScoreSubmission(questions, qPointsMap, answers)
+	// Giữ lại thời điểm trả lời từ SaveAnswer vì các dòng đáp án được xoá và tạo lại khi nộp bài.
+	var saved []domain.UserAnswerModel
+	if err := tx.WithContext(ctx).Where("submission_id = ?", submission.Id).Find(&saved).Error; err != nil {
+		return result, err
+	}
+	userAnswerModels := buildUserAnswerModels(submission.Id, questions, answers, result, latestAnswerTimes(saved))
 
-		submission.StatusID = completedStatus.Id
-		submission.Score = finalScore
-		now := time.Now().UTC()
-		submission.SubmittedAt = &now
+	tx.Where("submission_id = ?", submission.Id).Delete(&domain.UserAnswerModel{})
 
-		if _, err := s.repo.UpdateSubmission(ctx, tx, &submission); err != nil {
-			return err
+	if len(userAnswerModels) > 0 {
+		if err := s.repo.CreateUserAnswers(ctx, tx, userAnswerModels); err != nil {
+			return result, err
 		}
+	}
 
-		return nil
-	})
+	var completedStatus domain.SubmissionStatusModel
+	if err := tx.WithContext(ctx).Where("status = ?", "completed").First(&completedStatus).Error; err != nil {
+		return result, errors.New("không tìm thấy status 'completed' trong DB")
+	}
 
-	if err != nil {
-		return nil, err
+	submission.StatusID = completedStatus.Id
+	submission.Score = result.Score
+	now := time.Now().UTC()
+	submission.SubmittedAt = &now
+	submission.UserAnswers = nil
+	submission.QuestionVersions = domain.FormatQuestionVersions(questionVersionMap(questions))
+
+	if _, err := s.repo.UpdateSubmission(ctx, tx, submission); err != nil {
+		return result, err
 	}
 
+	return result, nil
+}
+
+func (s *examService) publishExamSubmitted(exam *domain.ExamModel, submission *domain.ExamSubmissionModel, score float64, fullName, email string) {
 	var examTitle string
-	if examModel != nil {
-		examTitle = examModel.Title
+	if exam != nil {
+		examTitle = exam.Title
 	}
 
 	eventPayload := contracts.ExamSubmittedEvent{
-		UserID:       req.UserId,
-		ExamID:       req.ExamId,
+		UserID:       submission.UserID,
+		ExamID:       submission.ExamID,
 		SubmissionID: submission.Id,
 		ExamTitle:    examTitle,
-		Score:        finalScore,
-		FullName:     req.FullName,
-		Email:        req.Email,
+		Score:        score,
+		FullName:     fullName,
+		Email:        email,
 	}
 	eventBytes, _ := json.Marshal(eventPayload)
 	key := []byte(strconv.FormatInt(submission.Id, 10))
 	s.producer.Produce("exam_events", key, eventBytes)
-
-	respScore := float32(finalScore)
-	respCorrectCount := correctCount
-	respTotalQuestions := totalQuestions
-
-	return &pb.SubmitExamResponse{
-		SubmissionId:   submission.Id,
-		Score:          respScore,
-		CorrectCount:   respCorrectCount,
-		TotalQuestions: respTotalQuestions,
-	}, nil
 }
 
-func compareInt64Slices(a, b []int64) bool {
-	if len(a) != len(b) {
-		return false
-	}
-
-	countMap := make(map[int64]int)
-
-	for _, x := range a {
-		countMap[x]++
+// latestAnswerTimes trả về thời điểm trả lời gần nhất của từng câu; dòng cũ chưa có AnsweredAt dùng CreatedAt.
+func latestAnswerTimes(rows []domain.UserAnswerModel) map[int64]*time.Time {
+	answeredAt := make(map[int64]*time.Time)
+	for i := range rows {
+		ua := &rows[i]
+		at := ua.AnsweredAt
+		if at == nil {
+			if ua.CreatedAt.IsZero() {
+				continue
+			}
+			at = &ua.CreatedAt
+		}
+		if prev := answeredAt[ua.QuestionID]; prev == nil || at.After(*prev) {
+			answeredAt[ua.QuestionID] = at
+		}
 	}
+	return answeredAt
+}
 
-	for _, x := range b {
-		countMap[x]--
-		if countMap[x] < 0 {
-			return false
+func buildUserAnswerModels(submissionID int64, questions []*domain.QuestionModel, answers map[int64]AnswerResponse, result SubmissionResult, answeredAt map[int64]*time.Time) []*domain.UserAnswerModel {
+	var models []*domain.UserAnswerModel
+	for _, q := range questions {
+		ans, ok := answers[q.Id]
+		if !ok {
+			continue
+		}
+		r := result.Results[q.Id]
+
+		var isCorrect *bool
+		var awarded *float64
+		if !r.Pending {
+			correctVal := r.IsCorrect
+			earnedVal := r.Earned
+			isCorrect = &correctVal
+			awarded = &earnedVal
+		}
+
+		structured := ans.Structured()
+		if ans.Text != "" || !structured.IsEmpty() {
+			row := &domain.UserAnswerModel{
+				SubmissionID:  submissionID,
+				QuestionID:    q.Id,
+				IsCorrect:     isCorrect,
+				AwardedPoints: awarded,
+				AnsweredAt:    answeredAt[q.Id],
+			}
+			if ans.Text != "" {
+				val := ans.Text
+				row.TextAnswer = &val
+			}
+			if !structured.IsEmpty() {
+				data := structured.JSON()
+				row.AnswerData = &data
+			}
+			models = append(models, row)
+		}
+
+		for _, cID := range ans.ChoiceIDs {
+			choiceIDVal := cID
+			models = append(models, &domain.UserAnswerModel{
+				SubmissionID:   submissionID,
+				QuestionID:     q.Id,
+				ChosenChoiceID: &choiceIDVal,
+				IsCorrect:      isCorrect,
+				AwardedPoints:  awarded,
+				AnsweredAt:     answeredAt[q.Id],
+			})
 		}
 	}
-
-	return true
+	return models
 }
 
 func (s *examService) GetSubmission(ctx context.Context, req *pb.GetSubmissionRequest) (*pb.GetSubmissionResponse, error) {
@@ -1125,40 +1117,45 @@ func (s *examService) GetSubmission(ctx context.Context, req *pb.GetSubmissionRe
 		}
 	}
 
-	var questions []*domain.QuestionModel
-	qPointsMap := make(map[int64]float64)
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, examFull, submission)
+	if err != nil {
+		questions, qPointsMap = nil, make(map[int64]float64)
+	}
+	structuredAnswers := groupUserAnswers(submission.UserAnswers)
 
-	if examFull.IsDynamic {
-		sExam, err := s.repo.GetStudentExam(ctx, submission.ExamID, submission.UserID)
-		if err == nil {
-			dynamicQs := parseStudentExamQuestions(sExam.QuestionIDs)
-			for _, dq := range dynamicQs {
-				q, err := s.repo.GetQuestionByID(ctx, dq.ID)
-				if err == nil {
-					questions = append(questions, q)
-					qPointsMap[dq.ID] = dq.Points
-				}
-			}
-		}
-	} else {
-		questions = examFull.Questions
-		for _, q := range questions {
-			qPointsMap[q.Id] = q.Points
+	rubricScores := make(map[int64][]*domain.RubricScoreModel)
+	if scores, err := s.repo.GetRubricScores(ctx, submission.Id); err == nil {
+		for _, sc := range scores {
+			rubricScores[sc.QuestionID] = append(rubricScores[sc.QuestionID], sc)
 		}
 	}
 
+	var similarity map[int64][]*pb.SimilarityMatch
+	if isInstructor {
+		similarity = s.similarityMatchesBySubmission(ctx, submission.ExamID, submission.Id)
+	}
+
+	// Lựa chọn hiển thị theo thứ tự học sinh đã thấy; OriginalLabel là nhãn theo thứ tự soạn đề.
+	choiceOrders := s.submissionChoiceOrders(ctx, submission.Id)
+
 	var pbDetails []*pb.SubmissionDetail
 
 	for _, q := range questions {
 		var pbChoices []*pb.ChoiceReview
 
-		for _, c := range q.Choices {
+		originalLabels := choiceLabels(orderedChoices(q.Choices))
+		for i, c := range displayedChoices(q.Choices, choiceOrders[q.Id]) {
 			pbChoices = append(pbChoices, &pb.ChoiceReview{
 				Id:            c.Id,
 				Content:       c.Content,
 				IsCorrect:     c.IsCorrect,
 				UserSelected:  userSelections[q.Id][c.Id],
 				AttachmentUrl: c.AttachmentURL,
+				MatchTarget:   c.MatchTarget,
+				Position:      int32(c.Position),
+				Label:         choiceLabel(i),
+				OriginalLabel: originalLabels[c.Id],
+				PinLast:       c.PinLast,
 			})
 		}
 
@@ -1194,7 +1191,7 @@ func (s *examService) GetSubmission(ctx context.Context, req *pb.GetSubmissionRe
 			}
 		}
 
-		pbDetails = append(pbDetails, &pb.SubmissionDetail{
+		detail := &pb.SubmissionDetail{
 			QuestionId:      q.Id,
 			QuestionContent: q.Content,
 			Explanation:     q.Explanation,
@@ -1206,7 +1203,21 @@ func (s *examService) GetSubmission(ctx context.Context, req *pb.GetSubmissionRe
 			AwardedPoints:   awardedPoints,
 			Points:          float32(qPoints),
 			IsGraded:        isGraded,
-		})
+		}
+		detail.OrderedChoiceIds = structuredAnswers[q.Id].Order
+		detail.Matches = matchesToProto(structuredAnswers[q.Id].Matches)
+		detail.Blanks = structuredAnswers[q.Id].Blanks
+		answerConfig := domain.ParseAnswerConfig(q.AnswerConfig)
+		detail.Numeric = numericToProto(answerConfig.Numeric)
+		detail.CorrectBlanks = blanksToProto(answerConfig.Blanks)
+		detail.Rubric = rubricResultToProto(rubricScores[q.Id])
+		if ua, ok := uaMap[q.Id]; ok && ua.Feedback != nil {
+			detail.Feedback = *ua.Feedback
+		}
+		if qType == domain.QuestionTypeEssay {
+			detail.SimilarityMatches = similarity[q.Id]
+		}
+		pbDetails = append(pbDetails, detail)
 	}
 
 	correctCount := 0
@@ -1265,6 +1276,7 @@ func (s *examService) GetExams(ctx context.Context, req *pb.GetExamsRequest) (*p
 			TopicId:         e.TopicID,
 			CreatorId:       e.CreatorID,
 			Status:          e.Status,
+			IsPractice:      e.IsPractice,
 		})
 	}
 
@@ -1283,7 +1295,19 @@ func (s *examService) PublishExam(ctx context.Context, req *pb.PublishExamReques
 		return nil, status.Error(codes.InvalidArgument, "exam_id is required")
 	}
 
-	if err := s.repo.UpdateExamStatus(ctx, database.DB, req.ExamId, req.Status); err != nil {
+	if req.Status == examStatusPublished {
+		if err := s.checkPublishableBlueprint(ctx, req.ExamId); err != nil {
+			return nil, err
+		}
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.UpdateExamStatus(ctx, tx, req.ExamId, req.Status); err != nil {
+			return err
+		}
+		return s.pinIfPublished(ctx, tx, req.ExamId)
+	})
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to update exam status")
 	}
 
@@ -1295,9 +1319,39 @@ func (s *examService) UpdateQuestion(ctx context.Context, req *pb.UpdateQuestion
 		diff, _ := s.repo.GetDifficulty(ctx, req.Difficulty)
 		qType, _ := s.repo.GetQuestionType(ctx, req.QuestionType)
 
+		answerConfig := answerConfigFromProto(req.Numeric, req.Blanks)
+		choices := choiceModelsFromInput(req.QuestionId, qType.Type, req.Choices)
+		if err := validateQuestionAnswers(qType.Type, choices, answerConfig); err != nil {
+			return err
+		}
+
+		current, err := loadQuestionTx(ctx, tx, req.QuestionId, true)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "Không tìm thấy câu hỏi: %v", err)
+		}
+		// Câu hỏi tạo trước khi có lịch sử phiên bản cần được chụp lại trước khi sửa.
+		if err := s.snapshotQuestion(ctx, tx, current, current.CreatorID); err != nil {
+			return err
+		}
+
+		edited := *current
+		edited.Content, edited.Explanation, edited.AttachmentURL = req.Content, req.Explanation, req.AttachmentUrl
+		edited.TypeID, edited.Type = qType.Id, *qType
+		edited.DifficultyID, edited.Difficulty = diff.Id, *diff
+		edited.AnswerConfig = answerConfig.JSON()
+		edited.Choices = make([]domain.ChoiceModel, 0, len(choices))
+		for _, c := range choices {
+			edited.Choices = append(edited.Choices, *c)
+		}
+		if domain.NewQuestionSnapshot(current).SameContent(domain.NewQuestionSnapshot(&edited)) {
+			return nil
+		}
+
 		updates := map[string]interface{}{
 			"content": req.Content, "explanation": req.Explanation,
 			"difficulty_id": diff.Id, "type_id": qType.Id, "attachment_url": req.AttachmentUrl,
+			"answer_config": answerConfig.JSON(),
+			"version":       current.Version + 1,
 		}
 
 		if err := s.repo.UpdateQuestion(ctx, tx, req.QuestionId, updates); err != nil {
@@ -1307,16 +1361,12 @@ func (s *examService) UpdateQuestion(ctx context.Context, req *pb.UpdateQuestion
 			return err
 		}
 
-		if len(req.Choices) > 0 {
-			var choices []*domain.ChoiceModel
-			for _, c := range req.Choices {
-				choices = append(choices, &domain.ChoiceModel{QuestionID: req.QuestionId, Content: c.Content, IsCorrect: c.IsCorrect, AttachmentURL: c.AttachmentUrl})
-			}
+		if len(choices) > 0 {
 			if err := s.repo.CreateChoices(ctx, tx, choices); err != nil {
 				return err
 			}
 		}
-		return nil
+		return s.recordQuestionVersion(ctx, tx, req.QuestionId, req.EditorId)
 	})
 	if err != nil {
 		return nil, err
@@ -1345,6 +1395,9 @@ func (s *examService) DeleteBulkQuestions(ctx context.Context, req *pb.DeleteBul
 }
 
 func (s *examService) UpdateExam(ctx context.Context, req *pb.UpdateExamRequest) (*pb.UpdateExamResponse, error) {
+	if err := validateScoringSettings(req.Settings); err != nil {
+		return nil, err
+	}
 	err := database.DB.Transaction(func(tx *gorm.DB) error {
 		updates := make(map[string]interface{})
 
@@ -1374,6 +1427,7 @@ func (s *examService) UpdateExam(ctx context.Context, req *pb.UpdateExamRequest)
 				updates["password"] = *req.Settings.Password
 			}
 			updates["shuffle_questions"] = req.Settings.ShuffleQuestions
+			updates["shuffle_choices"] = req.Settings.ShuffleChoices
 			updates["show_result_immediately"] = req.Settings.ShowResultImmediately
 			updates["requires_approval"] = req.Settings.RequiresApproval
 			updates["is_dynamic"] = req.Settings.IsDynamic
@@ -1382,6 +1436,20 @@ func (s *examService) UpdateExam(ctx context.Context, req *pb.UpdateExamRequest)
 			} else {
 				updates["dynamic_config"] = "{}"
 			}
+			if req.Settings.ScoringPolicy != "" {
+				updates["scoring_policy"] = req.Settings.ScoringPolicy
+			}
+			updates["negative_marking"] = req.Settings.NegativeMarking
+			if req.Settings.ScoreScale != "" {
+				updates["score_scale"] = req.Settings.ScoreScale
+			}
+			updates["is_adaptive"] = req.Settings.IsAdaptive
+			updates["is_practice"] = req.Settings.IsPractice
+			if req.Settings.AdaptiveConfig != "" {
+				updates["adaptive_config"] = req.Settings.AdaptiveConfig
+			} else {
+				updates["adaptive_config"] = "{}"
+			}
 
 			if req.Settings.StartTime != "" {
 				t, err := time.Parse(time.RFC3339, req.Settings.StartTime)
@@ -1409,37 +1477,18 @@ func (s *examService) UpdateExam(ctx context.Context, req *pb.UpdateExamRequest)
 				topicID = existing.TopicID
 			}
 
-			var configs []struct {
-				SectionId  int64   `json:"section_id"`
-				Count      int     `json:"count"`
-				Difficulty string  `json:"difficulty"`
-				Points     float64 `json:"points"`
+			rules, err := domain.ParseBlueprint(req.Settings.DynamicConfig)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
 			}
-			if req.Settings.DynamicConfig != "" && req.Settings.DynamicConfig != "{}" {
-				if err := json.Unmarshal([]byte(req.Settings.DynamicConfig), &configs); err != nil {
-					return fmt.Errorf("cấu hình sinh đề không hợp lệ: %v", err)
-				}
-				for i, cfg := range configs {
-					ids, err := s.repo.GetQuestionIDsForSection(ctx, cfg.SectionId, cfg.Difficulty, topicID)
-					if err != nil {
-						return fmt.Errorf("lỗi khi kiểm tra ngân hàng câu hỏi: %v", err)
-					}
-					if len(ids) < cfg.Count {
-						sectionName := "Tất cả chương"
-						if cfg.SectionId > 0 {
-							sec, errSec := s.repo.GetSectionByID(ctx, cfg.SectionId)
-							if errSec == nil && sec != nil {
-								sectionName = fmt.Sprintf("chương '%s'", sec.Name)
-							}
-						}
-						diffLabel := cfg.Difficulty
-						if diffLabel == "all" || diffLabel == "" {
-							diffLabel = "tất cả độ khó"
-						}
-						return fmt.Errorf("quy tắc %d: Không đủ câu hỏi trong ngân hàng. Yêu cầu %d câu (%s, %s), nhưng chỉ có %d câu.", i+1, cfg.Count, sectionName, diffLabel, len(ids))
-					}
-				}
+			var fixed []DynamicQuestion
+			for _, q := range req.Questions {
+				fixed = append(fixed, DynamicQuestion{ID: q.QuestionId, Points: float64(q.Points)})
+			}
+			if err := s.checkBlueprint(ctx, topicID, rules, fixed, req.Status == examStatusPublished); err != nil {
+				return err
 			}
+			updates["dynamic_config"] = domain.FormatBlueprint(rules)
 		}
 
 		if len(updates) > 0 {
@@ -1460,7 +1509,7 @@ func (s *examService) UpdateExam(ctx context.Context, req *pb.UpdateExamRequest)
 			return err
 		}
 
-		return nil
+		return s.pinIfPublished(ctx, tx, req.ExamId)
 	})
 	if err != nil {
 		return nil, err
@@ -1494,18 +1543,35 @@ func (s *examService) SaveAnswer(ctx context.Context, req *pb.SaveAnswerRequest)
 		return nil, err
 	}
 
+	exam, _ := s.repo.GetExamDetails(ctx, req.ExamId)
+	if exam != nil && exam.IsAdaptive {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi thích ứng chỉ nhận câu trả lời qua câu hỏi tiếp theo")
+	}
+
 	var sub domain.ExamSubmissionModel
 	err := database.DB.Where("exam_id = ? AND user_id = ? AND status_id = (SELECT id FROM submission_status_models WHERE status = 'in_progress')", req.ExamId, req.UserId).First(&sub).Error
 	if err != nil {
 		return nil, errors.New("không tìm thấy bài làm đang diễn ra")
 	}
+	if sub.PausedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi đang được giám thị tạm dừng")
+	}
 
+	answeredAt := time.Now().UTC()
 	ans := &domain.UserAnswerModel{
 		SubmissionID: sub.Id,
 		QuestionID:   req.QuestionId,
+		AnsweredAt:   &answeredAt,
 	}
 
-	if req.TextAnswer != "" {
+	var structured AnswerResponse
+	mergeStructuredAnswer(&structured, req.OrderedChoiceIds, req.Matches, req.Blanks)
+
+	if data := structured.Structured(); !data.IsEmpty() {
+		dataJSON := data.JSON()
+		ans.AnswerData = &dataJSON
+		ans.IsCorrect = nil
+	} else if req.TextAnswer != "" {
 		ans.TextAnswer = &req.TextAnswer
 
 		ans.IsCorrect = nil
@@ -1524,11 +1590,47 @@ func (s *examService) SaveAnswer(ctx context.Context, req *pb.SaveAnswerRequest)
 		ans.IsCorrect = &isCorrect
 	}
 
+	// Chế độ luyện tập: chấm ngay câu vừa trả lời bằng bộ chấm đầy đủ
+	var practiceQuestion *domain.QuestionModel
+	var practicePoints float64
+	var practiceResult ScoreResult
+	if exam != nil && exam.IsPractice {
+		answer := structured
+		answer.Text = req.TextAnswer
+		if req.ChosenChoiceId != 0 {
+			answer.ChoiceIDs = []int64{req.ChosenChoiceId}
+		}
+		practiceQuestion, practicePoints, practiceResult, err = s.scorePracticeAnswer(ctx, exam, &sub, req.QuestionId, answer)
+		if err != nil {
+			return nil, err
+		}
+		if !practiceResult.Pending {
+			isCorrect := practiceResult.IsCorrect
+			ans.IsCorrect = &isCorrect
+		}
+	}
+
+	duplicate := false
 	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if req.Seq > 0 {
+			advanced, err := s.repo.AdvanceAnswerSeq(ctx, tx, sub.Id, req.Seq)
+			if err != nil {
+				return err
+			}
+			// Đáp án đã được lưu trước khi mất kết nối, chỉ cần xác nhận lại
+			if !advanced {
+				duplicate = true
+				return nil
+			}
+		}
 		return s.repo.SaveUserAnswer(ctx, tx, ans)
 	})
 
-	return &pb.SaveAnswerResponse{Success: err == nil}, err
+	resp := &pb.SaveAnswerResponse{Success: err == nil, Seq: req.Seq, Duplicate: duplicate}
+	if err == nil && !duplicate && practiceQuestion != nil {
+		resp.Feedback = s.recordPracticeAttempt(ctx, &sub, practiceQuestion, practicePoints, practiceResult)
+	}
+	return resp, err
 }
 
 func (s *examService) LogViolation(ctx context.Context, req *pb.LogViolationRequest) (*pb.LogViolationResponse, error) {
@@ -1538,6 +1640,11 @@ func (s *examService) LogViolation(ctx context.Context, req *pb.LogViolationRequ
 		ViolationType: req.ViolationType,
 		ViolationTime: time.Now().UTC(),
 	}
+	var sub *domain.ExamSubmissionModel
+	if subs, _ := s.repo.GetInProgressSubmissionsByUser(ctx, req.UserId, req.ExamId); len(subs) > 0 {
+		sub = subs[0]
+		v.SubmissionID = sub.Id
+	}
 	err := s.repo.LogViolation(ctx, v)
 
 	if err == nil {
@@ -1561,6 +1668,9 @@ func (s *examService) LogViolation(ctx context.Context, req *pb.LogViolationRequ
 				log.Printf("📢 Redis Publish: Sending violation to [%s]", monitorChannel)
 				database.RedisClient.Publish(ctx, monitorChannel, string(jsonMsg))
 			}
+			if sub != nil {
+				s.applySuspicionRules(ctx, exam, sub)
+			}
 		}
 	}
 
@@ -1588,12 +1698,18 @@ func (s *examService) GetExamStatsDetailed(ctx context.Context, req *pb.GetExamS
 		}, nil
 	}
 
+	scaleMax := ScaleMax(ScoreScale10)
+	if exam, err := s.repo.GetExamDetails(ctx, req.ExamId); err == nil {
+		scaleMax = ScaleMax(exam.ScoreScale)
+	}
+	step := int(scaleMax / 10)
+
 	var sum, highest, lowest float64
-	lowest = 10.0
+	lowest = scaleMax
 	dist := make(map[string]int32)
 
 	for i := 0; i < 10; i++ {
-		key := fmt.Sprintf("%d-%d", i, i+1)
+		key := fmt.Sprintf("%d-%d", i*step, (i+1)*step)
 		dist[key] = 0
 	}
 
@@ -1607,7 +1723,7 @@ func (s *examService) GetExamStatsDetailed(ctx context.Context, req *pb.GetExamS
 			lowest = score
 		}
 
-		bucketIndex := int(score)
+		bucketIndex := int(score / float64(step))
 		if bucketIndex >= 10 {
 			bucketIndex = 9
 		}
@@ -1615,7 +1731,7 @@ func (s *examService) GetExamStatsDetailed(ctx context.Context, req *pb.GetExamS
 			bucketIndex = 0
 		}
 
-		key := fmt.Sprintf("%d-%d", bucketIndex, bucketIndex+1)
+		key := fmt.Sprintf("%d-%d", bucketIndex*step, (bucketIndex+1)*step)
 		dist[key]++
 	}
 
@@ -1650,12 +1766,16 @@ func (s *examService) ExportExamResults(ctx context.Context, req *pb.ExportExamR
 	f.SetCellValue(sheetName, "B1", "Score")
 	f.SetCellValue(sheetName, "C1", "Submitted At")
 	f.SetCellValue(sheetName, "D1", "Violations Count")
+	f.SetCellValue(sheetName, "E1", "Risk Score")
+	f.SetCellValue(sheetName, "F1", "Flagged")
+	f.SetCellValue(sheetName, "G1", "Risk Reasons")
 
 	violations, _ := s.repo.GetViolationsByExam(ctx, req.ExamId)
 	violationMap := make(map[int64]int)
 	for _, v := range violations {
 		violationMap[v.UserID]++
 	}
+	risks := s.submissionRisks(ctx, req.ExamId, submissions)
 
 	for i, sub := range submissions {
 		row := i + 2
@@ -1663,6 +1783,54 @@ func (s *examService) ExportExamResults(ctx context.Context, req *pb.ExportExamR
 		f.SetCellValue(sheetName, fmt.Sprintf("B%d", row), sub.Score)
 		f.SetCellValue(sheetName, fmt.Sprintf("C%d", row), sub.SubmittedAt.Format(time.RFC3339))
 		f.SetCellValue(sheetName, fmt.Sprintf("D%d", row), violationMap[sub.UserID])
+		if risk := risks[sub.Id]; risk != nil {
+			f.SetCellValue(sheetName, fmt.Sprintf("E%d", row), risk.Score)
+			f.SetCellValue(sheetName, fmt.Sprintf("F%d", row), risk.Flagged)
+			f.SetCellValue(sheetName, fmt.Sprintf("G%d", row), strings.Join(riskReasons(risk), "; "))
+		}
+	}
+
+	if err := s.writeChoiceAnswersSheet(ctx, f, req.ExamId); err != nil {
+		log.Printf("Lỗi xuất đáp án trắc nghiệm cho exam %d: %v", req.ExamId, err)
+	}
+
+	analysis, err := s.GetItemAnalysis(ctx, &pb.GetItemAnalysisRequest{ExamId: req.ExamId, InstructorId: req.RequesterId})
+	if err == nil {
+		itemSheet := "Item Analysis"
+		f.NewSheet(itemSheet)
+		headers := []string{"Question ID", "Content", "Type", "Responses", "P-Value", "Point-Biserial", "Discrimination (D)", "Choice", "Correct", "Picked", "Upper 27%", "Lower 27%"}
+		for i, h := range headers {
+			cell, _ := excelize.CoordinatesToCellName(i+1, 1)
+			f.SetCellValue(itemSheet, cell, h)
+		}
+
+		row := 2
+		for _, item := range analysis.Items {
+			f.SetCellValue(itemSheet, fmt.Sprintf("A%d", row), item.QuestionId)
+			f.SetCellValue(itemSheet, fmt.Sprintf("B%d", row), item.Content)
+			f.SetCellValue(itemSheet, fmt.Sprintf("C%d", row), item.QuestionType)
+			f.SetCellValue(itemSheet, fmt.Sprintf("D%d", row), item.Responses)
+			f.SetCellValue(itemSheet, fmt.Sprintf("E%d", row), item.PValue)
+			f.SetCellValue(itemSheet, fmt.Sprintf("F%d", row), item.PointBiserial)
+			f.SetCellValue(itemSheet, fmt.Sprintf("G%d", row), item.DiscriminationIndex)
+			row++
+			for _, d := range item.Distractors {
+				f.SetCellValue(itemSheet, fmt.Sprintf("H%d", row), d.Content)
+				f.SetCellValue(itemSheet, fmt.Sprintf("I%d", row), d.IsCorrect)
+				f.SetCellValue(itemSheet, fmt.Sprintf("J%d", row), d.TotalCount)
+				f.SetCellValue(itemSheet, fmt.Sprintf("K%d", row), d.UpperCount)
+				f.SetCellValue(itemSheet, fmt.Sprintf("L%d", row), d.LowerCount)
+				row++
+			}
+		}
+
+		row++
+		f.SetCellValue(itemSheet, fmt.Sprintf("A%d", row), "KR-20")
+		f.SetCellValue(itemSheet, fmt.Sprintf("B%d", row), analysis.Kr20)
+		f.SetCellValue(itemSheet, fmt.Sprintf("A%d", row+1), "Students")
+		f.SetCellValue(itemSheet, fmt.Sprintf("B%d", row+1), analysis.StudentCount)
+	} else {
+		log.Printf("Lỗi tính item analysis cho exam %d: %v", req.ExamId, err)
 	}
 
 	buf, err := f.WriteToBuffer()
@@ -1786,13 +1954,21 @@ func (s *examService) GetQuestion(ctx context.Context, req *pb.GetQuestionReques
 		return nil, err
 	}
 
+	return &pb.GetQuestionResponse{Question: questionDetailsWithAnswers(q)}, nil
+}
+
+// questionDetailsWithAnswers dựng câu hỏi cho giáo viên, kèm đáp án đúng.
+func questionDetailsWithAnswers(q *domain.QuestionModel) *pb.QuestionDetails {
 	var pbChoices []*pb.ChoiceDetails
-	for _, c := range q.Choices {
+	for _, c := range orderedChoices(q.Choices) {
 		pbChoices = append(pbChoices, &pb.ChoiceDetails{
 			Id:            c.Id,
 			Content:       c.Content,
 			IsCorrect:     c.IsCorrect,
 			AttachmentUrl: c.AttachmentURL,
+			MatchTarget:   c.MatchTarget,
+			Position:      int32(c.Position),
+			PinLast:       c.PinLast,
 		})
 	}
 
@@ -1806,21 +1982,25 @@ func (s *examService) GetQuestion(ctx context.Context, req *pb.GetQuestionReques
 		difficulty = q.Difficulty.Difficulty
 	}
 
-	return &pb.GetQuestionResponse{
-		Question: &pb.QuestionDetails{
-			Id:            q.Id,
-			Content:       q.Content,
-			QuestionType:  qType,
-			Difficulty:    difficulty,
-			Explanation:   q.Explanation,
-			AttachmentUrl: q.AttachmentURL,
-			SectionName:   q.Section.Name,
-			TopicName:     q.Section.Topic.Name,
-			SectionId:     q.SectionID,
-			TopicId:       q.Section.TopicID,
-			Choices:       pbChoices,
-		},
-	}, nil
+	pbQ := &pb.QuestionDetails{
+		Id:            q.Id,
+		Content:       q.Content,
+		QuestionType:  qType,
+		Difficulty:    difficulty,
+		Explanation:   q.Explanation,
+		AttachmentUrl: q.AttachmentURL,
+		SectionName:   q.Section.Name,
+		TopicName:     q.Section.Topic.Name,
+		SectionId:     q.SectionID,
+		TopicId:       q.Section.TopicID,
+		Choices:       pbChoices,
+		Version:       int32(q.Version),
+	}
+	if q.RubricID != nil {
+		pbQ.RubricId = *q.RubricID
+	}
+	applyAnswerKeyView(q, pbQ)
+	return pbQ
 }
 
 func (s *examService) GetExamViolations(ctx context.Context, req *pb.GetExamViolationsRequest) (*pb.GetExamViolationsResponse, error) {
@@ -1868,6 +2048,60 @@ func (s *examService) ExportQuestions(ctx context.Context, req *pb.ExportQuestio
 		return nil, err
 	}
 
+	var content []byte
+	var exportErrors []*pb.QuestionBankError
+	ext, contentType := "xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	format := normalizeBankFormat(req.Format)
+	if format == "" && req.Format != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Định dạng xuất %q không được hỗ trợ", req.Format)
+	}
+	switch format {
+	case BankFormatMoodleXML:
+		content, exportErrors = exportMoodleXML(questions)
+		ext, contentType = "xml", "application/xml"
+	case BankFormatGIFT:
+		content, exportErrors = exportGIFT(questions)
+		ext, contentType = "gift.txt", "text/plain; charset=utf-8"
+	case BankFormatAiken:
+		content, exportErrors = exportAiken(questions)
+		ext, contentType = "aiken.txt", "text/plain; charset=utf-8"
+	default:
+		var err error
+		if content, err = buildExcelQuestionExport(questions); err != nil {
+			return nil, err
+		}
+	}
+
+	fileKey := fmt.Sprintf("exports/backup_%d_%d.%s", req.CreatorId, time.Now().Unix(), ext)
+	bucketName := env.GetString("R2_BUCKET_NAME", "")
+
+	client, err := s.createR2ClientForUpload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(fileKey),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publicDomain := env.GetString("R2_PUBLIC_DOMAIN", "")
+	finalURL := fmt.Sprintf("https://%s/%s", publicDomain, fileKey)
+
+	return &pb.ExportQuestionsResponse{
+		FileUrl:       finalURL,
+		ExportedCount: int32(len(questions) - len(exportErrors)),
+		Errors:        exportErrors,
+	}, nil
+}
+
+func buildExcelQuestionExport(questions []*domain.QuestionModel) ([]byte, error) {
 	f := excelize.NewFile()
 	sheetName := "Sheet1"
 	f.SetSheetName("Sheet1", sheetName)
@@ -1900,14 +2134,33 @@ func (s *examService) ExportQuestions(ctx context.Context, req *pb.ExportQuestio
 		correctAnswers := []string{}
 
 		optionStartCol := 9
+		answerConfig := domain.ParseAnswerConfig(q.AnswerConfig)
+
+		switch q.Type.Type {
+		case domain.QuestionTypeNumeric:
+			correctAnswers = append(correctAnswers, formatNumericSpec(answerConfig.Numeric))
+		case domain.QuestionTypeCloze:
+			for j, b := range answerConfig.Blanks {
+				cell, _ := excelize.CoordinatesToCellName(optionStartCol+j, row)
+				f.SetCellValue(sheetName, cell, strings.Join(b.Answers, " | "))
+			}
+		default:
+			choices := q.Choices
+			if q.Type.Type == domain.QuestionTypeOrdering {
+				choices = orderedChoices(q.Choices)
+			}
+			for j, c := range choices {
+				cell, _ := excelize.CoordinatesToCellName(optionStartCol+j, row)
+				if q.Type.Type == domain.QuestionTypeMatching {
+					f.SetCellValue(sheetName, cell, c.Content+" => "+c.MatchTarget)
+				} else {
+					f.SetCellValue(sheetName, cell, c.Content)
+				}
 
-		for j, c := range q.Choices {
-			cell, _ := excelize.CoordinatesToCellName(optionStartCol+j, row)
-			f.SetCellValue(sheetName, cell, c.Content)
-
-			if c.IsCorrect {
-				char := string(rune('A' + j))
-				correctAnswers = append(correctAnswers, char)
+				if c.IsCorrect {
+					char := string(rune('A' + j))
+					correctAnswers = append(correctAnswers, char)
+				}
 			}
 		}
 
@@ -1919,29 +2172,8 @@ func (s *examService) ExportQuestions(ctx context.Context, req *pb.ExportQuestio
 	if err != nil {
 		return nil, err
 	}
+	return buf.Bytes(), nil
 
-	fileKey := fmt.Sprintf("exports/backup_%d_%d.xlsx", req.CreatorId, time.Now().Unix())
-	bucketName := env.GetString("R2_BUCKET_NAME", "")
-
-	client, err := s.createR2ClientForUpload(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket:      aws.String(bucketName),
-		Key:         aws.String(fileKey),
-		Body:        bytes.NewReader(buf.Bytes()),
-		ContentType: aws.String("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"),
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	publicDomain := env.GetString("R2_PUBLIC_DOMAIN", "")
-	finalURL := fmt.Sprintf("https://%s/%s", publicDomain, fileKey)
-
-	return &pb.ExportQuestionsResponse{FileUrl: finalURL}, nil
 }
 
 func generateSessionHash(ipAddress, userAgent string) string {
@@ -1962,11 +2194,43 @@ func (s *examService) validateSessionLock(ctx context.Context, examID, userID in
 
 	currentHash := generateSessionHash(ipAddress, userAgent)
 	if currentHash != expectedHash {
+		s.logSessionMismatch(ctx, examID, userID)
 		return fmt.Errorf("tài khoản đang mở bài thi trên một thiết bị hoặc trình duyệt khác (IP/Browser không khớp). Phát hiện gian lận thi hộ!")
 	}
 	return nil
 }
 
+func examRemainingSeconds(exam *domain.ExamModel, sub *domain.ExamSubmissionModel) int32 {
+	now := time.Now().UTC()
+	if sub.Deadline != nil {
+		// Lượt thi đang tạm dừng giữ nguyên thời gian còn lại tại thời điểm dừng
+		if sub.PausedAt != nil {
+			now = *sub.PausedAt
+		}
+		remaining := int32(sub.Deadline.Sub(now).Seconds())
+		if remaining < 0 {
+			remaining = 0
+		}
+		return remaining
+	}
+
+	durationSeconds := float64(exam.DurationMinutes * 60)
+	elapsed := now.Sub(sub.StartedAt).Seconds()
+	remaining := int32(durationSeconds - elapsed)
+
+	if exam.EndTime != nil {
+		timeUntilClose := exam.EndTime.Sub(now).Seconds()
+		if timeUntilClose < float64(remaining) {
+			remaining = int32(timeUntilClose)
+		}
+	}
+
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining
+}
+
 func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (*pb.StartExamResponse, error) {
 	examDetails, err := s.repo.GetExamDetails(ctx, req.ExamId)
 	if err != nil {
@@ -1974,11 +2238,12 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 	}
 
 	check, _ := s.CheckExamAccess(ctx, &pb.CheckExamAccessRequest{ExamId: req.ExamId, UserId: req.UserId})
+	terms := s.accommodationFor(ctx, examDetails, req.UserId)
 
 	if database.RedisClient != nil {
 		sessionKey := fmt.Sprintf("exam:%d:user:%d:session_lock", req.ExamId, req.UserId)
 		sessionHash := generateSessionHash(req.IpAddress, req.UserAgent)
-		ttl := time.Duration(examDetails.DurationMinutes) * time.Minute
+		ttl := terms.Duration(examDetails)
 		if ttl <= 0 {
 			ttl = 120 * time.Minute
 		}
@@ -1994,11 +2259,18 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 		First(&submission).Error
 
 	var submissionID int64
-	var startTime time.Time
 
 	if err == nil {
 		submissionID = submission.Id
-		startTime = submission.StartedAt
+
+		// Hỗ trợ có thể được cấp sau khi học sinh đã bắt đầu làm bài
+		deadline := s.submissionDeadline(ctx, examDetails, &submission)
+		if !sameDeadline(submission.Deadline, deadline) {
+			if err := s.repo.UpdateSubmissionDeadline(ctx, submissionID, deadline); err != nil {
+				log.Printf("⚠️ Không cập nhật được hạn nộp của bài %d: %v", submissionID, err)
+			}
+			submission.Deadline = deadline
+		}
 	} else {
 		if !check.CanAccess {
 			return nil, fmt.Errorf("bạn không thể bắt đầu bài thi: %s", check.Message)
@@ -2009,36 +2281,51 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 			return nil, errors.New("lỗi hệ thống: chưa cấu hình status in_progress")
 		}
 
+		var retryIDs []int64
+		if examDetails.IsPractice && req.RetryWrong {
+			retryIDs, err = s.practiceWrongQuestions(ctx, req.ExamId, req.UserId)
+			if err != nil {
+				return nil, fmt.Errorf("lỗi lấy lịch sử luyện tập: %v", err)
+			}
+			if len(retryIDs) == 0 {
+				return nil, status.Error(codes.FailedPrecondition, "Bạn không còn câu sai nào cần luyện lại")
+			}
+		}
+
+		now := time.Now().UTC()
 		newSub := &domain.ExamSubmissionModel{
-			ExamID:    req.ExamId,
-			UserID:    req.UserId,
-			StatusID:  inProgressStatus.Id,
-			StartedAt: time.Now().UTC(),
-			Score:     0,
+			ExamID:              req.ExamId,
+			UserID:              req.UserId,
+			StatusID:            inProgressStatus.Id,
+			StartedAt:           now,
+			Deadline:            terms.Deadline(examDetails, now),
+			Score:               0,
+			QuestionVersions:    "{}",
+			PracticeQuestionIDs: domain.FormatQuestionIDs(retryIDs),
 		}
 		created, err := s.repo.CreateSubmission(ctx, database.DB, newSub)
 		if err != nil {
 			return nil, err
 		}
 
+		submission = *created
 		submissionID = created.Id
-		startTime = created.StartedAt
 	}
 
-	durationSeconds := float64(examDetails.DurationMinutes * 60)
-	now := time.Now().UTC()
-	elapsed := now.Sub(startTime).Seconds()
-	remaining := int32(durationSeconds - elapsed)
+	remaining := examRemainingSeconds(examDetails, &submission)
 
-	if examDetails.EndTime != nil {
-		timeUntilClose := examDetails.EndTime.Sub(now).Seconds()
-		if timeUntilClose < float64(remaining) {
-			remaining = int32(timeUntilClose)
+	if examDetails.IsAdaptive {
+		if _, err := s.repo.GetAdaptiveSession(ctx, nil, submissionID); err != nil {
+			if err := s.startAdaptiveSession(ctx, examDetails, submissionID, req.UserId); err != nil {
+				return nil, err
+			}
 		}
-	}
-
-	if remaining < 0 {
-		remaining = 0
+		return &pb.StartExamResponse{
+			SubmissionId:     submissionID,
+			RemainingSeconds: remaining,
+			IsAdaptive:       true,
+			Paused:           submission.PausedAt != nil,
+		}, nil
 	}
 
 	var pbQuestions []*pb.QuestionDetails
@@ -2079,10 +2366,23 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 		}
 	}
 
+	if retryIDs := domain.ParseQuestionIDs(submission.PracticeQuestionIDs); len(retryIDs) > 0 {
+		qIDsToFetch = filterQuestionIDs(qIDsToFetch, retryIDs)
+		orderMap = make(map[int64]int, len(qIDsToFetch))
+		for i, id := range qIDsToFetch {
+			orderMap[id] = i
+		}
+	}
+
 	if len(qIDsToFetch) == 0 {
 		return nil, errors.New("đề thi chưa có câu hỏi nào (vui lòng liên hệ giáo viên)")
 	}
 
+	versions, err := s.ensureSubmissionVersions(ctx, examDetails, &submission, qIDsToFetch)
+	if err != nil {
+		return nil, fmt.Errorf("lỗi ghi nhận phiên bản câu hỏi: %v", err)
+	}
+
 	if len(qIDsToFetch) > 0 {
 		pbQuestions = make([]*pb.QuestionDetails, len(qIDsToFetch))
 		var missingIDs []int64
@@ -2090,7 +2390,7 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 		if database.RedisClient != nil {
 			keys := make([]string, len(qIDsToFetch))
 			for i, id := range qIDsToFetch {
-				keys[i] = fmt.Sprintf("question:%d", id)
+				keys[i] = questionVersionCacheKey(id, versions[id])
 			}
 
 			cachedVals, err := database.RedisClient.MGet(ctx, keys...).Result()
@@ -2129,6 +2429,10 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 		if len(missingIDs) > 0 {
 			var missingQuestions []*domain.QuestionModel
 			database.DB.WithContext(ctx).Where("id IN ?", missingIDs).Preload("Choices").Preload("Type").Preload("Difficulty").Preload("Section").Preload("Section.Topic").Find(&missingQuestions)
+			missingQuestions, err = s.applyQuestionVersions(ctx, missingQuestions, versions)
+			if err != nil {
+				return nil, fmt.Errorf("lỗi tải phiên bản câu hỏi: %v", err)
+			}
 
 			var pipe redis.Pipeliner
 			if database.RedisClient != nil {
@@ -2142,6 +2446,7 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 						Id:            c.Id,
 						Content:       c.Content,
 						AttachmentUrl: c.AttachmentURL,
+						PinLast:       c.PinLast,
 					})
 				}
 				qType, diff := "single_choice", "medium"
@@ -2191,13 +2496,14 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 					TopicId:       topicID,
 					Points:        float32(qPoints),
 				}
+				applyStudentQuestionView(q, pbQ)
 
 				idx := orderMap[q.Id]
 				pbQuestions[idx] = pbQ
 
 				if pipe != nil {
 					b, _ := json.Marshal(pbQ)
-					pipe.Set(ctx, fmt.Sprintf("question:%d", q.Id), b, 24*time.Hour)
+					pipe.Set(ctx, questionVersionCacheKey(q.Id, q.Version), b, 24*time.Hour)
 				}
 			}
 
@@ -2209,16 +2515,13 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 		var finalPbQs []*pb.QuestionDetails
 		for _, q := range pbQuestions {
 			if q != nil {
-
-				if examDetails.ShuffleQuestions && len(q.Choices) > 0 {
-					rand.Shuffle(len(q.Choices), func(i, j int) {
-						q.Choices[i], q.Choices[j] = q.Choices[j], q.Choices[i]
-					})
-				}
-
 				finalPbQs = append(finalPbQs, q)
 			}
 		}
+		choiceOrders := s.ensureChoiceOrders(ctx, examDetails, &submission, finalPbQs)
+		for _, q := range finalPbQs {
+			applyChoiceOrder(q, choiceOrders[q.Id])
+		}
 		pbQuestions = finalPbQs
 	}
 
@@ -2238,6 +2541,13 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 			userAnswerMap[ua.QuestionID].TextAnswer = *ua.TextAnswer
 		}
 	}
+	for qID, a := range groupUserAnswers(submission.UserAnswers) {
+		if ad, ok := userAnswerMap[qID]; ok {
+			ad.OrderedChoiceIds = a.Order
+			ad.Matches = matchesToProto(a.Matches)
+			ad.Blanks = a.Blanks
+		}
+	}
 	for _, ad := range userAnswerMap {
 		pbCurrentAnswers = append(pbCurrentAnswers, ad)
 	}
@@ -2247,6 +2557,8 @@ func (s *examService) StartExam(ctx context.Context, req *pb.StartExamRequest) (
 		RemainingSeconds: remaining,
 		Questions:        pbQuestions,
 		CurrentAnswers:   pbCurrentAnswers,
+		Paused:           submission.PausedAt != nil,
+		IsPractice:       examDetails.IsPractice,
 	}, nil
 }
 
@@ -2400,6 +2712,7 @@ func (s *examService) GetExamSubmissions(ctx context.Context, req *pb.GetExamSub
 	if err != nil {
 		return nil, err
 	}
+	risks := s.submissionRisks(ctx, req.ExamId, subs)
 
 	var pbSubs []*pb.SubmissionSummary
 	for _, sub := range subs {
@@ -2422,6 +2735,9 @@ func (s *examService) GetExamSubmissions(ctx context.Context, req *pb.GetExamSub
 			Status:         status,
 			CorrectCount:   0,
 			TotalQuestions: 0,
+			RiskScore:      float32(riskScore(risks[sub.Id])),
+			Flagged:        risks[sub.Id] != nil && risks[sub.Id].Flagged,
+			RiskReasons:    riskReasons(risks[sub.Id]),
 		})
 	}
 
@@ -2496,6 +2812,48 @@ func parseStudentExamQuestions(jsonStr string) []DynamicQuestion {
 	return result
 }
 
+func (s *examService) getExamQuestionsForUser(ctx context.Context, exam *domain.ExamModel, userID int64) ([]*domain.QuestionModel, map[int64]float64, error) {
+	qPointsMap := make(map[int64]float64)
+	if exam == nil {
+		return nil, qPointsMap, nil
+	}
+	if !exam.IsDynamic && !exam.IsAdaptive {
+		for _, q := range exam.Questions {
+			qPointsMap[q.Id] = q.Points
+		}
+		return exam.Questions, qPointsMap, nil
+	}
+
+	sExam, err := s.repo.GetStudentExam(ctx, exam.Id, userID)
+	if err != nil {
+		return nil, nil, errors.New("lỗi lấy đề thi cá nhân hóa")
+	}
+
+	dynamicQs := parseStudentExamQuestions(sExam.QuestionIDs)
+	ids := make([]int64, 0, len(dynamicQs))
+	for _, dq := range dynamicQs {
+		ids = append(ids, dq.ID)
+		qPointsMap[dq.ID] = dq.Points
+	}
+
+	found, err := s.repo.GetQuestionsByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[int64]*domain.QuestionModel, len(found))
+	for _, q := range found {
+		byID[q.Id] = q
+	}
+
+	var questions []*domain.QuestionModel
+	for _, id := range ids {
+		if q, ok := byID[id]; ok {
+			questions = append(questions, q)
+		}
+	}
+	return questions, qPointsMap, nil
+}
+
 func mapDomainExamToProto(e *domain.ExamModel) *pb.Exam {
 	if e == nil {
 		return nil
@@ -2530,6 +2888,8 @@ func mapDomainExamToProto(e *domain.ExamModel) *pb.Exam {
 		StartTime:       startTime,
 		EndTime:         endTime,
 		MaxAttempts:     int32(e.MaxAttempts),
+		ScoreScale:      e.ScoreScale,
+		IsPractice:      e.IsPractice,
 	}
 }
 
@@ -2541,34 +2901,18 @@ func (s *examService) GeneratePersonalizedExamForStudents(ctx context.Context, e
 	if !examDetails.IsDynamic {
 		return errors.New("đề thi không phải là dạng sinh động")
 	}
-	dynamicConfig := examDetails.DynamicConfig
-	if dynamicConfig == "" {
-		dynamicConfig = "{}"
-	}
-
-	var configs []struct {
-		SectionId  int64   `json:"section_id"`
-		Count      int     `json:"count"`
-		Difficulty string  `json:"difficulty"`
-		Points     float64 `json:"points"`
-	}
-	err = json.Unmarshal([]byte(dynamicConfig), &configs)
+	rules, err := domain.ParseBlueprint(examDetails.DynamicConfig)
 	if err != nil {
-		return errors.New("cấu hình sinh đề không hợp lệ")
+		return status.Error(codes.FailedPrecondition, err.Error())
 	}
 
-	configPools := make(map[int][]int64)
-	for i, cfg := range configs {
-		ids, err := s.repo.GetQuestionIDsForSection(ctx, cfg.SectionId, cfg.Difficulty, examDetails.TopicID)
-		if err != nil {
-			log.Printf("Lỗi lấy danh sách câu hỏi cho section %d: %v", cfg.SectionId, err)
-			ids = []int64{}
-		}
-		log.Printf("Rule %d: Section=%d, Diff=%s, Topic=%d => Found %d questions", i, cfg.SectionId, cfg.Difficulty, examDetails.TopicID, len(ids))
-		configPools[i] = ids
+	configPools, err := s.blueprintPools(ctx, examDetails.TopicID, rules)
+	if err != nil {
+		return err
 	}
 
 	var batchInserts []*domain.StudentExamModel
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	for _, studentID := range studentIDs {
 		allQuestions := []DynamicQuestion{}
@@ -2581,36 +2925,14 @@ func (s *examService) GeneratePersonalizedExamForStudents(ctx context.Context, e
 			}
 		}
 
-		for i, cfg := range configs {
-			pool := configPools[i]
-			if len(pool) == 0 {
-				continue
-			}
-
-			shuffledPool := make([]int64, len(pool))
-			copy(shuffledPool, pool)
-
-			for idx := len(shuffledPool) - 1; idx > 0; idx-- {
-				j := rand.Intn(idx + 1)
-				shuffledPool[idx], shuffledPool[j] = shuffledPool[j], shuffledPool[idx]
-			}
-
-			collectedCount := 0
-			pts := cfg.Points
-			if pts <= 0 {
-				pts = 1.0
-			}
-			for _, id := range shuffledPool {
-				if !uniqueMap[id] {
-					uniqueMap[id] = true
-					allQuestions = append(allQuestions, DynamicQuestion{ID: id, Points: pts})
-					collectedCount++
-					if collectedCount >= cfg.Count {
-						break
-					}
-				}
+		picked, short := drawBlueprint(rng, uniqueMap, rules, configPools)
+		for i, missing := range short {
+			if missing > 0 {
+				return status.Errorf(codes.FailedPrecondition, "quy tắc %d: chỉ rút được %d/%d câu cho học sinh %d, hãy bổ sung ngân hàng câu hỏi hoặc giảm số câu",
+					i+1, rules[i].Count-missing, rules[i].Count, studentID)
 			}
 		}
+		allQuestions = append(allQuestions, picked...)
 
 		if len(allQuestions) > 0 {
 			qBytes, _ := json.Marshal(allQuestions)
@@ -2674,75 +2996,101 @@ func (s *examService) GetMySubmissions(ctx context.Context, req *pb.GetMySubmiss
 }
 
 func (s *examService) GradeEssay(ctx context.Context, req *pb.GradeEssayRequest) (*pb.GradeEssayResponse, error) {
-
-	updates := map[string]interface{}{
-		"is_correct": req.IsCorrect,
-		"awarded_points": req.ScoreRatio,
+	submission, err := s.repo.GetSubmissionByID(ctx, req.SubmissionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài nộp: %v", err)
 	}
-	err := s.repo.UpdateUserAnswer(ctx, nil, req.SubmissionId, req.QuestionId, updates)
+
+	exam, err := s.repo.GetExamDetails(ctx, submission.ExamID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Lỗi cập nhật câu trả lời: %v", err)
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài thi: %v", err)
+	}
+	if s.usesDoubleMarking(ctx, exam.Id) {
+		return nil, status.Error(codes.FailedPrecondition, "Bài thi dùng chấm hai vòng, điểm chỉ được cập nhật qua phân công chấm")
 	}
 
-	submission, err := s.repo.GetSubmissionByID(ctx, req.SubmissionId)
+	questions, qPointsMap, err := s.getSubmissionQuestions(ctx, exam, submission)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Lỗi lấy thông tin bài nộp: %v", err)
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách câu hỏi: %v", err)
 	}
 
-	qPointsMap := make(map[int64]float64)
-	if submission.Exam.Id != 0 {
-		if submission.Exam.IsDynamic {
-			sExam, err := s.repo.GetStudentExam(ctx, submission.ExamID, submission.UserID)
-			if err == nil {
-				dynamicQs := parseStudentExamQuestions(sExam.QuestionIDs)
-				for _, dq := range dynamicQs {
-					qPointsMap[dq.ID] = dq.Points
-				}
-			}
-		} else {
-			for _, eq := range submission.Exam.Questions {
-				qPointsMap[eq.Id] = eq.Points
-			}
-		}
+	qPts := 1.0
+	if pts, ok := qPointsMap[req.QuestionId]; ok && pts > 0 {
+		qPts = pts
 	}
 
-	var totalMaxPoints float64 = 0
-	var totalEarnedPoints float64 = 0
+	ratio := float64(req.ScoreRatio)
+	if req.IsCorrect && ratio == 0 {
+		ratio = 1
+	}
+	if ratio < 0 {
+		ratio = 0
+	} else if ratio > 1 {
+		ratio = 1
+	}
+	isCorrect := req.IsCorrect
 
-	for _, ans := range submission.UserAnswers {
-		qPts := 1.0
-		if pts, ok := qPointsMap[ans.QuestionID]; ok {
-			qPts = pts
-		} else if ans.Question.Points > 0 {
-			qPts = ans.Question.Points
+	// Khi chấm theo thang chấm, điểm được suy ra từ các mức đã chọn thay vì ScoreRatio.
+	var rubricScores []*domain.RubricScoreModel
+	if len(req.RubricScores) > 0 {
+		var question *domain.QuestionModel
+		for _, q := range questions {
+			if q.Id == req.QuestionId {
+				question = q
+			}
 		}
-
-		totalMaxPoints += qPts
-
-		if ans.AwardedPoints != nil {
-			totalEarnedPoints += (*ans.AwardedPoints) * qPts
-		} else if ans.IsCorrect != nil && *ans.IsCorrect {
-			totalEarnedPoints += qPts
+		if question == nil || question.RubricID == nil {
+			return nil, status.Error(codes.FailedPrecondition, "Câu hỏi chưa được gắn thang chấm")
 		}
-	}
-
-	newScore := 0.0
-	if totalMaxPoints > 0 {
-		newScore = (totalEarnedPoints / totalMaxPoints) * 10.0
-	} else {
-		totalQuestions := len(submission.UserAnswers)
-		if totalQuestions > 0 {
-			newScore = (totalEarnedPoints / float64(totalQuestions)) * 10.0
+		rubric, err := s.repo.GetRubricByID(ctx, *question.RubricID)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "Không tìm thấy thang chấm: %v", err)
+		}
+		ratio, rubricScores, err = scoreRubric(rubric, req.RubricScores)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Chấm theo thang chấm không hợp lệ: %v", err)
+		}
+		isCorrect = ratio >= 1
+		for _, sc := range rubricScores {
+			sc.SubmissionID = req.SubmissionId
+			sc.QuestionID = req.QuestionId
+			sc.GraderID = req.GraderId
 		}
 	}
 
-	submission.Score = newScore
-	_, err = s.repo.UpdateSubmission(ctx, nil, submission)
+	feedback := strings.TrimSpace(req.Feedback)
+	updates := map[string]interface{}{
+		"is_correct":     isCorrect,
+		"awarded_points": ratio * qPts,
+		"feedback":       feedback,
+	}
+	var result SubmissionResult
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.LockSubmission(ctx, tx, req.SubmissionId); err != nil {
+			return err
+		}
+		if err := s.repo.UpdateUserAnswer(ctx, tx, req.SubmissionId, req.QuestionId, updates); err != nil {
+			return err
+		}
+		if err := s.repo.SaveRubricScores(ctx, tx, req.SubmissionId, req.QuestionId, rubricScores); err != nil {
+			return err
+		}
+		rows, err := s.repo.GetSubmissionAnswers(ctx, tx, req.SubmissionId)
+		if err != nil {
+			return err
+		}
+		engine, err := s.scoringEngineFor(ctx, exam)
+		if err != nil {
+			return err
+		}
+		result = engine.ScoreSubmission(questions, qPointsMap, groupUserAnswers(rows))
+		return s.repo.UpdateSubmissionScore(ctx, tx, req.SubmissionId, result.Score)
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Lỗi cập nhật điểm bài nộp: %v", err)
+		return nil, status.Errorf(codes.Internal, "Lỗi chấm câu trả lời: %v", err)
 	}
 
-	return &pb.GradeEssayResponse{Success: true}, nil
+	return &pb.GradeEssayResponse{Success: true, AwardedPoints: float32(ratio * qPts), Score: float32(result.Score)}, nil
 }
 
 func (s *examService) GetClassGradebook(ctx context.Context, req *pb.GetClassGradebookRequest) (*pb.GetClassGradebookResponse, error) {
@@ -2750,10 +3098,17 @@ func (s *examService) GetClassGradebook(ctx context.Context, req *pb.GetClassGra
 		return nil, status.Error(codes.InvalidArgument, "Mã lớp không hợp lệ")
 	}
 
-	exams, err := s.repo.GetExamsByClass(ctx, req.ClassId)
+	classExams, err := s.repo.GetExamsByClass(ctx, req.ClassId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách bài thi của lớp: %v", err)
 	}
+	// Bài luyện tập không được tính điểm
+	var exams []*domain.ExamModel
+	for _, e := range classExams {
+		if !e.IsPractice {
+			exams = append(exams, e)
+		}
+	}
 
 	examIDs := make([]int64, len(exams))
 	pbExams := make([]*pb.Exam, len(exams))
@@ -2764,6 +3119,7 @@ func (s *examService) GetClassGradebook(ctx context.Context, req *pb.GetClassGra
 			Title:           e.Title,
 			DurationMinutes: int32(e.DurationMinutes),
 			QuestionCount:   int32(len(e.Questions)),
+			ScoreScale:      e.ScoreScale,
 		}
 	}
 
@@ -2774,6 +3130,7 @@ func (s *examService) GetClassGradebook(ctx context.Context, req *pb.GetClassGra
 		}, nil
 	}
 
+	// Điểm lưu trên bài nộp luôn được cập nhật khi chấm lại, phúc khảo hay chấm tự luận nên không cần chấm lại ở đây.
 	scoreMap, err := s.repo.GetBestScoresForGradebook(ctx, examIDs, req.StudentIds)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Lỗi lấy bảng điểm: %v", err)
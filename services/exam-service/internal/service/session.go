@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	"github.com/06babyshark06/JQKStudy/shared/contracts"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const maxSessionExtendMinutes = 24 * 60
+
+// ControlExamSession thực hiện thao tác của giám thị trên các lượt thi đang diễn ra của đề
+// (tạm dừng, tiếp tục, cộng giờ, thu bài, gửi thông báo) và ghi lại vào nhật ký.
+func (s *examService) ControlExamSession(ctx context.Context, req *pb.ControlExamSessionRequest) (*pb.ControlExamSessionResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+
+	action := strings.TrimSpace(req.Action)
+	message := strings.TrimSpace(req.Message)
+	switch action {
+	case domain.SessionActionPause, domain.SessionActionResume:
+	case domain.SessionActionExtend:
+		if req.ExtraMinutes <= 0 || req.ExtraMinutes > maxSessionExtendMinutes {
+			return nil, status.Errorf(codes.InvalidArgument, "Số phút cộng thêm phải nằm trong khoảng 1 - %d", maxSessionExtendMinutes)
+		}
+	case domain.SessionActionForceSubmit:
+		if req.UserId == 0 {
+			return nil, status.Error(codes.InvalidArgument, "Cần chọn thí sinh để thu bài")
+		}
+	case domain.SessionActionBroadcast:
+		if message == "" {
+			return nil, status.Error(codes.InvalidArgument, "Nội dung thông báo không được để trống")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Thao tác không hợp lệ: %s", req.Action)
+	}
+
+	var subs []*domain.ExamSubmissionModel
+	if req.UserId > 0 {
+		subs, err = s.repo.GetInProgressSubmissionsByUser(ctx, req.UserId, exam.Id)
+	} else {
+		subs, err = s.repo.GetInProgressSubmissionsByExam(ctx, exam.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy danh sách lượt thi đang diễn ra: %v", err)
+	}
+	if req.UserId > 0 && len(subs) == 0 {
+		return nil, status.Error(codes.NotFound, "Thí sinh không có lượt thi đang diễn ra")
+	}
+
+	now := time.Now().UTC()
+	affected := 0
+	for _, sub := range subs {
+		switch action {
+		case domain.SessionActionForceSubmit:
+			submitted, err := s.autoSubmitSubmission(ctx, sub.Id, autoSubmitReasonInstructor)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Lỗi thu bài của thí sinh: %v", err)
+			}
+			if submitted {
+				affected++
+			}
+			continue
+		case domain.SessionActionBroadcast:
+			affected++
+			continue
+		}
+
+		sub, err = s.updateSubmissionTiming(ctx, exam, sub.Id, func(sub *domain.ExamSubmissionModel) bool {
+			switch action {
+			case domain.SessionActionPause:
+				if sub.PausedAt != nil {
+					return false
+				}
+				sub.PausedAt = &now
+			case domain.SessionActionResume:
+				if sub.PausedAt == nil {
+					return false
+				}
+				sub.TimeAdjustmentSeconds += int(now.Sub(*sub.PausedAt).Seconds())
+				sub.PausedAt = nil
+			case domain.SessionActionExtend:
+				sub.TimeAdjustmentSeconds += int(req.ExtraMinutes) * 60
+			}
+			return true
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi cập nhật thời gian làm bài: %v", err)
+		}
+		if sub == nil {
+			continue
+		}
+		affected++
+
+		s.publishSessionEvent(ctx, exam.Id, sub.UserID, sessionEventType(action), map[string]interface{}{
+			"submission_id":     sub.Id,
+			"paused":            sub.PausedAt != nil,
+			"remaining_seconds": examRemainingSeconds(exam, sub),
+			"extra_minutes":     req.ExtraMinutes,
+			"message":           message,
+		})
+	}
+
+	if action == domain.SessionActionBroadcast {
+		s.publishSessionEvent(ctx, exam.Id, req.UserId, sessionEventType(action), map[string]interface{}{
+			"message": message,
+		})
+	}
+
+	record := &domain.ExamSessionActionModel{
+		ExamID:        exam.Id,
+		InstructorID:  req.InstructorId,
+		Action:        action,
+		TargetUserID:  req.UserId,
+		ExtraMinutes:  int(req.ExtraMinutes),
+		Message:       message,
+		AffectedCount: affected,
+		CreatedAt:     now,
+	}
+	if err := s.repo.CreateSessionAction(ctx, record); err != nil {
+		log.Printf("❌ Không ghi được nhật ký thao tác %s của đề %d: %v", action, exam.Id, err)
+	}
+	log.Printf("🎛️ Giám thị %d thực hiện %s trên đề %d (user %d), ảnh hưởng %d lượt thi", req.InstructorId, action, exam.Id, req.UserId, affected)
+
+	if database.RedisClient != nil {
+		msg := map[string]interface{}{
+			"type":           "SESSION_ACTION",
+			"exam_id":        exam.Id,
+			"user_id":        req.UserId,
+			"action":         action,
+			"extra_minutes":  req.ExtraMinutes,
+			"affected_count": affected,
+			"message":        message,
+			"timestamp":      now.Format(time.RFC3339),
+		}
+		jsonMsg, _ := json.Marshal(msg)
+		database.RedisClient.Publish(ctx, fmt.Sprintf("exam_monitor:%d", exam.Id), string(jsonMsg))
+	}
+
+	return &pb.ControlExamSessionResponse{Action: sessionActionToProto(record)}, nil
+}
+
+// updateSubmissionTiming khoá lượt thi và đọc lại trạng thái mới nhất trước khi apply sửa thời gian làm bài,
+// để các thao tác đồng thời (tạm dừng, cộng giờ, tự khoá do nghi vấn) không ghi đè nhau.
+// Trả về nil khi lượt thi đã nộp hoặc apply báo không cần thay đổi.
+func (s *examService) updateSubmissionTiming(ctx context.Context, exam *domain.ExamModel, submissionID int64, apply func(sub *domain.ExamSubmissionModel) bool) (*domain.ExamSubmissionModel, error) {
+	var updated *domain.ExamSubmissionModel
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.LockSubmission(ctx, tx, submissionID); err != nil {
+			return err
+		}
+		sub, err := s.repo.LockInProgressSubmission(ctx, tx, submissionID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !apply(sub) {
+			return nil
+		}
+		sub.Deadline = s.submissionDeadline(ctx, exam, sub)
+		if err := s.repo.UpdateSubmissionTiming(ctx, tx, sub); err != nil {
+			return err
+		}
+		updated = sub
+		return nil
+	})
+	return updated, err
+}
+
+func (s *examService) GetExamSessionActions(ctx context.Context, req *pb.GetExamSessionActionsRequest) (*pb.GetExamSessionActionsResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	actions, err := s.repo.GetSessionActions(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy nhật ký giám sát: %v", err)
+	}
+	resp := &pb.GetExamSessionActionsResponse{Actions: []*pb.ExamSessionAction{}}
+	for _, a := range actions {
+		resp.Actions = append(resp.Actions, sessionActionToProto(a))
+	}
+	return resp, nil
+}
+
+// submissionDeadline là hạn nộp của lượt thi gồm hỗ trợ của học sinh và thời gian giám thị đã cộng thêm.
+func (s *examService) submissionDeadline(ctx context.Context, exam *domain.ExamModel, sub *domain.ExamSubmissionModel) *time.Time {
+	deadline := s.accommodationFor(ctx, exam, sub.UserID).Deadline(exam, sub.StartedAt)
+	if deadline != nil && sub.TimeAdjustmentSeconds != 0 {
+		adjusted := deadline.Add(time.Duration(sub.TimeAdjustmentSeconds) * time.Second)
+		deadline = &adjusted
+	}
+	return deadline
+}
+
+// publishSessionEvent gửi sự kiện tới WebSocket của thí sinh; userID = 0 gửi cho mọi thí sinh của đề.
+func (s *examService) publishSessionEvent(ctx context.Context, examID, userID int64, msgType string, data map[string]interface{}) {
+	if database.RedisClient == nil {
+		return
+	}
+	data["exam_id"] = examID
+	data["timestamp"] = time.Now().UTC().Format(time.RFC3339)
+	jsonMsg, _ := json.Marshal(contracts.WSMessage{Type: msgType, Data: data})
+
+	channel := fmt.Sprintf("exam_session:%d", examID)
+	if userID > 0 {
+		channel = fmt.Sprintf("exam_session:%d:user:%d", examID, userID)
+	}
+	if err := database.RedisClient.Publish(ctx, channel, string(jsonMsg)).Err(); err != nil {
+		log.Printf("⚠️ Không gửi được sự kiện %s tới [%s]: %v", msgType, channel, err)
+	}
+}
+
+func sessionEventType(action string) string {
+	switch action {
+	case domain.SessionActionPause:
+		return "EXAM_PAUSED"
+	case domain.SessionActionResume:
+		return "EXAM_RESUMED"
+	case domain.SessionActionExtend:
+		return "TIME_EXTENDED"
+	case domain.SessionActionForceSubmit:
+		return "EXAM_FORCE_SUBMITTED"
+	default:
+		return "ANNOUNCEMENT"
+	}
+}
+
+func sessionActionToProto(a *domain.ExamSessionActionModel) *pb.ExamSessionAction {
+	return &pb.ExamSessionAction{
+		Id:            a.Id,
+		ExamId:        a.ExamID,
+		InstructorId:  a.InstructorID,
+		Action:        a.Action,
+		UserId:        a.TargetUserID,
+		ExtraMinutes:  int32(a.ExtraMinutes),
+		Message:       a.Message,
+		AffectedCount: int32(a.AffectedCount),
+		CreatedAt:     a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// GetExamSessionState trả về trạng thái lượt thi đang làm để WebSocket của thí sinh đồng bộ đồng hồ
+// và gửi lại các đáp án chưa được xác nhận sau khi kết nối lại.
+func (s *examService) GetExamSessionState(ctx context.Context, req *pb.GetExamSessionStateRequest) (*pb.GetExamSessionStateResponse, error) {
+	if err := s.validateSessionLock(ctx, req.ExamId, req.UserId, req.IpAddress, req.UserAgent); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	exam, err := s.repo.GetExamDetails(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Không tìm thấy bài thi: %v", err)
+	}
+	subs, err := s.repo.GetInProgressSubmissionsByUser(ctx, req.UserId, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy lượt thi đang diễn ra: %v", err)
+	}
+	if len(subs) == 0 {
+		return nil, status.Error(codes.NotFound, "Không tìm thấy bài làm đang diễn ra")
+	}
+
+	sub := subs[0]
+	resp := &pb.GetExamSessionStateResponse{
+		SubmissionId:     sub.Id,
+		RemainingSeconds: examRemainingSeconds(exam, sub),
+		Paused:           sub.PausedAt != nil,
+		LastAnswerSeq:    sub.LastAnswerSeq,
+	}
+	if sub.Deadline != nil {
+		resp.Deadline = sub.Deadline.Format(time.RFC3339)
+	}
+	return resp, nil
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	defaultMinSimilarity  = 0.3
+	minSharedFingerprints = 2
+	maxSimilaritySources  = 50
+)
+
+type essayAnswer struct {
+	submissionID int64
+	userID       int64
+	fp           *essayFingerprint
+}
+
+type similarityReference struct {
+	source *domain.SimilaritySourceModel
+	fp     *essayFingerprint
+}
+
+// SaveSimilaritySources thay toàn bộ văn bản tham chiếu của đề (thường là nội dung bài giảng giáo viên dán vào).
+func (s *examService) SaveSimilaritySources(ctx context.Context, req *pb.SaveSimilaritySourcesRequest) (*pb.SaveSimilaritySourcesResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Sources) > maxSimilaritySources {
+		return nil, status.Errorf(codes.InvalidArgument, "Tối đa %d văn bản tham chiếu cho một đề", maxSimilaritySources)
+	}
+
+	now := time.Now().UTC()
+	sources := make([]*domain.SimilaritySourceModel, 0, len(req.Sources))
+	for _, src := range req.Sources {
+		content := strings.TrimSpace(src.Content)
+		if content == "" {
+			return nil, status.Error(codes.InvalidArgument, "Nội dung văn bản tham chiếu không được để trống")
+		}
+		sources = append(sources, &domain.SimilaritySourceModel{
+			ExamID:     exam.Id,
+			QuestionID: src.QuestionId,
+			Title:      strings.TrimSpace(src.Title),
+			Content:    content,
+			CreatedBy:  req.InstructorId,
+			CreatedAt:  now,
+		})
+	}
+	if err := s.repo.ReplaceSimilaritySources(ctx, exam.Id, sources); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu văn bản tham chiếu: %v", err)
+	}
+
+	resp := &pb.SaveSimilaritySourcesResponse{Sources: []*pb.SimilaritySource{}}
+	for _, src := range sources {
+		resp.Sources = append(resp.Sources, similaritySourceToProto(src))
+	}
+	return resp, nil
+}
+
+func (s *examService) GetSimilaritySources(ctx context.Context, req *pb.GetSimilaritySourcesRequest) (*pb.GetSimilaritySourcesResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	sources, err := s.repo.GetSimilaritySources(ctx, req.ExamId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy văn bản tham chiếu: %v", err)
+	}
+	resp := &pb.GetSimilaritySourcesResponse{Sources: []*pb.SimilaritySource{}}
+	for _, src := range sources {
+		resp.Sources = append(resp.Sources, similaritySourceToProto(src))
+	}
+	return resp, nil
+}
+
+// CheckEssaySimilarity so khớp lại toàn bộ bài tự luận đã nộp của đề (hoặc của một câu) với nhau và với văn bản tham chiếu.
+func (s *examService) CheckEssaySimilarity(ctx context.Context, req *pb.CheckEssaySimilarityRequest) (*pb.CheckEssaySimilarityResponse, error) {
+	exam, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId)
+	if err != nil {
+		return nil, err
+	}
+	minSimilarity := float64(req.MinSimilarity)
+	if minSimilarity <= 0 || minSimilarity > 1 {
+		minSimilarity = defaultMinSimilarity
+	}
+
+	answers, err := s.collectEssayAnswers(ctx, exam.Id, req.QuestionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy bài tự luận: %v", err)
+	}
+	references, err := s.similarityReferences(ctx, exam.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy văn bản tham chiếu: %v", err)
+	}
+
+	resp := &pb.CheckEssaySimilarityResponse{Matches: []*pb.SimilarityMatch{}}
+	for qID, list := range answers {
+		var matches []*domain.SimilarityMatchModel
+		for i := range list {
+			resp.CheckedAnswers++
+			for j := range list {
+				if i == j || list[i].userID == list[j].userID {
+					continue
+				}
+				if m := answerMatch(exam.Id, qID, list[i], list[j], minSimilarity); m != nil {
+					matches = append(matches, m)
+				}
+			}
+			matches = append(matches, referenceMatches(exam.Id, qID, list[i], references, minSimilarity)...)
+		}
+		if err := s.repo.ReplaceSimilarityMatches(ctx, exam.Id, qID, 0, matches); err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lưu kết quả so khớp: %v", err)
+		}
+		// Lưu dấu vân tay để các lần nộp bài sau so khớp được cả những bài nộp trước khi có bảng dấu vân tay.
+		rows := make([]*domain.EssayFingerprintModel, 0, len(list))
+		for _, a := range list {
+			rows = append(rows, essayFingerprintRow(exam.Id, qID, a))
+		}
+		if err := s.repo.SaveEssayFingerprints(ctx, rows); err != nil {
+			return nil, status.Errorf(codes.Internal, "Lỗi lưu dấu vân tay bài tự luận: %v", err)
+		}
+		for _, m := range matches {
+			resp.Matches = append(resp.Matches, similarityMatchToProto(m))
+		}
+	}
+	resp.MatchCount = int32(len(resp.Matches))
+	log.Printf("📝 Kiểm tra trùng lặp tự luận đề %d: %d bài, %d kết quả", exam.Id, resp.CheckedAnswers, resp.MatchCount)
+
+	return resp, nil
+}
+
+func (s *examService) GetEssaySimilarity(ctx context.Context, req *pb.GetEssaySimilarityRequest) (*pb.GetEssaySimilarityResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	matches, err := s.repo.GetSimilarityMatches(ctx, req.ExamId, req.QuestionId, req.SubmissionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lấy kết quả so khớp: %v", err)
+	}
+	resp := &pb.GetEssaySimilarityResponse{Matches: []*pb.SimilarityMatch{}}
+	for _, m := range matches {
+		resp.Matches = append(resp.Matches, similarityMatchToProto(m))
+	}
+	return resp, nil
+}
+
+// checkSubmissionSimilarity chạy sau khi nộp bài: chỉ băm các câu tự luận của bài vừa nộp rồi so với dấu vân tay
+// đã lưu của các bài khác; văn bản của bài khác chỉ được đọc lại khi tập dấu vân tay cho thấy có thể trùng.
+func (s *examService) checkSubmissionSimilarity(ctx context.Context, examID, submissionID int64) {
+	submission, err := s.repo.GetSubmissionByID(ctx, submissionID)
+	if err != nil {
+		return
+	}
+	texts := make(map[int64]string)
+	var questionIDs []int64
+	for _, ua := range submission.UserAnswers {
+		if ua.TextAnswer == nil || strings.TrimSpace(*ua.TextAnswer) == "" {
+			continue
+		}
+		if _, ok := texts[ua.QuestionID]; !ok {
+			questionIDs = append(questionIDs, ua.QuestionID)
+		}
+		texts[ua.QuestionID] = *ua.TextAnswer
+	}
+	if len(texts) == 0 {
+		return
+	}
+	questions, err := s.repo.GetQuestionsByIDs(ctx, questionIDs)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được câu hỏi của bài %d: %v", submissionID, err)
+		return
+	}
+
+	targets := make(map[int64]essayAnswer)
+	var essayIDs []int64
+	for _, q := range questions {
+		if q.Type.Type != domain.QuestionTypeEssay {
+			continue
+		}
+		fp := fingerprintText(texts[q.Id])
+		if fp.empty() {
+			continue
+		}
+		targets[q.Id] = essayAnswer{submissionID: submissionID, userID: submission.UserID, fp: fp}
+		essayIDs = append(essayIDs, q.Id)
+	}
+	if len(targets) == 0 {
+		return
+	}
+	rows := make([]*domain.EssayFingerprintModel, 0, len(targets))
+	for qID, target := range targets {
+		rows = append(rows, essayFingerprintRow(examID, qID, target))
+	}
+	if err := s.repo.SaveEssayFingerprints(ctx, rows); err != nil {
+		log.Printf("⚠️ Không lưu được dấu vân tay của bài %d: %v", submissionID, err)
+	}
+
+	stored, err := s.repo.GetEssayFingerprints(ctx, examID, essayIDs)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được dấu vân tay của đề %d: %v", examID, err)
+		return
+	}
+	references, err := s.similarityReferences(ctx, examID)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được văn bản tham chiếu của đề %d: %v", examID, err)
+		return
+	}
+
+	otherTexts := make(map[int64]map[int64]string)
+	for qID, target := range targets {
+		var matches []*domain.SimilarityMatchModel
+		for _, row := range stored {
+			if row.QuestionID != qID || row.UserID == target.userID {
+				continue
+			}
+			if !target.fp.mayMatch(domain.ParseFingerprintHashes(row.Hashes), defaultMinSimilarity) {
+				continue
+			}
+			if otherTexts[row.SubmissionID] == nil {
+				otherTexts[row.SubmissionID] = s.submissionTexts(ctx, row.SubmissionID)
+			}
+			other := essayAnswer{submissionID: row.SubmissionID, userID: row.UserID, fp: fingerprintText(otherTexts[row.SubmissionID][qID])}
+			if m := answerMatch(examID, qID, target, other, defaultMinSimilarity); m != nil {
+				matches = append(matches, m)
+			}
+			if m := answerMatch(examID, qID, other, target, defaultMinSimilarity); m != nil {
+				matches = append(matches, m)
+			}
+		}
+		matches = append(matches, referenceMatches(examID, qID, target, references, defaultMinSimilarity)...)
+
+		if err := s.repo.ReplaceSimilarityMatches(ctx, examID, qID, submissionID, matches); err != nil {
+			log.Printf("⚠️ Không lưu được kết quả so khớp của bài %d: %v", submissionID, err)
+		}
+	}
+}
+
+// submissionTexts đọc câu trả lời dạng văn bản của một bài nộp, theo câu hỏi.
+func (s *examService) submissionTexts(ctx context.Context, submissionID int64) map[int64]string {
+	texts := make(map[int64]string)
+	rows, err := s.repo.GetSubmissionAnswers(ctx, nil, submissionID)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được câu trả lời của bài %d: %v", submissionID, err)
+		return texts
+	}
+	for _, ua := range rows {
+		if ua.TextAnswer != nil {
+			texts[ua.QuestionID] = *ua.TextAnswer
+		}
+	}
+	return texts
+}
+
+func essayFingerprintRow(examID, questionID int64, a essayAnswer) *domain.EssayFingerprintModel {
+	return &domain.EssayFingerprintModel{
+		ExamID:       examID,
+		QuestionID:   questionID,
+		SubmissionID: a.submissionID,
+		UserID:       a.userID,
+		Hashes:       domain.FormatFingerprintHashes(a.fp.hashList()),
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+// collectEssayAnswers lấy dấu vân tay các câu trả lời tự luận của mọi bài đã nộp, gom theo câu hỏi.
+func (s *examService) collectEssayAnswers(ctx context.Context, examID, questionID int64) (map[int64][]essayAnswer, error) {
+	subs, err := s.repo.GetExamSubmissionsWithAnswers(ctx, examID)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make(map[int64]map[int64]string)
+	owners := make(map[int64]int64)
+	var questionIDs []int64
+	for _, sub := range subs {
+		owners[sub.Id] = sub.UserID
+		for _, ua := range sub.UserAnswers {
+			if ua.TextAnswer == nil || strings.TrimSpace(*ua.TextAnswer) == "" {
+				continue
+			}
+			if questionID > 0 && ua.QuestionID != questionID {
+				continue
+			}
+			if texts[ua.QuestionID] == nil {
+				texts[ua.QuestionID] = make(map[int64]string)
+				questionIDs = append(questionIDs, ua.QuestionID)
+			}
+			texts[ua.QuestionID][sub.Id] = *ua.TextAnswer
+		}
+	}
+
+	questions, err := s.repo.GetQuestionsByIDs(ctx, questionIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64][]essayAnswer)
+	for _, q := range questions {
+		if q.Type.Type != domain.QuestionTypeEssay {
+			continue
+		}
+		for subID, text := range texts[q.Id] {
+			fp := fingerprintText(text)
+			if fp.empty() {
+				continue
+			}
+			result[q.Id] = append(result[q.Id], essayAnswer{submissionID: subID, userID: owners[subID], fp: fp})
+		}
+	}
+	return result, nil
+}
+
+func (s *examService) similarityReferences(ctx context.Context, examID int64) ([]similarityReference, error) {
+	sources, err := s.repo.GetSimilaritySources(ctx, examID)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]similarityReference, 0, len(sources))
+	for _, src := range sources {
+		refs = append(refs, similarityReference{source: src, fp: fingerprintText(src.Content)})
+	}
+	return refs, nil
+}
+
+func answerMatch(examID, questionID int64, a, b essayAnswer, minSimilarity float64) *domain.SimilarityMatchModel {
+	similarity, shared, spans := compareFingerprints(a.fp, b.fp)
+	if shared < minSharedFingerprints || similarity < minSimilarity {
+		return nil
+	}
+	return &domain.SimilarityMatchModel{
+		ExamID:              examID,
+		QuestionID:          questionID,
+		SubmissionID:        a.submissionID,
+		UserID:              a.userID,
+		MatchedSubmissionID: b.submissionID,
+		MatchedUserID:       b.userID,
+		Similarity:          similarity,
+		SharedFingerprints:  shared,
+		Spans:               domain.FormatSimilaritySpans(spans),
+		CreatedAt:           time.Now().UTC(),
+	}
+}
+
+func referenceMatches(examID, questionID int64, a essayAnswer, refs []similarityReference, minSimilarity float64) []*domain.SimilarityMatchModel {
+	var matches []*domain.SimilarityMatchModel
+	for _, ref := range refs {
+		if ref.source.QuestionID != 0 && ref.source.QuestionID != questionID {
+			continue
+		}
+		similarity, shared, spans := compareFingerprints(a.fp, ref.fp)
+		if shared < minSharedFingerprints || similarity < minSimilarity {
+			continue
+		}
+		matches = append(matches, &domain.SimilarityMatchModel{
+			ExamID:             examID,
+			QuestionID:         questionID,
+			SubmissionID:       a.submissionID,
+			UserID:             a.userID,
+			SourceID:           ref.source.Id,
+			SourceTitle:        ref.source.Title,
+			Similarity:         similarity,
+			SharedFingerprints: shared,
+			Spans:              domain.FormatSimilaritySpans(spans),
+			CreatedAt:          time.Now().UTC(),
+		})
+	}
+	return matches
+}
+
+// similarityMatchesBySubmission gom kết quả so khớp của một bài theo câu hỏi để hiển thị khi chấm.
+func (s *examService) similarityMatchesBySubmission(ctx context.Context, examID, submissionID int64) map[int64][]*pb.SimilarityMatch {
+	result := make(map[int64][]*pb.SimilarityMatch)
+	matches, err := s.repo.GetSimilarityMatches(ctx, examID, 0, submissionID)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được kết quả so khớp của bài %d: %v", submissionID, err)
+		return result
+	}
+	for _, m := range matches {
+		result[m.QuestionID] = append(result[m.QuestionID], similarityMatchToProto(m))
+	}
+	return result
+}
+
+func similaritySourceToProto(src *domain.SimilaritySourceModel) *pb.SimilaritySource {
+	return &pb.SimilaritySource{
+		Id:         src.Id,
+		QuestionId: src.QuestionID,
+		Title:      src.Title,
+		Content:    src.Content,
+		CreatedAt:  src.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func similarityMatchToProto(m *domain.SimilarityMatchModel) *pb.SimilarityMatch {
+	res := &pb.SimilarityMatch{
+		Id:                  m.Id,
+		QuestionId:          m.QuestionID,
+		SubmissionId:        m.SubmissionID,
+		UserId:              m.UserID,
+		MatchedSubmissionId: m.MatchedSubmissionID,
+		MatchedUserId:       m.MatchedUserID,
+		SourceId:            m.SourceID,
+		SourceTitle:         m.SourceTitle,
+		Similarity:          float32(m.Similarity),
+		SharedFingerprints:  int32(m.SharedFingerprints),
+		Spans:               []*pb.SimilaritySpan{},
+		CreatedAt:           m.CreatedAt.Format(time.RFC3339),
+	}
+	for _, sp := range domain.ParseSimilaritySpans(m.Spans) {
+		res.Spans = append(res.Spans, &pb.SimilaritySpan{
+			Start:        int32(sp.Start),
+			End:          int32(sp.End),
+			MatchedStart: int32(sp.MatchedStart),
+			MatchedEnd:   int32(sp.MatchedEnd),
+			MatchedText:  sp.MatchedText,
+		})
+	}
+	return res
+}
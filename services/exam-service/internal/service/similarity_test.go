@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+)
+
+func TestStoredFingerprintsPrefilterMatches(t *testing.T) {
+	original := "Quang hợp là quá trình cây xanh sử dụng năng lượng ánh sáng mặt trời để tổng hợp chất hữu cơ từ khí cacbonic và nước, đồng thời giải phóng khí oxi ra môi trường."
+	copied := "Theo em, quang hợp là quá trình cây xanh sử dụng năng lượng ánh sáng mặt trời để tổng hợp chất hữu cơ từ khí cacbonic và nước, đồng thời giải phóng khí oxi."
+	unrelated := "Cách mạng tháng Tám năm 1945 thành công đã mở ra kỷ nguyên độc lập dân tộc gắn liền với chủ nghĩa xã hội cho nhân dân Việt Nam."
+
+	a, b, c := fingerprintText(original), fingerprintText(copied), fingerprintText(unrelated)
+	stored := domain.ParseFingerprintHashes(domain.FormatFingerprintHashes(b.hashList()))
+	if len(stored) != len(b.hashes) {
+		t.Fatalf("đọc lại được %d dấu vân tay, muốn %d", len(stored), len(b.hashes))
+	}
+	for h := range b.hashes {
+		if !stored[h] {
+			t.Fatalf("mất dấu vân tay %x khi lưu", h)
+		}
+	}
+
+	if !a.mayMatch(stored, defaultMinSimilarity) {
+		t.Error("bài chép lại không vượt qua bước lọc bằng dấu vân tay đã lưu")
+	}
+	if sim, shared, _ := compareFingerprints(a, b); shared < minSharedFingerprints || sim < defaultMinSimilarity {
+		t.Errorf("so khớp đầy đủ: similarity = %v, shared = %d", sim, shared)
+	}
+	if c.mayMatch(stored, defaultMinSimilarity) {
+		t.Error("bài không liên quan vẫn vượt qua bước lọc")
+	}
+}
@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	database "github.com/06babyshark06/JQKStudy/services/exam-service/internal/databases"
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+	pb "github.com/06babyshark06/JQKStudy/shared/proto/exam"
+)
+
+const (
+	maxSuspicionScore        = 100
+	suspicionBurstMultiplier = 1.5
+	ipChangeDedupWindow      = time.Minute
+)
+
+// violationKinds giữ thứ tự hiển thị lý do gắn cờ.
+var violationKinds = []string{
+	domain.ViolationIPChange,
+	domain.ViolationCopyPaste,
+	domain.ViolationFullscreenExit,
+	domain.ViolationTabSwitch,
+	domain.ViolationOther,
+}
+
+var violationLabels = map[string]string{
+	domain.ViolationTabSwitch:      "Chuyển tab / rời cửa sổ",
+	domain.ViolationFullscreenExit: "Thoát toàn màn hình",
+	domain.ViolationCopyPaste:      "Sao chép / dán",
+	domain.ViolationIPChange:       "Đổi IP hoặc trình duyệt khi đang làm bài",
+	domain.ViolationOther:          "Hành vi bất thường khác",
+}
+
+type suspicionRules struct {
+	weights             map[string]float64
+	burstWindow         time.Duration
+	flagThreshold       float64
+	lockThreshold       float64
+	autoSubmitThreshold float64
+}
+
+func (s *examService) suspicionRulesFor(ctx context.Context, examID int64) (*domain.SuspicionConfigModel, suspicionRules) {
+	cfg, err := s.repo.GetSuspicionConfig(ctx, examID)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được cấu hình nghi vấn của đề %d: %v", examID, err)
+	}
+	if cfg == nil {
+		cfg = &domain.SuspicionConfigModel{
+			ExamID:             examID,
+			Weights:            "{}",
+			BurstWindowSeconds: domain.DefaultSuspicionBurstWindow,
+			FlagThreshold:      domain.DefaultSuspicionFlagThreshold,
+		}
+	}
+
+	rules := suspicionRules{
+		weights:             make(map[string]float64, len(domain.DefaultViolationWeights)),
+		burstWindow:         time.Duration(cfg.BurstWindowSeconds) * time.Second,
+		flagThreshold:       cfg.FlagThreshold,
+		lockThreshold:       cfg.LockThreshold,
+		autoSubmitThreshold: cfg.AutoSubmitThreshold,
+	}
+	for kind, w := range domain.DefaultViolationWeights {
+		rules.weights[kind] = w
+	}
+	var custom map[string]float64
+	if cfg.Weights != "" {
+		_ = json.Unmarshal([]byte(cfg.Weights), &custom)
+	}
+	for kind, w := range custom {
+		rules.weights[kind] = w
+	}
+	return cfg, rules
+}
+
+// normalizeViolationType gom các tên vi phạm tự do từ client về một số loại cố định.
+func normalizeViolationType(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t))
+	t = strings.NewReplacer("-", "_", " ", "_").Replace(t)
+	switch {
+	case strings.Contains(t, "copy"), strings.Contains(t, "paste"), strings.Contains(t, "cut"), strings.Contains(t, "clipboard"):
+		return domain.ViolationCopyPaste
+	case strings.Contains(t, "fullscreen"), strings.Contains(t, "full_screen"):
+		return domain.ViolationFullscreenExit
+	case strings.Contains(t, "tab"), strings.Contains(t, "visibility"), strings.Contains(t, "blur"), strings.Contains(t, "focus"):
+		return domain.ViolationTabSwitch
+	case t == domain.ViolationIPChange, strings.Contains(t, "session"), strings.Contains(t, "device"):
+		return domain.ViolationIPChange
+	default:
+		return domain.ViolationOther
+	}
+}
+
+// scoreViolations cộng trọng số theo số lần vi phạm; vi phạm xảy ra trong burstWindow kể từ vi phạm trước
+// được nhân thêm hệ số. Điểm được chặn ở 100.
+func scoreViolations(rules suspicionRules, violations []*domain.ExamViolationModel) (float64, []string) {
+	counts := make(map[string]int)
+	points := make(map[string]float64)
+	bursts := 0
+	score := 0.0
+
+	var prev time.Time
+	for i, v := range violations {
+		kind := normalizeViolationType(v.ViolationType)
+		pts := rules.weights[kind]
+		if i > 0 && rules.burstWindow > 0 && v.ViolationTime.Sub(prev) <= rules.burstWindow {
+			pts *= suspicionBurstMultiplier
+			bursts++
+		}
+		prev = v.ViolationTime
+
+		counts[kind]++
+		points[kind] += pts
+		score += pts
+	}
+
+	reasons := []string{}
+	for _, kind := range violationKinds {
+		if counts[kind] == 0 {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %d lần (+%.1f)", violationLabels[kind], counts[kind], points[kind]))
+	}
+	if bursts > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d vi phạm liên tiếp trong vòng %d giây", bursts, int(rules.burstWindow.Seconds())))
+	}
+	return math.Min(score, maxSuspicionScore), reasons
+}
+
+func (s *examService) evaluateSubmissionRisk(ctx context.Context, rules suspicionRules, sub *domain.ExamSubmissionModel) (*domain.SubmissionRiskModel, error) {
+	violations, err := s.repo.GetSubmissionViolations(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	score, reasons := scoreViolations(rules, violations)
+	reasonsJSON, _ := json.Marshal(reasons)
+
+	risk := &domain.SubmissionRiskModel{
+		SubmissionID:   sub.Id,
+		ExamID:         sub.ExamID,
+		UserID:         sub.UserID,
+		Score:          score,
+		Flagged:        rules.flagThreshold > 0 && score >= rules.flagThreshold,
+		Reasons:        string(reasonsJSON),
+		ViolationCount: len(violations),
+		UpdatedAt:      time.Now().UTC(),
+	}
+	if err := s.repo.SaveSubmissionRisk(ctx, risk); err != nil {
+		return nil, err
+	}
+	return risk, nil
+}
+
+// submissionRisks trả về điểm nghi vấn đã lưu, tính bổ sung cho các bài chưa có.
+func (s *examService) submissionRisks(ctx context.Context, examID int64, subs []*domain.ExamSubmissionModel) map[int64]*domain.SubmissionRiskModel {
+	ids := make([]int64, 0, len(subs))
+	for _, sub := range subs {
+		ids = append(ids, sub.Id)
+	}
+	risks, err := s.repo.GetSubmissionRisks(ctx, ids)
+	if err != nil {
+		log.Printf("⚠️ Không lấy được điểm nghi vấn của đề %d: %v", examID, err)
+		return map[int64]*domain.SubmissionRiskModel{}
+	}
+
+	var rules *suspicionRules
+	for _, sub := range subs {
+		if _, ok := risks[sub.Id]; ok {
+			continue
+		}
+		if rules == nil {
+			_, r := s.suspicionRulesFor(ctx, examID)
+			rules = &r
+		}
+		risk, err := s.evaluateSubmissionRisk(ctx, *rules, sub)
+		if err != nil {
+			log.Printf("⚠️ Không tính được điểm nghi vấn của bài %d: %v", sub.Id, err)
+			continue
+		}
+		risks[sub.Id] = risk
+	}
+	return risks
+}
+
+// applySuspicionRules tính lại điểm nghi vấn sau mỗi vi phạm, báo cho giáo viên khi bài bị gắn cờ
+// và tự khóa hoặc tự nộp bài nếu vượt ngưỡng cấu hình.
+func (s *examService) applySuspicionRules(ctx context.Context, exam *domain.ExamModel, sub *domain.ExamSubmissionModel) {
+	_, rules := s.suspicionRulesFor(ctx, exam.Id)
+	prev, _ := s.repo.GetSubmissionRisks(ctx, []int64{sub.Id})
+	risk, err := s.evaluateSubmissionRisk(ctx, rules, sub)
+	if err != nil {
+		log.Printf("⚠️ Không tính được điểm nghi vấn của bài %d: %v", sub.Id, err)
+		return
+	}
+
+	if risk.Flagged && (prev[sub.Id] == nil || !prev[sub.Id].Flagged) && database.RedisClient != nil {
+		msg := map[string]interface{}{
+			"type":          "SUSPICION_FLAGGED",
+			"exam_id":       exam.Id,
+			"user_id":       sub.UserID,
+			"submission_id": sub.Id,
+			"risk_score":    risk.Score,
+			"reasons":       riskReasons(risk),
+			"message":       fmt.Sprintf("Bài làm trong đề \"%s\" bị gắn cờ nghi vấn (%.0f điểm)", exam.Title, risk.Score),
+			"timestamp":     time.Now().UTC().Format(time.RFC3339),
+		}
+		jsonMsg, _ := json.Marshal(msg)
+		database.RedisClient.Publish(ctx, fmt.Sprintf("notifications:%d", exam.CreatorID), string(jsonMsg))
+		database.RedisClient.Publish(ctx, fmt.Sprintf("exam_monitor:%d", exam.Id), string(jsonMsg))
+	}
+
+	now := time.Now().UTC()
+	switch {
+	case rules.autoSubmitThreshold > 0 && risk.Score >= rules.autoSubmitThreshold:
+		submitted, err := s.autoSubmitSubmission(ctx, sub.Id, autoSubmitReasonSuspicion)
+		if err != nil {
+			log.Printf("❌ Lỗi tự nộp bài %d do nghi vấn: %v", sub.Id, err)
+			return
+		}
+		if submitted {
+			s.recordSystemSessionAction(ctx, exam.Id, sub.UserID, domain.SessionActionForceSubmit,
+				fmt.Sprintf("Tự động nộp bài do điểm nghi vấn %.0f", risk.Score), now)
+		}
+
+	case rules.lockThreshold > 0 && risk.Score >= rules.lockThreshold && sub.PausedAt == nil:
+		locked, err := s.updateSubmissionTiming(ctx, exam, sub.Id, func(sub *domain.ExamSubmissionModel) bool {
+			if sub.PausedAt != nil {
+				return false
+			}
+			sub.PausedAt = &now
+			return true
+		})
+		if err != nil {
+			log.Printf("❌ Lỗi khóa bài %d do nghi vấn: %v", sub.Id, err)
+			return
+		}
+		if locked == nil {
+			return
+		}
+		sub = locked
+		message := "Bài thi bị tạm khóa do phát hiện nhiều hành vi bất thường, vui lòng chờ giám thị"
+		s.publishSessionEvent(ctx, exam.Id, sub.UserID, sessionEventType(domain.SessionActionPause), map[string]interface{}{
+			"submission_id":     sub.Id,
+			"paused":            true,
+			"remaining_seconds": examRemainingSeconds(exam, sub),
+			"message":           message,
+		})
+		s.recordSystemSessionAction(ctx, exam.Id, sub.UserID, domain.SessionActionPause,
+			fmt.Sprintf("Tự động khóa bài do điểm nghi vấn %.0f", risk.Score), now)
+	}
+}
+
+// recordSystemSessionAction ghi nhật ký cho thao tác do hệ thống tự thực hiện (InstructorID = 0).
+func (s *examService) recordSystemSessionAction(ctx context.Context, examID, userID int64, action, message string, at time.Time) {
+	record := &domain.ExamSessionActionModel{
+		ExamID:        examID,
+		Action:        action,
+		TargetUserID:  userID,
+		Message:       message,
+		AffectedCount: 1,
+		CreatedAt:     at,
+	}
+	if err := s.repo.CreateSessionAction(ctx, record); err != nil {
+		log.Printf("❌ Không ghi được nhật ký thao tác %s của đề %d: %v", action, examID, err)
+	}
+	log.Printf("🚨 %s (đề %d, user %d)", message, examID, userID)
+}
+
+// logSessionMismatch ghi vi phạm đổi IP / trình duyệt khi khóa phiên không khớp, tối đa một lần mỗi phút.
+func (s *examService) logSessionMismatch(ctx context.Context, examID, userID int64) {
+	if database.RedisClient != nil {
+		key := fmt.Sprintf("exam:%d:user:%d:ip_change", examID, userID)
+		if ok, err := database.RedisClient.SetNX(ctx, key, 1, ipChangeDedupWindow).Result(); err == nil && !ok {
+			return
+		}
+	}
+	if _, err := s.LogViolation(ctx, &pb.LogViolationRequest{ExamId: examID, UserId: userID, ViolationType: domain.ViolationIPChange}); err != nil {
+		log.Printf("⚠️ Không ghi được vi phạm đổi IP của user %d: %v", userID, err)
+	}
+}
+
+func (s *examService) GetSuspicionConfig(ctx context.Context, req *pb.GetSuspicionConfigRequest) (*pb.GetSuspicionConfigResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	cfg, rules := s.suspicionRulesFor(ctx, req.ExamId)
+	return &pb.GetSuspicionConfigResponse{Config: suspicionConfigToProto(cfg, rules)}, nil
+}
+
+func (s *examService) UpdateSuspicionConfig(ctx context.Context, req *pb.UpdateSuspicionConfigRequest) (*pb.UpdateSuspicionConfigResponse, error) {
+	if _, err := s.getOwnedExam(ctx, req.ExamId, req.InstructorId); err != nil {
+		return nil, err
+	}
+	in := req.Config
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Thiếu cấu hình")
+	}
+
+	weights := make(map[string]float64)
+	for kind, w := range in.Weights {
+		if _, ok := domain.DefaultViolationWeights[kind]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Loại vi phạm không hợp lệ: %s", kind)
+		}
+		if w < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Trọng số của %s không được âm", kind)
+		}
+		weights[kind] = float64(w)
+	}
+	if in.FlagThreshold <= 0 || in.FlagThreshold > maxSuspicionScore {
+		return nil, status.Errorf(codes.InvalidArgument, "Ngưỡng gắn cờ phải nằm trong khoảng 0 - %d", maxSuspicionScore)
+	}
+	if in.LockThreshold < 0 || in.AutoSubmitThreshold < 0 || in.BurstWindowSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Ngưỡng và khoảng thời gian không được âm")
+	}
+	burst := int(in.BurstWindowSeconds)
+	if burst == 0 {
+		burst = domain.DefaultSuspicionBurstWindow
+	}
+	weightsJSON, _ := json.Marshal(weights)
+
+	cfg := &domain.SuspicionConfigModel{
+		ExamID:              req.ExamId,
+		Weights:             string(weightsJSON),
+		BurstWindowSeconds:  burst,
+		FlagThreshold:       float64(in.FlagThreshold),
+		LockThreshold:       float64(in.LockThreshold),
+		AutoSubmitThreshold: float64(in.AutoSubmitThreshold),
+		UpdatedAt:           time.Now().UTC(),
+	}
+	if err := s.repo.SaveSuspicionConfig(ctx, cfg); err != nil {
+		return nil, status.Errorf(codes.Internal, "Lỗi lưu cấu hình nghi vấn: %v", err)
+	}
+
+	cfg, rules := s.suspicionRulesFor(ctx, req.ExamId)
+	return &pb.UpdateSuspicionConfigResponse{Config: suspicionConfigToProto(cfg, rules)}, nil
+}
+
+func suspicionConfigToProto(cfg *domain.SuspicionConfigModel, rules suspicionRules) *pb.SuspicionConfig {
+	res := &pb.SuspicionConfig{
+		ExamId:              cfg.ExamID,
+		Weights:             make(map[string]float32, len(rules.weights)),
+		BurstWindowSeconds:  int32(cfg.BurstWindowSeconds),
+		FlagThreshold:       float32(cfg.FlagThreshold),
+		LockThreshold:       float32(cfg.LockThreshold),
+		AutoSubmitThreshold: float32(cfg.AutoSubmitThreshold),
+	}
+	for kind, w := range rules.weights {
+		res.Weights[kind] = float32(w)
+	}
+	if !cfg.UpdatedAt.IsZero() {
+		res.UpdatedAt = cfg.UpdatedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func riskReasons(risk *domain.SubmissionRiskModel) []string {
+	var reasons []string
+	if risk != nil && risk.Reasons != "" {
+		_ = json.Unmarshal([]byte(risk.Reasons), &reasons)
+	}
+	return reasons
+}
+
+func riskScore(risk *domain.SubmissionRiskModel) float64 {
+	if risk == nil {
+		return 0
+	}
+	return risk.Score
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/06babyshark06/JQKStudy/services/exam-service/internal/domain"
+)
+
+const (
+	// shingleSize là số từ của mỗi k-gram; winnowWindow là số k-gram liên tiếp mà winnowing chọn ra một dấu vân tay.
+	// Hai bài chung một đoạn dài ít nhất shingleSize+winnowWindow-1 từ chắc chắn có dấu vân tay trùng nhau.
+	shingleSize  = 5
+	winnowWindow = 4
+)
+
+type textToken struct {
+	word       string
+	start, end int
+}
+
+type fingerprint struct {
+	hash  uint64
+	index int
+}
+
+// essayFingerprint là tập dấu vân tay của một văn bản, giữ lại vị trí ký tự để tô sáng đoạn trùng.
+type essayFingerprint struct {
+	runes  []rune
+	tokens []textToken
+	prints []fingerprint
+	hashes map[uint64][]int
+}
+
+// tokenizeText tách văn bản thành các từ chữ thường (giữ dấu tiếng Việt), bỏ dấu câu và khoảng trắng.
+func tokenizeText(runes []rune) []textToken {
+	var tokens []textToken
+	start := -1
+	var b strings.Builder
+	flush := func(end int) {
+		if start >= 0 {
+			tokens = append(tokens, textToken{word: b.String(), start: start, end: end})
+			b.Reset()
+			start = -1
+		}
+	}
+	for i, r := range runes {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) {
+			if start < 0 {
+				start = i
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		flush(i)
+	}
+	flush(len(runes))
+	return tokens
+}
+
+// fingerprintText băm các k-gram từ và chọn dấu vân tay theo winnowing (giá trị nhỏ nhất của mỗi cửa sổ,
+// lấy phần tử bên phải nhất khi bằng nhau).
+func fingerprintText(text string) *essayFingerprint {
+	fp := &essayFingerprint{runes: []rune(text), hashes: make(map[uint64][]int)}
+	fp.tokens = tokenizeText(fp.runes)
+	if len(fp.tokens) < shingleSize {
+		return fp
+	}
+
+	grams := make([]uint64, len(fp.tokens)-shingleSize+1)
+	for i := range grams {
+		h := fnv.New64a()
+		for _, t := range fp.tokens[i : i+shingleSize] {
+			h.Write([]byte(t.word))
+			h.Write([]byte{0})
+		}
+		grams[i] = h.Sum64()
+	}
+
+	window := winnowWindow
+	if window > len(grams) {
+		window = len(grams)
+	}
+	last := -1
+	for i := 0; i+window <= len(grams); i++ {
+		minIdx := i
+		for j := i; j < i+window; j++ {
+			if grams[j] <= grams[minIdx] {
+				minIdx = j
+			}
+		}
+		if minIdx != last {
+			fp.prints = append(fp.prints, fingerprint{hash: grams[minIdx], index: minIdx})
+			fp.hashes[grams[minIdx]] = append(fp.hashes[grams[minIdx]], minIdx)
+			last = minIdx
+		}
+	}
+	return fp
+}
+
+// hashList trả về các dấu vân tay khác nhau của văn bản, sắp xếp tăng dần để lưu lại.
+func (fp *essayFingerprint) hashList() []uint64 {
+	list := make([]uint64, 0, len(fp.hashes))
+	for h := range fp.hashes {
+		list = append(list, h)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// mayMatch kiểm tra nhanh trên tập dấu vân tay đã lưu xem hai văn bản có thể đạt ngưỡng trùng lặp theo một trong
+// hai chiều hay không; chỉ khi đó mới cần đọc văn bản để tính đoạn trùng.
+func (fp *essayFingerprint) mayMatch(other map[uint64]bool, minSimilarity float64) bool {
+	if fp.empty() || len(other) == 0 {
+		return false
+	}
+	shared := 0
+	for h := range fp.hashes {
+		if other[h] {
+			shared++
+		}
+	}
+	if shared < minSharedFingerprints {
+		return false
+	}
+	return float64(shared)/float64(len(fp.hashes)) >= minSimilarity || float64(shared)/float64(len(other)) >= minSimilarity
+}
+
+func (fp *essayFingerprint) empty() bool {
+	return len(fp.hashes) == 0
+}
+
+// gramSpan trả về vị trí ký tự [start, end) của k-gram bắt đầu tại từ thứ index.
+func (fp *essayFingerprint) gramSpan(index int) (int, int) {
+	return fp.tokens[index].start, fp.tokens[index+shingleSize-1].end
+}
+
+// compareFingerprints đo mức bài a được tìm thấy trong văn bản b: tỉ lệ dấu vân tay (khác nhau) của a có trong b,
+// kèm các đoạn trùng đã gộp.
+func compareFingerprints(a, b *essayFingerprint) (float64, int, []domain.SimilaritySpan) {
+	if a.empty() || b.empty() {
+		return 0, 0, nil
+	}
+
+	shared := 0
+	var spans []domain.SimilaritySpan
+	for hash, positions := range a.hashes {
+		matched, ok := b.hashes[hash]
+		if !ok {
+			continue
+		}
+		shared++
+		ms, me := b.gramSpan(matched[0])
+		for _, idx := range positions {
+			s, e := a.gramSpan(idx)
+			spans = append(spans, domain.SimilaritySpan{Start: s, End: e, MatchedStart: ms, MatchedEnd: me})
+		}
+	}
+	if shared == 0 {
+		return 0, 0, nil
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].Start < spans[j].Start })
+	merged := []domain.SimilaritySpan{spans[0]}
+	for _, sp := range spans[1:] {
+		cur := &merged[len(merged)-1]
+		// Chỉ gộp khi đoạn tương ứng bên b cũng liền kề, tránh nối hai đoạn chép từ hai chỗ khác nhau
+		if sp.Start <= cur.End && sp.MatchedStart <= cur.MatchedEnd && sp.MatchedEnd >= cur.MatchedStart {
+			if sp.End > cur.End {
+				cur.End = sp.End
+			}
+			if sp.MatchedStart < cur.MatchedStart {
+				cur.MatchedStart = sp.MatchedStart
+			}
+			if sp.MatchedEnd > cur.MatchedEnd {
+				cur.MatchedEnd = sp.MatchedEnd
+			}
+			continue
+		}
+		merged = append(merged, sp)
+	}
+	for i := range merged {
+		merged[i].MatchedText = string(b.runes[merged[i].MatchedStart:merged[i].MatchedEnd])
+	}
+
+	return float64(shared) / float64(len(a.hashes)), shared, merged
+}
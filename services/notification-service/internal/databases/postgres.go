@@ -124,6 +124,50 @@ func seedNotificationData(db *gorm.DB) {
 		</div>
 	`)
 
+	examRegradedBody := fmt.Sprintf(baseTemplate, `
+		<h2 style="color: #111827; margin-top: 0;">Bài thi đã được chấm lại ♻️</h2>
+		<p>Chào <strong>%s</strong>,</p>
+		<p>Giáo viên đã điều chỉnh đáp án và chấm lại bài thi của bạn:</p>
+		<div style="`+boxStyle+`">
+			<p style="margin: 5px 0;"><strong>Bài thi:</strong> %s</p>
+			<p style="margin: 5px 0;"><strong>Điểm cũ:</strong> %.2f</p>
+			<p style="margin: 5px 0; font-size: 18px;"><strong>Điểm mới:</strong> <span style="`+highlightStyle+`">%.2f</span></p>
+			<p style="margin: 5px 0;"><strong>Lý do:</strong> %s</p>
+		</div>
+		<div style="text-align: center;">
+			<a href="http://localhost:3000/dashboard" style="`+buttonStyle+`">Xem Chi Tiết</a>
+		</div>
+	`)
+
+	appealCreatedBody := fmt.Sprintf(baseTemplate, `
+		<h2 style="color: #111827; margin-top: 0;">Có đơn phúc khảo mới 📝</h2>
+		<p>Chào <strong>%s</strong>,</p>
+		<p>Một học sinh vừa gửi đơn phúc khảo điểm:</p>
+		<div style="`+boxStyle+`">
+			<p style="margin: 5px 0;"><strong>Bài thi:</strong> %s</p>
+			<p style="margin: 5px 0;"><strong>Câu hỏi:</strong> #%d</p>
+			<p style="margin: 5px 0;"><strong>Lý do:</strong> %s</p>
+		</div>
+		<div style="text-align: center;">
+			<a href="http://localhost:3000/instructor/appeals" style="`+buttonStyle+`">Xử Lý Ngay</a>
+		</div>
+	`)
+
+	appealResolvedBody := fmt.Sprintf(baseTemplate, `
+		<h2 style="color: #111827; margin-top: 0;">Kết quả phúc khảo ⚖️</h2>
+		<p>Chào <strong>%s</strong>,</p>
+		<p>Giáo viên đã xử lý đơn phúc khảo của bạn:</p>
+		<div style="`+boxStyle+`">
+			<p style="margin: 5px 0;"><strong>Bài thi:</strong> %s</p>
+			<p style="margin: 5px 0;"><strong>Kết quả:</strong> <span style="`+highlightStyle+`">%s</span></p>
+			<p style="margin: 5px 0;"><strong>Phản hồi:</strong> %s</p>
+			<p style="margin: 5px 0;"><strong>Điểm:</strong> %.2f → %.2f</p>
+		</div>
+		<div style="text-align: center;">
+			<a href="http://localhost:3000/dashboard" style="`+buttonStyle+`">Xem Chi Tiết</a>
+		</div>
+	`)
+
 	courseEnrolledBody := fmt.Sprintf(baseTemplate, `
 		<h2 style="color: #111827; margin-top: 0;">Đăng ký thành công! 🎓</h2>
 		<p>Xin chào <strong>%s</strong>,</p>
@@ -148,6 +192,24 @@ func seedNotificationData(db *gorm.DB) {
 			Subject: "Kết quả bài thi: %s",
 			Body:    examSubmittedBody,
 		},
+		{
+			Name:    "exam_regraded",
+			TypeID:  emailChannel.Id,
+			Subject: "Bài thi đã được chấm lại: %s",
+			Body:    examRegradedBody,
+		},
+		{
+			Name:    "appeal_created",
+			TypeID:  emailChannel.Id,
+			Subject: "Đơn phúc khảo mới: %s",
+			Body:    appealCreatedBody,
+		},
+		{
+			Name:    "appeal_resolved",
+			TypeID:  emailChannel.Id,
+			Subject: "Kết quả phúc khảo: %s",
+			Body:    appealResolvedBody,
+		},
 		{
 			Name:    "course_enrolled",
 			TypeID:  emailChannel.Id,
@@ -56,6 +56,10 @@ type NotificationService interface {
 
 	HandleExamSubmittedEvent(ctx context.Context, eventBytes []byte) error
 
+	HandleExamRegradedEvent(ctx context.Context, eventBytes []byte) error
+
+	HandleScoreAppealEvent(ctx context.Context, eventBytes []byte) error
+
 	HandleCourseEnrolledEvent(ctx context.Context, eventBytes []byte) error
 }
 
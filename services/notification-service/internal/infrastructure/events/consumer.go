@@ -45,6 +45,8 @@ func NewKafkaConsumer(service domain.NotificationService) (*KafkaConsumer, error
 	topics := []string{
 		"user_events",
 		"exam_events",
+		"exam_regraded",
+		"score_appeals",
 		"course_events",
 	}
 	if err := c.SubscribeTopics(topics, nil); err != nil {
@@ -94,6 +96,12 @@ func (kc *KafkaConsumer) routeEvent(ctx context.Context, topic string, value []b
 	case "exam_events":
 		err = kc.service.HandleExamSubmittedEvent(ctx, value)
 
+	case "exam_regraded":
+		err = kc.service.HandleExamRegradedEvent(ctx, value)
+
+	case "score_appeals":
+		err = kc.service.HandleScoreAppealEvent(ctx, value)
+
 	case "course_events":
 		err = kc.service.HandleCourseEnrolledEvent(ctx, value)
 
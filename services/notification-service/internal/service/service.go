@@ -168,6 +168,171 @@ func (s *notificationService) HandleExamSubmittedEvent(ctx context.Context, even
 	return err
 }
 
+func (s *notificationService) HandleExamRegradedEvent(ctx context.Context, eventBytes []byte) error {
+	var event contracts.ExamRegradedEvent
+	if err := json.Unmarshal(eventBytes, &event); err != nil {
+		log.Printf("Lỗi parse sự kiện exam_regraded: %v", err)
+		return errors.New("dữ liệu sự kiện không hợp lệ")
+	}
+	if event.Email == "" {
+		log.Printf("Bỏ qua exam_regraded cho user %d: không có email", event.UserID)
+		return nil
+	}
+
+	template, err := s.repo.GetTemplateByName(ctx, "exam_regraded")
+	if err != nil {
+		log.Printf("Không tìm thấy template 'exam_regraded': %v", err)
+		return err
+	}
+
+	reason := event.Reason
+	if reason == "" {
+		reason = "Điều chỉnh đáp án"
+	}
+	body := fmt.Sprintf(template.Body, event.FullName, event.ExamTitle, event.OldScore, event.NewScore, reason)
+	subject := fmt.Sprintf(template.Subject, event.ExamTitle)
+
+	pendingStatus, _ := s.repo.GetStatusByName(ctx, "pending")
+	sentStatus, _ := s.repo.GetStatusByName(ctx, "sent")
+	failedStatus, _ := s.repo.GetStatusByName(ctx, "failed")
+	if pendingStatus == nil || sentStatus == nil || failedStatus == nil {
+		return errors.New("không thể lấy các status ID từ CSDL")
+	}
+
+	var notificationLog *domain.NotificationModel
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		logEntry := &domain.NotificationModel{
+			RecipientID:     event.UserID,
+			TypeID:          template.TypeID,
+			StatusID:        pendingStatus.Id,
+			RenderedContent: body,
+			ScheduledAt:     time.Now().UTC(),
+		}
+		var createErr error
+		notificationLog, createErr = s.repo.CreateNotificationLog(ctx, tx, logEntry)
+		return createErr
+	})
+
+	if err != nil {
+		log.Printf("Lỗi khi tạo log thông báo: %v", err)
+		return err
+	}
+
+	err = s.email.SendEmail(ctx, event.Email, subject, body)
+
+	var statusToUpdate int64
+	var errMsg string
+	if err != nil {
+		log.Printf("LỖI GỬI EMAIL: %v", err)
+		statusToUpdate = failedStatus.Id
+		errMsg = err.Error()
+	} else {
+		log.Printf("Gửi email 'exam_regraded' cho %s thành công", event.Email)
+		statusToUpdate = sentStatus.Id
+		errMsg = ""
+	}
+
+	errUpdate := database.DB.Transaction(func(tx *gorm.DB) error {
+		return s.repo.UpdateLogStatus(ctx, tx, notificationLog.Id, statusToUpdate, errMsg)
+	})
+
+	if errUpdate != nil {
+		log.Printf("LỖI CẬP NHẬT LOG: %v", errUpdate)
+		return err
+	}
+
+	return err
+}
+
+func (s *notificationService) HandleScoreAppealEvent(ctx context.Context, eventBytes []byte) error {
+	var event contracts.ScoreAppealEvent
+	if err := json.Unmarshal(eventBytes, &event); err != nil {
+		log.Printf("Lỗi parse sự kiện score_appeals: %v", err)
+		return errors.New("dữ liệu sự kiện không hợp lệ")
+	}
+	if event.Email == "" {
+		log.Printf("Bỏ qua score_appeals cho user %d: không có email", event.RecipientID)
+		return nil
+	}
+
+	templateName := "appeal_created"
+	if event.Event == "resolved" {
+		templateName = "appeal_resolved"
+	}
+	template, err := s.repo.GetTemplateByName(ctx, templateName)
+	if err != nil {
+		log.Printf("Không tìm thấy template '%s': %v", templateName, err)
+		return err
+	}
+
+	var body string
+	if templateName == "appeal_created" {
+		body = fmt.Sprintf(template.Body, event.FullName, event.ExamTitle, event.QuestionID, event.Reason)
+	} else {
+		result := "Bị từ chối"
+		if event.Status == "accepted" {
+			result = "Được chấp nhận"
+		}
+		response := event.Response
+		if response == "" {
+			response = "Không có"
+		}
+		body = fmt.Sprintf(template.Body, event.FullName, event.ExamTitle, result, response, event.OldScore, event.NewScore)
+	}
+	subject := fmt.Sprintf(template.Subject, event.ExamTitle)
+
+	pendingStatus, _ := s.repo.GetStatusByName(ctx, "pending")
+	sentStatus, _ := s.repo.GetStatusByName(ctx, "sent")
+	failedStatus, _ := s.repo.GetStatusByName(ctx, "failed")
+	if pendingStatus == nil || sentStatus == nil || failedStatus == nil {
+		return errors.New("không thể lấy các status ID từ CSDL")
+	}
+
+	var notificationLog *domain.NotificationModel
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		logEntry := &domain.NotificationModel{
+			RecipientID:     event.RecipientID,
+			TypeID:          template.TypeID,
+			StatusID:        pendingStatus.Id,
+			RenderedContent: body,
+			ScheduledAt:     time.Now().UTC(),
+		}
+		var createErr error
+		notificationLog, createErr = s.repo.CreateNotificationLog(ctx, tx, logEntry)
+		return createErr
+	})
+
+	if err != nil {
+		log.Printf("Lỗi khi tạo log thông báo: %v", err)
+		return err
+	}
+
+	err = s.email.SendEmail(ctx, event.Email, subject, body)
+
+	var statusToUpdate int64
+	var errMsg string
+	if err != nil {
+		log.Printf("LỖI GỬI EMAIL: %v", err)
+		statusToUpdate = failedStatus.Id
+		errMsg = err.Error()
+	} else {
+		log.Printf("Gửi email '%s' cho %s thành công", templateName, event.Email)
+		statusToUpdate = sentStatus.Id
+		errMsg = ""
+	}
+
+	errUpdate := database.DB.Transaction(func(tx *gorm.DB) error {
+		return s.repo.UpdateLogStatus(ctx, tx, notificationLog.Id, statusToUpdate, errMsg)
+	})
+
+	if errUpdate != nil {
+		log.Printf("LỖI CẬP NHẬT LOG: %v", errUpdate)
+		return err
+	}
+
+	return err
+}
+
 func (s *notificationService) HandleCourseEnrolledEvent(ctx context.Context, eventBytes []byte) error {
 	var event contracts.CourseEnrolledEvent
 	if err := json.Unmarshal(eventBytes, &event); err != nil {
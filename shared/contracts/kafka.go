@@ -16,6 +16,36 @@ type ExamSubmittedEvent struct {
 	FullName     string  `json:"full_name"`
 }
 
+type ExamRegradedEvent struct {
+	UserID       int64   `json:"user_id"`
+	ExamID       int64   `json:"exam_id"`
+	SubmissionID int64   `json:"submission_id"`
+	RegradeID    int64   `json:"regrade_id"`
+	ExamTitle    string  `json:"exam_title"`
+	OldScore     float64 `json:"old_score"`
+	NewScore     float64 `json:"new_score"`
+	Reason       string  `json:"reason"`
+	Email        string  `json:"email"`
+	FullName     string  `json:"full_name"`
+}
+
+type ScoreAppealEvent struct {
+	AppealID     int64   `json:"appeal_id"`
+	Event        string  `json:"event"`
+	RecipientID  int64   `json:"recipient_id"`
+	Email        string  `json:"email"`
+	FullName     string  `json:"full_name"`
+	ExamID       int64   `json:"exam_id"`
+	ExamTitle    string  `json:"exam_title"`
+	SubmissionID int64   `json:"submission_id"`
+	QuestionID   int64   `json:"question_id"`
+	Reason       string  `json:"reason"`
+	Status       string  `json:"status"`
+	Response     string  `json:"response"`
+	OldScore     float64 `json:"old_score"`
+	NewScore     float64 `json:"new_score"`
+}
+
 type CourseEnrolledEvent struct {
 	UserID      int64  `json:"user_id"`
 	CourseID    int64  `json:"course_id"`
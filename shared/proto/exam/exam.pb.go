@@ -522,6 +522,9 @@ type ChoiceInput struct {
 	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	IsCorrect     bool                   `protobuf:"varint,2,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
 	AttachmentUrl string                 `protobuf:"bytes,3,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
+	MatchTarget   string                 `protobuf:"bytes,4,opt,name=match_target,json=matchTarget,proto3" json:"match_target,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	PinLast       bool                   `protobuf:"varint,6,opt,name=pin_last,json=pinLast,proto3" json:"pin_last,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -577,6 +580,27 @@ func (x *ChoiceInput) GetAttachmentUrl() string {
 	return ""
 }
 
+func (x *ChoiceInput) GetMatchTarget() string {
+	if x != nil {
+		return x.MatchTarget
+	}
+	return ""
+}
+
+func (x *ChoiceInput) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChoiceInput) GetPinLast() bool {
+	if x != nil {
+		return x.PinLast
+	}
+	return false
+}
+
 type CreateQuestionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SectionId     int64                  `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
@@ -587,6 +611,8 @@ type CreateQuestionRequest struct {
 	Choices       []*ChoiceInput         `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
 	CreatorId     int64                  `protobuf:"varint,7,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
 	AttachmentUrl string                 `protobuf:"bytes,8,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
+	Numeric       *NumericAnswerConfig   `protobuf:"bytes,9,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	Blanks        []*ClozeBlank          `protobuf:"bytes,10,rep,name=blanks,proto3" json:"blanks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -677,6 +703,20 @@ func (x *CreateQuestionRequest) GetAttachmentUrl() string {
 	return ""
 }
 
+func (x *CreateQuestionRequest) GetNumeric() *NumericAnswerConfig {
+	if x != nil {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetBlanks() []*ClozeBlank {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
 type CreateQuestionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -938,11 +978,15 @@ func (x *GetUploadURLResponse) GetFinalUrl() string {
 }
 
 type ImportQuestionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatorId     int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	FileContent   []byte                 `protobuf:"bytes,3,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId      int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	FileContent    []byte                 `protobuf:"bytes,3,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	Format         string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	FileName       string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	DefaultTopic   string                 `protobuf:"bytes,6,opt,name=default_topic,json=defaultTopic,proto3" json:"default_topic,omitempty"`
+	DefaultSection string                 `protobuf:"bytes,7,opt,name=default_section,json=defaultSection,proto3" json:"default_section,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ImportQuestionsRequest) Reset() {
@@ -989,10 +1033,40 @@ func (x *ImportQuestionsRequest) GetFileContent() []byte {
 	return nil
 }
 
+func (x *ImportQuestionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportQuestionsRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportQuestionsRequest) GetDefaultTopic() string {
+	if x != nil {
+		return x.DefaultTopic
+	}
+	return ""
+}
+
+func (x *ImportQuestionsRequest) GetDefaultSection() string {
+	if x != nil {
+		return x.DefaultSection
+	}
+	return ""
+}
+
 type ImportQuestionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SuccessCount  int32                  `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
 	ErrorCount    int32                  `protobuf:"varint,2,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	Errors        []*QuestionBankError   `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1041,6 +1115,20 @@ func (x *ImportQuestionsResponse) GetErrorCount() int32 {
 	return 0
 }
 
+func (x *ImportQuestionsResponse) GetErrors() []*QuestionBankError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportQuestionsResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type ExamSettings struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	DurationMinutes       int32                  `protobuf:"varint,1,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
@@ -1053,6 +1141,13 @@ type ExamSettings struct {
 	RequiresApproval      bool                   `protobuf:"varint,8,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
 	IsDynamic             bool                   `protobuf:"varint,9,opt,name=is_dynamic,json=isDynamic,proto3" json:"is_dynamic,omitempty"`
 	DynamicConfig         string                 `protobuf:"bytes,10,opt,name=dynamic_config,json=dynamicConfig,proto3" json:"dynamic_config,omitempty"`
+	ScoringPolicy         string                 `protobuf:"bytes,11,opt,name=scoring_policy,json=scoringPolicy,proto3" json:"scoring_policy,omitempty"`
+	NegativeMarking       float32                `protobuf:"fixed32,12,opt,name=negative_marking,json=negativeMarking,proto3" json:"negative_marking,omitempty"`
+	ScoreScale            string                 `protobuf:"bytes,13,opt,name=score_scale,json=scoreScale,proto3" json:"score_scale,omitempty"`
+	IsAdaptive            bool                   `protobuf:"varint,14,opt,name=is_adaptive,json=isAdaptive,proto3" json:"is_adaptive,omitempty"`
+	AdaptiveConfig        string                 `protobuf:"bytes,15,opt,name=adaptive_config,json=adaptiveConfig,proto3" json:"adaptive_config,omitempty"`
+	IsPractice            bool                   `protobuf:"varint,16,opt,name=is_practice,json=isPractice,proto3" json:"is_practice,omitempty"`
+	ShuffleChoices        bool                   `protobuf:"varint,17,opt,name=shuffle_choices,json=shuffleChoices,proto3" json:"shuffle_choices,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -1157,6 +1252,55 @@ func (x *ExamSettings) GetDynamicConfig() string {
 	return ""
 }
 
+func (x *ExamSettings) GetScoringPolicy() string {
+	if x != nil {
+		return x.ScoringPolicy
+	}
+	return ""
+}
+
+func (x *ExamSettings) GetNegativeMarking() float32 {
+	if x != nil {
+		return x.NegativeMarking
+	}
+	return 0
+}
+
+func (x *ExamSettings) GetScoreScale() string {
+	if x != nil {
+		return x.ScoreScale
+	}
+	return ""
+}
+
+func (x *ExamSettings) GetIsAdaptive() bool {
+	if x != nil {
+		return x.IsAdaptive
+	}
+	return false
+}
+
+func (x *ExamSettings) GetAdaptiveConfig() string {
+	if x != nil {
+		return x.AdaptiveConfig
+	}
+	return ""
+}
+
+func (x *ExamSettings) GetIsPractice() bool {
+	if x != nil {
+		return x.IsPractice
+	}
+	return false
+}
+
+func (x *ExamSettings) GetShuffleChoices() bool {
+	if x != nil {
+		return x.ShuffleChoices
+	}
+	return false
+}
+
 type QuestionAssignment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
@@ -1519,6 +1663,9 @@ type ChoiceDetails struct {
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	IsCorrect     bool                   `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
 	AttachmentUrl string                 `protobuf:"bytes,4,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
+	MatchTarget   string                 `protobuf:"bytes,5,opt,name=match_target,json=matchTarget,proto3" json:"match_target,omitempty"`
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	PinLast       bool                   `protobuf:"varint,7,opt,name=pin_last,json=pinLast,proto3" json:"pin_last,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1581,6 +1728,27 @@ func (x *ChoiceDetails) GetAttachmentUrl() string {
 	return ""
 }
 
+func (x *ChoiceDetails) GetMatchTarget() string {
+	if x != nil {
+		return x.MatchTarget
+	}
+	return ""
+}
+
+func (x *ChoiceDetails) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChoiceDetails) GetPinLast() bool {
+	if x != nil {
+		return x.PinLast
+	}
+	return false
+}
+
 type QuestionDetails struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1595,6 +1763,12 @@ type QuestionDetails struct {
 	SectionId     int64                  `protobuf:"varint,10,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
 	TopicId       int64                  `protobuf:"varint,11,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	Points        float32                `protobuf:"fixed32,12,opt,name=points,proto3" json:"points,omitempty"`
+	Numeric       *NumericAnswerConfig   `protobuf:"bytes,13,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	Blanks        []*ClozeBlank          `protobuf:"bytes,14,rep,name=blanks,proto3" json:"blanks,omitempty"`
+	MatchOptions  []string               `protobuf:"bytes,15,rep,name=match_options,json=matchOptions,proto3" json:"match_options,omitempty"`
+	BlankCount    int32                  `protobuf:"varint,16,opt,name=blank_count,json=blankCount,proto3" json:"blank_count,omitempty"`
+	Version       int32                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
+	RubricId      int64                  `protobuf:"varint,18,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1713,6 +1887,48 @@ func (x *QuestionDetails) GetPoints() float32 {
 	return 0
 }
 
+func (x *QuestionDetails) GetNumeric() *NumericAnswerConfig {
+	if x != nil {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *QuestionDetails) GetBlanks() []*ClozeBlank {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
+func (x *QuestionDetails) GetMatchOptions() []string {
+	if x != nil {
+		return x.MatchOptions
+	}
+	return nil
+}
+
+func (x *QuestionDetails) GetBlankCount() int32 {
+	if x != nil {
+		return x.BlankCount
+	}
+	return 0
+}
+
+func (x *QuestionDetails) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *QuestionDetails) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
 type GetExamDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
@@ -2118,11 +2334,16 @@ func (x *CheckExamAccessRequest) GetUserId() int64 {
 }
 
 type CheckExamAccessResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanAccess     bool                   `protobuf:"varint,1,opt,name=can_access,json=canAccess,proto3" json:"can_access,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanAccess       bool                   `protobuf:"varint,1,opt,name=can_access,json=canAccess,proto3" json:"can_access,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MaxAttempts     int32                  `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	AttemptsUsed    int32                  `protobuf:"varint,4,opt,name=attempts_used,json=attemptsUsed,proto3" json:"attempts_used,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	EndTime         string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Accommodation   *AccommodationTerms    `protobuf:"bytes,7,opt,name=accommodation,proto3" json:"accommodation,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CheckExamAccessResponse) Reset() {
@@ -2169,6 +2390,41 @@ func (x *CheckExamAccessResponse) GetMessage() string {
 	return ""
 }
 
+func (x *CheckExamAccessResponse) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *CheckExamAccessResponse) GetAttemptsUsed() int32 {
+	if x != nil {
+		return x.AttemptsUsed
+	}
+	return 0
+}
+
+func (x *CheckExamAccessResponse) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *CheckExamAccessResponse) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CheckExamAccessResponse) GetAccommodation() *AccommodationTerms {
+	if x != nil {
+		return x.Accommodation
+	}
+	return nil
+}
+
 type GetQuestionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
@@ -2266,6 +2522,9 @@ type UpdateQuestionRequest struct {
 	Explanation   string                 `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
 	Choices       []*ChoiceInput         `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
 	AttachmentUrl string                 `protobuf:"bytes,7,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
+	Numeric       *NumericAnswerConfig   `protobuf:"bytes,8,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	Blanks        []*ClozeBlank          `protobuf:"bytes,9,rep,name=blanks,proto3" json:"blanks,omitempty"`
+	EditorId      int64                  `protobuf:"varint,10,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2349,6 +2608,27 @@ func (x *UpdateQuestionRequest) GetAttachmentUrl() string {
 	return ""
 }
 
+func (x *UpdateQuestionRequest) GetNumeric() *NumericAnswerConfig {
+	if x != nil {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetBlanks() []*ClozeBlank {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
 type UpdateQuestionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -2577,6 +2857,7 @@ type ExamListItem struct {
 	TopicId         int64                  `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	CreatorId       int64                  `protobuf:"varint,5,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
 	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	IsPractice      bool                   `protobuf:"varint,7,opt,name=is_practice,json=isPractice,proto3" json:"is_practice,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2653,6 +2934,13 @@ func (x *ExamListItem) GetStatus() string {
 	return ""
 }
 
+func (x *ExamListItem) GetIsPractice() bool {
+	if x != nil {
+		return x.IsPractice
+	}
+	return false
+}
+
 type GetExamsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
@@ -3110,12 +3398,15 @@ func (x *PublishExamResponse) GetSuccess() bool {
 }
 
 type UserAnswer struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	QuestionId     int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	ChosenChoiceId int64                  `protobuf:"varint,2,opt,name=chosen_choice_id,json=chosenChoiceId,proto3" json:"chosen_choice_id,omitempty"`
-	TextAnswer     string                 `protobuf:"bytes,3,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId       int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ChosenChoiceId   int64                  `protobuf:"varint,2,opt,name=chosen_choice_id,json=chosenChoiceId,proto3" json:"chosen_choice_id,omitempty"`
+	TextAnswer       string                 `protobuf:"bytes,3,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
+	OrderedChoiceIds []int64                `protobuf:"varint,4,rep,packed,name=ordered_choice_ids,json=orderedChoiceIds,proto3" json:"ordered_choice_ids,omitempty"`
+	Matches          []*MatchAnswer         `protobuf:"bytes,5,rep,name=matches,proto3" json:"matches,omitempty"`
+	Blanks           []string               `protobuf:"bytes,6,rep,name=blanks,proto3" json:"blanks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UserAnswer) Reset() {
@@ -3169,6 +3460,27 @@ func (x *UserAnswer) GetTextAnswer() string {
 	return ""
 }
 
+func (x *UserAnswer) GetOrderedChoiceIds() []int64 {
+	if x != nil {
+		return x.OrderedChoiceIds
+	}
+	return nil
+}
+
+func (x *UserAnswer) GetMatches() []*MatchAnswer {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *UserAnswer) GetBlanks() []string {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
 type SubmitExamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
@@ -3382,20 +3694,28 @@ func (x *GetSubmissionRequest) GetUserId() int64 {
 }
 
 type SubmissionDetail struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	QuestionId      int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	QuestionContent string                 `protobuf:"bytes,2,opt,name=question_content,json=questionContent,proto3" json:"question_content,omitempty"`
-	Explanation     string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
-	QuestionType    string                 `protobuf:"bytes,4,opt,name=question_type,json=questionType,proto3" json:"question_type,omitempty"`
-	IsCorrect       bool                   `protobuf:"varint,5,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
-	Choices         []*ChoiceReview        `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
-	AttachmentUrl   string                 `protobuf:"bytes,7,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
-	TextAnswer      string                 `protobuf:"bytes,8,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
-	AwardedPoints   float32                `protobuf:"fixed32,9,opt,name=awarded_points,json=awardedPoints,proto3" json:"awarded_points,omitempty"`
-	Points          float32                `protobuf:"fixed32,10,opt,name=points,proto3" json:"points,omitempty"`
-	IsGraded        bool                   `protobuf:"varint,11,opt,name=is_graded,json=isGraded,proto3" json:"is_graded,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId        int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionContent   string                 `protobuf:"bytes,2,opt,name=question_content,json=questionContent,proto3" json:"question_content,omitempty"`
+	Explanation       string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	QuestionType      string                 `protobuf:"bytes,4,opt,name=question_type,json=questionType,proto3" json:"question_type,omitempty"`
+	IsCorrect         bool                   `protobuf:"varint,5,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	Choices           []*ChoiceReview        `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
+	AttachmentUrl     string                 `protobuf:"bytes,7,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
+	TextAnswer        string                 `protobuf:"bytes,8,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
+	AwardedPoints     float32                `protobuf:"fixed32,9,opt,name=awarded_points,json=awardedPoints,proto3" json:"awarded_points,omitempty"`
+	Points            float32                `protobuf:"fixed32,10,opt,name=points,proto3" json:"points,omitempty"`
+	IsGraded          bool                   `protobuf:"varint,11,opt,name=is_graded,json=isGraded,proto3" json:"is_graded,omitempty"`
+	OrderedChoiceIds  []int64                `protobuf:"varint,12,rep,packed,name=ordered_choice_ids,json=orderedChoiceIds,proto3" json:"ordered_choice_ids,omitempty"`
+	Matches           []*MatchAnswer         `protobuf:"bytes,13,rep,name=matches,proto3" json:"matches,omitempty"`
+	Blanks            []string               `protobuf:"bytes,14,rep,name=blanks,proto3" json:"blanks,omitempty"`
+	Numeric           *NumericAnswerConfig   `protobuf:"bytes,15,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	CorrectBlanks     []*ClozeBlank          `protobuf:"bytes,16,rep,name=correct_blanks,json=correctBlanks,proto3" json:"correct_blanks,omitempty"`
+	Rubric            *RubricResult          `protobuf:"bytes,17,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	Feedback          string                 `protobuf:"bytes,18,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	SimilarityMatches []*SimilarityMatch     `protobuf:"bytes,19,rep,name=similarity_matches,json=similarityMatches,proto3" json:"similarity_matches,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SubmissionDetail) Reset() {
@@ -3505,34 +3825,95 @@ func (x *SubmissionDetail) GetIsGraded() bool {
 	return false
 }
 
-type ChoiceReview struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	IsCorrect     bool                   `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
-	UserSelected  bool                   `protobuf:"varint,4,opt,name=user_selected,json=userSelected,proto3" json:"user_selected,omitempty"`
-	AttachmentUrl string                 `protobuf:"bytes,5,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SubmissionDetail) GetOrderedChoiceIds() []int64 {
+	if x != nil {
+		return x.OrderedChoiceIds
+	}
+	return nil
 }
 
-func (x *ChoiceReview) Reset() {
-	*x = ChoiceReview{}
-	mi := &file_exam_proto_msgTypes[57]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SubmissionDetail) GetMatches() []*MatchAnswer {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
 }
 
-func (x *ChoiceReview) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubmissionDetail) GetBlanks() []string {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
 }
 
-func (*ChoiceReview) ProtoMessage() {}
-
-func (x *ChoiceReview) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_proto_msgTypes[57]
+func (x *SubmissionDetail) GetNumeric() *NumericAnswerConfig {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *SubmissionDetail) GetCorrectBlanks() []*ClozeBlank {
+	if x != nil {
+		return x.CorrectBlanks
+	}
+	return nil
+}
+
+func (x *SubmissionDetail) GetRubric() *RubricResult {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *SubmissionDetail) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *SubmissionDetail) GetSimilarityMatches() []*SimilarityMatch {
+	if x != nil {
+		return x.SimilarityMatches
+	}
+	return nil
+}
+
+type ChoiceReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	IsCorrect     bool                   `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	UserSelected  bool                   `protobuf:"varint,4,opt,name=user_selected,json=userSelected,proto3" json:"user_selected,omitempty"`
+	AttachmentUrl string                 `protobuf:"bytes,5,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
+	MatchTarget   string                 `protobuf:"bytes,6,opt,name=match_target,json=matchTarget,proto3" json:"match_target,omitempty"`
+	Position      int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	Label         string                 `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	OriginalLabel string                 `protobuf:"bytes,9,opt,name=original_label,json=originalLabel,proto3" json:"original_label,omitempty"`
+	PinLast       bool                   `protobuf:"varint,10,opt,name=pin_last,json=pinLast,proto3" json:"pin_last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoiceReview) Reset() {
+	*x = ChoiceReview{}
+	mi := &file_exam_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceReview) ProtoMessage() {}
+
+func (x *ChoiceReview) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
@@ -3581,6 +3962,41 @@ func (x *ChoiceReview) GetAttachmentUrl() string {
 	return ""
 }
 
+func (x *ChoiceReview) GetMatchTarget() string {
+	if x != nil {
+		return x.MatchTarget
+	}
+	return ""
+}
+
+func (x *ChoiceReview) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChoiceReview) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ChoiceReview) GetOriginalLabel() string {
+	if x != nil {
+		return x.OriginalLabel
+	}
+	return ""
+}
+
+func (x *ChoiceReview) GetPinLast() bool {
+	if x != nil {
+		return x.PinLast
+	}
+	return false
+}
+
 type GetSubmissionResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3850,16 +4266,20 @@ func (x *GetExamCountResponse) GetCount() int64 {
 }
 
 type SaveAnswerRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ExamId         int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	QuestionId     int64                  `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	ChosenChoiceId int64                  `protobuf:"varint,4,opt,name=chosen_choice_id,json=chosenChoiceId,proto3" json:"chosen_choice_id,omitempty"`
-	IpAddress      string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	UserAgent      string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	TextAnswer     string                 `protobuf:"bytes,7,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExamId           int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionId       int64                  `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ChosenChoiceId   int64                  `protobuf:"varint,4,opt,name=chosen_choice_id,json=chosenChoiceId,proto3" json:"chosen_choice_id,omitempty"`
+	IpAddress        string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent        string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	TextAnswer       string                 `protobuf:"bytes,7,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
+	OrderedChoiceIds []int64                `protobuf:"varint,8,rep,packed,name=ordered_choice_ids,json=orderedChoiceIds,proto3" json:"ordered_choice_ids,omitempty"`
+	Matches          []*MatchAnswer         `protobuf:"bytes,9,rep,name=matches,proto3" json:"matches,omitempty"`
+	Blanks           []string               `protobuf:"bytes,10,rep,name=blanks,proto3" json:"blanks,omitempty"`
+	Seq              int64                  `protobuf:"varint,11,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SaveAnswerRequest) Reset() {
@@ -3941,9 +4361,40 @@ func (x *SaveAnswerRequest) GetTextAnswer() string {
 	return ""
 }
 
+func (x *SaveAnswerRequest) GetOrderedChoiceIds() []int64 {
+	if x != nil {
+		return x.OrderedChoiceIds
+	}
+	return nil
+}
+
+func (x *SaveAnswerRequest) GetMatches() []*MatchAnswer {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SaveAnswerRequest) GetBlanks() []string {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
+func (x *SaveAnswerRequest) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
 type SaveAnswerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Feedback      *PracticeFeedback      `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3985,6 +4436,27 @@ func (x *SaveAnswerResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *SaveAnswerResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SaveAnswerResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *SaveAnswerResponse) GetFeedback() *PracticeFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
 type LogViolationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -4103,6 +4575,9 @@ type GradeEssayRequest struct {
 	QuestionId    int64                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
 	IsCorrect     bool                   `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
 	ScoreRatio    float32                `protobuf:"fixed32,4,opt,name=score_ratio,json=scoreRatio,proto3" json:"score_ratio,omitempty"`
+	RubricScores  []*RubricSelection     `protobuf:"bytes,5,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	Feedback      string                 `protobuf:"bytes,6,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	GraderId      int64                  `protobuf:"varint,7,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4165,9 +4640,32 @@ func (x *GradeEssayRequest) GetScoreRatio() float32 {
 	return 0
 }
 
+func (x *GradeEssayRequest) GetRubricScores() []*RubricSelection {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *GradeEssayRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *GradeEssayRequest) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
 type GradeEssayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	AwardedPoints float32                `protobuf:"fixed32,2,opt,name=awarded_points,json=awardedPoints,proto3" json:"awarded_points,omitempty"`
+	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4209,6 +4707,20 @@ func (x *GradeEssayResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GradeEssayResponse) GetAwardedPoints() float32 {
+	if x != nil {
+		return x.AwardedPoints
+	}
+	return 0
+}
+
+func (x *GradeEssayResponse) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
 type GetExamStatsDetailedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
@@ -4349,6 +4861,9 @@ type SubmissionSummary struct {
 	TotalQuestions int32                  `protobuf:"varint,8,opt,name=total_questions,json=totalQuestions,proto3" json:"total_questions,omitempty"`
 	AttemptNumber  int32                  `protobuf:"varint,9,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
 	ExamTitle      string                 `protobuf:"bytes,10,opt,name=exam_title,json=examTitle,proto3" json:"exam_title,omitempty"`
+	RiskScore      float32                `protobuf:"fixed32,11,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	Flagged        bool                   `protobuf:"varint,12,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	RiskReasons    []string               `protobuf:"bytes,13,rep,name=risk_reasons,json=riskReasons,proto3" json:"risk_reasons,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -4453,6 +4968,27 @@ func (x *SubmissionSummary) GetExamTitle() string {
 	return ""
 }
 
+func (x *SubmissionSummary) GetRiskScore() float32 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *SubmissionSummary) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *SubmissionSummary) GetRiskReasons() []string {
+	if x != nil {
+		return x.RiskReasons
+	}
+	return nil
+}
+
 type GetExamSubmissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
@@ -5132,6 +5668,7 @@ type ExportQuestionsRequest struct {
 	Difficulty    string                 `protobuf:"bytes,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
 	Search        string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
 	CreatorId     int64                  `protobuf:"varint,5,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5201,9 +5738,18 @@ func (x *ExportQuestionsRequest) GetCreatorId() int64 {
 	return 0
 }
 
+func (x *ExportQuestionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type ExportQuestionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileUrl       string                 `protobuf:"bytes,1,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	ExportedCount int32                  `protobuf:"varint,2,opt,name=exported_count,json=exportedCount,proto3" json:"exported_count,omitempty"`
+	Errors        []*QuestionBankError   `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5245,12 +5791,27 @@ func (x *ExportQuestionsResponse) GetFileUrl() string {
 	return ""
 }
 
+func (x *ExportQuestionsResponse) GetExportedCount() int32 {
+	if x != nil {
+		return x.ExportedCount
+	}
+	return 0
+}
+
+func (x *ExportQuestionsResponse) GetErrors() []*QuestionBankError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 type StartExamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
 	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
 	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RetryWrong    bool                   `protobuf:"varint,5,opt,name=retry_wrong,json=retryWrong,proto3" json:"retry_wrong,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5313,13 +5874,23 @@ func (x *StartExamRequest) GetUserAgent() string {
 	return ""
 }
 
+func (x *StartExamRequest) GetRetryWrong() bool {
+	if x != nil {
+		return x.RetryWrong
+	}
+	return false
+}
+
 type AnswerDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	ChoiceIds     []int64                `protobuf:"varint,2,rep,packed,name=choice_ids,json=choiceIds,proto3" json:"choice_ids,omitempty"`
-	TextAnswer    string                 `protobuf:"bytes,3,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId       int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ChoiceIds        []int64                `protobuf:"varint,2,rep,packed,name=choice_ids,json=choiceIds,proto3" json:"choice_ids,omitempty"`
+	TextAnswer       string                 `protobuf:"bytes,3,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
+	OrderedChoiceIds []int64                `protobuf:"varint,4,rep,packed,name=ordered_choice_ids,json=orderedChoiceIds,proto3" json:"ordered_choice_ids,omitempty"`
+	Matches          []*MatchAnswer         `protobuf:"bytes,5,rep,name=matches,proto3" json:"matches,omitempty"`
+	Blanks           []string               `protobuf:"bytes,6,rep,name=blanks,proto3" json:"blanks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *AnswerDetail) Reset() {
@@ -5373,12 +5944,36 @@ func (x *AnswerDetail) GetTextAnswer() string {
 	return ""
 }
 
+func (x *AnswerDetail) GetOrderedChoiceIds() []int64 {
+	if x != nil {
+		return x.OrderedChoiceIds
+	}
+	return nil
+}
+
+func (x *AnswerDetail) GetMatches() []*MatchAnswer {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *AnswerDetail) GetBlanks() []string {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
 type StartExamResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	SubmissionId     int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
 	RemainingSeconds int32                  `protobuf:"varint,2,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
 	Questions        []*QuestionDetails     `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
 	CurrentAnswers   []*AnswerDetail        `protobuf:"bytes,4,rep,name=current_answers,json=currentAnswers,proto3" json:"current_answers,omitempty"`
+	IsAdaptive       bool                   `protobuf:"varint,5,opt,name=is_adaptive,json=isAdaptive,proto3" json:"is_adaptive,omitempty"`
+	Paused           bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	IsPractice       bool                   `protobuf:"varint,7,opt,name=is_practice,json=isPractice,proto3" json:"is_practice,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -5441,6 +6036,27 @@ func (x *StartExamResponse) GetCurrentAnswers() []*AnswerDetail {
 	return nil
 }
 
+func (x *StartExamResponse) GetIsAdaptive() bool {
+	if x != nil {
+		return x.IsAdaptive
+	}
+	return false
+}
+
+func (x *StartExamResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *StartExamResponse) GetIsPractice() bool {
+	if x != nil {
+		return x.IsPractice
+	}
+	return false
+}
+
 type Int64List struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Values        []int64                `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
@@ -6344,6 +6960,8 @@ type Exam struct {
 	EndTime         string                 `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	MaxAttempts     int32                  `protobuf:"varint,12,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
 	AttemptsUsed    int32                  `protobuf:"varint,13,opt,name=attempts_used,json=attemptsUsed,proto3" json:"attempts_used,omitempty"`
+	ScoreScale      string                 `protobuf:"bytes,14,opt,name=score_scale,json=scoreScale,proto3" json:"score_scale,omitempty"`
+	IsPractice      bool                   `protobuf:"varint,15,opt,name=is_practice,json=isPractice,proto3" json:"is_practice,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -6469,6 +7087,20 @@ func (x *Exam) GetAttemptsUsed() int32 {
 	return 0
 }
 
+func (x *Exam) GetScoreScale() string {
+	if x != nil {
+		return x.ScoreScale
+	}
+	return ""
+}
+
+func (x *Exam) GetIsPractice() bool {
+	if x != nil {
+		return x.IsPractice
+	}
+	return false
+}
+
 type GetExamPreviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
@@ -7013,31 +7645,10959 @@ func (x *GetClassGradebookResponse) GetGrades() []*StudentGrade {
 	return nil
 }
 
-var File_exam_proto protoreflect.FileDescriptor
+type GetItemAnalysisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_exam_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"exam.proto\x12\x04exam\"M\n" +
-	"\x05Topic\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"j\n" +
-	"\aSection\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
-	"\btopic_id\x18\x04 \x01(\x03R\atopicId\"J\n" +
-	"\x12CreateTopicRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"8\n" +
-	"\x13CreateTopicResponse\x12!\n" +
-	"\x05topic\x18\x01 \x01(\v2\v.exam.TopicR\x05topic\"\x12\n" +
-	"\x10GetTopicsRequest\"8\n" +
-	"\x11GetTopicsResponse\x12#\n" +
-	"\x06topics\x18\x01 \x03(\v2\v.exam.TopicR\x06topics\"g\n" +
-	"\x14CreateSectionRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+func (x *GetItemAnalysisRequest) Reset() {
+	*x = GetItemAnalysisRequest{}
+	mi := &file_exam_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemAnalysisRequest) ProtoMessage() {}
+
+func (x *GetItemAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetItemAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetItemAnalysisRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetItemAnalysisRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type DistractorStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChoiceId      int64                  `protobuf:"varint,1,opt,name=choice_id,json=choiceId,proto3" json:"choice_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	IsCorrect     bool                   `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	UpperCount    int32                  `protobuf:"varint,5,opt,name=upper_count,json=upperCount,proto3" json:"upper_count,omitempty"`
+	LowerCount    int32                  `protobuf:"varint,6,opt,name=lower_count,json=lowerCount,proto3" json:"lower_count,omitempty"`
+	SelectionRate float64                `protobuf:"fixed64,7,opt,name=selection_rate,json=selectionRate,proto3" json:"selection_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistractorStat) Reset() {
+	*x = DistractorStat{}
+	mi := &file_exam_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistractorStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistractorStat) ProtoMessage() {}
+
+func (x *DistractorStat) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistractorStat.ProtoReflect.Descriptor instead.
+func (*DistractorStat) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *DistractorStat) GetChoiceId() int64 {
+	if x != nil {
+		return x.ChoiceId
+	}
+	return 0
+}
+
+func (x *DistractorStat) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DistractorStat) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *DistractorStat) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *DistractorStat) GetUpperCount() int32 {
+	if x != nil {
+		return x.UpperCount
+	}
+	return 0
+}
+
+func (x *DistractorStat) GetLowerCount() int32 {
+	if x != nil {
+		return x.LowerCount
+	}
+	return 0
+}
+
+func (x *DistractorStat) GetSelectionRate() float64 {
+	if x != nil {
+		return x.SelectionRate
+	}
+	return 0
+}
+
+type ItemStat struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId          int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Content             string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	QuestionType        string                 `protobuf:"bytes,3,opt,name=question_type,json=questionType,proto3" json:"question_type,omitempty"`
+	Responses           int32                  `protobuf:"varint,4,opt,name=responses,proto3" json:"responses,omitempty"`
+	PValue              float64                `protobuf:"fixed64,5,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	PointBiserial       float64                `protobuf:"fixed64,6,opt,name=point_biserial,json=pointBiserial,proto3" json:"point_biserial,omitempty"`
+	DiscriminationIndex float64                `protobuf:"fixed64,7,opt,name=discrimination_index,json=discriminationIndex,proto3" json:"discrimination_index,omitempty"`
+	Distractors         []*DistractorStat      `protobuf:"bytes,8,rep,name=distractors,proto3" json:"distractors,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ItemStat) Reset() {
+	*x = ItemStat{}
+	mi := &file_exam_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemStat) ProtoMessage() {}
+
+func (x *ItemStat) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemStat.ProtoReflect.Descriptor instead.
+func (*ItemStat) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ItemStat) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ItemStat) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ItemStat) GetQuestionType() string {
+	if x != nil {
+		return x.QuestionType
+	}
+	return ""
+}
+
+func (x *ItemStat) GetResponses() int32 {
+	if x != nil {
+		return x.Responses
+	}
+	return 0
+}
+
+func (x *ItemStat) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *ItemStat) GetPointBiserial() float64 {
+	if x != nil {
+		return x.PointBiserial
+	}
+	return 0
+}
+
+func (x *ItemStat) GetDiscriminationIndex() float64 {
+	if x != nil {
+		return x.DiscriminationIndex
+	}
+	return 0
+}
+
+func (x *ItemStat) GetDistractors() []*DistractorStat {
+	if x != nil {
+		return x.Distractors
+	}
+	return nil
+}
+
+type GetItemAnalysisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	StudentCount  int32                  `protobuf:"varint,2,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	Kr20          float64                `protobuf:"fixed64,3,opt,name=kr20,proto3" json:"kr20,omitempty"`
+	MeanScore     float64                `protobuf:"fixed64,4,opt,name=mean_score,json=meanScore,proto3" json:"mean_score,omitempty"`
+	ScoreStdDev   float64                `protobuf:"fixed64,5,opt,name=score_std_dev,json=scoreStdDev,proto3" json:"score_std_dev,omitempty"`
+	Items         []*ItemStat            `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemAnalysisResponse) Reset() {
+	*x = GetItemAnalysisResponse{}
+	mi := &file_exam_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemAnalysisResponse) ProtoMessage() {}
+
+func (x *GetItemAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetItemAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetItemAnalysisResponse) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetItemAnalysisResponse) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *GetItemAnalysisResponse) GetKr20() float64 {
+	if x != nil {
+		return x.Kr20
+	}
+	return 0
+}
+
+func (x *GetItemAnalysisResponse) GetMeanScore() float64 {
+	if x != nil {
+		return x.MeanScore
+	}
+	return 0
+}
+
+func (x *GetItemAnalysisResponse) GetScoreStdDev() float64 {
+	if x != nil {
+		return x.ScoreStdDev
+	}
+	return 0
+}
+
+func (x *GetItemAnalysisResponse) GetItems() []*ItemStat {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetNextAdaptiveQuestionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ExamId           int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IpAddress        string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent        string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	QuestionId       int64                  `protobuf:"varint,5,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ChoiceIds        []int64                `protobuf:"varint,6,rep,packed,name=choice_ids,json=choiceIds,proto3" json:"choice_ids,omitempty"`
+	TextAnswer       string                 `protobuf:"bytes,7,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
+	OrderedChoiceIds []int64                `protobuf:"varint,8,rep,packed,name=ordered_choice_ids,json=orderedChoiceIds,proto3" json:"ordered_choice_ids,omitempty"`
+	Matches          []*MatchAnswer         `protobuf:"bytes,9,rep,name=matches,proto3" json:"matches,omitempty"`
+	Blanks           []string               `protobuf:"bytes,10,rep,name=blanks,proto3" json:"blanks,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNextAdaptiveQuestionRequest) Reset() {
+	*x = GetNextAdaptiveQuestionRequest{}
+	mi := &file_exam_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextAdaptiveQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextAdaptiveQuestionRequest) ProtoMessage() {}
+
+func (x *GetNextAdaptiveQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextAdaptiveQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GetNextAdaptiveQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetChoiceIds() []int64 {
+	if x != nil {
+		return x.ChoiceIds
+	}
+	return nil
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetTextAnswer() string {
+	if x != nil {
+		return x.TextAnswer
+	}
+	return ""
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetOrderedChoiceIds() []int64 {
+	if x != nil {
+		return x.OrderedChoiceIds
+	}
+	return nil
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetMatches() []*MatchAnswer {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GetNextAdaptiveQuestionRequest) GetBlanks() []string {
+	if x != nil {
+		return x.Blanks
+	}
+	return nil
+}
+
+type GetNextAdaptiveQuestionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Finished         bool                   `protobuf:"varint,1,opt,name=finished,proto3" json:"finished,omitempty"`
+	Question         *QuestionDetails       `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Theta            float64                `protobuf:"fixed64,3,opt,name=theta,proto3" json:"theta,omitempty"`
+	StandardError    float64                `protobuf:"fixed64,4,opt,name=standard_error,json=standardError,proto3" json:"standard_error,omitempty"`
+	AnsweredCount    int32                  `protobuf:"varint,5,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	MaxQuestions     int32                  `protobuf:"varint,6,opt,name=max_questions,json=maxQuestions,proto3" json:"max_questions,omitempty"`
+	RemainingSeconds int32                  `protobuf:"varint,7,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNextAdaptiveQuestionResponse) Reset() {
+	*x = GetNextAdaptiveQuestionResponse{}
+	mi := &file_exam_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextAdaptiveQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextAdaptiveQuestionResponse) ProtoMessage() {}
+
+func (x *GetNextAdaptiveQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextAdaptiveQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GetNextAdaptiveQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetNextAdaptiveQuestionResponse) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *GetNextAdaptiveQuestionResponse) GetQuestion() *QuestionDetails {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *GetNextAdaptiveQuestionResponse) GetTheta() float64 {
+	if x != nil {
+		return x.Theta
+	}
+	return 0
+}
+
+func (x *GetNextAdaptiveQuestionResponse) GetStandardError() float64 {
+	if x != nil {
+		return x.StandardError
+	}
+	return 0
+}
+
+func (x *GetNextAdaptiveQuestionResponse) GetAnsweredCount() int32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *GetNextAdaptiveQuestionResponse) GetMaxQuestions() int32 {
+	if x != nil {
+		return x.MaxQuestions
+	}
+	return 0
+}
+
+func (x *GetNextAdaptiveQuestionResponse) GetRemainingSeconds() int32 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+type NumericAnswerConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Tolerance     float64                `protobuf:"fixed64,2,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	ToleranceType string                 `protobuf:"bytes,3,opt,name=tolerance_type,json=toleranceType,proto3" json:"tolerance_type,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	AcceptedUnits []string               `protobuf:"bytes,5,rep,name=accepted_units,json=acceptedUnits,proto3" json:"accepted_units,omitempty"`
+	RequireUnit   bool                   `protobuf:"varint,6,opt,name=require_unit,json=requireUnit,proto3" json:"require_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NumericAnswerConfig) Reset() {
+	*x = NumericAnswerConfig{}
+	mi := &file_exam_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumericAnswerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericAnswerConfig) ProtoMessage() {}
+
+func (x *NumericAnswerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericAnswerConfig.ProtoReflect.Descriptor instead.
+func (*NumericAnswerConfig) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *NumericAnswerConfig) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *NumericAnswerConfig) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *NumericAnswerConfig) GetToleranceType() string {
+	if x != nil {
+		return x.ToleranceType
+	}
+	return ""
+}
+
+func (x *NumericAnswerConfig) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *NumericAnswerConfig) GetAcceptedUnits() []string {
+	if x != nil {
+		return x.AcceptedUnits
+	}
+	return nil
+}
+
+func (x *NumericAnswerConfig) GetRequireUnit() bool {
+	if x != nil {
+		return x.RequireUnit
+	}
+	return false
+}
+
+type ClozeBlank struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answers       []string               `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	CaseSensitive bool                   `protobuf:"varint,2,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClozeBlank) Reset() {
+	*x = ClozeBlank{}
+	mi := &file_exam_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClozeBlank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClozeBlank) ProtoMessage() {}
+
+func (x *ClozeBlank) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClozeBlank.ProtoReflect.Descriptor instead.
+func (*ClozeBlank) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ClozeBlank) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *ClozeBlank) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+type MatchAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChoiceId      int64                  `protobuf:"varint,1,opt,name=choice_id,json=choiceId,proto3" json:"choice_id,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchAnswer) Reset() {
+	*x = MatchAnswer{}
+	mi := &file_exam_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchAnswer) ProtoMessage() {}
+
+func (x *MatchAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchAnswer.ProtoReflect.Descriptor instead.
+func (*MatchAnswer) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *MatchAnswer) GetChoiceId() int64 {
+	if x != nil {
+		return x.ChoiceId
+	}
+	return 0
+}
+
+func (x *MatchAnswer) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type QuestionBankError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	QuestionId    int64                  `protobuf:"varint,4,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionBankError) Reset() {
+	*x = QuestionBankError{}
+	mi := &file_exam_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionBankError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionBankError) ProtoMessage() {}
+
+func (x *QuestionBankError) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionBankError.ProtoReflect.Descriptor instead.
+func (*QuestionBankError) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *QuestionBankError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *QuestionBankError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuestionBankError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QuestionBankError) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+type ImportQTIPackageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId      int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	FileContent    []byte                 `protobuf:"bytes,2,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	DefaultTopic   string                 `protobuf:"bytes,3,opt,name=default_topic,json=defaultTopic,proto3" json:"default_topic,omitempty"`
+	DefaultSection string                 `protobuf:"bytes,4,opt,name=default_section,json=defaultSection,proto3" json:"default_section,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportQTIPackageRequest) Reset() {
+	*x = ImportQTIPackageRequest{}
+	mi := &file_exam_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportQTIPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQTIPackageRequest) ProtoMessage() {}
+
+func (x *ImportQTIPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQTIPackageRequest.ProtoReflect.Descriptor instead.
+func (*ImportQTIPackageRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ImportQTIPackageRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ImportQTIPackageRequest) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+func (x *ImportQTIPackageRequest) GetDefaultTopic() string {
+	if x != nil {
+		return x.DefaultTopic
+	}
+	return ""
+}
+
+func (x *ImportQTIPackageRequest) GetDefaultSection() string {
+	if x != nil {
+		return x.DefaultSection
+	}
+	return ""
+}
+
+type ImportQTIPackageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	SuccessCount  int32                  `protobuf:"varint,4,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	ErrorCount    int32                  `protobuf:"varint,5,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	Errors        []*QuestionBankError   `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportQTIPackageResponse) Reset() {
+	*x = ImportQTIPackageResponse{}
+	mi := &file_exam_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportQTIPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportQTIPackageResponse) ProtoMessage() {}
+
+func (x *ImportQTIPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportQTIPackageResponse.ProtoReflect.Descriptor instead.
+func (*ImportQTIPackageResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ImportQTIPackageResponse) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ImportQTIPackageResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportQTIPackageResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ImportQTIPackageResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ImportQTIPackageResponse) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *ImportQTIPackageResponse) GetErrors() []*QuestionBankError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExportQTIPackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	CreatorId     int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportQTIPackageRequest) Reset() {
+	*x = ExportQTIPackageRequest{}
+	mi := &file_exam_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportQTIPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportQTIPackageRequest) ProtoMessage() {}
+
+func (x *ExportQTIPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportQTIPackageRequest.ProtoReflect.Descriptor instead.
+func (*ExportQTIPackageRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ExportQTIPackageRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ExportQTIPackageRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ExportQTIPackageRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ExportQTIPackageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileUrl       string                 `protobuf:"bytes,1,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	ExportedCount int32                  `protobuf:"varint,2,opt,name=exported_count,json=exportedCount,proto3" json:"exported_count,omitempty"`
+	Errors        []*QuestionBankError   `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportQTIPackageResponse) Reset() {
+	*x = ExportQTIPackageResponse{}
+	mi := &file_exam_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportQTIPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportQTIPackageResponse) ProtoMessage() {}
+
+func (x *ExportQTIPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportQTIPackageResponse.ProtoReflect.Descriptor instead.
+func (*ExportQTIPackageResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ExportQTIPackageResponse) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *ExportQTIPackageResponse) GetExportedCount() int32 {
+	if x != nil {
+		return x.ExportedCount
+	}
+	return 0
+}
+
+func (x *ExportQTIPackageResponse) GetErrors() []*QuestionBankError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type QuestionVersionSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	EditorId      int64                  `protobuf:"varint,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	ChoiceCount   int32                  `protobuf:"varint,5,opt,name=choice_count,json=choiceCount,proto3" json:"choice_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionVersionSummary) Reset() {
+	*x = QuestionVersionSummary{}
+	mi := &file_exam_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionVersionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionVersionSummary) ProtoMessage() {}
+
+func (x *QuestionVersionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionVersionSummary.ProtoReflect.Descriptor instead.
+func (*QuestionVersionSummary) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *QuestionVersionSummary) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *QuestionVersionSummary) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+func (x *QuestionVersionSummary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *QuestionVersionSummary) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QuestionVersionSummary) GetChoiceCount() int32 {
+	if x != nil {
+		return x.ChoiceCount
+	}
+	return 0
+}
+
+type GetQuestionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionHistoryRequest) Reset() {
+	*x = GetQuestionHistoryRequest{}
+	mi := &file_exam_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionHistoryRequest) ProtoMessage() {}
+
+func (x *GetQuestionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetQuestionHistoryRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+type GetQuestionHistoryResponse struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	QuestionId     int64                     `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	CurrentVersion int32                     `protobuf:"varint,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	Versions       []*QuestionVersionSummary `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetQuestionHistoryResponse) Reset() {
+	*x = GetQuestionHistoryResponse{}
+	mi := &file_exam_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionHistoryResponse) ProtoMessage() {}
+
+func (x *GetQuestionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetQuestionHistoryResponse) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *GetQuestionHistoryResponse) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *GetQuestionHistoryResponse) GetVersions() []*QuestionVersionSummary {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type GetQuestionVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionVersionRequest) Reset() {
+	*x = GetQuestionVersionRequest{}
+	mi := &file_exam_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionVersionRequest) ProtoMessage() {}
+
+func (x *GetQuestionVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionVersionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetQuestionVersionRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *GetQuestionVersionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetQuestionVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      *QuestionDetails       `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionVersionResponse) Reset() {
+	*x = GetQuestionVersionResponse{}
+	mi := &file_exam_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionVersionResponse) ProtoMessage() {}
+
+func (x *GetQuestionVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionVersionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetQuestionVersionResponse) GetQuestion() *QuestionDetails {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *GetQuestionVersionResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type QuestionFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionFieldChange) Reset() {
+	*x = QuestionFieldChange{}
+	mi := &file_exam_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionFieldChange) ProtoMessage() {}
+
+func (x *QuestionFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionFieldChange.ProtoReflect.Descriptor instead.
+func (*QuestionFieldChange) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *QuestionFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *QuestionFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *QuestionFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type ChoiceChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Index          int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Kind           string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	OldContent     string                 `protobuf:"bytes,3,opt,name=old_content,json=oldContent,proto3" json:"old_content,omitempty"`
+	NewContent     string                 `protobuf:"bytes,4,opt,name=new_content,json=newContent,proto3" json:"new_content,omitempty"`
+	OldIsCorrect   bool                   `protobuf:"varint,5,opt,name=old_is_correct,json=oldIsCorrect,proto3" json:"old_is_correct,omitempty"`
+	NewIsCorrect   bool                   `protobuf:"varint,6,opt,name=new_is_correct,json=newIsCorrect,proto3" json:"new_is_correct,omitempty"`
+	OldMatchTarget string                 `protobuf:"bytes,7,opt,name=old_match_target,json=oldMatchTarget,proto3" json:"old_match_target,omitempty"`
+	NewMatchTarget string                 `protobuf:"bytes,8,opt,name=new_match_target,json=newMatchTarget,proto3" json:"new_match_target,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChoiceChange) Reset() {
+	*x = ChoiceChange{}
+	mi := &file_exam_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceChange) ProtoMessage() {}
+
+func (x *ChoiceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoiceChange.ProtoReflect.Descriptor instead.
+func (*ChoiceChange) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ChoiceChange) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ChoiceChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChoiceChange) GetOldContent() string {
+	if x != nil {
+		return x.OldContent
+	}
+	return ""
+}
+
+func (x *ChoiceChange) GetNewContent() string {
+	if x != nil {
+		return x.NewContent
+	}
+	return ""
+}
+
+func (x *ChoiceChange) GetOldIsCorrect() bool {
+	if x != nil {
+		return x.OldIsCorrect
+	}
+	return false
+}
+
+func (x *ChoiceChange) GetNewIsCorrect() bool {
+	if x != nil {
+		return x.NewIsCorrect
+	}
+	return false
+}
+
+func (x *ChoiceChange) GetOldMatchTarget() string {
+	if x != nil {
+		return x.OldMatchTarget
+	}
+	return ""
+}
+
+func (x *ChoiceChange) GetNewMatchTarget() string {
+	if x != nil {
+		return x.NewMatchTarget
+	}
+	return ""
+}
+
+type DiffQuestionVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	FromVersion   int32                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int32                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffQuestionVersionsRequest) Reset() {
+	*x = DiffQuestionVersionsRequest{}
+	mi := &file_exam_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffQuestionVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffQuestionVersionsRequest) ProtoMessage() {}
+
+func (x *DiffQuestionVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffQuestionVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffQuestionVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DiffQuestionVersionsRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *DiffQuestionVersionsRequest) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffQuestionVersionsRequest) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+type DiffQuestionVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	FromVersion   int32                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int32                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Changes       []*QuestionFieldChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	ChoiceChanges []*ChoiceChange        `protobuf:"bytes,5,rep,name=choice_changes,json=choiceChanges,proto3" json:"choice_changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffQuestionVersionsResponse) Reset() {
+	*x = DiffQuestionVersionsResponse{}
+	mi := &file_exam_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffQuestionVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffQuestionVersionsResponse) ProtoMessage() {}
+
+func (x *DiffQuestionVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffQuestionVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffQuestionVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *DiffQuestionVersionsResponse) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *DiffQuestionVersionsResponse) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffQuestionVersionsResponse) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffQuestionVersionsResponse) GetChanges() []*QuestionFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DiffQuestionVersionsResponse) GetChoiceChanges() []*ChoiceChange {
+	if x != nil {
+		return x.ChoiceChanges
+	}
+	return nil
+}
+
+type RegradeExamRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ExamId            int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionId        int64                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Policy            string                 `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	AcceptedChoiceIds []int64                `protobuf:"varint,4,rep,packed,name=accepted_choice_ids,json=acceptedChoiceIds,proto3" json:"accepted_choice_ids,omitempty"`
+	AcceptedAnswers   []string               `protobuf:"bytes,5,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+	InstructorId      int64                  `protobuf:"varint,6,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Reason            string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RegradeExamRequest) Reset() {
+	*x = RegradeExamRequest{}
+	mi := &file_exam_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegradeExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeExamRequest) ProtoMessage() {}
+
+func (x *RegradeExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeExamRequest.ProtoReflect.Descriptor instead.
+func (*RegradeExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *RegradeExamRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *RegradeExamRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *RegradeExamRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *RegradeExamRequest) GetAcceptedChoiceIds() []int64 {
+	if x != nil {
+		return x.AcceptedChoiceIds
+	}
+	return nil
+}
+
+func (x *RegradeExamRequest) GetAcceptedAnswers() []string {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *RegradeExamRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *RegradeExamRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RegradeScoreChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldScore      float32                `protobuf:"fixed32,3,opt,name=old_score,json=oldScore,proto3" json:"old_score,omitempty"`
+	NewScore      float32                `protobuf:"fixed32,4,opt,name=new_score,json=newScore,proto3" json:"new_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegradeScoreChange) Reset() {
+	*x = RegradeScoreChange{}
+	mi := &file_exam_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegradeScoreChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeScoreChange) ProtoMessage() {}
+
+func (x *RegradeScoreChange) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeScoreChange.ProtoReflect.Descriptor instead.
+func (*RegradeScoreChange) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *RegradeScoreChange) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *RegradeScoreChange) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RegradeScoreChange) GetOldScore() float32 {
+	if x != nil {
+		return x.OldScore
+	}
+	return 0
+}
+
+func (x *RegradeScoreChange) GetNewScore() float32 {
+	if x != nil {
+		return x.NewScore
+	}
+	return 0
+}
+
+type RegradeExamResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegradeId      int64                  `protobuf:"varint,1,opt,name=regrade_id,json=regradeId,proto3" json:"regrade_id,omitempty"`
+	AffectedCount  int32                  `protobuf:"varint,2,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	EvaluatedCount int32                  `protobuf:"varint,3,opt,name=evaluated_count,json=evaluatedCount,proto3" json:"evaluated_count,omitempty"`
+	Changes        []*RegradeScoreChange  `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegradeExamResponse) Reset() {
+	*x = RegradeExamResponse{}
+	mi := &file_exam_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegradeExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeExamResponse) ProtoMessage() {}
+
+func (x *RegradeExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeExamResponse.ProtoReflect.Descriptor instead.
+func (*RegradeExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *RegradeExamResponse) GetRegradeId() int64 {
+	if x != nil {
+		return x.RegradeId
+	}
+	return 0
+}
+
+func (x *RegradeExamResponse) GetAffectedCount() int32 {
+	if x != nil {
+		return x.AffectedCount
+	}
+	return 0
+}
+
+func (x *RegradeExamResponse) GetEvaluatedCount() int32 {
+	if x != nil {
+		return x.EvaluatedCount
+	}
+	return 0
+}
+
+func (x *RegradeExamResponse) GetChanges() []*RegradeScoreChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RegradeRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExamId        int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionId    int64                  `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Policy        string                 `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,5,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	AffectedCount int32                  `protobuf:"varint,7,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Changes       []*RegradeScoreChange  `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegradeRecord) Reset() {
+	*x = RegradeRecord{}
+	mi := &file_exam_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegradeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeRecord) ProtoMessage() {}
+
+func (x *RegradeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeRecord.ProtoReflect.Descriptor instead.
+func (*RegradeRecord) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RegradeRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RegradeRecord) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *RegradeRecord) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *RegradeRecord) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *RegradeRecord) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *RegradeRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RegradeRecord) GetAffectedCount() int32 {
+	if x != nil {
+		return x.AffectedCount
+	}
+	return 0
+}
+
+func (x *RegradeRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RegradeRecord) GetChanges() []*RegradeScoreChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type GetRegradeHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegradeHistoryRequest) Reset() {
+	*x = GetRegradeHistoryRequest{}
+	mi := &file_exam_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegradeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegradeHistoryRequest) ProtoMessage() {}
+
+func (x *GetRegradeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegradeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRegradeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetRegradeHistoryRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetRegradeHistoryRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetRegradeHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regrades      []*RegradeRecord       `protobuf:"bytes,1,rep,name=regrades,proto3" json:"regrades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegradeHistoryResponse) Reset() {
+	*x = GetRegradeHistoryResponse{}
+	mi := &file_exam_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegradeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegradeHistoryResponse) ProtoMessage() {}
+
+func (x *GetRegradeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegradeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRegradeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetRegradeHistoryResponse) GetRegrades() []*RegradeRecord {
+	if x != nil {
+		return x.Regrades
+	}
+	return nil
+}
+
+type ScoreAppeal struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubmissionId    int64                  `protobuf:"varint,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	ExamId          int64                  `protobuf:"varint,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionId      int64                  `protobuf:"varint,4,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Response        string                 `protobuf:"bytes,8,opt,name=response,proto3" json:"response,omitempty"`
+	AwardedPoints   *float32               `protobuf:"fixed32,9,opt,name=awarded_points,json=awardedPoints,proto3,oneof" json:"awarded_points,omitempty"`
+	OldScore        float32                `protobuf:"fixed32,10,opt,name=old_score,json=oldScore,proto3" json:"old_score,omitempty"`
+	NewScore        *float32               `protobuf:"fixed32,11,opt,name=new_score,json=newScore,proto3,oneof" json:"new_score,omitempty"`
+	ResolvedBy      int64                  `protobuf:"varint,12,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt      string                 `protobuf:"bytes,14,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ExamTitle       string                 `protobuf:"bytes,15,opt,name=exam_title,json=examTitle,proto3" json:"exam_title,omitempty"`
+	QuestionContent string                 `protobuf:"bytes,16,opt,name=question_content,json=questionContent,proto3" json:"question_content,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScoreAppeal) Reset() {
+	*x = ScoreAppeal{}
+	mi := &file_exam_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreAppeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreAppeal) ProtoMessage() {}
+
+func (x *ScoreAppeal) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreAppeal.ProtoReflect.Descriptor instead.
+func (*ScoreAppeal) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ScoreAppeal) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScoreAppeal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScoreAppeal) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *ScoreAppeal) GetAwardedPoints() float32 {
+	if x != nil && x.AwardedPoints != nil {
+		return *x.AwardedPoints
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetOldScore() float32 {
+	if x != nil {
+		return x.OldScore
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetNewScore() float32 {
+	if x != nil && x.NewScore != nil {
+		return *x.NewScore
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetResolvedBy() int64 {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return 0
+}
+
+func (x *ScoreAppeal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ScoreAppeal) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *ScoreAppeal) GetExamTitle() string {
+	if x != nil {
+		return x.ExamTitle
+	}
+	return ""
+}
+
+func (x *ScoreAppeal) GetQuestionContent() string {
+	if x != nil {
+		return x.QuestionContent
+	}
+	return ""
+}
+
+type CreateAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	QuestionId    int64                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppealRequest) Reset() {
+	*x = CreateAppealRequest{}
+	mi := &file_exam_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppealRequest) ProtoMessage() {}
+
+func (x *CreateAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppealRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppealRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *CreateAppealRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *CreateAppealRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *CreateAppealRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAppealRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeal        *ScoreAppeal           `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppealResponse) Reset() {
+	*x = CreateAppealResponse{}
+	mi := &file_exam_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppealResponse) ProtoMessage() {}
+
+func (x *CreateAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppealResponse.ProtoReflect.Descriptor instead.
+func (*CreateAppealResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *CreateAppealResponse) GetAppeal() *ScoreAppeal {
+	if x != nil {
+		return x.Appeal
+	}
+	return nil
+}
+
+type GetAppealQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	ExamId        int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppealQueueRequest) Reset() {
+	*x = GetAppealQueueRequest{}
+	mi := &file_exam_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppealQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppealQueueRequest) ProtoMessage() {}
+
+func (x *GetAppealQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppealQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetAppealQueueRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetAppealQueueRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GetAppealQueueRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetAppealQueueRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAppealQueueRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAppealQueueRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAppealQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeals       []*ScoreAppeal         `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppealQueueResponse) Reset() {
+	*x = GetAppealQueueResponse{}
+	mi := &file_exam_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppealQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppealQueueResponse) ProtoMessage() {}
+
+func (x *GetAppealQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppealQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetAppealQueueResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetAppealQueueResponse) GetAppeals() []*ScoreAppeal {
+	if x != nil {
+		return x.Appeals
+	}
+	return nil
+}
+
+func (x *GetAppealQueueResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetAppealQueueResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAppealQueueResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMyAppealsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SubmissionId  int64                  `protobuf:"varint,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyAppealsRequest) Reset() {
+	*x = GetMyAppealsRequest{}
+	mi := &file_exam_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyAppealsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyAppealsRequest) ProtoMessage() {}
+
+func (x *GetMyAppealsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyAppealsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyAppealsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetMyAppealsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMyAppealsRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+type GetMyAppealsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeals       []*ScoreAppeal         `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyAppealsResponse) Reset() {
+	*x = GetMyAppealsResponse{}
+	mi := &file_exam_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyAppealsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyAppealsResponse) ProtoMessage() {}
+
+func (x *GetMyAppealsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyAppealsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyAppealsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetMyAppealsResponse) GetAppeals() []*ScoreAppeal {
+	if x != nil {
+		return x.Appeals
+	}
+	return nil
+}
+
+type ResolveAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      int64                  `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Response      string                 `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	AwardedPoints *float32               `protobuf:"fixed32,5,opt,name=awarded_points,json=awardedPoints,proto3,oneof" json:"awarded_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAppealRequest) Reset() {
+	*x = ResolveAppealRequest{}
+	mi := &file_exam_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAppealRequest) ProtoMessage() {}
+
+func (x *ResolveAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAppealRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAppealRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ResolveAppealRequest) GetAppealId() int64 {
+	if x != nil {
+		return x.AppealId
+	}
+	return 0
+}
+
+func (x *ResolveAppealRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ResolveAppealRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ResolveAppealRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *ResolveAppealRequest) GetAwardedPoints() float32 {
+	if x != nil && x.AwardedPoints != nil {
+		return *x.AwardedPoints
+	}
+	return 0
+}
+
+type ResolveAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeal        *ScoreAppeal           `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAppealResponse) Reset() {
+	*x = ResolveAppealResponse{}
+	mi := &file_exam_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAppealResponse) ProtoMessage() {}
+
+func (x *ResolveAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAppealResponse.ProtoReflect.Descriptor instead.
+func (*ResolveAppealResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ResolveAppealResponse) GetAppeal() *ScoreAppeal {
+	if x != nil {
+		return x.Appeal
+	}
+	return nil
+}
+
+type RubricLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Points        float32                `protobuf:"fixed32,4,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricLevel) Reset() {
+	*x = RubricLevel{}
+	mi := &file_exam_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricLevel) ProtoMessage() {}
+
+func (x *RubricLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricLevel.ProtoReflect.Descriptor instead.
+func (*RubricLevel) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *RubricLevel) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RubricLevel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RubricLevel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RubricLevel) GetPoints() float32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type RubricCriterion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Levels        []*RubricLevel         `protobuf:"bytes,4,rep,name=levels,proto3" json:"levels,omitempty"`
+	MaxPoints     float32                `protobuf:"fixed32,5,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
+	mi := &file_exam_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricCriterion) ProtoMessage() {}
+
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *RubricCriterion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RubricCriterion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetLevels() []*RubricLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *RubricCriterion) GetMaxPoints() float32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+type Rubric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId     int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Criteria      []*RubricCriterion     `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	MaxPoints     float32                `protobuf:"fixed32,6,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rubric) Reset() {
+	*x = Rubric{}
+	mi := &file_exam_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rubric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rubric) ProtoMessage() {}
+
+func (x *Rubric) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rubric.ProtoReflect.Descriptor instead.
+func (*Rubric) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *Rubric) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rubric) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *Rubric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rubric) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Rubric) GetCriteria() []*RubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *Rubric) GetMaxPoints() float32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *Rubric) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Rubric) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateRubricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Criteria      []*RubricCriterion     `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRubricRequest) Reset() {
+	*x = CreateRubricRequest{}
+	mi := &file_exam_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRubricRequest) ProtoMessage() {}
+
+func (x *CreateRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRubricRequest.ProtoReflect.Descriptor instead.
+func (*CreateRubricRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *CreateRubricRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *CreateRubricRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRubricRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRubricRequest) GetCriteria() []*RubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type CreateRubricResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rubric        *Rubric                `protobuf:"bytes,1,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRubricResponse) Reset() {
+	*x = CreateRubricResponse{}
+	mi := &file_exam_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRubricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRubricResponse) ProtoMessage() {}
+
+func (x *CreateRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRubricResponse.ProtoReflect.Descriptor instead.
+func (*CreateRubricResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *CreateRubricResponse) GetRubric() *Rubric {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+type UpdateRubricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RubricId      int64                  `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	CreatorId     int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Criteria      []*RubricCriterion     `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRubricRequest) Reset() {
+	*x = UpdateRubricRequest{}
+	mi := &file_exam_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRubricRequest) ProtoMessage() {}
+
+func (x *UpdateRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRubricRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRubricRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *UpdateRubricRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *UpdateRubricRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *UpdateRubricRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRubricRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRubricRequest) GetCriteria() []*RubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type UpdateRubricResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rubric        *Rubric                `protobuf:"bytes,1,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRubricResponse) Reset() {
+	*x = UpdateRubricResponse{}
+	mi := &file_exam_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRubricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRubricResponse) ProtoMessage() {}
+
+func (x *UpdateRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRubricResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRubricResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *UpdateRubricResponse) GetRubric() *Rubric {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+type GetRubricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RubricId      int64                  `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRubricRequest) Reset() {
+	*x = GetRubricRequest{}
+	mi := &file_exam_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRubricRequest) ProtoMessage() {}
+
+func (x *GetRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRubricRequest.ProtoReflect.Descriptor instead.
+func (*GetRubricRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetRubricRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+type GetRubricResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rubric        *Rubric                `protobuf:"bytes,1,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRubricResponse) Reset() {
+	*x = GetRubricResponse{}
+	mi := &file_exam_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRubricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRubricResponse) ProtoMessage() {}
+
+func (x *GetRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRubricResponse.ProtoReflect.Descriptor instead.
+func (*GetRubricResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetRubricResponse) GetRubric() *Rubric {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+type GetRubricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId     int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRubricsRequest) Reset() {
+	*x = GetRubricsRequest{}
+	mi := &file_exam_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRubricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRubricsRequest) ProtoMessage() {}
+
+func (x *GetRubricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRubricsRequest.ProtoReflect.Descriptor instead.
+func (*GetRubricsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *GetRubricsRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+type GetRubricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rubrics       []*Rubric              `protobuf:"bytes,1,rep,name=rubrics,proto3" json:"rubrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRubricsResponse) Reset() {
+	*x = GetRubricsResponse{}
+	mi := &file_exam_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRubricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRubricsResponse) ProtoMessage() {}
+
+func (x *GetRubricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRubricsResponse.ProtoReflect.Descriptor instead.
+func (*GetRubricsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *GetRubricsResponse) GetRubrics() []*Rubric {
+	if x != nil {
+		return x.Rubrics
+	}
+	return nil
+}
+
+type DeleteRubricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RubricId      int64                  `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	CreatorId     int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRubricRequest) Reset() {
+	*x = DeleteRubricRequest{}
+	mi := &file_exam_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRubricRequest) ProtoMessage() {}
+
+func (x *DeleteRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRubricRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRubricRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *DeleteRubricRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *DeleteRubricRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+type DeleteRubricResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRubricResponse) Reset() {
+	*x = DeleteRubricResponse{}
+	mi := &file_exam_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRubricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRubricResponse) ProtoMessage() {}
+
+func (x *DeleteRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRubricResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRubricResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *DeleteRubricResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetQuestionRubricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	RubricId      int64                  `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	CreatorId     int64                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuestionRubricRequest) Reset() {
+	*x = SetQuestionRubricRequest{}
+	mi := &file_exam_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuestionRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuestionRubricRequest) ProtoMessage() {}
+
+func (x *SetQuestionRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuestionRubricRequest.ProtoReflect.Descriptor instead.
+func (*SetQuestionRubricRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *SetQuestionRubricRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *SetQuestionRubricRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *SetQuestionRubricRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+type SetQuestionRubricResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuestionRubricResponse) Reset() {
+	*x = SetQuestionRubricResponse{}
+	mi := &file_exam_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuestionRubricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuestionRubricResponse) ProtoMessage() {}
+
+func (x *SetQuestionRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuestionRubricResponse.ProtoReflect.Descriptor instead.
+func (*SetQuestionRubricResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *SetQuestionRubricResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RubricSelection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CriterionId   int64                  `protobuf:"varint,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"`
+	LevelId       int64                  `protobuf:"varint,2,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricSelection) Reset() {
+	*x = RubricSelection{}
+	mi := &file_exam_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricSelection) ProtoMessage() {}
+
+func (x *RubricSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricSelection.ProtoReflect.Descriptor instead.
+func (*RubricSelection) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *RubricSelection) GetCriterionId() int64 {
+	if x != nil {
+		return x.CriterionId
+	}
+	return 0
+}
+
+func (x *RubricSelection) GetLevelId() int64 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *RubricSelection) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RubricCriterionScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CriterionId   int64                  `protobuf:"varint,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"`
+	CriterionName string                 `protobuf:"bytes,2,opt,name=criterion_name,json=criterionName,proto3" json:"criterion_name,omitempty"`
+	LevelId       int64                  `protobuf:"varint,3,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	LevelName     string                 `protobuf:"bytes,4,opt,name=level_name,json=levelName,proto3" json:"level_name,omitempty"`
+	Points        float32                `protobuf:"fixed32,5,opt,name=points,proto3" json:"points,omitempty"`
+	MaxPoints     float32                `protobuf:"fixed32,6,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricCriterionScore) Reset() {
+	*x = RubricCriterionScore{}
+	mi := &file_exam_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricCriterionScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricCriterionScore) ProtoMessage() {}
+
+func (x *RubricCriterionScore) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricCriterionScore.ProtoReflect.Descriptor instead.
+func (*RubricCriterionScore) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *RubricCriterionScore) GetCriterionId() int64 {
+	if x != nil {
+		return x.CriterionId
+	}
+	return 0
+}
+
+func (x *RubricCriterionScore) GetCriterionName() string {
+	if x != nil {
+		return x.CriterionName
+	}
+	return ""
+}
+
+func (x *RubricCriterionScore) GetLevelId() int64 {
+	if x != nil {
+		return x.LevelId
+	}
+	return 0
+}
+
+func (x *RubricCriterionScore) GetLevelName() string {
+	if x != nil {
+		return x.LevelName
+	}
+	return ""
+}
+
+func (x *RubricCriterionScore) GetPoints() float32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RubricCriterionScore) GetMaxPoints() float32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *RubricCriterionScore) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RubricResult struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	RubricId      int64                   `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Criteria      []*RubricCriterionScore `protobuf:"bytes,2,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	TotalPoints   float32                 `protobuf:"fixed32,3,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	MaxPoints     float32                 `protobuf:"fixed32,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricResult) Reset() {
+	*x = RubricResult{}
+	mi := &file_exam_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricResult) ProtoMessage() {}
+
+func (x *RubricResult) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricResult.ProtoReflect.Descriptor instead.
+func (*RubricResult) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *RubricResult) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *RubricResult) GetCriteria() []*RubricCriterionScore {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *RubricResult) GetTotalPoints() float32 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *RubricResult) GetMaxPoints() float32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+type MarkingConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ExamId               int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	DoubleMarking        bool                   `protobuf:"varint,2,opt,name=double_marking,json=doubleMarking,proto3" json:"double_marking,omitempty"`
+	DiscrepancyThreshold float32                `protobuf:"fixed32,3,opt,name=discrepancy_threshold,json=discrepancyThreshold,proto3" json:"discrepancy_threshold,omitempty"`
+	ModeratorId          int64                  `protobuf:"varint,4,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MarkingConfig) Reset() {
+	*x = MarkingConfig{}
+	mi := &file_exam_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkingConfig) ProtoMessage() {}
+
+func (x *MarkingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkingConfig.ProtoReflect.Descriptor instead.
+func (*MarkingConfig) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *MarkingConfig) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *MarkingConfig) GetDoubleMarking() bool {
+	if x != nil {
+		return x.DoubleMarking
+	}
+	return false
+}
+
+func (x *MarkingConfig) GetDiscrepancyThreshold() float32 {
+	if x != nil {
+		return x.DiscrepancyThreshold
+	}
+	return 0
+}
+
+func (x *MarkingConfig) GetModeratorId() int64 {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return 0
+}
+
+type ConfigureMarkingRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ExamId               int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId         int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	DoubleMarking        bool                   `protobuf:"varint,3,opt,name=double_marking,json=doubleMarking,proto3" json:"double_marking,omitempty"`
+	DiscrepancyThreshold float32                `protobuf:"fixed32,4,opt,name=discrepancy_threshold,json=discrepancyThreshold,proto3" json:"discrepancy_threshold,omitempty"`
+	ModeratorId          int64                  `protobuf:"varint,5,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ConfigureMarkingRequest) Reset() {
+	*x = ConfigureMarkingRequest{}
+	mi := &file_exam_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureMarkingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureMarkingRequest) ProtoMessage() {}
+
+func (x *ConfigureMarkingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureMarkingRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureMarkingRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ConfigureMarkingRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ConfigureMarkingRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ConfigureMarkingRequest) GetDoubleMarking() bool {
+	if x != nil {
+		return x.DoubleMarking
+	}
+	return false
+}
+
+func (x *ConfigureMarkingRequest) GetDiscrepancyThreshold() float32 {
+	if x != nil {
+		return x.DiscrepancyThreshold
+	}
+	return 0
+}
+
+func (x *ConfigureMarkingRequest) GetModeratorId() int64 {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return 0
+}
+
+type ConfigureMarkingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *MarkingConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureMarkingResponse) Reset() {
+	*x = ConfigureMarkingResponse{}
+	mi := &file_exam_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureMarkingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureMarkingResponse) ProtoMessage() {}
+
+func (x *ConfigureMarkingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureMarkingResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureMarkingResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ConfigureMarkingResponse) GetConfig() *MarkingConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type AssignMarkersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	MarkerIds     []int64                `protobuf:"varint,3,rep,packed,name=marker_ids,json=markerIds,proto3" json:"marker_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignMarkersRequest) Reset() {
+	*x = AssignMarkersRequest{}
+	mi := &file_exam_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignMarkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignMarkersRequest) ProtoMessage() {}
+
+func (x *AssignMarkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignMarkersRequest.ProtoReflect.Descriptor instead.
+func (*AssignMarkersRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *AssignMarkersRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *AssignMarkersRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *AssignMarkersRequest) GetMarkerIds() []int64 {
+	if x != nil {
+		return x.MarkerIds
+	}
+	return nil
+}
+
+type AssignMarkersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignedCount int32                  `protobuf:"varint,1,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignMarkersResponse) Reset() {
+	*x = AssignMarkersResponse{}
+	mi := &file_exam_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignMarkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignMarkersResponse) ProtoMessage() {}
+
+func (x *AssignMarkersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignMarkersResponse.ProtoReflect.Descriptor instead.
+func (*AssignMarkersResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *AssignMarkersResponse) GetAssignedCount() int32 {
+	if x != nil {
+		return x.AssignedCount
+	}
+	return 0
+}
+
+func (x *AssignMarkersResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+type MarkingTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	AnonymousId   string                 `protobuf:"bytes,2,opt,name=anonymous_id,json=anonymousId,proto3" json:"anonymous_id,omitempty"`
+	ExamId        int64                  `protobuf:"varint,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	ExamTitle     string                 `protobuf:"bytes,4,opt,name=exam_title,json=examTitle,proto3" json:"exam_title,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedAt    string                 `protobuf:"bytes,7,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkingTask) Reset() {
+	*x = MarkingTask{}
+	mi := &file_exam_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkingTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkingTask) ProtoMessage() {}
+
+func (x *MarkingTask) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkingTask.ProtoReflect.Descriptor instead.
+func (*MarkingTask) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *MarkingTask) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *MarkingTask) GetAnonymousId() string {
+	if x != nil {
+		return x.AnonymousId
+	}
+	return ""
+}
+
+func (x *MarkingTask) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *MarkingTask) GetExamTitle() string {
+	if x != nil {
+		return x.ExamTitle
+	}
+	return ""
+}
+
+func (x *MarkingTask) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MarkingTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MarkingTask) GetAssignedAt() string {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return ""
+}
+
+func (x *MarkingTask) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type GetMarkingTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarkerId      int64                  `protobuf:"varint,1,opt,name=marker_id,json=markerId,proto3" json:"marker_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarkingTasksRequest) Reset() {
+	*x = GetMarkingTasksRequest{}
+	mi := &file_exam_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarkingTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarkingTasksRequest) ProtoMessage() {}
+
+func (x *GetMarkingTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarkingTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetMarkingTasksRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *GetMarkingTasksRequest) GetMarkerId() int64 {
+	if x != nil {
+		return x.MarkerId
+	}
+	return 0
+}
+
+func (x *GetMarkingTasksRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetMarkingTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*MarkingTask         `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarkingTasksResponse) Reset() {
+	*x = GetMarkingTasksResponse{}
+	mi := &file_exam_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarkingTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarkingTasksResponse) ProtoMessage() {}
+
+func (x *GetMarkingTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarkingTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetMarkingTasksResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *GetMarkingTasksResponse) GetTasks() []*MarkingTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type MarkingEssay struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId        int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionContent   string                 `protobuf:"bytes,2,opt,name=question_content,json=questionContent,proto3" json:"question_content,omitempty"`
+	AttachmentUrl     string                 `protobuf:"bytes,3,opt,name=attachment_url,json=attachmentUrl,proto3" json:"attachment_url,omitempty"`
+	Points            float32                `protobuf:"fixed32,4,opt,name=points,proto3" json:"points,omitempty"`
+	TextAnswer        string                 `protobuf:"bytes,5,opt,name=text_answer,json=textAnswer,proto3" json:"text_answer,omitempty"`
+	RubricId          int64                  `protobuf:"varint,6,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	MyPoints          *float32               `protobuf:"fixed32,7,opt,name=my_points,json=myPoints,proto3,oneof" json:"my_points,omitempty"`
+	MyFeedback        string                 `protobuf:"bytes,8,opt,name=my_feedback,json=myFeedback,proto3" json:"my_feedback,omitempty"`
+	SimilarityMatches []*SimilarityMatch     `protobuf:"bytes,9,rep,name=similarity_matches,json=similarityMatches,proto3" json:"similarity_matches,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarkingEssay) Reset() {
+	*x = MarkingEssay{}
+	mi := &file_exam_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkingEssay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkingEssay) ProtoMessage() {}
+
+func (x *MarkingEssay) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkingEssay.ProtoReflect.Descriptor instead.
+func (*MarkingEssay) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *MarkingEssay) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *MarkingEssay) GetQuestionContent() string {
+	if x != nil {
+		return x.QuestionContent
+	}
+	return ""
+}
+
+func (x *MarkingEssay) GetAttachmentUrl() string {
+	if x != nil {
+		return x.AttachmentUrl
+	}
+	return ""
+}
+
+func (x *MarkingEssay) GetPoints() float32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *MarkingEssay) GetTextAnswer() string {
+	if x != nil {
+		return x.TextAnswer
+	}
+	return ""
+}
+
+func (x *MarkingEssay) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *MarkingEssay) GetMyPoints() float32 {
+	if x != nil && x.MyPoints != nil {
+		return *x.MyPoints
+	}
+	return 0
+}
+
+func (x *MarkingEssay) GetMyFeedback() string {
+	if x != nil {
+		return x.MyFeedback
+	}
+	return ""
+}
+
+func (x *MarkingEssay) GetSimilarityMatches() []*SimilarityMatch {
+	if x != nil {
+		return x.SimilarityMatches
+	}
+	return nil
+}
+
+type GetMarkingTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	MarkerId      int64                  `protobuf:"varint,2,opt,name=marker_id,json=markerId,proto3" json:"marker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarkingTaskRequest) Reset() {
+	*x = GetMarkingTaskRequest{}
+	mi := &file_exam_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarkingTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarkingTaskRequest) ProtoMessage() {}
+
+func (x *GetMarkingTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarkingTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetMarkingTaskRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *GetMarkingTaskRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GetMarkingTaskRequest) GetMarkerId() int64 {
+	if x != nil {
+		return x.MarkerId
+	}
+	return 0
+}
+
+type GetMarkingTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *MarkingTask           `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Essays        []*MarkingEssay        `protobuf:"bytes,2,rep,name=essays,proto3" json:"essays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarkingTaskResponse) Reset() {
+	*x = GetMarkingTaskResponse{}
+	mi := &file_exam_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarkingTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarkingTaskResponse) ProtoMessage() {}
+
+func (x *GetMarkingTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarkingTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetMarkingTaskResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *GetMarkingTaskResponse) GetTask() *MarkingTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *GetMarkingTaskResponse) GetEssays() []*MarkingEssay {
+	if x != nil {
+		return x.Essays
+	}
+	return nil
+}
+
+type EssayMarkInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ScoreRatio    float32                `protobuf:"fixed32,2,opt,name=score_ratio,json=scoreRatio,proto3" json:"score_ratio,omitempty"`
+	RubricScores  []*RubricSelection     `protobuf:"bytes,3,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	Feedback      string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EssayMarkInput) Reset() {
+	*x = EssayMarkInput{}
+	mi := &file_exam_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EssayMarkInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EssayMarkInput) ProtoMessage() {}
+
+func (x *EssayMarkInput) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EssayMarkInput.ProtoReflect.Descriptor instead.
+func (*EssayMarkInput) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *EssayMarkInput) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *EssayMarkInput) GetScoreRatio() float32 {
+	if x != nil {
+		return x.ScoreRatio
+	}
+	return 0
+}
+
+func (x *EssayMarkInput) GetRubricScores() []*RubricSelection {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *EssayMarkInput) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+type SubmitMarksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	MarkerId      int64                  `protobuf:"varint,2,opt,name=marker_id,json=markerId,proto3" json:"marker_id,omitempty"`
+	Marks         []*EssayMarkInput      `protobuf:"bytes,3,rep,name=marks,proto3" json:"marks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMarksRequest) Reset() {
+	*x = SubmitMarksRequest{}
+	mi := &file_exam_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMarksRequest) ProtoMessage() {}
+
+func (x *SubmitMarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMarksRequest.ProtoReflect.Descriptor instead.
+func (*SubmitMarksRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *SubmitMarksRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SubmitMarksRequest) GetMarkerId() int64 {
+	if x != nil {
+		return x.MarkerId
+	}
+	return 0
+}
+
+func (x *SubmitMarksRequest) GetMarks() []*EssayMarkInput {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+type SubmitMarksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarkingStatus string                 `protobuf:"bytes,1,opt,name=marking_status,json=markingStatus,proto3" json:"marking_status,omitempty"`
+	Escalated     bool                   `protobuf:"varint,2,opt,name=escalated,proto3" json:"escalated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitMarksResponse) Reset() {
+	*x = SubmitMarksResponse{}
+	mi := &file_exam_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitMarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitMarksResponse) ProtoMessage() {}
+
+func (x *SubmitMarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitMarksResponse.ProtoReflect.Descriptor instead.
+func (*SubmitMarksResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *SubmitMarksResponse) GetMarkingStatus() string {
+	if x != nil {
+		return x.MarkingStatus
+	}
+	return ""
+}
+
+func (x *SubmitMarksResponse) GetEscalated() bool {
+	if x != nil {
+		return x.Escalated
+	}
+	return false
+}
+
+type MarkerAssignmentSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	MarkerId      int64                  `protobuf:"varint,2,opt,name=marker_id,json=markerId,proto3" json:"marker_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	TotalPoints   float32                `protobuf:"fixed32,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkerAssignmentSummary) Reset() {
+	*x = MarkerAssignmentSummary{}
+	mi := &file_exam_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkerAssignmentSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkerAssignmentSummary) ProtoMessage() {}
+
+func (x *MarkerAssignmentSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkerAssignmentSummary.ProtoReflect.Descriptor instead.
+func (*MarkerAssignmentSummary) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *MarkerAssignmentSummary) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *MarkerAssignmentSummary) GetMarkerId() int64 {
+	if x != nil {
+		return x.MarkerId
+	}
+	return 0
+}
+
+func (x *MarkerAssignmentSummary) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MarkerAssignmentSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MarkerAssignmentSummary) GetTotalPoints() float32 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+func (x *MarkerAssignmentSummary) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type SubmissionMarking struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	SubmissionId  int64                      `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	AnonymousId   string                     `protobuf:"bytes,2,opt,name=anonymous_id,json=anonymousId,proto3" json:"anonymous_id,omitempty"`
+	UserId        int64                      `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                     `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Assignments   []*MarkerAssignmentSummary `protobuf:"bytes,5,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	FinalScore    *float32                   `protobuf:"fixed32,6,opt,name=final_score,json=finalScore,proto3,oneof" json:"final_score,omitempty"`
+	ReconciledAt  string                     `protobuf:"bytes,7,opt,name=reconciled_at,json=reconciledAt,proto3" json:"reconciled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionMarking) Reset() {
+	*x = SubmissionMarking{}
+	mi := &file_exam_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionMarking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionMarking) ProtoMessage() {}
+
+func (x *SubmissionMarking) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionMarking.ProtoReflect.Descriptor instead.
+func (*SubmissionMarking) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *SubmissionMarking) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *SubmissionMarking) GetAnonymousId() string {
+	if x != nil {
+		return x.AnonymousId
+	}
+	return ""
+}
+
+func (x *SubmissionMarking) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmissionMarking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubmissionMarking) GetAssignments() []*MarkerAssignmentSummary {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *SubmissionMarking) GetFinalScore() float32 {
+	if x != nil && x.FinalScore != nil {
+		return *x.FinalScore
+	}
+	return 0
+}
+
+func (x *SubmissionMarking) GetReconciledAt() string {
+	if x != nil {
+		return x.ReconciledAt
+	}
+	return ""
+}
+
+type GetMarkingOverviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarkingOverviewRequest) Reset() {
+	*x = GetMarkingOverviewRequest{}
+	mi := &file_exam_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarkingOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarkingOverviewRequest) ProtoMessage() {}
+
+func (x *GetMarkingOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarkingOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetMarkingOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *GetMarkingOverviewRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetMarkingOverviewRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetMarkingOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *MarkingConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Submissions   []*SubmissionMarking   `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarkingOverviewResponse) Reset() {
+	*x = GetMarkingOverviewResponse{}
+	mi := &file_exam_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarkingOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarkingOverviewResponse) ProtoMessage() {}
+
+func (x *GetMarkingOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarkingOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetMarkingOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *GetMarkingOverviewResponse) GetConfig() *MarkingConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetMarkingOverviewResponse) GetSubmissions() []*SubmissionMarking {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type AccommodationTerms struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TimeMultiplier         float32                `protobuf:"fixed32,1,opt,name=time_multiplier,json=timeMultiplier,proto3" json:"time_multiplier,omitempty"`
+	ExtraMinutes           int32                  `protobuf:"varint,2,opt,name=extra_minutes,json=extraMinutes,proto3" json:"extra_minutes,omitempty"`
+	WindowExtensionMinutes int32                  `protobuf:"varint,3,opt,name=window_extension_minutes,json=windowExtensionMinutes,proto3" json:"window_extension_minutes,omitempty"`
+	ExtraAttempts          int32                  `protobuf:"varint,4,opt,name=extra_attempts,json=extraAttempts,proto3" json:"extra_attempts,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AccommodationTerms) Reset() {
+	*x = AccommodationTerms{}
+	mi := &file_exam_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccommodationTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccommodationTerms) ProtoMessage() {}
+
+func (x *AccommodationTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccommodationTerms.ProtoReflect.Descriptor instead.
+func (*AccommodationTerms) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *AccommodationTerms) GetTimeMultiplier() float32 {
+	if x != nil {
+		return x.TimeMultiplier
+	}
+	return 0
+}
+
+func (x *AccommodationTerms) GetExtraMinutes() int32 {
+	if x != nil {
+		return x.ExtraMinutes
+	}
+	return 0
+}
+
+func (x *AccommodationTerms) GetWindowExtensionMinutes() int32 {
+	if x != nil {
+		return x.WindowExtensionMinutes
+	}
+	return 0
+}
+
+func (x *AccommodationTerms) GetExtraAttempts() int32 {
+	if x != nil {
+		return x.ExtraAttempts
+	}
+	return 0
+}
+
+type Accommodation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClassId       int64                  `protobuf:"varint,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ExamId        int64                  `protobuf:"varint,4,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Terms         *AccommodationTerms    `protobuf:"bytes,5,opt,name=terms,proto3" json:"terms,omitempty"`
+	Notes         string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Accommodation) Reset() {
+	*x = Accommodation{}
+	mi := &file_exam_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Accommodation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Accommodation) ProtoMessage() {}
+
+func (x *Accommodation) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Accommodation.ProtoReflect.Descriptor instead.
+func (*Accommodation) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *Accommodation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Accommodation) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Accommodation) GetClassId() int64 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *Accommodation) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *Accommodation) GetTerms() *AccommodationTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *Accommodation) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Accommodation) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Accommodation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SaveAccommodationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClassId       int64                  `protobuf:"varint,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Terms         *AccommodationTerms    `protobuf:"bytes,3,opt,name=terms,proto3" json:"terms,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,5,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAccommodationRequest) Reset() {
+	*x = SaveAccommodationRequest{}
+	mi := &file_exam_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAccommodationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAccommodationRequest) ProtoMessage() {}
+
+func (x *SaveAccommodationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAccommodationRequest.ProtoReflect.Descriptor instead.
+func (*SaveAccommodationRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *SaveAccommodationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SaveAccommodationRequest) GetClassId() int64 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *SaveAccommodationRequest) GetTerms() *AccommodationTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *SaveAccommodationRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *SaveAccommodationRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type SaveAccommodationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accommodation *Accommodation         `protobuf:"bytes,1,opt,name=accommodation,proto3" json:"accommodation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAccommodationResponse) Reset() {
+	*x = SaveAccommodationResponse{}
+	mi := &file_exam_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAccommodationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAccommodationResponse) ProtoMessage() {}
+
+func (x *SaveAccommodationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAccommodationResponse.ProtoReflect.Descriptor instead.
+func (*SaveAccommodationResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *SaveAccommodationResponse) GetAccommodation() *Accommodation {
+	if x != nil {
+		return x.Accommodation
+	}
+	return nil
+}
+
+type GetAccommodationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClassId       int64                  `protobuf:"varint,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,3,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccommodationsRequest) Reset() {
+	*x = GetAccommodationsRequest{}
+	mi := &file_exam_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccommodationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccommodationsRequest) ProtoMessage() {}
+
+func (x *GetAccommodationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccommodationsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccommodationsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *GetAccommodationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAccommodationsRequest) GetClassId() int64 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *GetAccommodationsRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetAccommodationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Accommodations []*Accommodation       `protobuf:"bytes,1,rep,name=accommodations,proto3" json:"accommodations,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAccommodationsResponse) Reset() {
+	*x = GetAccommodationsResponse{}
+	mi := &file_exam_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccommodationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccommodationsResponse) ProtoMessage() {}
+
+func (x *GetAccommodationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccommodationsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccommodationsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *GetAccommodationsResponse) GetAccommodations() []*Accommodation {
+	if x != nil {
+		return x.Accommodations
+	}
+	return nil
+}
+
+type DeleteAccommodationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccommodationRequest) Reset() {
+	*x = DeleteAccommodationRequest{}
+	mi := &file_exam_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccommodationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccommodationRequest) ProtoMessage() {}
+
+func (x *DeleteAccommodationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccommodationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccommodationRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *DeleteAccommodationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteAccommodationRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type DeleteAccommodationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccommodationResponse) Reset() {
+	*x = DeleteAccommodationResponse{}
+	mi := &file_exam_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccommodationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccommodationResponse) ProtoMessage() {}
+
+func (x *DeleteAccommodationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccommodationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccommodationResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *DeleteAccommodationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SaveExamAccommodationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Terms         *AccommodationTerms    `protobuf:"bytes,3,opt,name=terms,proto3" json:"terms,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,5,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveExamAccommodationRequest) Reset() {
+	*x = SaveExamAccommodationRequest{}
+	mi := &file_exam_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveExamAccommodationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveExamAccommodationRequest) ProtoMessage() {}
+
+func (x *SaveExamAccommodationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveExamAccommodationRequest.ProtoReflect.Descriptor instead.
+func (*SaveExamAccommodationRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *SaveExamAccommodationRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *SaveExamAccommodationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SaveExamAccommodationRequest) GetTerms() *AccommodationTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *SaveExamAccommodationRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *SaveExamAccommodationRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type SaveExamAccommodationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accommodation *Accommodation         `protobuf:"bytes,1,opt,name=accommodation,proto3" json:"accommodation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveExamAccommodationResponse) Reset() {
+	*x = SaveExamAccommodationResponse{}
+	mi := &file_exam_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveExamAccommodationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveExamAccommodationResponse) ProtoMessage() {}
+
+func (x *SaveExamAccommodationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveExamAccommodationResponse.ProtoReflect.Descriptor instead.
+func (*SaveExamAccommodationResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *SaveExamAccommodationResponse) GetAccommodation() *Accommodation {
+	if x != nil {
+		return x.Accommodation
+	}
+	return nil
+}
+
+type GetExamAccommodationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamAccommodationsRequest) Reset() {
+	*x = GetExamAccommodationsRequest{}
+	mi := &file_exam_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamAccommodationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamAccommodationsRequest) ProtoMessage() {}
+
+func (x *GetExamAccommodationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamAccommodationsRequest.ProtoReflect.Descriptor instead.
+func (*GetExamAccommodationsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *GetExamAccommodationsRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetExamAccommodationsRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetExamAccommodationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Accommodations []*Accommodation       `protobuf:"bytes,1,rep,name=accommodations,proto3" json:"accommodations,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetExamAccommodationsResponse) Reset() {
+	*x = GetExamAccommodationsResponse{}
+	mi := &file_exam_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamAccommodationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamAccommodationsResponse) ProtoMessage() {}
+
+func (x *GetExamAccommodationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamAccommodationsResponse.ProtoReflect.Descriptor instead.
+func (*GetExamAccommodationsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *GetExamAccommodationsResponse) GetAccommodations() []*Accommodation {
+	if x != nil {
+		return x.Accommodations
+	}
+	return nil
+}
+
+type DeleteExamAccommodationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,3,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExamAccommodationRequest) Reset() {
+	*x = DeleteExamAccommodationRequest{}
+	mi := &file_exam_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExamAccommodationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExamAccommodationRequest) ProtoMessage() {}
+
+func (x *DeleteExamAccommodationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExamAccommodationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExamAccommodationRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *DeleteExamAccommodationRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *DeleteExamAccommodationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteExamAccommodationRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type DeleteExamAccommodationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExamAccommodationResponse) Reset() {
+	*x = DeleteExamAccommodationResponse{}
+	mi := &file_exam_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExamAccommodationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExamAccommodationResponse) ProtoMessage() {}
+
+func (x *DeleteExamAccommodationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExamAccommodationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExamAccommodationResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *DeleteExamAccommodationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ExamSessionAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExamId        int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,3,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExtraMinutes  int32                  `protobuf:"varint,6,opt,name=extra_minutes,json=extraMinutes,proto3" json:"extra_minutes,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	AffectedCount int32                  `protobuf:"varint,8,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExamSessionAction) Reset() {
+	*x = ExamSessionAction{}
+	mi := &file_exam_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamSessionAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamSessionAction) ProtoMessage() {}
+
+func (x *ExamSessionAction) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamSessionAction.ProtoReflect.Descriptor instead.
+func (*ExamSessionAction) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ExamSessionAction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExamSessionAction) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ExamSessionAction) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ExamSessionAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ExamSessionAction) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExamSessionAction) GetExtraMinutes() int32 {
+	if x != nil {
+		return x.ExtraMinutes
+	}
+	return 0
+}
+
+func (x *ExamSessionAction) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExamSessionAction) GetAffectedCount() int32 {
+	if x != nil {
+		return x.AffectedCount
+	}
+	return 0
+}
+
+func (x *ExamSessionAction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ControlExamSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExtraMinutes  int32                  `protobuf:"varint,5,opt,name=extra_minutes,json=extraMinutes,proto3" json:"extra_minutes,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlExamSessionRequest) Reset() {
+	*x = ControlExamSessionRequest{}
+	mi := &file_exam_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlExamSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlExamSessionRequest) ProtoMessage() {}
+
+func (x *ControlExamSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlExamSessionRequest.ProtoReflect.Descriptor instead.
+func (*ControlExamSessionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *ControlExamSessionRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ControlExamSessionRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ControlExamSessionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ControlExamSessionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ControlExamSessionRequest) GetExtraMinutes() int32 {
+	if x != nil {
+		return x.ExtraMinutes
+	}
+	return 0
+}
+
+func (x *ControlExamSessionRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ControlExamSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        *ExamSessionAction     `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlExamSessionResponse) Reset() {
+	*x = ControlExamSessionResponse{}
+	mi := &file_exam_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlExamSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlExamSessionResponse) ProtoMessage() {}
+
+func (x *ControlExamSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlExamSessionResponse.ProtoReflect.Descriptor instead.
+func (*ControlExamSessionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *ControlExamSessionResponse) GetAction() *ExamSessionAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type GetExamSessionActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamSessionActionsRequest) Reset() {
+	*x = GetExamSessionActionsRequest{}
+	mi := &file_exam_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamSessionActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamSessionActionsRequest) ProtoMessage() {}
+
+func (x *GetExamSessionActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamSessionActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetExamSessionActionsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *GetExamSessionActionsRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetExamSessionActionsRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetExamSessionActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*ExamSessionAction   `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamSessionActionsResponse) Reset() {
+	*x = GetExamSessionActionsResponse{}
+	mi := &file_exam_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamSessionActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamSessionActionsResponse) ProtoMessage() {}
+
+func (x *GetExamSessionActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamSessionActionsResponse.ProtoReflect.Descriptor instead.
+func (*GetExamSessionActionsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *GetExamSessionActionsResponse) GetActions() []*ExamSessionAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type GetExamSessionStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamSessionStateRequest) Reset() {
+	*x = GetExamSessionStateRequest{}
+	mi := &file_exam_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamSessionStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamSessionStateRequest) ProtoMessage() {}
+
+func (x *GetExamSessionStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamSessionStateRequest.ProtoReflect.Descriptor instead.
+func (*GetExamSessionStateRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *GetExamSessionStateRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetExamSessionStateRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetExamSessionStateRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *GetExamSessionStateRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type GetExamSessionStateResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId     int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	RemainingSeconds int32                  `protobuf:"varint,2,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	Paused           bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	LastAnswerSeq    int64                  `protobuf:"varint,4,opt,name=last_answer_seq,json=lastAnswerSeq,proto3" json:"last_answer_seq,omitempty"`
+	Deadline         string                 `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetExamSessionStateResponse) Reset() {
+	*x = GetExamSessionStateResponse{}
+	mi := &file_exam_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamSessionStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamSessionStateResponse) ProtoMessage() {}
+
+func (x *GetExamSessionStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamSessionStateResponse.ProtoReflect.Descriptor instead.
+func (*GetExamSessionStateResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *GetExamSessionStateResponse) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *GetExamSessionStateResponse) GetRemainingSeconds() int32 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *GetExamSessionStateResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GetExamSessionStateResponse) GetLastAnswerSeq() int64 {
+	if x != nil {
+		return x.LastAnswerSeq
+	}
+	return 0
+}
+
+func (x *GetExamSessionStateResponse) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+type SuspicionConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExamId              int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Weights             map[string]float32     `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	BurstWindowSeconds  int32                  `protobuf:"varint,3,opt,name=burst_window_seconds,json=burstWindowSeconds,proto3" json:"burst_window_seconds,omitempty"`
+	FlagThreshold       float32                `protobuf:"fixed32,4,opt,name=flag_threshold,json=flagThreshold,proto3" json:"flag_threshold,omitempty"`
+	LockThreshold       float32                `protobuf:"fixed32,5,opt,name=lock_threshold,json=lockThreshold,proto3" json:"lock_threshold,omitempty"`
+	AutoSubmitThreshold float32                `protobuf:"fixed32,6,opt,name=auto_submit_threshold,json=autoSubmitThreshold,proto3" json:"auto_submit_threshold,omitempty"`
+	UpdatedAt           string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SuspicionConfig) Reset() {
+	*x = SuspicionConfig{}
+	mi := &file_exam_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspicionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspicionConfig) ProtoMessage() {}
+
+func (x *SuspicionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspicionConfig.ProtoReflect.Descriptor instead.
+func (*SuspicionConfig) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *SuspicionConfig) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *SuspicionConfig) GetWeights() map[string]float32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *SuspicionConfig) GetBurstWindowSeconds() int32 {
+	if x != nil {
+		return x.BurstWindowSeconds
+	}
+	return 0
+}
+
+func (x *SuspicionConfig) GetFlagThreshold() float32 {
+	if x != nil {
+		return x.FlagThreshold
+	}
+	return 0
+}
+
+func (x *SuspicionConfig) GetLockThreshold() float32 {
+	if x != nil {
+		return x.LockThreshold
+	}
+	return 0
+}
+
+func (x *SuspicionConfig) GetAutoSubmitThreshold() float32 {
+	if x != nil {
+		return x.AutoSubmitThreshold
+	}
+	return 0
+}
+
+func (x *SuspicionConfig) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetSuspicionConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuspicionConfigRequest) Reset() {
+	*x = GetSuspicionConfigRequest{}
+	mi := &file_exam_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuspicionConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuspicionConfigRequest) ProtoMessage() {}
+
+func (x *GetSuspicionConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuspicionConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSuspicionConfigRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *GetSuspicionConfigRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetSuspicionConfigRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetSuspicionConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *SuspicionConfig       `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuspicionConfigResponse) Reset() {
+	*x = GetSuspicionConfigResponse{}
+	mi := &file_exam_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuspicionConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuspicionConfigResponse) ProtoMessage() {}
+
+func (x *GetSuspicionConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuspicionConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSuspicionConfigResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *GetSuspicionConfigResponse) GetConfig() *SuspicionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateSuspicionConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Config        *SuspicionConfig       `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSuspicionConfigRequest) Reset() {
+	*x = UpdateSuspicionConfigRequest{}
+	mi := &file_exam_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSuspicionConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSuspicionConfigRequest) ProtoMessage() {}
+
+func (x *UpdateSuspicionConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSuspicionConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSuspicionConfigRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *UpdateSuspicionConfigRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *UpdateSuspicionConfigRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *UpdateSuspicionConfigRequest) GetConfig() *SuspicionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateSuspicionConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *SuspicionConfig       `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSuspicionConfigResponse) Reset() {
+	*x = UpdateSuspicionConfigResponse{}
+	mi := &file_exam_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSuspicionConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSuspicionConfigResponse) ProtoMessage() {}
+
+func (x *UpdateSuspicionConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSuspicionConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSuspicionConfigResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *UpdateSuspicionConfigResponse) GetConfig() *SuspicionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CollusionEvidence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Response      string                 `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	IsCorrect     bool                   `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	ResponseRate  float32                `protobuf:"fixed32,4,opt,name=response_rate,json=responseRate,proto3" json:"response_rate,omitempty"`
+	AnsweredAtA   string                 `protobuf:"bytes,5,opt,name=answered_at_a,json=answeredAtA,proto3" json:"answered_at_a,omitempty"`
+	AnsweredAtB   string                 `protobuf:"bytes,6,opt,name=answered_at_b,json=answeredAtB,proto3" json:"answered_at_b,omitempty"`
+	SecondsApart  int32                  `protobuf:"varint,7,opt,name=seconds_apart,json=secondsApart,proto3" json:"seconds_apart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollusionEvidence) Reset() {
+	*x = CollusionEvidence{}
+	mi := &file_exam_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollusionEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollusionEvidence) ProtoMessage() {}
+
+func (x *CollusionEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollusionEvidence.ProtoReflect.Descriptor instead.
+func (*CollusionEvidence) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *CollusionEvidence) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *CollusionEvidence) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *CollusionEvidence) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *CollusionEvidence) GetResponseRate() float32 {
+	if x != nil {
+		return x.ResponseRate
+	}
+	return 0
+}
+
+func (x *CollusionEvidence) GetAnsweredAtA() string {
+	if x != nil {
+		return x.AnsweredAtA
+	}
+	return ""
+}
+
+func (x *CollusionEvidence) GetAnsweredAtB() string {
+	if x != nil {
+		return x.AnsweredAtB
+	}
+	return ""
+}
+
+func (x *CollusionEvidence) GetSecondsApart() int32 {
+	if x != nil {
+		return x.SecondsApart
+	}
+	return 0
+}
+
+type CollusionPair struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Rank                   int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserA                  int64                  `protobuf:"varint,2,opt,name=user_a,json=userA,proto3" json:"user_a,omitempty"`
+	UserB                  int64                  `protobuf:"varint,3,opt,name=user_b,json=userB,proto3" json:"user_b,omitempty"`
+	SubmissionA            int64                  `protobuf:"varint,4,opt,name=submission_a,json=submissionA,proto3" json:"submission_a,omitempty"`
+	SubmissionB            int64                  `protobuf:"varint,5,opt,name=submission_b,json=submissionB,proto3" json:"submission_b,omitempty"`
+	SharedQuestions        int32                  `protobuf:"varint,6,opt,name=shared_questions,json=sharedQuestions,proto3" json:"shared_questions,omitempty"`
+	IdenticalAnswers       int32                  `protobuf:"varint,7,opt,name=identical_answers,json=identicalAnswers,proto3" json:"identical_answers,omitempty"`
+	IdenticalWrong         int32                  `protobuf:"varint,8,opt,name=identical_wrong,json=identicalWrong,proto3" json:"identical_wrong,omitempty"`
+	ExpectedIdenticalWrong float32                `protobuf:"fixed32,9,opt,name=expected_identical_wrong,json=expectedIdenticalWrong,proto3" json:"expected_identical_wrong,omitempty"`
+	ZScore                 float32                `protobuf:"fixed32,10,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`
+	TimingMatches          int32                  `protobuf:"varint,11,opt,name=timing_matches,json=timingMatches,proto3" json:"timing_matches,omitempty"`
+	SubmitSecondsApart     int32                  `protobuf:"varint,12,opt,name=submit_seconds_apart,json=submitSecondsApart,proto3" json:"submit_seconds_apart,omitempty"`
+	Flagged                bool                   `protobuf:"varint,13,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	Evidence               []*CollusionEvidence   `protobuf:"bytes,14,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CollusionPair) Reset() {
+	*x = CollusionPair{}
+	mi := &file_exam_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollusionPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollusionPair) ProtoMessage() {}
+
+func (x *CollusionPair) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollusionPair.ProtoReflect.Descriptor instead.
+func (*CollusionPair) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *CollusionPair) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetUserA() int64 {
+	if x != nil {
+		return x.UserA
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetUserB() int64 {
+	if x != nil {
+		return x.UserB
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetSubmissionA() int64 {
+	if x != nil {
+		return x.SubmissionA
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetSubmissionB() int64 {
+	if x != nil {
+		return x.SubmissionB
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetSharedQuestions() int32 {
+	if x != nil {
+		return x.SharedQuestions
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetIdenticalAnswers() int32 {
+	if x != nil {
+		return x.IdenticalAnswers
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetIdenticalWrong() int32 {
+	if x != nil {
+		return x.IdenticalWrong
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetExpectedIdenticalWrong() float32 {
+	if x != nil {
+		return x.ExpectedIdenticalWrong
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetZScore() float32 {
+	if x != nil {
+		return x.ZScore
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetTimingMatches() int32 {
+	if x != nil {
+		return x.TimingMatches
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetSubmitSecondsApart() int32 {
+	if x != nil {
+		return x.SubmitSecondsApart
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *CollusionPair) GetEvidence() []*CollusionEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type CollusionReport struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExamId              int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	StudentCount        int32                  `protobuf:"varint,3,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	PairCount           int32                  `protobuf:"varint,4,opt,name=pair_count,json=pairCount,proto3" json:"pair_count,omitempty"`
+	MinIdenticalWrong   int32                  `protobuf:"varint,5,opt,name=min_identical_wrong,json=minIdenticalWrong,proto3" json:"min_identical_wrong,omitempty"`
+	ZThreshold          float32                `protobuf:"fixed32,6,opt,name=z_threshold,json=zThreshold,proto3" json:"z_threshold,omitempty"`
+	TimingWindowSeconds int32                  `protobuf:"varint,7,opt,name=timing_window_seconds,json=timingWindowSeconds,proto3" json:"timing_window_seconds,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Pairs               []*CollusionPair       `protobuf:"bytes,9,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CollusionReport) Reset() {
+	*x = CollusionReport{}
+	mi := &file_exam_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollusionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollusionReport) ProtoMessage() {}
+
+func (x *CollusionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollusionReport.ProtoReflect.Descriptor instead.
+func (*CollusionReport) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *CollusionReport) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CollusionReport) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *CollusionReport) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *CollusionReport) GetPairCount() int32 {
+	if x != nil {
+		return x.PairCount
+	}
+	return 0
+}
+
+func (x *CollusionReport) GetMinIdenticalWrong() int32 {
+	if x != nil {
+		return x.MinIdenticalWrong
+	}
+	return 0
+}
+
+func (x *CollusionReport) GetZThreshold() float32 {
+	if x != nil {
+		return x.ZThreshold
+	}
+	return 0
+}
+
+func (x *CollusionReport) GetTimingWindowSeconds() int32 {
+	if x != nil {
+		return x.TimingWindowSeconds
+	}
+	return 0
+}
+
+func (x *CollusionReport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CollusionReport) GetPairs() []*CollusionPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type AnalyzeCollusionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExamId              int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId        int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	MinIdenticalWrong   int32                  `protobuf:"varint,3,opt,name=min_identical_wrong,json=minIdenticalWrong,proto3" json:"min_identical_wrong,omitempty"`
+	ZThreshold          float32                `protobuf:"fixed32,4,opt,name=z_threshold,json=zThreshold,proto3" json:"z_threshold,omitempty"`
+	TimingWindowSeconds int32                  `protobuf:"varint,5,opt,name=timing_window_seconds,json=timingWindowSeconds,proto3" json:"timing_window_seconds,omitempty"`
+	MaxPairs            int32                  `protobuf:"varint,6,opt,name=max_pairs,json=maxPairs,proto3" json:"max_pairs,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AnalyzeCollusionRequest) Reset() {
+	*x = AnalyzeCollusionRequest{}
+	mi := &file_exam_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeCollusionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeCollusionRequest) ProtoMessage() {}
+
+func (x *AnalyzeCollusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeCollusionRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeCollusionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *AnalyzeCollusionRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *AnalyzeCollusionRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *AnalyzeCollusionRequest) GetMinIdenticalWrong() int32 {
+	if x != nil {
+		return x.MinIdenticalWrong
+	}
+	return 0
+}
+
+func (x *AnalyzeCollusionRequest) GetZThreshold() float32 {
+	if x != nil {
+		return x.ZThreshold
+	}
+	return 0
+}
+
+func (x *AnalyzeCollusionRequest) GetTimingWindowSeconds() int32 {
+	if x != nil {
+		return x.TimingWindowSeconds
+	}
+	return 0
+}
+
+func (x *AnalyzeCollusionRequest) GetMaxPairs() int32 {
+	if x != nil {
+		return x.MaxPairs
+	}
+	return 0
+}
+
+type AnalyzeCollusionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *CollusionReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeCollusionResponse) Reset() {
+	*x = AnalyzeCollusionResponse{}
+	mi := &file_exam_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeCollusionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeCollusionResponse) ProtoMessage() {}
+
+func (x *AnalyzeCollusionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeCollusionResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeCollusionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *AnalyzeCollusionResponse) GetReport() *CollusionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type GetCollusionReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollusionReportRequest) Reset() {
+	*x = GetCollusionReportRequest{}
+	mi := &file_exam_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollusionReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollusionReportRequest) ProtoMessage() {}
+
+func (x *GetCollusionReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollusionReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCollusionReportRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *GetCollusionReportRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetCollusionReportRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetCollusionReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *CollusionReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollusionReportResponse) Reset() {
+	*x = GetCollusionReportResponse{}
+	mi := &file_exam_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollusionReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollusionReportResponse) ProtoMessage() {}
+
+func (x *GetCollusionReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollusionReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCollusionReportResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *GetCollusionReportResponse) GetReport() *CollusionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type SimilaritySource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId    int64                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilaritySource) Reset() {
+	*x = SimilaritySource{}
+	mi := &file_exam_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilaritySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilaritySource) ProtoMessage() {}
+
+func (x *SimilaritySource) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilaritySource.ProtoReflect.Descriptor instead.
+func (*SimilaritySource) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *SimilaritySource) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SimilaritySource) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *SimilaritySource) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SimilaritySource) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SimilaritySource) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SimilaritySpan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	MatchedStart  int32                  `protobuf:"varint,3,opt,name=matched_start,json=matchedStart,proto3" json:"matched_start,omitempty"`
+	MatchedEnd    int32                  `protobuf:"varint,4,opt,name=matched_end,json=matchedEnd,proto3" json:"matched_end,omitempty"`
+	MatchedText   string                 `protobuf:"bytes,5,opt,name=matched_text,json=matchedText,proto3" json:"matched_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilaritySpan) Reset() {
+	*x = SimilaritySpan{}
+	mi := &file_exam_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilaritySpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilaritySpan) ProtoMessage() {}
+
+func (x *SimilaritySpan) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilaritySpan.ProtoReflect.Descriptor instead.
+func (*SimilaritySpan) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *SimilaritySpan) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SimilaritySpan) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *SimilaritySpan) GetMatchedStart() int32 {
+	if x != nil {
+		return x.MatchedStart
+	}
+	return 0
+}
+
+func (x *SimilaritySpan) GetMatchedEnd() int32 {
+	if x != nil {
+		return x.MatchedEnd
+	}
+	return 0
+}
+
+func (x *SimilaritySpan) GetMatchedText() string {
+	if x != nil {
+		return x.MatchedText
+	}
+	return ""
+}
+
+type SimilarityMatch struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId          int64                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	SubmissionId        int64                  `protobuf:"varint,3,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	UserId              int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MatchedSubmissionId int64                  `protobuf:"varint,5,opt,name=matched_submission_id,json=matchedSubmissionId,proto3" json:"matched_submission_id,omitempty"`
+	MatchedUserId       int64                  `protobuf:"varint,6,opt,name=matched_user_id,json=matchedUserId,proto3" json:"matched_user_id,omitempty"`
+	SourceId            int64                  `protobuf:"varint,7,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceTitle         string                 `protobuf:"bytes,8,opt,name=source_title,json=sourceTitle,proto3" json:"source_title,omitempty"`
+	Similarity          float32                `protobuf:"fixed32,9,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	SharedFingerprints  int32                  `protobuf:"varint,10,opt,name=shared_fingerprints,json=sharedFingerprints,proto3" json:"shared_fingerprints,omitempty"`
+	Spans               []*SimilaritySpan      `protobuf:"bytes,11,rep,name=spans,proto3" json:"spans,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SimilarityMatch) Reset() {
+	*x = SimilarityMatch{}
+	mi := &file_exam_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarityMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarityMatch) ProtoMessage() {}
+
+func (x *SimilarityMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarityMatch.ProtoReflect.Descriptor instead.
+func (*SimilarityMatch) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *SimilarityMatch) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetMatchedSubmissionId() int64 {
+	if x != nil {
+		return x.MatchedSubmissionId
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetMatchedUserId() int64 {
+	if x != nil {
+		return x.MatchedUserId
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetSourceTitle() string {
+	if x != nil {
+		return x.SourceTitle
+	}
+	return ""
+}
+
+func (x *SimilarityMatch) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetSharedFingerprints() int32 {
+	if x != nil {
+		return x.SharedFingerprints
+	}
+	return 0
+}
+
+func (x *SimilarityMatch) GetSpans() []*SimilaritySpan {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+func (x *SimilarityMatch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SaveSimilaritySourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Sources       []*SimilaritySource    `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSimilaritySourcesRequest) Reset() {
+	*x = SaveSimilaritySourcesRequest{}
+	mi := &file_exam_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSimilaritySourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSimilaritySourcesRequest) ProtoMessage() {}
+
+func (x *SaveSimilaritySourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSimilaritySourcesRequest.ProtoReflect.Descriptor instead.
+func (*SaveSimilaritySourcesRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *SaveSimilaritySourcesRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *SaveSimilaritySourcesRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *SaveSimilaritySourcesRequest) GetSources() []*SimilaritySource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type SaveSimilaritySourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sources       []*SimilaritySource    `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSimilaritySourcesResponse) Reset() {
+	*x = SaveSimilaritySourcesResponse{}
+	mi := &file_exam_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSimilaritySourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSimilaritySourcesResponse) ProtoMessage() {}
+
+func (x *SaveSimilaritySourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSimilaritySourcesResponse.ProtoReflect.Descriptor instead.
+func (*SaveSimilaritySourcesResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *SaveSimilaritySourcesResponse) GetSources() []*SimilaritySource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GetSimilaritySourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilaritySourcesRequest) Reset() {
+	*x = GetSimilaritySourcesRequest{}
+	mi := &file_exam_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilaritySourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilaritySourcesRequest) ProtoMessage() {}
+
+func (x *GetSimilaritySourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilaritySourcesRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilaritySourcesRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *GetSimilaritySourcesRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetSimilaritySourcesRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetSimilaritySourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sources       []*SimilaritySource    `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilaritySourcesResponse) Reset() {
+	*x = GetSimilaritySourcesResponse{}
+	mi := &file_exam_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilaritySourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilaritySourcesResponse) ProtoMessage() {}
+
+func (x *GetSimilaritySourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilaritySourcesResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilaritySourcesResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *GetSimilaritySourcesResponse) GetSources() []*SimilaritySource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type CheckEssaySimilarityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	QuestionId    int64                  `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	MinSimilarity float32                `protobuf:"fixed32,4,opt,name=min_similarity,json=minSimilarity,proto3" json:"min_similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEssaySimilarityRequest) Reset() {
+	*x = CheckEssaySimilarityRequest{}
+	mi := &file_exam_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEssaySimilarityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEssaySimilarityRequest) ProtoMessage() {}
+
+func (x *CheckEssaySimilarityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEssaySimilarityRequest.ProtoReflect.Descriptor instead.
+func (*CheckEssaySimilarityRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *CheckEssaySimilarityRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *CheckEssaySimilarityRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *CheckEssaySimilarityRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *CheckEssaySimilarityRequest) GetMinSimilarity() float32 {
+	if x != nil {
+		return x.MinSimilarity
+	}
+	return 0
+}
+
+type CheckEssaySimilarityResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CheckedAnswers int32                  `protobuf:"varint,1,opt,name=checked_answers,json=checkedAnswers,proto3" json:"checked_answers,omitempty"`
+	MatchCount     int32                  `protobuf:"varint,2,opt,name=match_count,json=matchCount,proto3" json:"match_count,omitempty"`
+	Matches        []*SimilarityMatch     `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckEssaySimilarityResponse) Reset() {
+	*x = CheckEssaySimilarityResponse{}
+	mi := &file_exam_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEssaySimilarityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEssaySimilarityResponse) ProtoMessage() {}
+
+func (x *CheckEssaySimilarityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEssaySimilarityResponse.ProtoReflect.Descriptor instead.
+func (*CheckEssaySimilarityResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *CheckEssaySimilarityResponse) GetCheckedAnswers() int32 {
+	if x != nil {
+		return x.CheckedAnswers
+	}
+	return 0
+}
+
+func (x *CheckEssaySimilarityResponse) GetMatchCount() int32 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *CheckEssaySimilarityResponse) GetMatches() []*SimilarityMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type GetEssaySimilarityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	QuestionId    int64                  `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	SubmissionId  int64                  `protobuf:"varint,4,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEssaySimilarityRequest) Reset() {
+	*x = GetEssaySimilarityRequest{}
+	mi := &file_exam_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEssaySimilarityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEssaySimilarityRequest) ProtoMessage() {}
+
+func (x *GetEssaySimilarityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEssaySimilarityRequest.ProtoReflect.Descriptor instead.
+func (*GetEssaySimilarityRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *GetEssaySimilarityRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetEssaySimilarityRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GetEssaySimilarityRequest) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *GetEssaySimilarityRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+type GetEssaySimilarityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*SimilarityMatch     `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEssaySimilarityResponse) Reset() {
+	*x = GetEssaySimilarityResponse{}
+	mi := &file_exam_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEssaySimilarityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEssaySimilarityResponse) ProtoMessage() {}
+
+func (x *GetEssaySimilarityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEssaySimilarityResponse.ProtoReflect.Descriptor instead.
+func (*GetEssaySimilarityResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *GetEssaySimilarityResponse) GetMatches() []*SimilarityMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type PracticeFeedback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	IsCorrect     bool                   `protobuf:"varint,2,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	Pending       bool                   `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	EarnedPoints  float32                `protobuf:"fixed32,4,opt,name=earned_points,json=earnedPoints,proto3" json:"earned_points,omitempty"`
+	Points        float32                `protobuf:"fixed32,5,opt,name=points,proto3" json:"points,omitempty"`
+	Explanation   string                 `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Choices       []*ChoiceReview        `protobuf:"bytes,7,rep,name=choices,proto3" json:"choices,omitempty"`
+	Numeric       *NumericAnswerConfig   `protobuf:"bytes,8,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	CorrectBlanks []*ClozeBlank          `protobuf:"bytes,9,rep,name=correct_blanks,json=correctBlanks,proto3" json:"correct_blanks,omitempty"`
+	AttemptCount  int32                  `protobuf:"varint,10,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PracticeFeedback) Reset() {
+	*x = PracticeFeedback{}
+	mi := &file_exam_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticeFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticeFeedback) ProtoMessage() {}
+
+func (x *PracticeFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticeFeedback.ProtoReflect.Descriptor instead.
+func (*PracticeFeedback) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *PracticeFeedback) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *PracticeFeedback) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *PracticeFeedback) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *PracticeFeedback) GetEarnedPoints() float32 {
+	if x != nil {
+		return x.EarnedPoints
+	}
+	return 0
+}
+
+func (x *PracticeFeedback) GetPoints() float32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *PracticeFeedback) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *PracticeFeedback) GetChoices() []*ChoiceReview {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *PracticeFeedback) GetNumeric() *NumericAnswerConfig {
+	if x != nil {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *PracticeFeedback) GetCorrectBlanks() []*ClozeBlank {
+	if x != nil {
+		return x.CorrectBlanks
+	}
+	return nil
+}
+
+func (x *PracticeFeedback) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+type PracticeArea struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Questions         int32                  `protobuf:"varint,3,opt,name=questions,proto3" json:"questions,omitempty"`
+	Attempts          int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CorrectAttempts   int32                  `protobuf:"varint,5,opt,name=correct_attempts,json=correctAttempts,proto3" json:"correct_attempts,omitempty"`
+	Accuracy          float32                `protobuf:"fixed32,6,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	MasteredQuestions int32                  `protobuf:"varint,7,opt,name=mastered_questions,json=masteredQuestions,proto3" json:"mastered_questions,omitempty"`
+	WeakQuestionIds   []int64                `protobuf:"varint,8,rep,packed,name=weak_question_ids,json=weakQuestionIds,proto3" json:"weak_question_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PracticeArea) Reset() {
+	*x = PracticeArea{}
+	mi := &file_exam_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticeArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticeArea) ProtoMessage() {}
+
+func (x *PracticeArea) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticeArea.ProtoReflect.Descriptor instead.
+func (*PracticeArea) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *PracticeArea) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PracticeArea) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PracticeArea) GetQuestions() int32 {
+	if x != nil {
+		return x.Questions
+	}
+	return 0
+}
+
+func (x *PracticeArea) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PracticeArea) GetCorrectAttempts() int32 {
+	if x != nil {
+		return x.CorrectAttempts
+	}
+	return 0
+}
+
+func (x *PracticeArea) GetAccuracy() float32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *PracticeArea) GetMasteredQuestions() int32 {
+	if x != nil {
+		return x.MasteredQuestions
+	}
+	return 0
+}
+
+func (x *PracticeArea) GetWeakQuestionIds() []int64 {
+	if x != nil {
+		return x.WeakQuestionIds
+	}
+	return nil
+}
+
+type GetPracticeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExamId        int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPracticeReportRequest) Reset() {
+	*x = GetPracticeReportRequest{}
+	mi := &file_exam_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPracticeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPracticeReportRequest) ProtoMessage() {}
+
+func (x *GetPracticeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPracticeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetPracticeReportRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *GetPracticeReportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPracticeReportRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+type GetPracticeReportResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalAttempts   int32                  `protobuf:"varint,1,opt,name=total_attempts,json=totalAttempts,proto3" json:"total_attempts,omitempty"`
+	CorrectAttempts int32                  `protobuf:"varint,2,opt,name=correct_attempts,json=correctAttempts,proto3" json:"correct_attempts,omitempty"`
+	Accuracy        float32                `protobuf:"fixed32,3,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Sections        []*PracticeArea        `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	Topics          []*PracticeArea        `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPracticeReportResponse) Reset() {
+	*x = GetPracticeReportResponse{}
+	mi := &file_exam_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPracticeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPracticeReportResponse) ProtoMessage() {}
+
+func (x *GetPracticeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPracticeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetPracticeReportResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *GetPracticeReportResponse) GetTotalAttempts() int32 {
+	if x != nil {
+		return x.TotalAttempts
+	}
+	return 0
+}
+
+func (x *GetPracticeReportResponse) GetCorrectAttempts() int32 {
+	if x != nil {
+		return x.CorrectAttempts
+	}
+	return 0
+}
+
+func (x *GetPracticeReportResponse) GetAccuracy() float32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *GetPracticeReportResponse) GetSections() []*PracticeArea {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *GetPracticeReportResponse) GetTopics() []*PracticeArea {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type ReviewAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Explanation   string                 `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Choices       []*ChoiceReview        `protobuf:"bytes,2,rep,name=choices,proto3" json:"choices,omitempty"`
+	Numeric       *NumericAnswerConfig   `protobuf:"bytes,3,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	CorrectBlanks []*ClozeBlank          `protobuf:"bytes,4,rep,name=correct_blanks,json=correctBlanks,proto3" json:"correct_blanks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAnswer) Reset() {
+	*x = ReviewAnswer{}
+	mi := &file_exam_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAnswer) ProtoMessage() {}
+
+func (x *ReviewAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAnswer.ProtoReflect.Descriptor instead.
+func (*ReviewAnswer) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *ReviewAnswer) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *ReviewAnswer) GetChoices() []*ChoiceReview {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *ReviewAnswer) GetNumeric() *NumericAnswerConfig {
+	if x != nil {
+		return x.Numeric
+	}
+	return nil
+}
+
+func (x *ReviewAnswer) GetCorrectBlanks() []*ClozeBlank {
+	if x != nil {
+		return x.CorrectBlanks
+	}
+	return nil
+}
+
+type ReviewCard struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId     int64                  `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ExamId         int64                  `protobuf:"varint,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	TopicId        int64                  `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	EaseFactor     float32                `protobuf:"fixed32,5,opt,name=ease_factor,json=easeFactor,proto3" json:"ease_factor,omitempty"`
+	IntervalDays   int32                  `protobuf:"varint,6,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`
+	Repetitions    int32                  `protobuf:"varint,7,opt,name=repetitions,proto3" json:"repetitions,omitempty"`
+	Lapses         int32                  `protobuf:"varint,8,opt,name=lapses,proto3" json:"lapses,omitempty"`
+	DueAt          string                 `protobuf:"bytes,9,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	LastRating     int32                  `protobuf:"varint,10,opt,name=last_rating,json=lastRating,proto3" json:"last_rating,omitempty"`
+	LastReviewedAt string                 `protobuf:"bytes,11,opt,name=last_reviewed_at,json=lastReviewedAt,proto3" json:"last_reviewed_at,omitempty"`
+	Question       *QuestionDetails       `protobuf:"bytes,12,opt,name=question,proto3" json:"question,omitempty"`
+	Answer         *ReviewAnswer          `protobuf:"bytes,13,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReviewCard) Reset() {
+	*x = ReviewCard{}
+	mi := &file_exam_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCard) ProtoMessage() {}
+
+func (x *ReviewCard) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCard.ProtoReflect.Descriptor instead.
+func (*ReviewCard) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *ReviewCard) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetEaseFactor() float32 {
+	if x != nil {
+		return x.EaseFactor
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetRepetitions() int32 {
+	if x != nil {
+		return x.Repetitions
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *ReviewCard) GetLastRating() int32 {
+	if x != nil {
+		return x.LastRating
+	}
+	return 0
+}
+
+func (x *ReviewCard) GetLastReviewedAt() string {
+	if x != nil {
+		return x.LastReviewedAt
+	}
+	return ""
+}
+
+func (x *ReviewCard) GetQuestion() *QuestionDetails {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *ReviewCard) GetAnswer() *ReviewAnswer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type GetDueReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TopicId       int64                  `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ClassId       int64                  `protobuf:"varint,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDueReviewsRequest) Reset() {
+	*x = GetDueReviewsRequest{}
+	mi := &file_exam_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDueReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDueReviewsRequest) ProtoMessage() {}
+
+func (x *GetDueReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDueReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetDueReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *GetDueReviewsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDueReviewsRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *GetDueReviewsRequest) GetClassId() int64 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *GetDueReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDueReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*ReviewCard          `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	DueCount      int64                  `protobuf:"varint,2,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`
+	TotalCards    int64                  `protobuf:"varint,3,opt,name=total_cards,json=totalCards,proto3" json:"total_cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDueReviewsResponse) Reset() {
+	*x = GetDueReviewsResponse{}
+	mi := &file_exam_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDueReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDueReviewsResponse) ProtoMessage() {}
+
+func (x *GetDueReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDueReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetDueReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *GetDueReviewsResponse) GetCards() []*ReviewCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *GetDueReviewsResponse) GetDueCount() int64 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *GetDueReviewsResponse) GetTotalCards() int64 {
+	if x != nil {
+		return x.TotalCards
+	}
+	return 0
+}
+
+type RecordReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CardId        int64                  `protobuf:"varint,2,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReviewRequest) Reset() {
+	*x = RecordReviewRequest{}
+	mi := &file_exam_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReviewRequest) ProtoMessage() {}
+
+func (x *RecordReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReviewRequest.ProtoReflect.Descriptor instead.
+func (*RecordReviewRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *RecordReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecordReviewRequest) GetCardId() int64 {
+	if x != nil {
+		return x.CardId
+	}
+	return 0
+}
+
+func (x *RecordReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type RecordReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *ReviewCard            `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReviewResponse) Reset() {
+	*x = RecordReviewResponse{}
+	mi := &file_exam_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReviewResponse) ProtoMessage() {}
+
+func (x *RecordReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReviewResponse.ProtoReflect.Descriptor instead.
+func (*RecordReviewResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *RecordReviewResponse) GetCard() *ReviewCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type GeneratePaperExamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	VersionCount  int32                  `protobuf:"varint,3,opt,name=version_count,json=versionCount,proto3" json:"version_count,omitempty"`
+	StudentIds    []int64                `protobuf:"varint,4,rep,packed,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	SchoolName    string                 `protobuf:"bytes,5,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	ExamDate      string                 `protobuf:"bytes,6,opt,name=exam_date,json=examDate,proto3" json:"exam_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePaperExamRequest) Reset() {
+	*x = GeneratePaperExamRequest{}
+	mi := &file_exam_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePaperExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePaperExamRequest) ProtoMessage() {}
+
+func (x *GeneratePaperExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePaperExamRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePaperExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *GeneratePaperExamRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GeneratePaperExamRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GeneratePaperExamRequest) GetVersionCount() int32 {
+	if x != nil {
+		return x.VersionCount
+	}
+	return 0
+}
+
+func (x *GeneratePaperExamRequest) GetStudentIds() []int64 {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *GeneratePaperExamRequest) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+func (x *GeneratePaperExamRequest) GetExamDate() string {
+	if x != nil {
+		return x.ExamDate
+	}
+	return ""
+}
+
+type PaperExamVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	StudentId     int64                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName   string                 `protobuf:"bytes,3,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	QuestionCount int32                  `protobuf:"varint,4,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	TotalPoints   float32                `protobuf:"fixed32,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaperExamVersion) Reset() {
+	*x = PaperExamVersion{}
+	mi := &file_exam_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaperExamVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaperExamVersion) ProtoMessage() {}
+
+func (x *PaperExamVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaperExamVersion.ProtoReflect.Descriptor instead.
+func (*PaperExamVersion) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *PaperExamVersion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PaperExamVersion) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *PaperExamVersion) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *PaperExamVersion) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *PaperExamVersion) GetTotalPoints() float32 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+type GeneratePaperExamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZipData       []byte                 `protobuf:"bytes,1,opt,name=zip_data,json=zipData,proto3" json:"zip_data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Versions      []*PaperExamVersion    `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePaperExamResponse) Reset() {
+	*x = GeneratePaperExamResponse{}
+	mi := &file_exam_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePaperExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePaperExamResponse) ProtoMessage() {}
+
+func (x *GeneratePaperExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePaperExamResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePaperExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *GeneratePaperExamResponse) GetZipData() []byte {
+	if x != nil {
+		return x.ZipData
+	}
+	return nil
+}
+
+func (x *GeneratePaperExamResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GeneratePaperExamResponse) GetVersions() []*PaperExamVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type GenerateAnswerSheetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	StudentIds    []int64                `protobuf:"varint,3,rep,packed,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	VersionCount  int32                  `protobuf:"varint,4,opt,name=version_count,json=versionCount,proto3" json:"version_count,omitempty"`
+	SchoolName    string                 `protobuf:"bytes,5,opt,name=school_name,json=schoolName,proto3" json:"school_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateAnswerSheetsRequest) Reset() {
+	*x = GenerateAnswerSheetsRequest{}
+	mi := &file_exam_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAnswerSheetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAnswerSheetsRequest) ProtoMessage() {}
+
+func (x *GenerateAnswerSheetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAnswerSheetsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAnswerSheetsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *GenerateAnswerSheetsRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GenerateAnswerSheetsRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GenerateAnswerSheetsRequest) GetStudentIds() []int64 {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *GenerateAnswerSheetsRequest) GetVersionCount() int32 {
+	if x != nil {
+		return x.VersionCount
+	}
+	return 0
+}
+
+func (x *GenerateAnswerSheetsRequest) GetSchoolName() string {
+	if x != nil {
+		return x.SchoolName
+	}
+	return ""
+}
+
+type AnswerSheetInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StudentId       int64                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName     string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	VersionCode     string                 `protobuf:"bytes,3,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
+	BubbleQuestions int32                  `protobuf:"varint,4,opt,name=bubble_questions,json=bubbleQuestions,proto3" json:"bubble_questions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AnswerSheetInfo) Reset() {
+	*x = AnswerSheetInfo{}
+	mi := &file_exam_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerSheetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerSheetInfo) ProtoMessage() {}
+
+func (x *AnswerSheetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerSheetInfo.ProtoReflect.Descriptor instead.
+func (*AnswerSheetInfo) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *AnswerSheetInfo) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *AnswerSheetInfo) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AnswerSheetInfo) GetVersionCode() string {
+	if x != nil {
+		return x.VersionCode
+	}
+	return ""
+}
+
+func (x *AnswerSheetInfo) GetBubbleQuestions() int32 {
+	if x != nil {
+		return x.BubbleQuestions
+	}
+	return 0
+}
+
+type GenerateAnswerSheetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PdfData       []byte                 `protobuf:"bytes,1,opt,name=pdf_data,json=pdfData,proto3" json:"pdf_data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Sheets        []*AnswerSheetInfo     `protobuf:"bytes,3,rep,name=sheets,proto3" json:"sheets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateAnswerSheetsResponse) Reset() {
+	*x = GenerateAnswerSheetsResponse{}
+	mi := &file_exam_proto_msgTypes[249]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateAnswerSheetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAnswerSheetsResponse) ProtoMessage() {}
+
+func (x *GenerateAnswerSheetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[249]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAnswerSheetsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAnswerSheetsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *GenerateAnswerSheetsResponse) GetPdfData() []byte {
+	if x != nil {
+		return x.PdfData
+	}
+	return nil
+}
+
+func (x *GenerateAnswerSheetsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GenerateAnswerSheetsResponse) GetSheets() []*AnswerSheetInfo {
+	if x != nil {
+		return x.Sheets
+	}
+	return nil
+}
+
+type ScannedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScannedFile) Reset() {
+	*x = ScannedFile{}
+	mi := &file_exam_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScannedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScannedFile) ProtoMessage() {}
+
+func (x *ScannedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScannedFile.ProtoReflect.Descriptor instead.
+func (*ScannedFile) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *ScannedFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ScannedFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ScanMark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Number        int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Marked        []string               `protobuf:"bytes,3,rep,name=marked,proto3" json:"marked,omitempty"`
+	FillRatios    []float32              `protobuf:"fixed32,4,rep,packed,name=fill_ratios,json=fillRatios,proto3" json:"fill_ratios,omitempty"`
+	Confidence    float32                `protobuf:"fixed32,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	NeedsReview   bool                   `protobuf:"varint,6,opt,name=needs_review,json=needsReview,proto3" json:"needs_review,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanMark) Reset() {
+	*x = ScanMark{}
+	mi := &file_exam_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanMark) ProtoMessage() {}
+
+func (x *ScanMark) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[251]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanMark.ProtoReflect.Descriptor instead.
+func (*ScanMark) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *ScanMark) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ScanMark) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ScanMark) GetMarked() []string {
+	if x != nil {
+		return x.Marked
+	}
+	return nil
+}
+
+func (x *ScanMark) GetFillRatios() []float32 {
+	if x != nil {
+		return x.FillRatios
+	}
+	return nil
+}
+
+func (x *ScanMark) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *ScanMark) GetNeedsReview() bool {
+	if x != nil {
+		return x.NeedsReview
+	}
+	return false
+}
+
+func (x *ScanMark) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AnswerSheetScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExamId        int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	StudentId     int64                  `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	VersionCode   string                 `protobuf:"bytes,4,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
+	SubmissionId  int64                  `protobuf:"varint,5,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Page          int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Confidence    float32                `protobuf:"fixed32,10,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Marks         []*ScanMark            `protobuf:"bytes,11,rep,name=marks,proto3" json:"marks,omitempty"`
+	Error         string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	Score         float32                `protobuf:"fixed32,13,opt,name=score,proto3" json:"score,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerSheetScan) Reset() {
+	*x = AnswerSheetScan{}
+	mi := &file_exam_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerSheetScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerSheetScan) ProtoMessage() {}
+
+func (x *AnswerSheetScan) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerSheetScan.ProtoReflect.Descriptor instead.
+func (*AnswerSheetScan) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *AnswerSheetScan) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AnswerSheetScan) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *AnswerSheetScan) GetStudentId() int64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *AnswerSheetScan) GetVersionCode() string {
+	if x != nil {
+		return x.VersionCode
+	}
+	return ""
+}
+
+func (x *AnswerSheetScan) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *AnswerSheetScan) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AnswerSheetScan) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AnswerSheetScan) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *AnswerSheetScan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AnswerSheetScan) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *AnswerSheetScan) GetMarks() []*ScanMark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+func (x *AnswerSheetScan) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AnswerSheetScan) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AnswerSheetScan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GradeScannedSheetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Files         []*ScannedFile         `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeScannedSheetsRequest) Reset() {
+	*x = GradeScannedSheetsRequest{}
+	mi := &file_exam_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeScannedSheetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeScannedSheetsRequest) ProtoMessage() {}
+
+func (x *GradeScannedSheetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeScannedSheetsRequest.ProtoReflect.Descriptor instead.
+func (*GradeScannedSheetsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *GradeScannedSheetsRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GradeScannedSheetsRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GradeScannedSheetsRequest) GetFiles() []*ScannedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GradeScannedSheetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scans         []*AnswerSheetScan     `protobuf:"bytes,1,rep,name=scans,proto3" json:"scans,omitempty"`
+	GradedCount   int32                  `protobuf:"varint,2,opt,name=graded_count,json=gradedCount,proto3" json:"graded_count,omitempty"`
+	ReviewCount   int32                  `protobuf:"varint,3,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeScannedSheetsResponse) Reset() {
+	*x = GradeScannedSheetsResponse{}
+	mi := &file_exam_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeScannedSheetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeScannedSheetsResponse) ProtoMessage() {}
+
+func (x *GradeScannedSheetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[254]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeScannedSheetsResponse.ProtoReflect.Descriptor instead.
+func (*GradeScannedSheetsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *GradeScannedSheetsResponse) GetScans() []*AnswerSheetScan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+func (x *GradeScannedSheetsResponse) GetGradedCount() int32 {
+	if x != nil {
+		return x.GradedCount
+	}
+	return 0
+}
+
+func (x *GradeScannedSheetsResponse) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *GradeScannedSheetsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type GetAnswerSheetScansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnswerSheetScansRequest) Reset() {
+	*x = GetAnswerSheetScansRequest{}
+	mi := &file_exam_proto_msgTypes[255]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnswerSheetScansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnswerSheetScansRequest) ProtoMessage() {}
+
+func (x *GetAnswerSheetScansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[255]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnswerSheetScansRequest.ProtoReflect.Descriptor instead.
+func (*GetAnswerSheetScansRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{255}
+}
+
+func (x *GetAnswerSheetScansRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *GetAnswerSheetScansRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GetAnswerSheetScansRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetAnswerSheetScansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scans         []*AnswerSheetScan     `protobuf:"bytes,1,rep,name=scans,proto3" json:"scans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnswerSheetScansResponse) Reset() {
+	*x = GetAnswerSheetScansResponse{}
+	mi := &file_exam_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnswerSheetScansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnswerSheetScansResponse) ProtoMessage() {}
+
+func (x *GetAnswerSheetScansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[256]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnswerSheetScansResponse.ProtoReflect.Descriptor instead.
+func (*GetAnswerSheetScansResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *GetAnswerSheetScansResponse) GetScans() []*AnswerSheetScan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+type ScanCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Marked        []string               `protobuf:"bytes,2,rep,name=marked,proto3" json:"marked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanCorrection) Reset() {
+	*x = ScanCorrection{}
+	mi := &file_exam_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanCorrection) ProtoMessage() {}
+
+func (x *ScanCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[257]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanCorrection.ProtoReflect.Descriptor instead.
+func (*ScanCorrection) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{257}
+}
+
+func (x *ScanCorrection) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ScanCorrection) GetMarked() []string {
+	if x != nil {
+		return x.Marked
+	}
+	return nil
+}
+
+type ResolveAnswerSheetScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanId        int64                  `protobuf:"varint,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Corrections   []*ScanCorrection      `protobuf:"bytes,3,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAnswerSheetScanRequest) Reset() {
+	*x = ResolveAnswerSheetScanRequest{}
+	mi := &file_exam_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAnswerSheetScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAnswerSheetScanRequest) ProtoMessage() {}
+
+func (x *ResolveAnswerSheetScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAnswerSheetScanRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAnswerSheetScanRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *ResolveAnswerSheetScanRequest) GetScanId() int64 {
+	if x != nil {
+		return x.ScanId
+	}
+	return 0
+}
+
+func (x *ResolveAnswerSheetScanRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ResolveAnswerSheetScanRequest) GetCorrections() []*ScanCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+type ResolveAnswerSheetScanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scan          *AnswerSheetScan       `protobuf:"bytes,1,opt,name=scan,proto3" json:"scan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAnswerSheetScanResponse) Reset() {
+	*x = ResolveAnswerSheetScanResponse{}
+	mi := &file_exam_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAnswerSheetScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAnswerSheetScanResponse) ProtoMessage() {}
+
+func (x *ResolveAnswerSheetScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[259]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAnswerSheetScanResponse.ProtoReflect.Descriptor instead.
+func (*ResolveAnswerSheetScanResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{259}
+}
+
+func (x *ResolveAnswerSheetScanResponse) GetScan() *AnswerSheetScan {
+	if x != nil {
+		return x.Scan
+	}
+	return nil
+}
+
+type CloneExamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExamId        int64                  `protobuf:"varint,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	ShiftDays     int32                  `protobuf:"varint,4,opt,name=shift_days,json=shiftDays,proto3" json:"shift_days,omitempty"`
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CopyClasses   bool                   `protobuf:"varint,6,opt,name=copy_classes,json=copyClasses,proto3" json:"copy_classes,omitempty"`
+	ClassIds      []int64                `protobuf:"varint,7,rep,packed,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneExamRequest) Reset() {
+	*x = CloneExamRequest{}
+	mi := &file_exam_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneExamRequest) ProtoMessage() {}
+
+func (x *CloneExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[260]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneExamRequest.ProtoReflect.Descriptor instead.
+func (*CloneExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *CloneExamRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *CloneExamRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *CloneExamRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CloneExamRequest) GetShiftDays() int32 {
+	if x != nil {
+		return x.ShiftDays
+	}
+	return 0
+}
+
+func (x *CloneExamRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CloneExamRequest) GetCopyClasses() bool {
+	if x != nil {
+		return x.CopyClasses
+	}
+	return false
+}
+
+func (x *CloneExamRequest) GetClassIds() []int64 {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+type CloneExamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	QuestionCount int32                  `protobuf:"varint,5,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	ClassIds      []int64                `protobuf:"varint,6,rep,packed,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneExamResponse) Reset() {
+	*x = CloneExamResponse{}
+	mi := &file_exam_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneExamResponse) ProtoMessage() {}
+
+func (x *CloneExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[261]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneExamResponse.ProtoReflect.Descriptor instead.
+func (*CloneExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{261}
+}
+
+func (x *CloneExamResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloneExamResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CloneExamResponse) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CloneExamResponse) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CloneExamResponse) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *CloneExamResponse) GetClassIds() []int64 {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+type ExamTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TopicId        int64                  `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Settings       *ExamSettings          `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	SectionConfigs []*SectionConfig       `protobuf:"bytes,6,rep,name=section_configs,json=sectionConfigs,proto3" json:"section_configs,omitempty"`
+	QuestionCount  int32                  `protobuf:"varint,7,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	SourceExamId   int64                  `protobuf:"varint,8,opt,name=source_exam_id,json=sourceExamId,proto3" json:"source_exam_id,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExamTemplate) Reset() {
+	*x = ExamTemplate{}
+	mi := &file_exam_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExamTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamTemplate) ProtoMessage() {}
+
+func (x *ExamTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[262]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamTemplate.ProtoReflect.Descriptor instead.
+func (*ExamTemplate) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{262}
+}
+
+func (x *ExamTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExamTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExamTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExamTemplate) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ExamTemplate) GetSettings() *ExamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ExamTemplate) GetSectionConfigs() []*SectionConfig {
+	if x != nil {
+		return x.SectionConfigs
+	}
+	return nil
+}
+
+func (x *ExamTemplate) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *ExamTemplate) GetSourceExamId() int64 {
+	if x != nil {
+		return x.SourceExamId
+	}
+	return 0
+}
+
+func (x *ExamTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateExamTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId   int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TopicId        int64                  `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Settings       *ExamSettings          `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	SectionConfigs []*SectionConfig       `protobuf:"bytes,6,rep,name=section_configs,json=sectionConfigs,proto3" json:"section_configs,omitempty"`
+	SourceExamId   int64                  `protobuf:"varint,7,opt,name=source_exam_id,json=sourceExamId,proto3" json:"source_exam_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateExamTemplateRequest) Reset() {
+	*x = CreateExamTemplateRequest{}
+	mi := &file_exam_proto_msgTypes[263]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExamTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExamTemplateRequest) ProtoMessage() {}
+
+func (x *CreateExamTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[263]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExamTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateExamTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{263}
+}
+
+func (x *CreateExamTemplateRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *CreateExamTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateExamTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateExamTemplateRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *CreateExamTemplateRequest) GetSettings() *ExamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CreateExamTemplateRequest) GetSectionConfigs() []*SectionConfig {
+	if x != nil {
+		return x.SectionConfigs
+	}
+	return nil
+}
+
+func (x *CreateExamTemplateRequest) GetSourceExamId() int64 {
+	if x != nil {
+		return x.SourceExamId
+	}
+	return 0
+}
+
+type CreateExamTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ExamTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExamTemplateResponse) Reset() {
+	*x = CreateExamTemplateResponse{}
+	mi := &file_exam_proto_msgTypes[264]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExamTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExamTemplateResponse) ProtoMessage() {}
+
+func (x *CreateExamTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[264]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExamTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateExamTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{264}
+}
+
+func (x *CreateExamTemplateResponse) GetTemplate() *ExamTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetExamTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamTemplatesRequest) Reset() {
+	*x = GetExamTemplatesRequest{}
+	mi := &file_exam_proto_msgTypes[265]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamTemplatesRequest) ProtoMessage() {}
+
+func (x *GetExamTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[265]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetExamTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{265}
+}
+
+func (x *GetExamTemplatesRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type GetExamTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*ExamTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExamTemplatesResponse) Reset() {
+	*x = GetExamTemplatesResponse{}
+	mi := &file_exam_proto_msgTypes[266]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExamTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamTemplatesResponse) ProtoMessage() {}
+
+func (x *GetExamTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[266]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetExamTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{266}
+}
+
+func (x *GetExamTemplatesResponse) GetTemplates() []*ExamTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteExamTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExamTemplateRequest) Reset() {
+	*x = DeleteExamTemplateRequest{}
+	mi := &file_exam_proto_msgTypes[267]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExamTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExamTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteExamTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[267]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExamTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExamTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{267}
+}
+
+func (x *DeleteExamTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *DeleteExamTemplateRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+type DeleteExamTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExamTemplateResponse) Reset() {
+	*x = DeleteExamTemplateResponse{}
+	mi := &file_exam_proto_msgTypes[268]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExamTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExamTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteExamTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[268]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExamTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExamTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{268}
+}
+
+func (x *DeleteExamTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type InstantiateExamTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	InstructorId  int64                  `protobuf:"varint,2,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ClassIds      []int64                `protobuf:"varint,6,rep,packed,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateExamTemplateRequest) Reset() {
+	*x = InstantiateExamTemplateRequest{}
+	mi := &file_exam_proto_msgTypes[269]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateExamTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateExamTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateExamTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[269]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateExamTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateExamTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{269}
+}
+
+func (x *InstantiateExamTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *InstantiateExamTemplateRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *InstantiateExamTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InstantiateExamTemplateRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *InstantiateExamTemplateRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *InstantiateExamTemplateRequest) GetClassIds() []int64 {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+type InstantiateExamTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	QuestionCount int32                  `protobuf:"varint,3,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	ClassIds      []int64                `protobuf:"varint,4,rep,packed,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateExamTemplateResponse) Reset() {
+	*x = InstantiateExamTemplateResponse{}
+	mi := &file_exam_proto_msgTypes[270]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateExamTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateExamTemplateResponse) ProtoMessage() {}
+
+func (x *InstantiateExamTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[270]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateExamTemplateResponse.ProtoReflect.Descriptor instead.
+func (*InstantiateExamTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{270}
+}
+
+func (x *InstantiateExamTemplateResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InstantiateExamTemplateResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InstantiateExamTemplateResponse) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *InstantiateExamTemplateResponse) GetClassIds() []int64 {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+type BlueprintRuleReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	SectionId     int64                  `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	SectionName   string                 `protobuf:"bytes,3,opt,name=section_name,json=sectionName,proto3" json:"section_name,omitempty"`
+	Difficulty    string                 `protobuf:"bytes,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Points        float32                `protobuf:"fixed32,6,opt,name=points,proto3" json:"points,omitempty"`
+	PoolSize      int32                  `protobuf:"varint,7,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	Guaranteed    int32                  `protobuf:"varint,8,opt,name=guaranteed,proto3" json:"guaranteed,omitempty"`
+	Coverage      float64                `protobuf:"fixed64,9,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlueprintRuleReport) Reset() {
+	*x = BlueprintRuleReport{}
+	mi := &file_exam_proto_msgTypes[271]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueprintRuleReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintRuleReport) ProtoMessage() {}
+
+func (x *BlueprintRuleReport) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[271]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintRuleReport.ProtoReflect.Descriptor instead.
+func (*BlueprintRuleReport) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{271}
+}
+
+func (x *BlueprintRuleReport) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BlueprintRuleReport) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+func (x *BlueprintRuleReport) GetSectionName() string {
+	if x != nil {
+		return x.SectionName
+	}
+	return ""
+}
+
+func (x *BlueprintRuleReport) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *BlueprintRuleReport) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BlueprintRuleReport) GetPoints() float32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *BlueprintRuleReport) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *BlueprintRuleReport) GetGuaranteed() int32 {
+	if x != nil {
+		return x.Guaranteed
+	}
+	return 0
+}
+
+func (x *BlueprintRuleReport) GetCoverage() float64 {
+	if x != nil {
+		return x.Coverage
+	}
+	return 0
+}
+
+type BlueprintOverlap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleA         int32                  `protobuf:"varint,1,opt,name=rule_a,json=ruleA,proto3" json:"rule_a,omitempty"`
+	RuleB         int32                  `protobuf:"varint,2,opt,name=rule_b,json=ruleB,proto3" json:"rule_b,omitempty"`
+	Shared        int32                  `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlueprintOverlap) Reset() {
+	*x = BlueprintOverlap{}
+	mi := &file_exam_proto_msgTypes[272]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueprintOverlap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintOverlap) ProtoMessage() {}
+
+func (x *BlueprintOverlap) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[272]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintOverlap.ProtoReflect.Descriptor instead.
+func (*BlueprintOverlap) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{272}
+}
+
+func (x *BlueprintOverlap) GetRuleA() int32 {
+	if x != nil {
+		return x.RuleA
+	}
+	return 0
+}
+
+func (x *BlueprintOverlap) GetRuleB() int32 {
+	if x != nil {
+		return x.RuleB
+	}
+	return 0
+}
+
+func (x *BlueprintOverlap) GetShared() int32 {
+	if x != nil {
+		return x.Shared
+	}
+	return 0
+}
+
+type BlueprintIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          int32                  `protobuf:"varint,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlueprintIssue) Reset() {
+	*x = BlueprintIssue{}
+	mi := &file_exam_proto_msgTypes[273]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueprintIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintIssue) ProtoMessage() {}
+
+func (x *BlueprintIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[273]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintIssue.ProtoReflect.Descriptor instead.
+func (*BlueprintIssue) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{273}
+}
+
+func (x *BlueprintIssue) GetRule() int32 {
+	if x != nil {
+		return x.Rule
+	}
+	return 0
+}
+
+func (x *BlueprintIssue) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *BlueprintIssue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BlueprintIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateExamBlueprintRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId     int64                  `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	ExamId           int64                  `protobuf:"varint,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	TopicId          int64                  `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	SectionConfigs   []*SectionConfig       `protobuf:"bytes,4,rep,name=section_configs,json=sectionConfigs,proto3" json:"section_configs,omitempty"`
+	FixedQuestionIds []int64                `protobuf:"varint,5,rep,packed,name=fixed_question_ids,json=fixedQuestionIds,proto3" json:"fixed_question_ids,omitempty"`
+	CoverageFactor   int32                  `protobuf:"varint,6,opt,name=coverage_factor,json=coverageFactor,proto3" json:"coverage_factor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValidateExamBlueprintRequest) Reset() {
+	*x = ValidateExamBlueprintRequest{}
+	mi := &file_exam_proto_msgTypes[274]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateExamBlueprintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateExamBlueprintRequest) ProtoMessage() {}
+
+func (x *ValidateExamBlueprintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[274]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateExamBlueprintRequest.ProtoReflect.Descriptor instead.
+func (*ValidateExamBlueprintRequest) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{274}
+}
+
+func (x *ValidateExamBlueprintRequest) GetInstructorId() int64 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintRequest) GetExamId() int64 {
+	if x != nil {
+		return x.ExamId
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintRequest) GetTopicId() int64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintRequest) GetSectionConfigs() []*SectionConfig {
+	if x != nil {
+		return x.SectionConfigs
+	}
+	return nil
+}
+
+func (x *ValidateExamBlueprintRequest) GetFixedQuestionIds() []int64 {
+	if x != nil {
+		return x.FixedQuestionIds
+	}
+	return nil
+}
+
+func (x *ValidateExamBlueprintRequest) GetCoverageFactor() int32 {
+	if x != nil {
+		return x.CoverageFactor
+	}
+	return 0
+}
+
+type ValidateExamBlueprintResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Valid            bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Rules            []*BlueprintRuleReport `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Overlaps         []*BlueprintOverlap    `protobuf:"bytes,3,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	Issues           []*BlueprintIssue      `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	FixedCount       int32                  `protobuf:"varint,5,opt,name=fixed_count,json=fixedCount,proto3" json:"fixed_count,omitempty"`
+	DesignedLength   int32                  `protobuf:"varint,6,opt,name=designed_length,json=designedLength,proto3" json:"designed_length,omitempty"`
+	MinLength        int32                  `protobuf:"varint,7,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength        int32                  `protobuf:"varint,8,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	ExpectedLength   float64                `protobuf:"fixed64,9,opt,name=expected_length,json=expectedLength,proto3" json:"expected_length,omitempty"`
+	ShortProbability float64                `protobuf:"fixed64,10,opt,name=short_probability,json=shortProbability,proto3" json:"short_probability,omitempty"`
+	DesignedPoints   float64                `protobuf:"fixed64,11,opt,name=designed_points,json=designedPoints,proto3" json:"designed_points,omitempty"`
+	ExpectedPoints   float64                `protobuf:"fixed64,12,opt,name=expected_points,json=expectedPoints,proto3" json:"expected_points,omitempty"`
+	CoverageFactor   int32                  `protobuf:"varint,13,opt,name=coverage_factor,json=coverageFactor,proto3" json:"coverage_factor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValidateExamBlueprintResponse) Reset() {
+	*x = ValidateExamBlueprintResponse{}
+	mi := &file_exam_proto_msgTypes[275]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateExamBlueprintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateExamBlueprintResponse) ProtoMessage() {}
+
+func (x *ValidateExamBlueprintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_proto_msgTypes[275]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateExamBlueprintResponse.ProtoReflect.Descriptor instead.
+func (*ValidateExamBlueprintResponse) Descriptor() ([]byte, []int) {
+	return file_exam_proto_rawDescGZIP(), []int{275}
+}
+
+func (x *ValidateExamBlueprintResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateExamBlueprintResponse) GetRules() []*BlueprintRuleReport {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ValidateExamBlueprintResponse) GetOverlaps() []*BlueprintOverlap {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+func (x *ValidateExamBlueprintResponse) GetIssues() []*BlueprintIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ValidateExamBlueprintResponse) GetFixedCount() int32 {
+	if x != nil {
+		return x.FixedCount
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetDesignedLength() int32 {
+	if x != nil {
+		return x.DesignedLength
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetExpectedLength() float64 {
+	if x != nil {
+		return x.ExpectedLength
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetShortProbability() float64 {
+	if x != nil {
+		return x.ShortProbability
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetDesignedPoints() float64 {
+	if x != nil {
+		return x.DesignedPoints
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetExpectedPoints() float64 {
+	if x != nil {
+		return x.ExpectedPoints
+	}
+	return 0
+}
+
+func (x *ValidateExamBlueprintResponse) GetCoverageFactor() int32 {
+	if x != nil {
+		return x.CoverageFactor
+	}
+	return 0
+}
+
+var File_exam_proto protoreflect.FileDescriptor
+
+const file_exam_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"exam.proto\x12\x04exam\"M\n" +
+	"\x05Topic\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"j\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\btopic_id\x18\x04 \x01(\x03R\atopicId\"J\n" +
+	"\x12CreateTopicRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"8\n" +
+	"\x13CreateTopicResponse\x12!\n" +
+	"\x05topic\x18\x01 \x01(\v2\v.exam.TopicR\x05topic\"\x12\n" +
+	"\x10GetTopicsRequest\"8\n" +
+	"\x11GetTopicsResponse\x12#\n" +
+	"\x06topics\x18\x01 \x03(\v2\v.exam.TopicR\x06topics\"g\n" +
+	"\x14CreateSectionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
 	"\btopic_id\x18\x03 \x01(\x03R\atopicId\"@\n" +
 	"\x15CreateSectionResponse\x12'\n" +
@@ -7045,12 +18605,15 @@ const file_exam_proto_rawDesc = "" +
 	"\x12GetSectionsRequest\x12\x19\n" +
 	"\btopic_id\x18\x01 \x01(\x03R\atopicId\"@\n" +
 	"\x13GetSectionsResponse\x12)\n" +
-	"\bsections\x18\x01 \x03(\v2\r.exam.SectionR\bsections\"m\n" +
+	"\bsections\x18\x01 \x03(\v2\r.exam.SectionR\bsections\"\xc7\x01\n" +
 	"\vChoiceInput\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"is_correct\x18\x02 \x01(\bR\tisCorrect\x12%\n" +
-	"\x0eattachment_url\x18\x03 \x01(\tR\rattachmentUrl\"\xaa\x02\n" +
+	"\x0eattachment_url\x18\x03 \x01(\tR\rattachmentUrl\x12!\n" +
+	"\fmatch_target\x18\x04 \x01(\tR\vmatchTarget\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x19\n" +
+	"\bpin_last\x18\x06 \x01(\bR\apinLast\"\x89\x03\n" +
 	"\x15CreateQuestionRequest\x12\x1d\n" +
 	"\n" +
 	"section_id\x18\x01 \x01(\x03R\tsectionId\x12\x18\n" +
@@ -7063,7 +18626,10 @@ const file_exam_proto_rawDesc = "" +
 	"\achoices\x18\x06 \x03(\v2\x11.exam.ChoiceInputR\achoices\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\a \x01(\x03R\tcreatorId\x12%\n" +
-	"\x0eattachment_url\x18\b \x01(\tR\rattachmentUrl\"B\n" +
+	"\x0eattachment_url\x18\b \x01(\tR\rattachmentUrl\x123\n" +
+	"\anumeric\x18\t \x01(\v2\x19.exam.NumericAnswerConfigR\anumeric\x12(\n" +
+	"\x06blanks\x18\n" +
+	" \x03(\v2\x10.exam.ClozeBlankR\x06blanks\"B\n" +
 	"\x16CreateQuestionResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\"W\n" +
@@ -7079,15 +18645,21 @@ const file_exam_proto_rawDesc = "" +
 	"\x14GetUploadURLResponse\x12\x1d\n" +
 	"\n" +
 	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1b\n" +
-	"\tfinal_url\x18\x02 \x01(\tR\bfinalUrl\"Z\n" +
+	"\tfinal_url\x18\x02 \x01(\tR\bfinalUrl\"\xdd\x01\n" +
 	"\x16ImportQuestionsRequest\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\x02 \x01(\x03R\tcreatorId\x12!\n" +
-	"\ffile_content\x18\x03 \x01(\fR\vfileContent\"_\n" +
+	"\ffile_content\x18\x03 \x01(\fR\vfileContent\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12#\n" +
+	"\rdefault_topic\x18\x06 \x01(\tR\fdefaultTopic\x12'\n" +
+	"\x0fdefault_section\x18\a \x01(\tR\x0edefaultSection\"\xa8\x01\n" +
 	"\x17ImportQuestionsResponse\x12#\n" +
 	"\rsuccess_count\x18\x01 \x01(\x05R\fsuccessCount\x12\x1f\n" +
 	"\verror_count\x18\x02 \x01(\x05R\n" +
-	"errorCount\"\x9c\x03\n" +
+	"errorCount\x12/\n" +
+	"\x06errors\x18\x03 \x03(\v2\x17.exam.QuestionBankErrorR\x06errors\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\"\xa3\x05\n" +
 	"\fExamSettings\x12)\n" +
 	"\x10duration_minutes\x18\x01 \x01(\x05R\x0fdurationMinutes\x12!\n" +
 	"\fmax_attempts\x18\x02 \x01(\x05R\vmaxAttempts\x12\x1f\n" +
@@ -7101,7 +18673,17 @@ const file_exam_proto_rawDesc = "" +
 	"\n" +
 	"is_dynamic\x18\t \x01(\bR\tisDynamic\x12%\n" +
 	"\x0edynamic_config\x18\n" +
-	" \x01(\tR\rdynamicConfigB\v\n" +
+	" \x01(\tR\rdynamicConfig\x12%\n" +
+	"\x0escoring_policy\x18\v \x01(\tR\rscoringPolicy\x12)\n" +
+	"\x10negative_marking\x18\f \x01(\x02R\x0fnegativeMarking\x12\x1f\n" +
+	"\vscore_scale\x18\r \x01(\tR\n" +
+	"scoreScale\x12\x1f\n" +
+	"\vis_adaptive\x18\x0e \x01(\bR\n" +
+	"isAdaptive\x12'\n" +
+	"\x0fadaptive_config\x18\x0f \x01(\tR\x0eadaptiveConfig\x12\x1f\n" +
+	"\vis_practice\x18\x10 \x01(\bR\n" +
+	"isPractice\x12'\n" +
+	"\x0fshuffle_choices\x18\x11 \x01(\bR\x0eshuffleChoicesB\v\n" +
 	"\t_password\"M\n" +
 	"\x12QuestionAssignment\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\x03R\n" +
@@ -7135,13 +18717,16 @@ const file_exam_proto_rawDesc = "" +
 	"\x0ffixed_questions\x18\a \x03(\v2\x18.exam.QuestionAssignmentR\x0efixedQuestions\":\n" +
 	"\x12CreateExamResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"\x7f\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"\xd9\x01\n" +
 	"\rChoiceDetails\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"is_correct\x18\x03 \x01(\bR\tisCorrect\x12%\n" +
-	"\x0eattachment_url\x18\x04 \x01(\tR\rattachmentUrl\"\x8c\x03\n" +
+	"\x0eattachment_url\x18\x04 \x01(\tR\rattachmentUrl\x12!\n" +
+	"\fmatch_target\x18\x05 \x01(\tR\vmatchTarget\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x19\n" +
+	"\bpin_last\x18\a \x01(\bR\apinLast\"\xe8\x04\n" +
 	"\x0fQuestionDetails\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12-\n" +
@@ -7159,7 +18744,14 @@ const file_exam_proto_rawDesc = "" +
 	"section_id\x18\n" +
 	" \x01(\x03R\tsectionId\x12\x19\n" +
 	"\btopic_id\x18\v \x01(\x03R\atopicId\x12\x16\n" +
-	"\x06points\x18\f \x01(\x02R\x06points\"0\n" +
+	"\x06points\x18\f \x01(\x02R\x06points\x123\n" +
+	"\anumeric\x18\r \x01(\v2\x19.exam.NumericAnswerConfigR\anumeric\x12(\n" +
+	"\x06blanks\x18\x0e \x03(\v2\x10.exam.ClozeBlankR\x06blanks\x12#\n" +
+	"\rmatch_options\x18\x0f \x03(\tR\fmatchOptions\x12\x1f\n" +
+	"\vblank_count\x18\x10 \x01(\x05R\n" +
+	"blankCount\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x05R\aversion\x12\x1b\n" +
+	"\trubric_id\x18\x12 \x01(\x03R\brubricId\"0\n" +
 	"\x15GetExamDetailsRequest\x12\x17\n" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\"\xf8\x01\n" +
 	"\x16GetExamDetailsResponse\x12\x0e\n" +
@@ -7187,16 +18779,21 @@ const file_exam_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"J\n" +
 	"\x16CheckExamAccessRequest\x12\x17\n" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"R\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\xa0\x02\n" +
 	"\x17CheckExamAccessResponse\x12\x1d\n" +
 	"\n" +
 	"can_access\x18\x01 \x01(\bR\tcanAccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x12#\n" +
+	"\rattempts_used\x18\x04 \x01(\x05R\fattemptsUsed\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\tR\aendTime\x12>\n" +
+	"\raccommodation\x18\a \x01(\v2\x18.exam.AccommodationTermsR\raccommodation\"5\n" +
 	"\x12GetQuestionRequest\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\x03R\n" +
 	"questionId\"H\n" +
 	"\x13GetQuestionResponse\x121\n" +
-	"\bquestion\x18\x01 \x01(\v2\x15.exam.QuestionDetailsR\bquestion\"\x8d\x02\n" +
+	"\bquestion\x18\x01 \x01(\v2\x15.exam.QuestionDetailsR\bquestion\"\x89\x03\n" +
 	"\x15UpdateQuestionRequest\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\x03R\n" +
 	"questionId\x12\x18\n" +
@@ -7207,7 +18804,11 @@ const file_exam_proto_rawDesc = "" +
 	"difficulty\x12 \n" +
 	"\vexplanation\x18\x05 \x01(\tR\vexplanation\x12+\n" +
 	"\achoices\x18\x06 \x03(\v2\x11.exam.ChoiceInputR\achoices\x12%\n" +
-	"\x0eattachment_url\x18\a \x01(\tR\rattachmentUrl\"2\n" +
+	"\x0eattachment_url\x18\a \x01(\tR\rattachmentUrl\x123\n" +
+	"\anumeric\x18\b \x01(\v2\x19.exam.NumericAnswerConfigR\anumeric\x12(\n" +
+	"\x06blanks\x18\t \x03(\v2\x10.exam.ClozeBlankR\x06blanks\x12\x1b\n" +
+	"\teditor_id\x18\n" +
+	" \x01(\x03R\beditorId\"2\n" +
 	"\x16UpdateQuestionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"8\n" +
 	"\x15DeleteQuestionRequest\x12\x1f\n" +
@@ -7218,7 +18819,7 @@ const file_exam_proto_rawDesc = "" +
 	"\x1aDeleteBulkQuestionsRequest\x12!\n" +
 	"\fquestion_ids\x18\x01 \x03(\x03R\vquestionIds\"7\n" +
 	"\x1bDeleteBulkQuestionsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb1\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd2\x01\n" +
 	"\fExamListItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12)\n" +
@@ -7226,7 +18827,9 @@ const file_exam_proto_rawDesc = "" +
 	"\btopic_id\x18\x04 \x01(\x03R\atopicId\x12\x1d\n" +
 	"\n" +
 	"creator_id\x18\x05 \x01(\x03R\tcreatorId\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\"r\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vis_practice\x18\a \x01(\bR\n" +
+	"isPractice\"r\n" +
 	"\x0fGetExamsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
@@ -7258,14 +18861,17 @@ const file_exam_proto_rawDesc = "" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\"/\n" +
 	"\x13PublishExamResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"x\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xeb\x01\n" +
 	"\n" +
 	"UserAnswer\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\x03R\n" +
 	"questionId\x12(\n" +
 	"\x10chosen_choice_id\x18\x02 \x01(\x03R\x0echosenChoiceId\x12\x1f\n" +
 	"\vtext_answer\x18\x03 \x01(\tR\n" +
-	"textAnswer\"\xe2\x01\n" +
+	"textAnswer\x12,\n" +
+	"\x12ordered_choice_ids\x18\x04 \x03(\x03R\x10orderedChoiceIds\x12+\n" +
+	"\amatches\x18\x05 \x03(\v2\x11.exam.MatchAnswerR\amatches\x12\x16\n" +
+	"\x06blanks\x18\x06 \x03(\tR\x06blanks\"\xe2\x01\n" +
 	"\x11SubmitExamRequest\x12\x17\n" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12*\n" +
@@ -7283,7 +18889,7 @@ const file_exam_proto_rawDesc = "" +
 	"\x0ftotal_questions\x18\x04 \x01(\x05R\x0etotalQuestions\"T\n" +
 	"\x14GetSubmissionRequest\x12#\n" +
 	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x96\x03\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x85\x06\n" +
 	"\x10SubmissionDetail\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\x03R\n" +
 	"questionId\x12)\n" +
@@ -7299,14 +18905,28 @@ const file_exam_proto_rawDesc = "" +
 	"\x0eawarded_points\x18\t \x01(\x02R\rawardedPoints\x12\x16\n" +
 	"\x06points\x18\n" +
 	" \x01(\x02R\x06points\x12\x1b\n" +
-	"\tis_graded\x18\v \x01(\bR\bisGraded\"\xa3\x01\n" +
+	"\tis_graded\x18\v \x01(\bR\bisGraded\x12,\n" +
+	"\x12ordered_choice_ids\x18\f \x03(\x03R\x10orderedChoiceIds\x12+\n" +
+	"\amatches\x18\r \x03(\v2\x11.exam.MatchAnswerR\amatches\x12\x16\n" +
+	"\x06blanks\x18\x0e \x03(\tR\x06blanks\x123\n" +
+	"\anumeric\x18\x0f \x01(\v2\x19.exam.NumericAnswerConfigR\anumeric\x127\n" +
+	"\x0ecorrect_blanks\x18\x10 \x03(\v2\x10.exam.ClozeBlankR\rcorrectBlanks\x12*\n" +
+	"\x06rubric\x18\x11 \x01(\v2\x12.exam.RubricResultR\x06rubric\x12\x1a\n" +
+	"\bfeedback\x18\x12 \x01(\tR\bfeedback\x12D\n" +
+	"\x12similarity_matches\x18\x13 \x03(\v2\x15.exam.SimilarityMatchR\x11similarityMatches\"\xba\x02\n" +
 	"\fChoiceReview\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"is_correct\x18\x03 \x01(\bR\tisCorrect\x12#\n" +
 	"\ruser_selected\x18\x04 \x01(\bR\fuserSelected\x12%\n" +
-	"\x0eattachment_url\x18\x05 \x01(\tR\rattachmentUrl\"\x97\x02\n" +
+	"\x0eattachment_url\x18\x05 \x01(\tR\rattachmentUrl\x12!\n" +
+	"\fmatch_target\x18\x06 \x01(\tR\vmatchTarget\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x12\x14\n" +
+	"\x05label\x18\b \x01(\tR\x05label\x12%\n" +
+	"\x0eoriginal_label\x18\t \x01(\tR\roriginalLabel\x12\x19\n" +
+	"\bpin_last\x18\n" +
+	" \x01(\bR\apinLast\"\x97\x02\n" +
 	"\x15GetSubmissionResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -7323,7 +18943,7 @@ const file_exam_proto_rawDesc = "" +
 	"\x11total_exams_taken\x18\x01 \x01(\x03R\x0ftotalExamsTaken\"\x15\n" +
 	"\x13GetExamCountRequest\",\n" +
 	"\x14GetExamCountResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x03R\x05count\"\xef\x01\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\xf4\x02\n" +
 	"\x11SaveAnswerRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12\x1f\n" +
@@ -7335,16 +18955,24 @@ const file_exam_proto_rawDesc = "" +
 	"\n" +
 	"user_agent\x18\x06 \x01(\tR\tuserAgent\x12\x1f\n" +
 	"\vtext_answer\x18\a \x01(\tR\n" +
-	"textAnswer\".\n" +
+	"textAnswer\x12,\n" +
+	"\x12ordered_choice_ids\x18\b \x03(\x03R\x10orderedChoiceIds\x12+\n" +
+	"\amatches\x18\t \x03(\v2\x11.exam.MatchAnswerR\amatches\x12\x16\n" +
+	"\x06blanks\x18\n" +
+	" \x03(\tR\x06blanks\x12\x10\n" +
+	"\x03seq\x18\v \x01(\x03R\x03seq\"\x92\x01\n" +
 	"\x12SaveAnswerResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x95\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\x12\x1c\n" +
+	"\tduplicate\x18\x03 \x01(\bR\tduplicate\x122\n" +
+	"\bfeedback\x18\x04 \x01(\v2\x16.exam.PracticeFeedbackR\bfeedback\"\x95\x01\n" +
 	"\x13LogViolationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12%\n" +
 	"\x0eviolation_type\x18\x03 \x01(\tR\rviolationType\x12%\n" +
 	"\x0eviolation_time\x18\x04 \x01(\tR\rviolationTime\"0\n" +
 	"\x14LogViolationResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x99\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8e\x02\n" +
 	"\x11GradeEssayRequest\x12#\n" +
 	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12\x1f\n" +
 	"\vquestion_id\x18\x02 \x01(\x03R\n" +
@@ -7352,9 +18980,14 @@ const file_exam_proto_rawDesc = "" +
 	"\n" +
 	"is_correct\x18\x03 \x01(\bR\tisCorrect\x12\x1f\n" +
 	"\vscore_ratio\x18\x04 \x01(\x02R\n" +
-	"scoreRatio\".\n" +
+	"scoreRatio\x12:\n" +
+	"\rrubric_scores\x18\x05 \x03(\v2\x15.exam.RubricSelectionR\frubricScores\x12\x1a\n" +
+	"\bfeedback\x18\x06 \x01(\tR\bfeedback\x12\x1b\n" +
+	"\tgrader_id\x18\a \x01(\x03R\bgraderId\"k\n" +
 	"\x12GradeEssayResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"6\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0eawarded_points\x18\x02 \x01(\x02R\rawardedPoints\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\"6\n" +
 	"\x1bGetExamStatsDetailedRequest\x12\x17\n" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\"\x8b\x03\n" +
 	"\x1cGetExamStatsDetailedResponse\x12%\n" +
@@ -7366,7 +18999,7 @@ const file_exam_proto_rawDesc = "" +
 	"\x12score_distribution\x18\x06 \x03(\v29.exam.GetExamStatsDetailedResponse.ScoreDistributionEntryR\x11scoreDistribution\x1aD\n" +
 	"\x16ScoreDistributionEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xd9\x02\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb5\x03\n" +
 	"\x11SubmissionSummary\x12#\n" +
 	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12!\n" +
@@ -7379,7 +19012,11 @@ const file_exam_proto_rawDesc = "" +
 	"\x0eattempt_number\x18\t \x01(\x05R\rattemptNumber\x12\x1d\n" +
 	"\n" +
 	"exam_title\x18\n" +
-	" \x01(\tR\texamTitle\"\x9b\x01\n" +
+	" \x01(\tR\texamTitle\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\v \x01(\x02R\triskScore\x12\x18\n" +
+	"\aflagged\x18\f \x01(\bR\aflagged\x12!\n" +
+	"\frisk_reasons\x18\r \x03(\tR\vriskReasons\"\x9b\x01\n" +
 	"\x19GetExamSubmissionsRequest\x12\x17\n" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
@@ -7440,7 +19077,7 @@ const file_exam_proto_rawDesc = "" +
 	"\x19GetExamViolationsResponse\x123\n" +
 	"\n" +
 	"violations\x18\x01 \x03(\v2\x13.exam.ExamViolationR\n" +
-	"violations\"\xa9\x01\n" +
+	"violations\"\xc1\x01\n" +
 	"\x16ExportQuestionsRequest\x12\x1d\n" +
 	"\n" +
 	"section_id\x18\x01 \x01(\x03R\tsectionId\x12\x19\n" +
@@ -7450,28 +19087,41 @@ const file_exam_proto_rawDesc = "" +
 	"difficulty\x12\x16\n" +
 	"\x06search\x18\x04 \x01(\tR\x06search\x12\x1d\n" +
 	"\n" +
-	"creator_id\x18\x05 \x01(\x03R\tcreatorId\"4\n" +
+	"creator_id\x18\x05 \x01(\x03R\tcreatorId\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\"\x8c\x01\n" +
 	"\x17ExportQuestionsResponse\x12\x19\n" +
-	"\bfile_url\x18\x01 \x01(\tR\afileUrl\"\x82\x01\n" +
+	"\bfile_url\x18\x01 \x01(\tR\afileUrl\x12%\n" +
+	"\x0eexported_count\x18\x02 \x01(\x05R\rexportedCount\x12/\n" +
+	"\x06errors\x18\x03 \x03(\v2\x17.exam.QuestionBankErrorR\x06errors\"\xa3\x01\n" +
 	"\x10StartExamRequest\x12\x17\n" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
 	"\n" +
 	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
 	"\n" +
-	"user_agent\x18\x04 \x01(\tR\tuserAgent\"o\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1f\n" +
+	"\vretry_wrong\x18\x05 \x01(\bR\n" +
+	"retryWrong\"\xe2\x01\n" +
 	"\fAnswerDetail\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\x03R\n" +
 	"questionId\x12\x1d\n" +
 	"\n" +
 	"choice_ids\x18\x02 \x03(\x03R\tchoiceIds\x12\x1f\n" +
 	"\vtext_answer\x18\x03 \x01(\tR\n" +
-	"textAnswer\"\xd7\x01\n" +
+	"textAnswer\x12,\n" +
+	"\x12ordered_choice_ids\x18\x04 \x03(\x03R\x10orderedChoiceIds\x12+\n" +
+	"\amatches\x18\x05 \x03(\v2\x11.exam.MatchAnswerR\amatches\x12\x16\n" +
+	"\x06blanks\x18\x06 \x03(\tR\x06blanks\"\xb1\x02\n" +
 	"\x11StartExamResponse\x12#\n" +
 	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12+\n" +
 	"\x11remaining_seconds\x18\x02 \x01(\x05R\x10remainingSeconds\x123\n" +
 	"\tquestions\x18\x03 \x03(\v2\x15.exam.QuestionDetailsR\tquestions\x12;\n" +
-	"\x0fcurrent_answers\x18\x04 \x03(\v2\x12.exam.AnswerDetailR\x0ecurrentAnswers\"#\n" +
+	"\x0fcurrent_answers\x18\x04 \x03(\v2\x12.exam.AnswerDetailR\x0ecurrentAnswers\x12\x1f\n" +
+	"\vis_adaptive\x18\x05 \x01(\bR\n" +
+	"isAdaptive\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12\x1f\n" +
+	"\vis_practice\x18\a \x01(\bR\n" +
+	"isPractice\"#\n" +
 	"\tInt64List\x12\x16\n" +
 	"\x06values\x18\x01 \x03(\x03R\x06values\"R\n" +
 	"\x18GetAccessRequestsRequest\x12\x17\n" +
@@ -7529,7 +19179,7 @@ const file_exam_proto_rawDesc = "" +
 	"teacher_id\x18\x01 \x01(\x03R\tteacherId\">\n" +
 	"\x1aGetInstructorExamsResponse\x12 \n" +
 	"\x05exams\x18\x01 \x03(\v2\n" +
-	".exam.ExamR\x05exams\"\x93\x03\n" +
+	".exam.ExamR\x05exams\"\xd5\x03\n" +
 	"\x04Exam\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -7547,7 +19197,11 @@ const file_exam_proto_rawDesc = "" +
 	" \x01(\tR\tstartTime\x12\x19\n" +
 	"\bend_time\x18\v \x01(\tR\aendTime\x12!\n" +
 	"\fmax_attempts\x18\f \x01(\x05R\vmaxAttempts\x12#\n" +
-	"\rattempts_used\x18\r \x01(\x05R\fattemptsUsed\"0\n" +
+	"\rattempts_used\x18\r \x01(\x05R\fattemptsUsed\x12\x1f\n" +
+	"\vscore_scale\x18\x0e \x01(\tR\n" +
+	"scoreScale\x12\x1f\n" +
+	"\vis_practice\x18\x0f \x01(\bR\n" +
+	"isPractice\"0\n" +
 	"\x15GetExamPreviewRequest\x12\x17\n" +
 	"\aexam_id\x18\x01 \x01(\x03R\x06examId\"X\n" +
 	"\x1bGetRecentSubmissionsRequest\x12#\n" +
@@ -7585,7 +19239,949 @@ const file_exam_proto_rawDesc = "" +
 	"\x19GetClassGradebookResponse\x12 \n" +
 	"\x05exams\x18\x01 \x03(\v2\n" +
 	".exam.ExamR\x05exams\x12*\n" +
-	"\x06grades\x18\x02 \x03(\v2\x12.exam.StudentGradeR\x06grades2\xae\x1d\n" +
+	"\x06grades\x18\x02 \x03(\v2\x12.exam.StudentGradeR\x06grades\"V\n" +
+	"\x16GetItemAnalysisRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"\xf0\x01\n" +
+	"\x0eDistractorStat\x12\x1b\n" +
+	"\tchoice_id\x18\x01 \x01(\x03R\bchoiceId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x03 \x01(\bR\tisCorrect\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vupper_count\x18\x05 \x01(\x05R\n" +
+	"upperCount\x12\x1f\n" +
+	"\vlower_count\x18\x06 \x01(\x05R\n" +
+	"lowerCount\x12%\n" +
+	"\x0eselection_rate\x18\a \x01(\x01R\rselectionRate\"\xb3\x02\n" +
+	"\bItemStat\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12#\n" +
+	"\rquestion_type\x18\x03 \x01(\tR\fquestionType\x12\x1c\n" +
+	"\tresponses\x18\x04 \x01(\x05R\tresponses\x12\x17\n" +
+	"\ap_value\x18\x05 \x01(\x01R\x06pValue\x12%\n" +
+	"\x0epoint_biserial\x18\x06 \x01(\x01R\rpointBiserial\x121\n" +
+	"\x14discrimination_index\x18\a \x01(\x01R\x13discriminationIndex\x126\n" +
+	"\vdistractors\x18\b \x03(\v2\x14.exam.DistractorStatR\vdistractors\"\xd4\x01\n" +
+	"\x17GetItemAnalysisResponse\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rstudent_count\x18\x02 \x01(\x05R\fstudentCount\x12\x12\n" +
+	"\x04kr20\x18\x03 \x01(\x01R\x04kr20\x12\x1d\n" +
+	"\n" +
+	"mean_score\x18\x04 \x01(\x01R\tmeanScore\x12\"\n" +
+	"\rscore_std_dev\x18\x05 \x01(\x01R\vscoreStdDev\x12$\n" +
+	"\x05items\x18\x06 \x03(\v2\x0e.exam.ItemStatR\x05items\"\xe4\x02\n" +
+	"\x1eGetNextAdaptiveQuestionRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1f\n" +
+	"\vquestion_id\x18\x05 \x01(\x03R\n" +
+	"questionId\x12\x1d\n" +
+	"\n" +
+	"choice_ids\x18\x06 \x03(\x03R\tchoiceIds\x12\x1f\n" +
+	"\vtext_answer\x18\a \x01(\tR\n" +
+	"textAnswer\x12,\n" +
+	"\x12ordered_choice_ids\x18\b \x03(\x03R\x10orderedChoiceIds\x12+\n" +
+	"\amatches\x18\t \x03(\v2\x11.exam.MatchAnswerR\amatches\x12\x16\n" +
+	"\x06blanks\x18\n" +
+	" \x03(\tR\x06blanks\"\xa6\x02\n" +
+	"\x1fGetNextAdaptiveQuestionResponse\x12\x1a\n" +
+	"\bfinished\x18\x01 \x01(\bR\bfinished\x121\n" +
+	"\bquestion\x18\x02 \x01(\v2\x15.exam.QuestionDetailsR\bquestion\x12\x14\n" +
+	"\x05theta\x18\x03 \x01(\x01R\x05theta\x12%\n" +
+	"\x0estandard_error\x18\x04 \x01(\x01R\rstandardError\x12%\n" +
+	"\x0eanswered_count\x18\x05 \x01(\x05R\ransweredCount\x12#\n" +
+	"\rmax_questions\x18\x06 \x01(\x05R\fmaxQuestions\x12+\n" +
+	"\x11remaining_seconds\x18\a \x01(\x05R\x10remainingSeconds\"\xce\x01\n" +
+	"\x13NumericAnswerConfig\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1c\n" +
+	"\ttolerance\x18\x02 \x01(\x01R\ttolerance\x12%\n" +
+	"\x0etolerance_type\x18\x03 \x01(\tR\rtoleranceType\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12%\n" +
+	"\x0eaccepted_units\x18\x05 \x03(\tR\racceptedUnits\x12!\n" +
+	"\frequire_unit\x18\x06 \x01(\bR\vrequireUnit\"M\n" +
+	"\n" +
+	"ClozeBlank\x12\x18\n" +
+	"\aanswers\x18\x01 \x03(\tR\aanswers\x12%\n" +
+	"\x0ecase_sensitive\x18\x02 \x01(\bR\rcaseSensitive\"B\n" +
+	"\vMatchAnswer\x12\x1b\n" +
+	"\tchoice_id\x18\x01 \x01(\x03R\bchoiceId\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"x\n" +
+	"\x11QuestionBankError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
+	"\vquestion_id\x18\x04 \x01(\x03R\n" +
+	"questionId\"\xa9\x01\n" +
+	"\x17ImportQTIPackageRequest\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\x03R\tcreatorId\x12!\n" +
+	"\ffile_content\x18\x02 \x01(\fR\vfileContent\x12#\n" +
+	"\rdefault_topic\x18\x03 \x01(\tR\fdefaultTopic\x12'\n" +
+	"\x0fdefault_section\x18\x04 \x01(\tR\x0edefaultSection\"\xda\x01\n" +
+	"\x18ImportQTIPackageResponse\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12#\n" +
+	"\rsuccess_count\x18\x04 \x01(\x05R\fsuccessCount\x12\x1f\n" +
+	"\verror_count\x18\x05 \x01(\x05R\n" +
+	"errorCount\x12/\n" +
+	"\x06errors\x18\x06 \x03(\v2\x17.exam.QuestionBankErrorR\x06errors\"k\n" +
+	"\x17ExportQTIPackageRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x03R\tcreatorId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"\x8d\x01\n" +
+	"\x18ExportQTIPackageResponse\x12\x19\n" +
+	"\bfile_url\x18\x01 \x01(\tR\afileUrl\x12%\n" +
+	"\x0eexported_count\x18\x02 \x01(\x05R\rexportedCount\x12/\n" +
+	"\x06errors\x18\x03 \x03(\v2\x17.exam.QuestionBankErrorR\x06errors\"\xab\x01\n" +
+	"\x16QuestionVersionSummary\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1b\n" +
+	"\teditor_id\x18\x02 \x01(\x03R\beditorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12!\n" +
+	"\fchoice_count\x18\x05 \x01(\x05R\vchoiceCount\"<\n" +
+	"\x19GetQuestionHistoryRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\"\xa0\x01\n" +
+	"\x1aGetQuestionHistoryResponse\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12'\n" +
+	"\x0fcurrent_version\x18\x02 \x01(\x05R\x0ecurrentVersion\x128\n" +
+	"\bversions\x18\x03 \x03(\v2\x1c.exam.QuestionVersionSummaryR\bversions\"V\n" +
+	"\x19GetQuestionVersionRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"i\n" +
+	"\x1aGetQuestionVersionResponse\x121\n" +
+	"\bquestion\x18\x01 \x01(\v2\x15.exam.QuestionDetailsR\bquestion\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"e\n" +
+	"\x13QuestionFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\x9a\x02\n" +
+	"\fChoiceChange\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vold_content\x18\x03 \x01(\tR\n" +
+	"oldContent\x12\x1f\n" +
+	"\vnew_content\x18\x04 \x01(\tR\n" +
+	"newContent\x12$\n" +
+	"\x0eold_is_correct\x18\x05 \x01(\bR\foldIsCorrect\x12$\n" +
+	"\x0enew_is_correct\x18\x06 \x01(\bR\fnewIsCorrect\x12(\n" +
+	"\x10old_match_target\x18\a \x01(\tR\x0eoldMatchTarget\x12(\n" +
+	"\x10new_match_target\x18\b \x01(\tR\x0enewMatchTarget\"\x80\x01\n" +
+	"\x1bDiffQuestionVersionsRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x05R\ttoVersion\"\xf1\x01\n" +
+	"\x1cDiffQuestionVersionsResponse\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x05R\ttoVersion\x123\n" +
+	"\achanges\x18\x04 \x03(\v2\x19.exam.QuestionFieldChangeR\achanges\x129\n" +
+	"\x0echoice_changes\x18\x05 \x03(\v2\x12.exam.ChoiceChangeR\rchoiceChanges\"\xfe\x01\n" +
+	"\x12RegradeExamRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\x03R\n" +
+	"questionId\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x12.\n" +
+	"\x13accepted_choice_ids\x18\x04 \x03(\x03R\x11acceptedChoiceIds\x12)\n" +
+	"\x10accepted_answers\x18\x05 \x03(\tR\x0facceptedAnswers\x12#\n" +
+	"\rinstructor_id\x18\x06 \x01(\x03R\finstructorId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\x8c\x01\n" +
+	"\x12RegradeScoreChange\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\told_score\x18\x03 \x01(\x02R\boldScore\x12\x1b\n" +
+	"\tnew_score\x18\x04 \x01(\x02R\bnewScore\"\xb8\x01\n" +
+	"\x13RegradeExamResponse\x12\x1d\n" +
+	"\n" +
+	"regrade_id\x18\x01 \x01(\x03R\tregradeId\x12%\n" +
+	"\x0eaffected_count\x18\x02 \x01(\x05R\raffectedCount\x12'\n" +
+	"\x0fevaluated_count\x18\x03 \x01(\x05R\x0eevaluatedCount\x122\n" +
+	"\achanges\x18\x04 \x03(\v2\x18.exam.RegradeScoreChangeR\achanges\"\xa8\x02\n" +
+	"\rRegradeRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12\x1f\n" +
+	"\vquestion_id\x18\x03 \x01(\x03R\n" +
+	"questionId\x12\x16\n" +
+	"\x06policy\x18\x04 \x01(\tR\x06policy\x12#\n" +
+	"\rinstructor_id\x18\x05 \x01(\x03R\finstructorId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12%\n" +
+	"\x0eaffected_count\x18\a \x01(\x05R\raffectedCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x122\n" +
+	"\achanges\x18\t \x03(\v2\x18.exam.RegradeScoreChangeR\achanges\"X\n" +
+	"\x18GetRegradeHistoryRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"L\n" +
+	"\x19GetRegradeHistoryResponse\x12/\n" +
+	"\bregrades\x18\x01 \x03(\v2\x13.exam.RegradeRecordR\bregrades\"\x98\x04\n" +
+	"\vScoreAppeal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rsubmission_id\x18\x02 \x01(\x03R\fsubmissionId\x12\x17\n" +
+	"\aexam_id\x18\x03 \x01(\x03R\x06examId\x12\x1f\n" +
+	"\vquestion_id\x18\x04 \x01(\x03R\n" +
+	"questionId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\bresponse\x18\b \x01(\tR\bresponse\x12*\n" +
+	"\x0eawarded_points\x18\t \x01(\x02H\x00R\rawardedPoints\x88\x01\x01\x12\x1b\n" +
+	"\told_score\x18\n" +
+	" \x01(\x02R\boldScore\x12 \n" +
+	"\tnew_score\x18\v \x01(\x02H\x01R\bnewScore\x88\x01\x01\x12\x1f\n" +
+	"\vresolved_by\x18\f \x01(\x03R\n" +
+	"resolvedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\x0e \x01(\tR\n" +
+	"resolvedAt\x12\x1d\n" +
+	"\n" +
+	"exam_title\x18\x0f \x01(\tR\texamTitle\x12)\n" +
+	"\x10question_content\x18\x10 \x01(\tR\x0fquestionContentB\x11\n" +
+	"\x0f_awarded_pointsB\f\n" +
+	"\n" +
+	"_new_score\"\x8c\x01\n" +
+	"\x13CreateAppealRequest\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\x03R\n" +
+	"questionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"A\n" +
+	"\x14CreateAppealResponse\x12)\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x11.exam.ScoreAppealR\x06appeal\"\x97\x01\n" +
+	"\x15GetAppealQueueRequest\x12#\n" +
+	"\rinstructor_id\x18\x01 \x01(\x03R\finstructorId\x12\x17\n" +
+	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x85\x01\n" +
+	"\x16GetAppealQueueResponse\x12+\n" +
+	"\aappeals\x18\x01 \x03(\v2\x11.exam.ScoreAppealR\aappeals\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"S\n" +
+	"\x13GetMyAppealsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rsubmission_id\x18\x02 \x01(\x03R\fsubmissionId\"C\n" +
+	"\x14GetMyAppealsResponse\x12+\n" +
+	"\aappeals\x18\x01 \x03(\v2\x11.exam.ScoreAppealR\aappeals\"\xcb\x01\n" +
+	"\x14ResolveAppealRequest\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\x03R\bappealId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bresponse\x18\x04 \x01(\tR\bresponse\x12*\n" +
+	"\x0eawarded_points\x18\x05 \x01(\x02H\x00R\rawardedPoints\x88\x01\x01B\x11\n" +
+	"\x0f_awarded_points\"B\n" +
+	"\x15ResolveAppealResponse\x12)\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x11.exam.ScoreAppealR\x06appeal\"k\n" +
+	"\vRubricLevel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x02R\x06points\"\xa1\x01\n" +
+	"\x0fRubricCriterion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12)\n" +
+	"\x06levels\x18\x04 \x03(\v2\x11.exam.RubricLevelR\x06levels\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\x05 \x01(\x02R\tmaxPoints\"\xfd\x01\n" +
+	"\x06Rubric\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x03R\tcreatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\bcriteria\x18\x05 \x03(\v2\x15.exam.RubricCriterionR\bcriteria\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\x06 \x01(\x02R\tmaxPoints\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x9d\x01\n" +
+	"\x13CreateRubricRequest\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\x03R\tcreatorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\bcriteria\x18\x04 \x03(\v2\x15.exam.RubricCriterionR\bcriteria\"<\n" +
+	"\x14CreateRubricResponse\x12$\n" +
+	"\x06rubric\x18\x01 \x01(\v2\f.exam.RubricR\x06rubric\"\xba\x01\n" +
+	"\x13UpdateRubricRequest\x12\x1b\n" +
+	"\trubric_id\x18\x01 \x01(\x03R\brubricId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x03R\tcreatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\bcriteria\x18\x05 \x03(\v2\x15.exam.RubricCriterionR\bcriteria\"<\n" +
+	"\x14UpdateRubricResponse\x12$\n" +
+	"\x06rubric\x18\x01 \x01(\v2\f.exam.RubricR\x06rubric\"/\n" +
+	"\x10GetRubricRequest\x12\x1b\n" +
+	"\trubric_id\x18\x01 \x01(\x03R\brubricId\"9\n" +
+	"\x11GetRubricResponse\x12$\n" +
+	"\x06rubric\x18\x01 \x01(\v2\f.exam.RubricR\x06rubric\"2\n" +
+	"\x11GetRubricsRequest\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\x03R\tcreatorId\"<\n" +
+	"\x12GetRubricsResponse\x12&\n" +
+	"\arubrics\x18\x01 \x03(\v2\f.exam.RubricR\arubrics\"Q\n" +
+	"\x13DeleteRubricRequest\x12\x1b\n" +
+	"\trubric_id\x18\x01 \x01(\x03R\brubricId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x03R\tcreatorId\"0\n" +
+	"\x14DeleteRubricResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"w\n" +
+	"\x18SetQuestionRubricRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x1b\n" +
+	"\trubric_id\x18\x02 \x01(\x03R\brubricId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x03R\tcreatorId\"5\n" +
+	"\x19SetQuestionRubricResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"i\n" +
+	"\x0fRubricSelection\x12!\n" +
+	"\fcriterion_id\x18\x01 \x01(\x03R\vcriterionId\x12\x19\n" +
+	"\blevel_id\x18\x02 \x01(\x03R\alevelId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\xeb\x01\n" +
+	"\x14RubricCriterionScore\x12!\n" +
+	"\fcriterion_id\x18\x01 \x01(\x03R\vcriterionId\x12%\n" +
+	"\x0ecriterion_name\x18\x02 \x01(\tR\rcriterionName\x12\x19\n" +
+	"\blevel_id\x18\x03 \x01(\x03R\alevelId\x12\x1d\n" +
+	"\n" +
+	"level_name\x18\x04 \x01(\tR\tlevelName\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x02R\x06points\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\x06 \x01(\x02R\tmaxPoints\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\"\xa5\x01\n" +
+	"\fRubricResult\x12\x1b\n" +
+	"\trubric_id\x18\x01 \x01(\x03R\brubricId\x126\n" +
+	"\bcriteria\x18\x02 \x03(\v2\x1a.exam.RubricCriterionScoreR\bcriteria\x12!\n" +
+	"\ftotal_points\x18\x03 \x01(\x02R\vtotalPoints\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\x04 \x01(\x02R\tmaxPoints\"\xa7\x01\n" +
+	"\rMarkingConfig\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12%\n" +
+	"\x0edouble_marking\x18\x02 \x01(\bR\rdoubleMarking\x123\n" +
+	"\x15discrepancy_threshold\x18\x03 \x01(\x02R\x14discrepancyThreshold\x12!\n" +
+	"\fmoderator_id\x18\x04 \x01(\x03R\vmoderatorId\"\xd6\x01\n" +
+	"\x17ConfigureMarkingRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12%\n" +
+	"\x0edouble_marking\x18\x03 \x01(\bR\rdoubleMarking\x123\n" +
+	"\x15discrepancy_threshold\x18\x04 \x01(\x02R\x14discrepancyThreshold\x12!\n" +
+	"\fmoderator_id\x18\x05 \x01(\x03R\vmoderatorId\"G\n" +
+	"\x18ConfigureMarkingResponse\x12+\n" +
+	"\x06config\x18\x01 \x01(\v2\x13.exam.MarkingConfigR\x06config\"s\n" +
+	"\x14AssignMarkersRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x1d\n" +
+	"\n" +
+	"marker_ids\x18\x03 \x03(\x03R\tmarkerIds\"c\n" +
+	"\x15AssignMarkersResponse\x12%\n" +
+	"\x0eassigned_count\x18\x01 \x01(\x05R\rassignedCount\x12#\n" +
+	"\rskipped_count\x18\x02 \x01(\x05R\fskippedCount\"\xfd\x01\n" +
+	"\vMarkingTask\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\x12!\n" +
+	"\fanonymous_id\x18\x02 \x01(\tR\vanonymousId\x12\x17\n" +
+	"\aexam_id\x18\x03 \x01(\x03R\x06examId\x12\x1d\n" +
+	"\n" +
+	"exam_title\x18\x04 \x01(\tR\texamTitle\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vassigned_at\x18\a \x01(\tR\n" +
+	"assignedAt\x12!\n" +
+	"\fcompleted_at\x18\b \x01(\tR\vcompletedAt\"M\n" +
+	"\x16GetMarkingTasksRequest\x12\x1b\n" +
+	"\tmarker_id\x18\x01 \x01(\x03R\bmarkerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"B\n" +
+	"\x17GetMarkingTasksResponse\x12'\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x11.exam.MarkingTaskR\x05tasks\"\xee\x02\n" +
+	"\fMarkingEssay\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12)\n" +
+	"\x10question_content\x18\x02 \x01(\tR\x0fquestionContent\x12%\n" +
+	"\x0eattachment_url\x18\x03 \x01(\tR\rattachmentUrl\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x02R\x06points\x12\x1f\n" +
+	"\vtext_answer\x18\x05 \x01(\tR\n" +
+	"textAnswer\x12\x1b\n" +
+	"\trubric_id\x18\x06 \x01(\x03R\brubricId\x12 \n" +
+	"\tmy_points\x18\a \x01(\x02H\x00R\bmyPoints\x88\x01\x01\x12\x1f\n" +
+	"\vmy_feedback\x18\b \x01(\tR\n" +
+	"myFeedback\x12D\n" +
+	"\x12similarity_matches\x18\t \x03(\v2\x15.exam.SimilarityMatchR\x11similarityMatchesB\f\n" +
+	"\n" +
+	"_my_points\"Y\n" +
+	"\x15GetMarkingTaskRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\x12\x1b\n" +
+	"\tmarker_id\x18\x02 \x01(\x03R\bmarkerId\"k\n" +
+	"\x16GetMarkingTaskResponse\x12%\n" +
+	"\x04task\x18\x01 \x01(\v2\x11.exam.MarkingTaskR\x04task\x12*\n" +
+	"\x06essays\x18\x02 \x03(\v2\x12.exam.MarkingEssayR\x06essays\"\xaa\x01\n" +
+	"\x0eEssayMarkInput\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x1f\n" +
+	"\vscore_ratio\x18\x02 \x01(\x02R\n" +
+	"scoreRatio\x12:\n" +
+	"\rrubric_scores\x18\x03 \x03(\v2\x15.exam.RubricSelectionR\frubricScores\x12\x1a\n" +
+	"\bfeedback\x18\x04 \x01(\tR\bfeedback\"\x82\x01\n" +
+	"\x12SubmitMarksRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\x12\x1b\n" +
+	"\tmarker_id\x18\x02 \x01(\x03R\bmarkerId\x12*\n" +
+	"\x05marks\x18\x03 \x03(\v2\x14.exam.EssayMarkInputR\x05marks\"Z\n" +
+	"\x13SubmitMarksResponse\x12%\n" +
+	"\x0emarking_status\x18\x01 \x01(\tR\rmarkingStatus\x12\x1c\n" +
+	"\tescalated\x18\x02 \x01(\bR\tescalated\"\xcd\x01\n" +
+	"\x17MarkerAssignmentSummary\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\x12\x1b\n" +
+	"\tmarker_id\x18\x02 \x01(\x03R\bmarkerId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\ftotal_points\x18\x05 \x01(\x02R\vtotalPoints\x12!\n" +
+	"\fcompleted_at\x18\x06 \x01(\tR\vcompletedAt\"\xa8\x02\n" +
+	"\x11SubmissionMarking\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12!\n" +
+	"\fanonymous_id\x18\x02 \x01(\tR\vanonymousId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12?\n" +
+	"\vassignments\x18\x05 \x03(\v2\x1d.exam.MarkerAssignmentSummaryR\vassignments\x12$\n" +
+	"\vfinal_score\x18\x06 \x01(\x02H\x00R\n" +
+	"finalScore\x88\x01\x01\x12#\n" +
+	"\rreconciled_at\x18\a \x01(\tR\freconciledAtB\x0e\n" +
+	"\f_final_score\"Y\n" +
+	"\x19GetMarkingOverviewRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"\x84\x01\n" +
+	"\x1aGetMarkingOverviewResponse\x12+\n" +
+	"\x06config\x18\x01 \x01(\v2\x13.exam.MarkingConfigR\x06config\x129\n" +
+	"\vsubmissions\x18\x02 \x03(\v2\x17.exam.SubmissionMarkingR\vsubmissions\"\xc3\x01\n" +
+	"\x12AccommodationTerms\x12'\n" +
+	"\x0ftime_multiplier\x18\x01 \x01(\x02R\x0etimeMultiplier\x12#\n" +
+	"\rextra_minutes\x18\x02 \x01(\x05R\fextraMinutes\x128\n" +
+	"\x18window_extension_minutes\x18\x03 \x01(\x05R\x16windowExtensionMinutes\x12%\n" +
+	"\x0eextra_attempts\x18\x04 \x01(\x05R\rextraAttempts\"\xf0\x01\n" +
+	"\rAccommodation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\x03R\aclassId\x12\x17\n" +
+	"\aexam_id\x18\x04 \x01(\x03R\x06examId\x12.\n" +
+	"\x05terms\x18\x05 \x01(\v2\x18.exam.AccommodationTermsR\x05terms\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x03R\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\xb9\x01\n" +
+	"\x18SaveAccommodationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\x03R\aclassId\x12.\n" +
+	"\x05terms\x18\x03 \x01(\v2\x18.exam.AccommodationTermsR\x05terms\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12#\n" +
+	"\rinstructor_id\x18\x05 \x01(\x03R\finstructorId\"V\n" +
+	"\x19SaveAccommodationResponse\x129\n" +
+	"\raccommodation\x18\x01 \x01(\v2\x13.exam.AccommodationR\raccommodation\"s\n" +
+	"\x18GetAccommodationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\x03R\aclassId\x12#\n" +
+	"\rinstructor_id\x18\x03 \x01(\x03R\finstructorId\"X\n" +
+	"\x19GetAccommodationsResponse\x12;\n" +
+	"\x0eaccommodations\x18\x01 \x03(\v2\x13.exam.AccommodationR\x0eaccommodations\"Q\n" +
+	"\x1aDeleteAccommodationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"7\n" +
+	"\x1bDeleteAccommodationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbb\x01\n" +
+	"\x1cSaveExamAccommodationRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12.\n" +
+	"\x05terms\x18\x03 \x01(\v2\x18.exam.AccommodationTermsR\x05terms\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12#\n" +
+	"\rinstructor_id\x18\x05 \x01(\x03R\finstructorId\"Z\n" +
+	"\x1dSaveExamAccommodationResponse\x129\n" +
+	"\raccommodation\x18\x01 \x01(\v2\x13.exam.AccommodationR\raccommodation\"\\\n" +
+	"\x1cGetExamAccommodationsRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"\\\n" +
+	"\x1dGetExamAccommodationsResponse\x12;\n" +
+	"\x0eaccommodations\x18\x01 \x03(\v2\x13.exam.AccommodationR\x0eaccommodations\"w\n" +
+	"\x1eDeleteExamAccommodationRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12#\n" +
+	"\rinstructor_id\x18\x03 \x01(\x03R\finstructorId\";\n" +
+	"\x1fDeleteExamAccommodationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x97\x02\n" +
+	"\x11ExamSessionAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x03 \x01(\x03R\finstructorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12#\n" +
+	"\rextra_minutes\x18\x06 \x01(\x05R\fextraMinutes\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12%\n" +
+	"\x0eaffected_count\x18\b \x01(\x05R\raffectedCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xc9\x01\n" +
+	"\x19ControlExamSessionRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12#\n" +
+	"\rextra_minutes\x18\x05 \x01(\x05R\fextraMinutes\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"M\n" +
+	"\x1aControlExamSessionResponse\x12/\n" +
+	"\x06action\x18\x01 \x01(\v2\x17.exam.ExamSessionActionR\x06action\"\\\n" +
+	"\x1cGetExamSessionActionsRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"R\n" +
+	"\x1dGetExamSessionActionsResponse\x121\n" +
+	"\aactions\x18\x01 \x03(\v2\x17.exam.ExamSessionActionR\aactions\"\x8c\x01\n" +
+	"\x1aGetExamSessionStateRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"\xcb\x01\n" +
+	"\x1bGetExamSessionStateResponse\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\x03R\fsubmissionId\x12+\n" +
+	"\x11remaining_seconds\x18\x02 \x01(\x05R\x10remainingSeconds\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\x12&\n" +
+	"\x0flast_answer_seq\x18\x04 \x01(\x03R\rlastAnswerSeq\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\tR\bdeadline\"\xf7\x02\n" +
+	"\x0fSuspicionConfig\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12<\n" +
+	"\aweights\x18\x02 \x03(\v2\".exam.SuspicionConfig.WeightsEntryR\aweights\x120\n" +
+	"\x14burst_window_seconds\x18\x03 \x01(\x05R\x12burstWindowSeconds\x12%\n" +
+	"\x0eflag_threshold\x18\x04 \x01(\x02R\rflagThreshold\x12%\n" +
+	"\x0elock_threshold\x18\x05 \x01(\x02R\rlockThreshold\x122\n" +
+	"\x15auto_submit_threshold\x18\x06 \x01(\x02R\x13autoSubmitThreshold\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"Y\n" +
+	"\x19GetSuspicionConfigRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"K\n" +
+	"\x1aGetSuspicionConfigResponse\x12-\n" +
+	"\x06config\x18\x01 \x01(\v2\x15.exam.SuspicionConfigR\x06config\"\x8b\x01\n" +
+	"\x1cUpdateSuspicionConfigRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12-\n" +
+	"\x06config\x18\x03 \x01(\v2\x15.exam.SuspicionConfigR\x06config\"N\n" +
+	"\x1dUpdateSuspicionConfigResponse\x12-\n" +
+	"\x06config\x18\x01 \x01(\v2\x15.exam.SuspicionConfigR\x06config\"\x81\x02\n" +
+	"\x11CollusionEvidence\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x1a\n" +
+	"\bresponse\x18\x02 \x01(\tR\bresponse\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x03 \x01(\bR\tisCorrect\x12#\n" +
+	"\rresponse_rate\x18\x04 \x01(\x02R\fresponseRate\x12\"\n" +
+	"\ranswered_at_a\x18\x05 \x01(\tR\vansweredAtA\x12\"\n" +
+	"\ranswered_at_b\x18\x06 \x01(\tR\vansweredAtB\x12#\n" +
+	"\rseconds_apart\x18\a \x01(\x05R\fsecondsApart\"\x93\x04\n" +
+	"\rCollusionPair\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x15\n" +
+	"\x06user_a\x18\x02 \x01(\x03R\x05userA\x12\x15\n" +
+	"\x06user_b\x18\x03 \x01(\x03R\x05userB\x12!\n" +
+	"\fsubmission_a\x18\x04 \x01(\x03R\vsubmissionA\x12!\n" +
+	"\fsubmission_b\x18\x05 \x01(\x03R\vsubmissionB\x12)\n" +
+	"\x10shared_questions\x18\x06 \x01(\x05R\x0fsharedQuestions\x12+\n" +
+	"\x11identical_answers\x18\a \x01(\x05R\x10identicalAnswers\x12'\n" +
+	"\x0fidentical_wrong\x18\b \x01(\x05R\x0eidenticalWrong\x128\n" +
+	"\x18expected_identical_wrong\x18\t \x01(\x02R\x16expectedIdenticalWrong\x12\x17\n" +
+	"\az_score\x18\n" +
+	" \x01(\x02R\x06zScore\x12%\n" +
+	"\x0etiming_matches\x18\v \x01(\x05R\rtimingMatches\x120\n" +
+	"\x14submit_seconds_apart\x18\f \x01(\x05R\x12submitSecondsApart\x12\x18\n" +
+	"\aflagged\x18\r \x01(\bR\aflagged\x123\n" +
+	"\bevidence\x18\x0e \x03(\v2\x17.exam.CollusionEvidenceR\bevidence\"\xcd\x02\n" +
+	"\x0fCollusionReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12#\n" +
+	"\rstudent_count\x18\x03 \x01(\x05R\fstudentCount\x12\x1d\n" +
+	"\n" +
+	"pair_count\x18\x04 \x01(\x05R\tpairCount\x12.\n" +
+	"\x13min_identical_wrong\x18\x05 \x01(\x05R\x11minIdenticalWrong\x12\x1f\n" +
+	"\vz_threshold\x18\x06 \x01(\x02R\n" +
+	"zThreshold\x122\n" +
+	"\x15timing_window_seconds\x18\a \x01(\x05R\x13timingWindowSeconds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12)\n" +
+	"\x05pairs\x18\t \x03(\v2\x13.exam.CollusionPairR\x05pairs\"\xf9\x01\n" +
+	"\x17AnalyzeCollusionRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12.\n" +
+	"\x13min_identical_wrong\x18\x03 \x01(\x05R\x11minIdenticalWrong\x12\x1f\n" +
+	"\vz_threshold\x18\x04 \x01(\x02R\n" +
+	"zThreshold\x122\n" +
+	"\x15timing_window_seconds\x18\x05 \x01(\x05R\x13timingWindowSeconds\x12\x1b\n" +
+	"\tmax_pairs\x18\x06 \x01(\x05R\bmaxPairs\"I\n" +
+	"\x18AnalyzeCollusionResponse\x12-\n" +
+	"\x06report\x18\x01 \x01(\v2\x15.exam.CollusionReportR\x06report\"Y\n" +
+	"\x19GetCollusionReportRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"K\n" +
+	"\x1aGetCollusionReportResponse\x12-\n" +
+	"\x06report\x18\x01 \x01(\v2\x15.exam.CollusionReportR\x06report\"\x98\x01\n" +
+	"\x10SimilaritySource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\x03R\n" +
+	"questionId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAtJ\x04\b\x03\x10\x04\"\xa1\x01\n" +
+	"\x0eSimilaritySpan\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\x12#\n" +
+	"\rmatched_start\x18\x03 \x01(\x05R\fmatchedStart\x12\x1f\n" +
+	"\vmatched_end\x18\x04 \x01(\x05R\n" +
+	"matchedEnd\x12!\n" +
+	"\fmatched_text\x18\x05 \x01(\tR\vmatchedText\"\xb8\x03\n" +
+	"\x0fSimilarityMatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\x03R\n" +
+	"questionId\x12#\n" +
+	"\rsubmission_id\x18\x03 \x01(\x03R\fsubmissionId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x122\n" +
+	"\x15matched_submission_id\x18\x05 \x01(\x03R\x13matchedSubmissionId\x12&\n" +
+	"\x0fmatched_user_id\x18\x06 \x01(\x03R\rmatchedUserId\x12\x1b\n" +
+	"\tsource_id\x18\a \x01(\x03R\bsourceId\x12!\n" +
+	"\fsource_title\x18\b \x01(\tR\vsourceTitle\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\t \x01(\x02R\n" +
+	"similarity\x12/\n" +
+	"\x13shared_fingerprints\x18\n" +
+	" \x01(\x05R\x12sharedFingerprints\x12*\n" +
+	"\x05spans\x18\v \x03(\v2\x14.exam.SimilaritySpanR\x05spans\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"\x8e\x01\n" +
+	"\x1cSaveSimilaritySourcesRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x120\n" +
+	"\asources\x18\x03 \x03(\v2\x16.exam.SimilaritySourceR\asources\"Q\n" +
+	"\x1dSaveSimilaritySourcesResponse\x120\n" +
+	"\asources\x18\x01 \x03(\v2\x16.exam.SimilaritySourceR\asources\"[\n" +
+	"\x1bGetSimilaritySourcesRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"P\n" +
+	"\x1cGetSimilaritySourcesResponse\x120\n" +
+	"\asources\x18\x01 \x03(\v2\x16.exam.SimilaritySourceR\asources\"\xa3\x01\n" +
+	"\x1bCheckEssaySimilarityRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x1f\n" +
+	"\vquestion_id\x18\x03 \x01(\x03R\n" +
+	"questionId\x12%\n" +
+	"\x0emin_similarity\x18\x04 \x01(\x02R\rminSimilarity\"\x99\x01\n" +
+	"\x1cCheckEssaySimilarityResponse\x12'\n" +
+	"\x0fchecked_answers\x18\x01 \x01(\x05R\x0echeckedAnswers\x12\x1f\n" +
+	"\vmatch_count\x18\x02 \x01(\x05R\n" +
+	"matchCount\x12/\n" +
+	"\amatches\x18\x03 \x03(\v2\x15.exam.SimilarityMatchR\amatches\"\x9f\x01\n" +
+	"\x19GetEssaySimilarityRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x1f\n" +
+	"\vquestion_id\x18\x03 \x01(\x03R\n" +
+	"questionId\x12#\n" +
+	"\rsubmission_id\x18\x04 \x01(\x03R\fsubmissionId\"M\n" +
+	"\x1aGetEssaySimilarityResponse\x12/\n" +
+	"\amatches\x18\x01 \x03(\v2\x15.exam.SimilarityMatchR\amatches\"\x8c\x03\n" +
+	"\x10PracticeFeedback\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x02 \x01(\bR\tisCorrect\x12\x18\n" +
+	"\apending\x18\x03 \x01(\bR\apending\x12#\n" +
+	"\rearned_points\x18\x04 \x01(\x02R\fearnedPoints\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x02R\x06points\x12 \n" +
+	"\vexplanation\x18\x06 \x01(\tR\vexplanation\x12,\n" +
+	"\achoices\x18\a \x03(\v2\x12.exam.ChoiceReviewR\achoices\x123\n" +
+	"\anumeric\x18\b \x01(\v2\x19.exam.NumericAnswerConfigR\anumeric\x127\n" +
+	"\x0ecorrect_blanks\x18\t \x03(\v2\x10.exam.ClozeBlankR\rcorrectBlanks\x12#\n" +
+	"\rattempt_count\x18\n" +
+	" \x01(\x05R\fattemptCount\"\x8e\x02\n" +
+	"\fPracticeArea\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tquestions\x18\x03 \x01(\x05R\tquestions\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12)\n" +
+	"\x10correct_attempts\x18\x05 \x01(\x05R\x0fcorrectAttempts\x12\x1a\n" +
+	"\baccuracy\x18\x06 \x01(\x02R\baccuracy\x12-\n" +
+	"\x12mastered_questions\x18\a \x01(\x05R\x11masteredQuestions\x12*\n" +
+	"\x11weak_question_ids\x18\b \x03(\x03R\x0fweakQuestionIds\"L\n" +
+	"\x18GetPracticeReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\aexam_id\x18\x02 \x01(\x03R\x06examId\"\xe5\x01\n" +
+	"\x19GetPracticeReportResponse\x12%\n" +
+	"\x0etotal_attempts\x18\x01 \x01(\x05R\rtotalAttempts\x12)\n" +
+	"\x10correct_attempts\x18\x02 \x01(\x05R\x0fcorrectAttempts\x12\x1a\n" +
+	"\baccuracy\x18\x03 \x01(\x02R\baccuracy\x12.\n" +
+	"\bsections\x18\x04 \x03(\v2\x12.exam.PracticeAreaR\bsections\x12*\n" +
+	"\x06topics\x18\x05 \x03(\v2\x12.exam.PracticeAreaR\x06topics\"\xcc\x01\n" +
+	"\fReviewAnswer\x12 \n" +
+	"\vexplanation\x18\x01 \x01(\tR\vexplanation\x12,\n" +
+	"\achoices\x18\x02 \x03(\v2\x12.exam.ChoiceReviewR\achoices\x123\n" +
+	"\anumeric\x18\x03 \x01(\v2\x19.exam.NumericAnswerConfigR\anumeric\x127\n" +
+	"\x0ecorrect_blanks\x18\x04 \x03(\v2\x10.exam.ClozeBlankR\rcorrectBlanks\"\xb2\x03\n" +
+	"\n" +
+	"ReviewCard\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\x03R\n" +
+	"questionId\x12\x17\n" +
+	"\aexam_id\x18\x03 \x01(\x03R\x06examId\x12\x19\n" +
+	"\btopic_id\x18\x04 \x01(\x03R\atopicId\x12\x1f\n" +
+	"\vease_factor\x18\x05 \x01(\x02R\n" +
+	"easeFactor\x12#\n" +
+	"\rinterval_days\x18\x06 \x01(\x05R\fintervalDays\x12 \n" +
+	"\vrepetitions\x18\a \x01(\x05R\vrepetitions\x12\x16\n" +
+	"\x06lapses\x18\b \x01(\x05R\x06lapses\x12\x15\n" +
+	"\x06due_at\x18\t \x01(\tR\x05dueAt\x12\x1f\n" +
+	"\vlast_rating\x18\n" +
+	" \x01(\x05R\n" +
+	"lastRating\x12(\n" +
+	"\x10last_reviewed_at\x18\v \x01(\tR\x0elastReviewedAt\x121\n" +
+	"\bquestion\x18\f \x01(\v2\x15.exam.QuestionDetailsR\bquestion\x12*\n" +
+	"\x06answer\x18\r \x01(\v2\x12.exam.ReviewAnswerR\x06answer\"{\n" +
+	"\x14GetDueReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\btopic_id\x18\x02 \x01(\x03R\atopicId\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\x03R\aclassId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"}\n" +
+	"\x15GetDueReviewsResponse\x12&\n" +
+	"\x05cards\x18\x01 \x03(\v2\x10.exam.ReviewCardR\x05cards\x12\x1b\n" +
+	"\tdue_count\x18\x02 \x01(\x03R\bdueCount\x12\x1f\n" +
+	"\vtotal_cards\x18\x03 \x01(\x03R\n" +
+	"totalCards\"_\n" +
+	"\x13RecordReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\acard_id\x18\x02 \x01(\x03R\x06cardId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\"<\n" +
+	"\x14RecordReviewResponse\x12$\n" +
+	"\x04card\x18\x01 \x01(\v2\x10.exam.ReviewCardR\x04card\"\xdc\x01\n" +
+	"\x18GeneratePaperExamRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12#\n" +
+	"\rversion_count\x18\x03 \x01(\x05R\fversionCount\x12\x1f\n" +
+	"\vstudent_ids\x18\x04 \x03(\x03R\n" +
+	"studentIds\x12\x1f\n" +
+	"\vschool_name\x18\x05 \x01(\tR\n" +
+	"schoolName\x12\x1b\n" +
+	"\texam_date\x18\x06 \x01(\tR\bexamDate\"\xb2\x01\n" +
+	"\x10PaperExamVersion\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\x03R\tstudentId\x12!\n" +
+	"\fstudent_name\x18\x03 \x01(\tR\vstudentName\x12%\n" +
+	"\x0equestion_count\x18\x04 \x01(\x05R\rquestionCount\x12!\n" +
+	"\ftotal_points\x18\x05 \x01(\x02R\vtotalPoints\"\x86\x01\n" +
+	"\x19GeneratePaperExamResponse\x12\x19\n" +
+	"\bzip_data\x18\x01 \x01(\fR\azipData\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x122\n" +
+	"\bversions\x18\x03 \x03(\v2\x16.exam.PaperExamVersionR\bversions\"\xc2\x01\n" +
+	"\x1bGenerateAnswerSheetsRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x1f\n" +
+	"\vstudent_ids\x18\x03 \x03(\x03R\n" +
+	"studentIds\x12#\n" +
+	"\rversion_count\x18\x04 \x01(\x05R\fversionCount\x12\x1f\n" +
+	"\vschool_name\x18\x05 \x01(\tR\n" +
+	"schoolName\"\xa1\x01\n" +
+	"\x0fAnswerSheetInfo\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\x03R\tstudentId\x12!\n" +
+	"\fstudent_name\x18\x02 \x01(\tR\vstudentName\x12!\n" +
+	"\fversion_code\x18\x03 \x01(\tR\vversionCode\x12)\n" +
+	"\x10bubble_questions\x18\x04 \x01(\x05R\x0fbubbleQuestions\"\x84\x01\n" +
+	"\x1cGenerateAnswerSheetsResponse\x12\x19\n" +
+	"\bpdf_data\x18\x01 \x01(\fR\apdfData\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12-\n" +
+	"\x06sheets\x18\x03 \x03(\v2\x15.exam.AnswerSheetInfoR\x06sheets\"C\n" +
+	"\vScannedFile\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\xd7\x01\n" +
+	"\bScanMark\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x16\n" +
+	"\x06marked\x18\x03 \x03(\tR\x06marked\x12\x1f\n" +
+	"\vfill_ratios\x18\x04 \x03(\x02R\n" +
+	"fillRatios\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\x02R\n" +
+	"confidence\x12!\n" +
+	"\fneeds_review\x18\x06 \x01(\bR\vneedsReview\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\x98\x03\n" +
+	"\x0fAnswerSheetScan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\x03R\tstudentId\x12!\n" +
+	"\fversion_code\x18\x04 \x01(\tR\vversionCode\x12#\n" +
+	"\rsubmission_id\x18\x05 \x01(\x03R\fsubmissionId\x12\x1b\n" +
+	"\tfile_name\x18\x06 \x01(\tR\bfileName\x12\x12\n" +
+	"\x04page\x18\a \x01(\x05R\x04page\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\n" +
+	" \x01(\x02R\n" +
+	"confidence\x12$\n" +
+	"\x05marks\x18\v \x03(\v2\x0e.exam.ScanMarkR\x05marks\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x12\x14\n" +
+	"\x05score\x18\r \x01(\x02R\x05score\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\"\x82\x01\n" +
+	"\x19GradeScannedSheetsRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12'\n" +
+	"\x05files\x18\x03 \x03(\v2\x11.exam.ScannedFileR\x05files\"\xb2\x01\n" +
+	"\x1aGradeScannedSheetsResponse\x12+\n" +
+	"\x05scans\x18\x01 \x03(\v2\x15.exam.AnswerSheetScanR\x05scans\x12!\n" +
+	"\fgraded_count\x18\x02 \x01(\x05R\vgradedCount\x12!\n" +
+	"\freview_count\x18\x03 \x01(\x05R\vreviewCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x05R\vfailedCount\"r\n" +
+	"\x1aGetAnswerSheetScansRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"J\n" +
+	"\x1bGetAnswerSheetScansResponse\x12+\n" +
+	"\x05scans\x18\x01 \x03(\v2\x15.exam.AnswerSheetScanR\x05scans\"I\n" +
+	"\x0eScanCorrection\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x16\n" +
+	"\x06marked\x18\x02 \x03(\tR\x06marked\"\x95\x01\n" +
+	"\x1dResolveAnswerSheetScanRequest\x12\x17\n" +
+	"\ascan_id\x18\x01 \x01(\x03R\x06scanId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x126\n" +
+	"\vcorrections\x18\x03 \x03(\v2\x14.exam.ScanCorrectionR\vcorrections\"K\n" +
+	"\x1eResolveAnswerSheetScanResponse\x12)\n" +
+	"\x04scan\x18\x01 \x01(\v2\x15.exam.AnswerSheetScanR\x04scan\"\xe4\x01\n" +
+	"\x10CloneExamRequest\x12\x17\n" +
+	"\aexam_id\x18\x01 \x01(\x03R\x06examId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"shift_days\x18\x04 \x01(\x05R\tshiftDays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12!\n" +
+	"\fcopy_classes\x18\x06 \x01(\bR\vcopyClasses\x12\x1b\n" +
+	"\tclass_ids\x18\a \x03(\x03R\bclassIds\"\xb7\x01\n" +
+	"\x11CloneExamResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12%\n" +
+	"\x0equestion_count\x18\x05 \x01(\x05R\rquestionCount\x12\x1b\n" +
+	"\tclass_ids\x18\x06 \x03(\x03R\bclassIds\"\xc9\x02\n" +
+	"\fExamTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\btopic_id\x18\x04 \x01(\x03R\atopicId\x12.\n" +
+	"\bsettings\x18\x05 \x01(\v2\x12.exam.ExamSettingsR\bsettings\x12<\n" +
+	"\x0fsection_configs\x18\x06 \x03(\v2\x13.exam.SectionConfigR\x0esectionConfigs\x12%\n" +
+	"\x0equestion_count\x18\a \x01(\x05R\rquestionCount\x12$\n" +
+	"\x0esource_exam_id\x18\b \x01(\x03R\fsourceExamId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xa5\x02\n" +
+	"\x19CreateExamTemplateRequest\x12#\n" +
+	"\rinstructor_id\x18\x01 \x01(\x03R\finstructorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\btopic_id\x18\x04 \x01(\x03R\atopicId\x12.\n" +
+	"\bsettings\x18\x05 \x01(\v2\x12.exam.ExamSettingsR\bsettings\x12<\n" +
+	"\x0fsection_configs\x18\x06 \x03(\v2\x13.exam.SectionConfigR\x0esectionConfigs\x12$\n" +
+	"\x0esource_exam_id\x18\a \x01(\x03R\fsourceExamId\"L\n" +
+	"\x1aCreateExamTemplateResponse\x12.\n" +
+	"\btemplate\x18\x01 \x01(\v2\x12.exam.ExamTemplateR\btemplate\">\n" +
+	"\x17GetExamTemplatesRequest\x12#\n" +
+	"\rinstructor_id\x18\x01 \x01(\x03R\finstructorId\"L\n" +
+	"\x18GetExamTemplatesResponse\x120\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x12.exam.ExamTemplateR\ttemplates\"a\n" +
+	"\x19DeleteExamTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\"6\n" +
+	"\x1aDeleteExamTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd3\x01\n" +
+	"\x1eInstantiateExamTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12#\n" +
+	"\rinstructor_id\x18\x02 \x01(\x03R\finstructorId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\x12\x1b\n" +
+	"\tclass_ids\x18\x06 \x03(\x03R\bclassIds\"\x8b\x01\n" +
+	"\x1fInstantiateExamTemplateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0equestion_count\x18\x03 \x01(\x05R\rquestionCount\x12\x1b\n" +
+	"\tclass_ids\x18\x04 \x03(\x03R\bclassIds\"\x94\x02\n" +
+	"\x13BlueprintRuleReport\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\x03R\tsectionId\x12!\n" +
+	"\fsection_name\x18\x03 \x01(\tR\vsectionName\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x04 \x01(\tR\n" +
+	"difficulty\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06points\x18\x06 \x01(\x02R\x06points\x12\x1b\n" +
+	"\tpool_size\x18\a \x01(\x05R\bpoolSize\x12\x1e\n" +
+	"\n" +
+	"guaranteed\x18\b \x01(\x05R\n" +
+	"guaranteed\x12\x1a\n" +
+	"\bcoverage\x18\t \x01(\x01R\bcoverage\"X\n" +
+	"\x10BlueprintOverlap\x12\x15\n" +
+	"\x06rule_a\x18\x01 \x01(\x05R\x05ruleA\x12\x15\n" +
+	"\x06rule_b\x18\x02 \x01(\x05R\x05ruleB\x12\x16\n" +
+	"\x06shared\x18\x03 \x01(\x05R\x06shared\"n\n" +
+	"\x0eBlueprintIssue\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\x05R\x04rule\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8c\x02\n" +
+	"\x1cValidateExamBlueprintRequest\x12#\n" +
+	"\rinstructor_id\x18\x01 \x01(\x03R\finstructorId\x12\x17\n" +
+	"\aexam_id\x18\x02 \x01(\x03R\x06examId\x12\x19\n" +
+	"\btopic_id\x18\x03 \x01(\x03R\atopicId\x12<\n" +
+	"\x0fsection_configs\x18\x04 \x03(\v2\x13.exam.SectionConfigR\x0esectionConfigs\x12,\n" +
+	"\x12fixed_question_ids\x18\x05 \x03(\x03R\x10fixedQuestionIds\x12'\n" +
+	"\x0fcoverage_factor\x18\x06 \x01(\x05R\x0ecoverageFactor\"\xa1\x04\n" +
+	"\x1dValidateExamBlueprintResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12/\n" +
+	"\x05rules\x18\x02 \x03(\v2\x19.exam.BlueprintRuleReportR\x05rules\x122\n" +
+	"\boverlaps\x18\x03 \x03(\v2\x16.exam.BlueprintOverlapR\boverlaps\x12,\n" +
+	"\x06issues\x18\x04 \x03(\v2\x14.exam.BlueprintIssueR\x06issues\x12\x1f\n" +
+	"\vfixed_count\x18\x05 \x01(\x05R\n" +
+	"fixedCount\x12'\n" +
+	"\x0fdesigned_length\x18\x06 \x01(\x05R\x0edesignedLength\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\a \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\b \x01(\x05R\tmaxLength\x12'\n" +
+	"\x0fexpected_length\x18\t \x01(\x01R\x0eexpectedLength\x12+\n" +
+	"\x11short_probability\x18\n" +
+	" \x01(\x01R\x10shortProbability\x12'\n" +
+	"\x0fdesigned_points\x18\v \x01(\x01R\x0edesignedPoints\x12'\n" +
+	"\x0fexpected_points\x18\f \x01(\x01R\x0eexpectedPoints\x12'\n" +
+	"\x0fcoverage_factor\x18\r \x01(\x05R\x0ecoverageFactor2\xcbB\n" +
 	"\vExamService\x12B\n" +
 	"\vCreateTopic\x12\x18.exam.CreateTopicRequest\x1a\x19.exam.CreateTopicResponse\x12<\n" +
 	"\tGetTopics\x12\x16.exam.GetTopicsRequest\x1a\x17.exam.GetTopicsResponse\x12H\n" +
@@ -7641,7 +20237,64 @@ const file_exam_proto_rawDesc = "" +
 	"\x10GetMySubmissions\x12\x1d.exam.GetMySubmissionsRequest\x1a\x1e.exam.GetMySubmissionsResponse\x12?\n" +
 	"\n" +
 	"GradeEssay\x12\x17.exam.GradeEssayRequest\x1a\x18.exam.GradeEssayResponse\x12T\n" +
-	"\x11GetClassGradebook\x12\x1e.exam.GetClassGradebookRequest\x1a\x1f.exam.GetClassGradebookResponseB\x18Z\x16shared/proto/exam;examb\x06proto3"
+	"\x11GetClassGradebook\x12\x1e.exam.GetClassGradebookRequest\x1a\x1f.exam.GetClassGradebookResponse\x12N\n" +
+	"\x0fGetItemAnalysis\x12\x1c.exam.GetItemAnalysisRequest\x1a\x1d.exam.GetItemAnalysisResponse\x12f\n" +
+	"\x17GetNextAdaptiveQuestion\x12$.exam.GetNextAdaptiveQuestionRequest\x1a%.exam.GetNextAdaptiveQuestionResponse\x12Q\n" +
+	"\x10ImportQTIPackage\x12\x1d.exam.ImportQTIPackageRequest\x1a\x1e.exam.ImportQTIPackageResponse\x12Q\n" +
+	"\x10ExportQTIPackage\x12\x1d.exam.ExportQTIPackageRequest\x1a\x1e.exam.ExportQTIPackageResponse\x12W\n" +
+	"\x12GetQuestionHistory\x12\x1f.exam.GetQuestionHistoryRequest\x1a .exam.GetQuestionHistoryResponse\x12W\n" +
+	"\x12GetQuestionVersion\x12\x1f.exam.GetQuestionVersionRequest\x1a .exam.GetQuestionVersionResponse\x12]\n" +
+	"\x14DiffQuestionVersions\x12!.exam.DiffQuestionVersionsRequest\x1a\".exam.DiffQuestionVersionsResponse\x12B\n" +
+	"\vRegradeExam\x12\x18.exam.RegradeExamRequest\x1a\x19.exam.RegradeExamResponse\x12T\n" +
+	"\x11GetRegradeHistory\x12\x1e.exam.GetRegradeHistoryRequest\x1a\x1f.exam.GetRegradeHistoryResponse\x12E\n" +
+	"\fCreateAppeal\x12\x19.exam.CreateAppealRequest\x1a\x1a.exam.CreateAppealResponse\x12K\n" +
+	"\x0eGetAppealQueue\x12\x1b.exam.GetAppealQueueRequest\x1a\x1c.exam.GetAppealQueueResponse\x12E\n" +
+	"\fGetMyAppeals\x12\x19.exam.GetMyAppealsRequest\x1a\x1a.exam.GetMyAppealsResponse\x12H\n" +
+	"\rResolveAppeal\x12\x1a.exam.ResolveAppealRequest\x1a\x1b.exam.ResolveAppealResponse\x12E\n" +
+	"\fCreateRubric\x12\x19.exam.CreateRubricRequest\x1a\x1a.exam.CreateRubricResponse\x12E\n" +
+	"\fUpdateRubric\x12\x19.exam.UpdateRubricRequest\x1a\x1a.exam.UpdateRubricResponse\x12<\n" +
+	"\tGetRubric\x12\x16.exam.GetRubricRequest\x1a\x17.exam.GetRubricResponse\x12?\n" +
+	"\n" +
+	"GetRubrics\x12\x17.exam.GetRubricsRequest\x1a\x18.exam.GetRubricsResponse\x12E\n" +
+	"\fDeleteRubric\x12\x19.exam.DeleteRubricRequest\x1a\x1a.exam.DeleteRubricResponse\x12T\n" +
+	"\x11SetQuestionRubric\x12\x1e.exam.SetQuestionRubricRequest\x1a\x1f.exam.SetQuestionRubricResponse\x12Q\n" +
+	"\x10ConfigureMarking\x12\x1d.exam.ConfigureMarkingRequest\x1a\x1e.exam.ConfigureMarkingResponse\x12H\n" +
+	"\rAssignMarkers\x12\x1a.exam.AssignMarkersRequest\x1a\x1b.exam.AssignMarkersResponse\x12N\n" +
+	"\x0fGetMarkingTasks\x12\x1c.exam.GetMarkingTasksRequest\x1a\x1d.exam.GetMarkingTasksResponse\x12K\n" +
+	"\x0eGetMarkingTask\x12\x1b.exam.GetMarkingTaskRequest\x1a\x1c.exam.GetMarkingTaskResponse\x12B\n" +
+	"\vSubmitMarks\x12\x18.exam.SubmitMarksRequest\x1a\x19.exam.SubmitMarksResponse\x12W\n" +
+	"\x12GetMarkingOverview\x12\x1f.exam.GetMarkingOverviewRequest\x1a .exam.GetMarkingOverviewResponse\x12T\n" +
+	"\x11SaveAccommodation\x12\x1e.exam.SaveAccommodationRequest\x1a\x1f.exam.SaveAccommodationResponse\x12T\n" +
+	"\x11GetAccommodations\x12\x1e.exam.GetAccommodationsRequest\x1a\x1f.exam.GetAccommodationsResponse\x12Z\n" +
+	"\x13DeleteAccommodation\x12 .exam.DeleteAccommodationRequest\x1a!.exam.DeleteAccommodationResponse\x12`\n" +
+	"\x15SaveExamAccommodation\x12\".exam.SaveExamAccommodationRequest\x1a#.exam.SaveExamAccommodationResponse\x12`\n" +
+	"\x15GetExamAccommodations\x12\".exam.GetExamAccommodationsRequest\x1a#.exam.GetExamAccommodationsResponse\x12f\n" +
+	"\x17DeleteExamAccommodation\x12$.exam.DeleteExamAccommodationRequest\x1a%.exam.DeleteExamAccommodationResponse\x12W\n" +
+	"\x12ControlExamSession\x12\x1f.exam.ControlExamSessionRequest\x1a .exam.ControlExamSessionResponse\x12`\n" +
+	"\x15GetExamSessionActions\x12\".exam.GetExamSessionActionsRequest\x1a#.exam.GetExamSessionActionsResponse\x12Z\n" +
+	"\x13GetExamSessionState\x12 .exam.GetExamSessionStateRequest\x1a!.exam.GetExamSessionStateResponse\x12W\n" +
+	"\x12GetSuspicionConfig\x12\x1f.exam.GetSuspicionConfigRequest\x1a .exam.GetSuspicionConfigResponse\x12`\n" +
+	"\x15UpdateSuspicionConfig\x12\".exam.UpdateSuspicionConfigRequest\x1a#.exam.UpdateSuspicionConfigResponse\x12Q\n" +
+	"\x10AnalyzeCollusion\x12\x1d.exam.AnalyzeCollusionRequest\x1a\x1e.exam.AnalyzeCollusionResponse\x12W\n" +
+	"\x12GetCollusionReport\x12\x1f.exam.GetCollusionReportRequest\x1a .exam.GetCollusionReportResponse\x12`\n" +
+	"\x15SaveSimilaritySources\x12\".exam.SaveSimilaritySourcesRequest\x1a#.exam.SaveSimilaritySourcesResponse\x12]\n" +
+	"\x14GetSimilaritySources\x12!.exam.GetSimilaritySourcesRequest\x1a\".exam.GetSimilaritySourcesResponse\x12]\n" +
+	"\x14CheckEssaySimilarity\x12!.exam.CheckEssaySimilarityRequest\x1a\".exam.CheckEssaySimilarityResponse\x12W\n" +
+	"\x12GetEssaySimilarity\x12\x1f.exam.GetEssaySimilarityRequest\x1a .exam.GetEssaySimilarityResponse\x12T\n" +
+	"\x11GetPracticeReport\x12\x1e.exam.GetPracticeReportRequest\x1a\x1f.exam.GetPracticeReportResponse\x12H\n" +
+	"\rGetDueReviews\x12\x1a.exam.GetDueReviewsRequest\x1a\x1b.exam.GetDueReviewsResponse\x12E\n" +
+	"\fRecordReview\x12\x19.exam.RecordReviewRequest\x1a\x1a.exam.RecordReviewResponse\x12T\n" +
+	"\x11GeneratePaperExam\x12\x1e.exam.GeneratePaperExamRequest\x1a\x1f.exam.GeneratePaperExamResponse\x12]\n" +
+	"\x14GenerateAnswerSheets\x12!.exam.GenerateAnswerSheetsRequest\x1a\".exam.GenerateAnswerSheetsResponse\x12W\n" +
+	"\x12GradeScannedSheets\x12\x1f.exam.GradeScannedSheetsRequest\x1a .exam.GradeScannedSheetsResponse\x12Z\n" +
+	"\x13GetAnswerSheetScans\x12 .exam.GetAnswerSheetScansRequest\x1a!.exam.GetAnswerSheetScansResponse\x12c\n" +
+	"\x16ResolveAnswerSheetScan\x12#.exam.ResolveAnswerSheetScanRequest\x1a$.exam.ResolveAnswerSheetScanResponse\x12<\n" +
+	"\tCloneExam\x12\x16.exam.CloneExamRequest\x1a\x17.exam.CloneExamResponse\x12W\n" +
+	"\x12CreateExamTemplate\x12\x1f.exam.CreateExamTemplateRequest\x1a .exam.CreateExamTemplateResponse\x12Q\n" +
+	"\x10GetExamTemplates\x12\x1d.exam.GetExamTemplatesRequest\x1a\x1e.exam.GetExamTemplatesResponse\x12W\n" +
+	"\x12DeleteExamTemplate\x12\x1f.exam.DeleteExamTemplateRequest\x1a .exam.DeleteExamTemplateResponse\x12f\n" +
+	"\x17InstantiateExamTemplate\x12$.exam.InstantiateExamTemplateRequest\x1a%.exam.InstantiateExamTemplateResponse\x12`\n" +
+	"\x15ValidateExamBlueprint\x12\".exam.ValidateExamBlueprintRequest\x1a#.exam.ValidateExamBlueprintResponseB\x18Z\x16shared/proto/exam;examb\x06proto3"
 
 var (
 	file_exam_proto_rawDescOnce sync.Once
@@ -7655,125 +20308,286 @@ func file_exam_proto_rawDescGZIP() []byte {
 	return file_exam_proto_rawDescData
 }
 
-var file_exam_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
+var file_exam_proto_msgTypes = make([]protoimpl.MessageInfo, 278)
 var file_exam_proto_goTypes = []any{
-	(*Topic)(nil),                        // 0: exam.Topic
-	(*Section)(nil),                      // 1: exam.Section
-	(*CreateTopicRequest)(nil),           // 2: exam.CreateTopicRequest
-	(*CreateTopicResponse)(nil),          // 3: exam.CreateTopicResponse
-	(*GetTopicsRequest)(nil),             // 4: exam.GetTopicsRequest
-	(*GetTopicsResponse)(nil),            // 5: exam.GetTopicsResponse
-	(*CreateSectionRequest)(nil),         // 6: exam.CreateSectionRequest
-	(*CreateSectionResponse)(nil),        // 7: exam.CreateSectionResponse
-	(*GetSectionsRequest)(nil),           // 8: exam.GetSectionsRequest
-	(*GetSectionsResponse)(nil),          // 9: exam.GetSectionsResponse
-	(*ChoiceInput)(nil),                  // 10: exam.ChoiceInput
-	(*CreateQuestionRequest)(nil),        // 11: exam.CreateQuestionRequest
-	(*CreateQuestionResponse)(nil),       // 12: exam.CreateQuestionResponse
-	(*CreateBulkQuestionsRequest)(nil),   // 13: exam.CreateBulkQuestionsRequest
-	(*CreateBulkQuestionsResponse)(nil),  // 14: exam.CreateBulkQuestionsResponse
-	(*GetUploadURLRequest)(nil),          // 15: exam.GetUploadURLRequest
-	(*GetUploadURLResponse)(nil),         // 16: exam.GetUploadURLResponse
-	(*ImportQuestionsRequest)(nil),       // 17: exam.ImportQuestionsRequest
-	(*ImportQuestionsResponse)(nil),      // 18: exam.ImportQuestionsResponse
-	(*ExamSettings)(nil),                 // 19: exam.ExamSettings
-	(*QuestionAssignment)(nil),           // 20: exam.QuestionAssignment
-	(*CreateExamRequest)(nil),            // 21: exam.CreateExamRequest
-	(*SectionConfig)(nil),                // 22: exam.SectionConfig
-	(*GenerateExamRequest)(nil),          // 23: exam.GenerateExamRequest
-	(*CreateExamResponse)(nil),           // 24: exam.CreateExamResponse
-	(*ChoiceDetails)(nil),                // 25: exam.ChoiceDetails
-	(*QuestionDetails)(nil),              // 26: exam.QuestionDetails
-	(*GetExamDetailsRequest)(nil),        // 27: exam.GetExamDetailsRequest
-	(*GetExamDetailsResponse)(nil),       // 28: exam.GetExamDetailsResponse
-	(*RequestExamAccessRequest)(nil),     // 29: exam.RequestExamAccessRequest
-	(*RequestExamAccessResponse)(nil),    // 30: exam.RequestExamAccessResponse
-	(*ApproveExamAccessRequest)(nil),     // 31: exam.ApproveExamAccessRequest
-	(*ApproveExamAccessResponse)(nil),    // 32: exam.ApproveExamAccessResponse
-	(*CheckExamAccessRequest)(nil),       // 33: exam.CheckExamAccessRequest
-	(*CheckExamAccessResponse)(nil),      // 34: exam.CheckExamAccessResponse
-	(*GetQuestionRequest)(nil),           // 35: exam.GetQuestionRequest
-	(*GetQuestionResponse)(nil),          // 36: exam.GetQuestionResponse
-	(*UpdateQuestionRequest)(nil),        // 37: exam.UpdateQuestionRequest
-	(*UpdateQuestionResponse)(nil),       // 38: exam.UpdateQuestionResponse
-	(*DeleteQuestionRequest)(nil),        // 39: exam.DeleteQuestionRequest
-	(*DeleteQuestionResponse)(nil),       // 40: exam.DeleteQuestionResponse
-	(*DeleteBulkQuestionsRequest)(nil),   // 41: exam.DeleteBulkQuestionsRequest
-	(*DeleteBulkQuestionsResponse)(nil),  // 42: exam.DeleteBulkQuestionsResponse
-	(*ExamListItem)(nil),                 // 43: exam.ExamListItem
-	(*GetExamsRequest)(nil),              // 44: exam.GetExamsRequest
-	(*GetExamsResponse)(nil),             // 45: exam.GetExamsResponse
-	(*UpdateExamRequest)(nil),            // 46: exam.UpdateExamRequest
-	(*UpdateExamResponse)(nil),           // 47: exam.UpdateExamResponse
-	(*DeleteExamRequest)(nil),            // 48: exam.DeleteExamRequest
-	(*DeleteExamResponse)(nil),           // 49: exam.DeleteExamResponse
-	(*PublishExamRequest)(nil),           // 50: exam.PublishExamRequest
-	(*PublishExamResponse)(nil),          // 51: exam.PublishExamResponse
-	(*UserAnswer)(nil),                   // 52: exam.UserAnswer
-	(*SubmitExamRequest)(nil),            // 53: exam.SubmitExamRequest
-	(*SubmitExamResponse)(nil),           // 54: exam.SubmitExamResponse
-	(*GetSubmissionRequest)(nil),         // 55: exam.GetSubmissionRequest
-	(*SubmissionDetail)(nil),             // 56: exam.SubmissionDetail
-	(*ChoiceReview)(nil),                 // 57: exam.ChoiceReview
-	(*GetSubmissionResponse)(nil),        // 58: exam.GetSubmissionResponse
-	(*GetUserExamStatsRequest)(nil),      // 59: exam.GetUserExamStatsRequest
-	(*GetUserExamStatsResponse)(nil),     // 60: exam.GetUserExamStatsResponse
-	(*GetExamCountRequest)(nil),          // 61: exam.GetExamCountRequest
-	(*GetExamCountResponse)(nil),         // 62: exam.GetExamCountResponse
-	(*SaveAnswerRequest)(nil),            // 63: exam.SaveAnswerRequest
-	(*SaveAnswerResponse)(nil),           // 64: exam.SaveAnswerResponse
-	(*LogViolationRequest)(nil),          // 65: exam.LogViolationRequest
-	(*LogViolationResponse)(nil),         // 66: exam.LogViolationResponse
-	(*GradeEssayRequest)(nil),            // 67: exam.GradeEssayRequest
-	(*GradeEssayResponse)(nil),           // 68: exam.GradeEssayResponse
-	(*GetExamStatsDetailedRequest)(nil),  // 69: exam.GetExamStatsDetailedRequest
-	(*GetExamStatsDetailedResponse)(nil), // 70: exam.GetExamStatsDetailedResponse
-	(*SubmissionSummary)(nil),            // 71: exam.SubmissionSummary
-	(*GetExamSubmissionsRequest)(nil),    // 72: exam.GetExamSubmissionsRequest
-	(*GetExamSubmissionsResponse)(nil),   // 73: exam.GetExamSubmissionsResponse
-	(*ExportExamResultsRequest)(nil),     // 74: exam.ExportExamResultsRequest
-	(*ExportExamResultsResponse)(nil),    // 75: exam.ExportExamResultsResponse
-	(*GetQuestionsRequest)(nil),          // 76: exam.GetQuestionsRequest
-	(*QuestionListItem)(nil),             // 77: exam.QuestionListItem
-	(*GetQuestionsResponse)(nil),         // 78: exam.GetQuestionsResponse
-	(*ExamViolation)(nil),                // 79: exam.ExamViolation
-	(*GetExamViolationsRequest)(nil),     // 80: exam.GetExamViolationsRequest
-	(*GetExamViolationsResponse)(nil),    // 81: exam.GetExamViolationsResponse
-	(*ExportQuestionsRequest)(nil),       // 82: exam.ExportQuestionsRequest
-	(*ExportQuestionsResponse)(nil),      // 83: exam.ExportQuestionsResponse
-	(*StartExamRequest)(nil),             // 84: exam.StartExamRequest
-	(*AnswerDetail)(nil),                 // 85: exam.AnswerDetail
-	(*StartExamResponse)(nil),            // 86: exam.StartExamResponse
-	(*Int64List)(nil),                    // 87: exam.Int64List
-	(*GetAccessRequestsRequest)(nil),     // 88: exam.GetAccessRequestsRequest
-	(*AccessRequestItem)(nil),            // 89: exam.AccessRequestItem
-	(*GetAccessRequestsResponse)(nil),    // 90: exam.GetAccessRequestsResponse
-	(*UpdateTopicRequest)(nil),           // 91: exam.UpdateTopicRequest
-	(*UpdateTopicResponse)(nil),          // 92: exam.UpdateTopicResponse
-	(*DeleteTopicRequest)(nil),           // 93: exam.DeleteTopicRequest
-	(*DeleteTopicResponse)(nil),          // 94: exam.DeleteTopicResponse
-	(*UpdateSectionRequest)(nil),         // 95: exam.UpdateSectionRequest
-	(*UpdateSectionResponse)(nil),        // 96: exam.UpdateSectionResponse
-	(*DeleteSectionRequest)(nil),         // 97: exam.DeleteSectionRequest
-	(*DeleteSectionResponse)(nil),        // 98: exam.DeleteSectionResponse
-	(*GetExamsByClassRequest)(nil),       // 99: exam.GetExamsByClassRequest
-	(*GetExamsByClassResponse)(nil),      // 100: exam.GetExamsByClassResponse
-	(*AssignExamToClassRequest)(nil),     // 101: exam.AssignExamToClassRequest
-	(*AssignExamToClassResponse)(nil),    // 102: exam.AssignExamToClassResponse
-	(*GetInstructorExamsRequest)(nil),    // 103: exam.GetInstructorExamsRequest
-	(*GetInstructorExamsResponse)(nil),   // 104: exam.GetInstructorExamsResponse
-	(*Exam)(nil),                         // 105: exam.Exam
-	(*GetExamPreviewRequest)(nil),        // 106: exam.GetExamPreviewRequest
-	(*GetRecentSubmissionsRequest)(nil),  // 107: exam.GetRecentSubmissionsRequest
-	(*RecentSubmissionItem)(nil),         // 108: exam.RecentSubmissionItem
-	(*GetRecentSubmissionsResponse)(nil), // 109: exam.GetRecentSubmissionsResponse
-	(*GetMySubmissionsRequest)(nil),      // 110: exam.GetMySubmissionsRequest
-	(*GetMySubmissionsResponse)(nil),     // 111: exam.GetMySubmissionsResponse
-	(*GetClassGradebookRequest)(nil),     // 112: exam.GetClassGradebookRequest
-	(*ExamScore)(nil),                    // 113: exam.ExamScore
-	(*StudentGrade)(nil),                 // 114: exam.StudentGrade
-	(*GetClassGradebookResponse)(nil),    // 115: exam.GetClassGradebookResponse
-	nil,                                  // 116: exam.GetExamStatsDetailedResponse.ScoreDistributionEntry
+	(*Topic)(nil),                           // 0: exam.Topic
+	(*Section)(nil),                         // 1: exam.Section
+	(*CreateTopicRequest)(nil),              // 2: exam.CreateTopicRequest
+	(*CreateTopicResponse)(nil),             // 3: exam.CreateTopicResponse
+	(*GetTopicsRequest)(nil),                // 4: exam.GetTopicsRequest
+	(*GetTopicsResponse)(nil),               // 5: exam.GetTopicsResponse
+	(*CreateSectionRequest)(nil),            // 6: exam.CreateSectionRequest
+	(*CreateSectionResponse)(nil),           // 7: exam.CreateSectionResponse
+	(*GetSectionsRequest)(nil),              // 8: exam.GetSectionsRequest
+	(*GetSectionsResponse)(nil),             // 9: exam.GetSectionsResponse
+	(*ChoiceInput)(nil),                     // 10: exam.ChoiceInput
+	(*CreateQuestionRequest)(nil),           // 11: exam.CreateQuestionRequest
+	(*CreateQuestionResponse)(nil),          // 12: exam.CreateQuestionResponse
+	(*CreateBulkQuestionsRequest)(nil),      // 13: exam.CreateBulkQuestionsRequest
+	(*CreateBulkQuestionsResponse)(nil),     // 14: exam.CreateBulkQuestionsResponse
+	(*GetUploadURLRequest)(nil),             // 15: exam.GetUploadURLRequest
+	(*GetUploadURLResponse)(nil),            // 16: exam.GetUploadURLResponse
+	(*ImportQuestionsRequest)(nil),          // 17: exam.ImportQuestionsRequest
+	(*ImportQuestionsResponse)(nil),         // 18: exam.ImportQuestionsResponse
+	(*ExamSettings)(nil),                    // 19: exam.ExamSettings
+	(*QuestionAssignment)(nil),              // 20: exam.QuestionAssignment
+	(*CreateExamRequest)(nil),               // 21: exam.CreateExamRequest
+	(*SectionConfig)(nil),                   // 22: exam.SectionConfig
+	(*GenerateExamRequest)(nil),             // 23: exam.GenerateExamRequest
+	(*CreateExamResponse)(nil),              // 24: exam.CreateExamResponse
+	(*ChoiceDetails)(nil),                   // 25: exam.ChoiceDetails
+	(*QuestionDetails)(nil),                 // 26: exam.QuestionDetails
+	(*GetExamDetailsRequest)(nil),           // 27: exam.GetExamDetailsRequest
+	(*GetExamDetailsResponse)(nil),          // 28: exam.GetExamDetailsResponse
+	(*RequestExamAccessRequest)(nil),        // 29: exam.RequestExamAccessRequest
+	(*RequestExamAccessResponse)(nil),       // 30: exam.RequestExamAccessResponse
+	(*ApproveExamAccessRequest)(nil),        // 31: exam.ApproveExamAccessRequest
+	(*ApproveExamAccessResponse)(nil),       // 32: exam.ApproveExamAccessResponse
+	(*CheckExamAccessRequest)(nil),          // 33: exam.CheckExamAccessRequest
+	(*CheckExamAccessResponse)(nil),         // 34: exam.CheckExamAccessResponse
+	(*GetQuestionRequest)(nil),              // 35: exam.GetQuestionRequest
+	(*GetQuestionResponse)(nil),             // 36: exam.GetQuestionResponse
+	(*UpdateQuestionRequest)(nil),           // 37: exam.UpdateQuestionRequest
+	(*UpdateQuestionResponse)(nil),          // 38: exam.UpdateQuestionResponse
+	(*DeleteQuestionRequest)(nil),           // 39: exam.DeleteQuestionRequest
+	(*DeleteQuestionResponse)(nil),          // 40: exam.DeleteQuestionResponse
+	(*DeleteBulkQuestionsRequest)(nil),      // 41: exam.DeleteBulkQuestionsRequest
+	(*DeleteBulkQuestionsResponse)(nil),     // 42: exam.DeleteBulkQuestionsResponse
+	(*ExamListItem)(nil),                    // 43: exam.ExamListItem
+	(*GetExamsRequest)(nil),                 // 44: exam.GetExamsRequest
+	(*GetExamsResponse)(nil),                // 45: exam.GetExamsResponse
+	(*UpdateExamRequest)(nil),               // 46: exam.UpdateExamRequest
+	(*UpdateExamResponse)(nil),              // 47: exam.UpdateExamResponse
+	(*DeleteExamRequest)(nil),               // 48: exam.DeleteExamRequest
+	(*DeleteExamResponse)(nil),              // 49: exam.DeleteExamResponse
+	(*PublishExamRequest)(nil),              // 50: exam.PublishExamRequest
+	(*PublishExamResponse)(nil),             // 51: exam.PublishExamResponse
+	(*UserAnswer)(nil),                      // 52: exam.UserAnswer
+	(*SubmitExamRequest)(nil),               // 53: exam.SubmitExamRequest
+	(*SubmitExamResponse)(nil),              // 54: exam.SubmitExamResponse
+	(*GetSubmissionRequest)(nil),            // 55: exam.GetSubmissionRequest
+	(*SubmissionDetail)(nil),                // 56: exam.SubmissionDetail
+	(*ChoiceReview)(nil),                    // 57: exam.ChoiceReview
+	(*GetSubmissionResponse)(nil),           // 58: exam.GetSubmissionResponse
+	(*GetUserExamStatsRequest)(nil),         // 59: exam.GetUserExamStatsRequest
+	(*GetUserExamStatsResponse)(nil),        // 60: exam.GetUserExamStatsResponse
+	(*GetExamCountRequest)(nil),             // 61: exam.GetExamCountRequest
+	(*GetExamCountResponse)(nil),            // 62: exam.GetExamCountResponse
+	(*SaveAnswerRequest)(nil),               // 63: exam.SaveAnswerRequest
+	(*SaveAnswerResponse)(nil),              // 64: exam.SaveAnswerResponse
+	(*LogViolationRequest)(nil),             // 65: exam.LogViolationRequest
+	(*LogViolationResponse)(nil),            // 66: exam.LogViolationResponse
+	(*GradeEssayRequest)(nil),               // 67: exam.GradeEssayRequest
+	(*GradeEssayResponse)(nil),              // 68: exam.GradeEssayResponse
+	(*GetExamStatsDetailedRequest)(nil),     // 69: exam.GetExamStatsDetailedRequest
+	(*GetExamStatsDetailedResponse)(nil),    // 70: exam.GetExamStatsDetailedResponse
+	(*SubmissionSummary)(nil),               // 71: exam.SubmissionSummary
+	(*GetExamSubmissionsRequest)(nil),       // 72: exam.GetExamSubmissionsRequest
+	(*GetExamSubmissionsResponse)(nil),      // 73: exam.GetExamSubmissionsResponse
+	(*ExportExamResultsRequest)(nil),        // 74: exam.ExportExamResultsRequest
+	(*ExportExamResultsResponse)(nil),       // 75: exam.ExportExamResultsResponse
+	(*GetQuestionsRequest)(nil),             // 76: exam.GetQuestionsRequest
+	(*QuestionListItem)(nil),                // 77: exam.QuestionListItem
+	(*GetQuestionsResponse)(nil),            // 78: exam.GetQuestionsResponse
+	(*ExamViolation)(nil),                   // 79: exam.ExamViolation
+	(*GetExamViolationsRequest)(nil),        // 80: exam.GetExamViolationsRequest
+	(*GetExamViolationsResponse)(nil),       // 81: exam.GetExamViolationsResponse
+	(*ExportQuestionsRequest)(nil),          // 82: exam.ExportQuestionsRequest
+	(*ExportQuestionsResponse)(nil),         // 83: exam.ExportQuestionsResponse
+	(*StartExamRequest)(nil),                // 84: exam.StartExamRequest
+	(*AnswerDetail)(nil),                    // 85: exam.AnswerDetail
+	(*StartExamResponse)(nil),               // 86: exam.StartExamResponse
+	(*Int64List)(nil),                       // 87: exam.Int64List
+	(*GetAccessRequestsRequest)(nil),        // 88: exam.GetAccessRequestsRequest
+	(*AccessRequestItem)(nil),               // 89: exam.AccessRequestItem
+	(*GetAccessRequestsResponse)(nil),       // 90: exam.GetAccessRequestsResponse
+	(*UpdateTopicRequest)(nil),              // 91: exam.UpdateTopicRequest
+	(*UpdateTopicResponse)(nil),             // 92: exam.UpdateTopicResponse
+	(*DeleteTopicRequest)(nil),              // 93: exam.DeleteTopicRequest
+	(*DeleteTopicResponse)(nil),             // 94: exam.DeleteTopicResponse
+	(*UpdateSectionRequest)(nil),            // 95: exam.UpdateSectionRequest
+	(*UpdateSectionResponse)(nil),           // 96: exam.UpdateSectionResponse
+	(*DeleteSectionRequest)(nil),            // 97: exam.DeleteSectionRequest
+	(*DeleteSectionResponse)(nil),           // 98: exam.DeleteSectionResponse
+	(*GetExamsByClassRequest)(nil),          // 99: exam.GetExamsByClassRequest
+	(*GetExamsByClassResponse)(nil),         // 100: exam.GetExamsByClassResponse
+	(*AssignExamToClassRequest)(nil),        // 101: exam.AssignExamToClassRequest
+	(*AssignExamToClassResponse)(nil),       // 102: exam.AssignExamToClassResponse
+	(*GetInstructorExamsRequest)(nil),       // 103: exam.GetInstructorExamsRequest
+	(*GetInstructorExamsResponse)(nil),      // 104: exam.GetInstructorExamsResponse
+	(*Exam)(nil),                            // 105: exam.Exam
+	(*GetExamPreviewRequest)(nil),           // 106: exam.GetExamPreviewRequest
+	(*GetRecentSubmissionsRequest)(nil),     // 107: exam.GetRecentSubmissionsRequest
+	(*RecentSubmissionItem)(nil),            // 108: exam.RecentSubmissionItem
+	(*GetRecentSubmissionsResponse)(nil),    // 109: exam.GetRecentSubmissionsResponse
+	(*GetMySubmissionsRequest)(nil),         // 110: exam.GetMySubmissionsRequest
+	(*GetMySubmissionsResponse)(nil),        // 111: exam.GetMySubmissionsResponse
+	(*GetClassGradebookRequest)(nil),        // 112: exam.GetClassGradebookRequest
+	(*ExamScore)(nil),                       // 113: exam.ExamScore
+	(*StudentGrade)(nil),                    // 114: exam.StudentGrade
+	(*GetClassGradebookResponse)(nil),       // 115: exam.GetClassGradebookResponse
+	(*GetItemAnalysisRequest)(nil),          // 116: exam.GetItemAnalysisRequest
+	(*DistractorStat)(nil),                  // 117: exam.DistractorStat
+	(*ItemStat)(nil),                        // 118: exam.ItemStat
+	(*GetItemAnalysisResponse)(nil),         // 119: exam.GetItemAnalysisResponse
+	(*GetNextAdaptiveQuestionRequest)(nil),  // 120: exam.GetNextAdaptiveQuestionRequest
+	(*GetNextAdaptiveQuestionResponse)(nil), // 121: exam.GetNextAdaptiveQuestionResponse
+	(*NumericAnswerConfig)(nil),             // 122: exam.NumericAnswerConfig
+	(*ClozeBlank)(nil),                      // 123: exam.ClozeBlank
+	(*MatchAnswer)(nil),                     // 124: exam.MatchAnswer
+	(*QuestionBankError)(nil),               // 125: exam.QuestionBankError
+	(*ImportQTIPackageRequest)(nil),         // 126: exam.ImportQTIPackageRequest
+	(*ImportQTIPackageResponse)(nil),        // 127: exam.ImportQTIPackageResponse
+	(*ExportQTIPackageRequest)(nil),         // 128: exam.ExportQTIPackageRequest
+	(*ExportQTIPackageResponse)(nil),        // 129: exam.ExportQTIPackageResponse
+	(*QuestionVersionSummary)(nil),          // 130: exam.QuestionVersionSummary
+	(*GetQuestionHistoryRequest)(nil),       // 131: exam.GetQuestionHistoryRequest
+	(*GetQuestionHistoryResponse)(nil),      // 132: exam.GetQuestionHistoryResponse
+	(*GetQuestionVersionRequest)(nil),       // 133: exam.GetQuestionVersionRequest
+	(*GetQuestionVersionResponse)(nil),      // 134: exam.GetQuestionVersionResponse
+	(*QuestionFieldChange)(nil),             // 135: exam.QuestionFieldChange
+	(*ChoiceChange)(nil),                    // 136: exam.ChoiceChange
+	(*DiffQuestionVersionsRequest)(nil),     // 137: exam.DiffQuestionVersionsRequest
+	(*DiffQuestionVersionsResponse)(nil),    // 138: exam.DiffQuestionVersionsResponse
+	(*RegradeExamRequest)(nil),              // 139: exam.RegradeExamRequest
+	(*RegradeScoreChange)(nil),              // 140: exam.RegradeScoreChange
+	(*RegradeExamResponse)(nil),             // 141: exam.RegradeExamResponse
+	(*RegradeRecord)(nil),                   // 142: exam.RegradeRecord
+	(*GetRegradeHistoryRequest)(nil),        // 143: exam.GetRegradeHistoryRequest
+	(*GetRegradeHistoryResponse)(nil),       // 144: exam.GetRegradeHistoryResponse
+	(*ScoreAppeal)(nil),                     // 145: exam.ScoreAppeal
+	(*CreateAppealRequest)(nil),             // 146: exam.CreateAppealRequest
+	(*CreateAppealResponse)(nil),            // 147: exam.CreateAppealResponse
+	(*GetAppealQueueRequest)(nil),           // 148: exam.GetAppealQueueRequest
+	(*GetAppealQueueResponse)(nil),          // 149: exam.GetAppealQueueResponse
+	(*GetMyAppealsRequest)(nil),             // 150: exam.GetMyAppealsRequest
+	(*GetMyAppealsResponse)(nil),            // 151: exam.GetMyAppealsResponse
+	(*ResolveAppealRequest)(nil),            // 152: exam.ResolveAppealRequest
+	(*ResolveAppealResponse)(nil),           // 153: exam.ResolveAppealResponse
+	(*RubricLevel)(nil),                     // 154: exam.RubricLevel
+	(*RubricCriterion)(nil),                 // 155: exam.RubricCriterion
+	(*Rubric)(nil),                          // 156: exam.Rubric
+	(*CreateRubricRequest)(nil),             // 157: exam.CreateRubricRequest
+	(*CreateRubricResponse)(nil),            // 158: exam.CreateRubricResponse
+	(*UpdateRubricRequest)(nil),             // 159: exam.UpdateRubricRequest
+	(*UpdateRubricResponse)(nil),            // 160: exam.UpdateRubricResponse
+	(*GetRubricRequest)(nil),                // 161: exam.GetRubricRequest
+	(*GetRubricResponse)(nil),               // 162: exam.GetRubricResponse
+	(*GetRubricsRequest)(nil),               // 163: exam.GetRubricsRequest
+	(*GetRubricsResponse)(nil),              // 164: exam.GetRubricsResponse
+	(*DeleteRubricRequest)(nil),             // 165: exam.DeleteRubricRequest
+	(*DeleteRubricResponse)(nil),            // 166: exam.DeleteRubricResponse
+	(*SetQuestionRubricRequest)(nil),        // 167: exam.SetQuestionRubricRequest
+	(*SetQuestionRubricResponse)(nil),       // 168: exam.SetQuestionRubricResponse
+	(*RubricSelection)(nil),                 // 169: exam.RubricSelection
+	(*RubricCriterionScore)(nil),            // 170: exam.RubricCriterionScore
+	(*RubricResult)(nil),                    // 171: exam.RubricResult
+	(*MarkingConfig)(nil),                   // 172: exam.MarkingConfig
+	(*ConfigureMarkingRequest)(nil),         // 173: exam.ConfigureMarkingRequest
+	(*ConfigureMarkingResponse)(nil),        // 174: exam.ConfigureMarkingResponse
+	(*AssignMarkersRequest)(nil),            // 175: exam.AssignMarkersRequest
+	(*AssignMarkersResponse)(nil),           // 176: exam.AssignMarkersResponse
+	(*MarkingTask)(nil),                     // 177: exam.MarkingTask
+	(*GetMarkingTasksRequest)(nil),          // 178: exam.GetMarkingTasksRequest
+	(*GetMarkingTasksResponse)(nil),         // 179: exam.GetMarkingTasksResponse
+	(*MarkingEssay)(nil),                    // 180: exam.MarkingEssay
+	(*GetMarkingTaskRequest)(nil),           // 181: exam.GetMarkingTaskRequest
+	(*GetMarkingTaskResponse)(nil),          // 182: exam.GetMarkingTaskResponse
+	(*EssayMarkInput)(nil),                  // 183: exam.EssayMarkInput
+	(*SubmitMarksRequest)(nil),              // 184: exam.SubmitMarksRequest
+	(*SubmitMarksResponse)(nil),             // 185: exam.SubmitMarksResponse
+	(*MarkerAssignmentSummary)(nil),         // 186: exam.MarkerAssignmentSummary
+	(*SubmissionMarking)(nil),               // 187: exam.SubmissionMarking
+	(*GetMarkingOverviewRequest)(nil),       // 188: exam.GetMarkingOverviewRequest
+	(*GetMarkingOverviewResponse)(nil),      // 189: exam.GetMarkingOverviewResponse
+	(*AccommodationTerms)(nil),              // 190: exam.AccommodationTerms
+	(*Accommodation)(nil),                   // 191: exam.Accommodation
+	(*SaveAccommodationRequest)(nil),        // 192: exam.SaveAccommodationRequest
+	(*SaveAccommodationResponse)(nil),       // 193: exam.SaveAccommodationResponse
+	(*GetAccommodationsRequest)(nil),        // 194: exam.GetAccommodationsRequest
+	(*GetAccommodationsResponse)(nil),       // 195: exam.GetAccommodationsResponse
+	(*DeleteAccommodationRequest)(nil),      // 196: exam.DeleteAccommodationRequest
+	(*DeleteAccommodationResponse)(nil),     // 197: exam.DeleteAccommodationResponse
+	(*SaveExamAccommodationRequest)(nil),    // 198: exam.SaveExamAccommodationRequest
+	(*SaveExamAccommodationResponse)(nil),   // 199: exam.SaveExamAccommodationResponse
+	(*GetExamAccommodationsRequest)(nil),    // 200: exam.GetExamAccommodationsRequest
+	(*GetExamAccommodationsResponse)(nil),   // 201: exam.GetExamAccommodationsResponse
+	(*DeleteExamAccommodationRequest)(nil),  // 202: exam.DeleteExamAccommodationRequest
+	(*DeleteExamAccommodationResponse)(nil), // 203: exam.DeleteExamAccommodationResponse
+	(*ExamSessionAction)(nil),               // 204: exam.ExamSessionAction
+	(*ControlExamSessionRequest)(nil),       // 205: exam.ControlExamSessionRequest
+	(*ControlExamSessionResponse)(nil),      // 206: exam.ControlExamSessionResponse
+	(*GetExamSessionActionsRequest)(nil),    // 207: exam.GetExamSessionActionsRequest
+	(*GetExamSessionActionsResponse)(nil),   // 208: exam.GetExamSessionActionsResponse
+	(*GetExamSessionStateRequest)(nil),      // 209: exam.GetExamSessionStateRequest
+	(*GetExamSessionStateResponse)(nil),     // 210: exam.GetExamSessionStateResponse
+	(*SuspicionConfig)(nil),                 // 211: exam.SuspicionConfig
+	(*GetSuspicionConfigRequest)(nil),       // 212: exam.GetSuspicionConfigRequest
+	(*GetSuspicionConfigResponse)(nil),      // 213: exam.GetSuspicionConfigResponse
+	(*UpdateSuspicionConfigRequest)(nil),    // 214: exam.UpdateSuspicionConfigRequest
+	(*UpdateSuspicionConfigResponse)(nil),   // 215: exam.UpdateSuspicionConfigResponse
+	(*CollusionEvidence)(nil),               // 216: exam.CollusionEvidence
+	(*CollusionPair)(nil),                   // 217: exam.CollusionPair
+	(*CollusionReport)(nil),                 // 218: exam.CollusionReport
+	(*AnalyzeCollusionRequest)(nil),         // 219: exam.AnalyzeCollusionRequest
+	(*AnalyzeCollusionResponse)(nil),        // 220: exam.AnalyzeCollusionResponse
+	(*GetCollusionReportRequest)(nil),       // 221: exam.GetCollusionReportRequest
+	(*GetCollusionReportResponse)(nil),      // 222: exam.GetCollusionReportResponse
+	(*SimilaritySource)(nil),                // 223: exam.SimilaritySource
+	(*SimilaritySpan)(nil),                  // 224: exam.SimilaritySpan
+	(*SimilarityMatch)(nil),                 // 225: exam.SimilarityMatch
+	(*SaveSimilaritySourcesRequest)(nil),    // 226: exam.SaveSimilaritySourcesRequest
+	(*SaveSimilaritySourcesResponse)(nil),   // 227: exam.SaveSimilaritySourcesResponse
+	(*GetSimilaritySourcesRequest)(nil),     // 228: exam.GetSimilaritySourcesRequest
+	(*GetSimilaritySourcesResponse)(nil),    // 229: exam.GetSimilaritySourcesResponse
+	(*CheckEssaySimilarityRequest)(nil),     // 230: exam.CheckEssaySimilarityRequest
+	(*CheckEssaySimilarityResponse)(nil),    // 231: exam.CheckEssaySimilarityResponse
+	(*GetEssaySimilarityRequest)(nil),       // 232: exam.GetEssaySimilarityRequest
+	(*GetEssaySimilarityResponse)(nil),      // 233: exam.GetEssaySimilarityResponse
+	(*PracticeFeedback)(nil),                // 234: exam.PracticeFeedback
+	(*PracticeArea)(nil),                    // 235: exam.PracticeArea
+	(*GetPracticeReportRequest)(nil),        // 236: exam.GetPracticeReportRequest
+	(*GetPracticeReportResponse)(nil),       // 237: exam.GetPracticeReportResponse
+	(*ReviewAnswer)(nil),                    // 238: exam.ReviewAnswer
+	(*ReviewCard)(nil),                      // 239: exam.ReviewCard
+	(*GetDueReviewsRequest)(nil),            // 240: exam.GetDueReviewsRequest
+	(*GetDueReviewsResponse)(nil),           // 241: exam.GetDueReviewsResponse
+	(*RecordReviewRequest)(nil),             // 242: exam.RecordReviewRequest
+	(*RecordReviewResponse)(nil),            // 243: exam.RecordReviewResponse
+	(*GeneratePaperExamRequest)(nil),        // 244: exam.GeneratePaperExamRequest
+	(*PaperExamVersion)(nil),                // 245: exam.PaperExamVersion
+	(*GeneratePaperExamResponse)(nil),       // 246: exam.GeneratePaperExamResponse
+	(*GenerateAnswerSheetsRequest)(nil),     // 247: exam.GenerateAnswerSheetsRequest
+	(*AnswerSheetInfo)(nil),                 // 248: exam.AnswerSheetInfo
+	(*GenerateAnswerSheetsResponse)(nil),    // 249: exam.GenerateAnswerSheetsResponse
+	(*ScannedFile)(nil),                     // 250: exam.ScannedFile
+	(*ScanMark)(nil),                        // 251: exam.ScanMark
+	(*AnswerSheetScan)(nil),                 // 252: exam.AnswerSheetScan
+	(*GradeScannedSheetsRequest)(nil),       // 253: exam.GradeScannedSheetsRequest
+	(*GradeScannedSheetsResponse)(nil),      // 254: exam.GradeScannedSheetsResponse
+	(*GetAnswerSheetScansRequest)(nil),      // 255: exam.GetAnswerSheetScansRequest
+	(*GetAnswerSheetScansResponse)(nil),     // 256: exam.GetAnswerSheetScansResponse
+	(*ScanCorrection)(nil),                  // 257: exam.ScanCorrection
+	(*ResolveAnswerSheetScanRequest)(nil),   // 258: exam.ResolveAnswerSheetScanRequest
+	(*ResolveAnswerSheetScanResponse)(nil),  // 259: exam.ResolveAnswerSheetScanResponse
+	(*CloneExamRequest)(nil),                // 260: exam.CloneExamRequest
+	(*CloneExamResponse)(nil),               // 261: exam.CloneExamResponse
+	(*ExamTemplate)(nil),                    // 262: exam.ExamTemplate
+	(*CreateExamTemplateRequest)(nil),       // 263: exam.CreateExamTemplateRequest
+	(*CreateExamTemplateResponse)(nil),      // 264: exam.CreateExamTemplateResponse
+	(*GetExamTemplatesRequest)(nil),         // 265: exam.GetExamTemplatesRequest
+	(*GetExamTemplatesResponse)(nil),        // 266: exam.GetExamTemplatesResponse
+	(*DeleteExamTemplateRequest)(nil),       // 267: exam.DeleteExamTemplateRequest
+	(*DeleteExamTemplateResponse)(nil),      // 268: exam.DeleteExamTemplateResponse
+	(*InstantiateExamTemplateRequest)(nil),  // 269: exam.InstantiateExamTemplateRequest
+	(*InstantiateExamTemplateResponse)(nil), // 270: exam.InstantiateExamTemplateResponse
+	(*BlueprintRuleReport)(nil),             // 271: exam.BlueprintRuleReport
+	(*BlueprintOverlap)(nil),                // 272: exam.BlueprintOverlap
+	(*BlueprintIssue)(nil),                  // 273: exam.BlueprintIssue
+	(*ValidateExamBlueprintRequest)(nil),    // 274: exam.ValidateExamBlueprintRequest
+	(*ValidateExamBlueprintResponse)(nil),   // 275: exam.ValidateExamBlueprintResponse
+	nil,                                     // 276: exam.GetExamStatsDetailedResponse.ScoreDistributionEntry
+	nil,                                     // 277: exam.SuspicionConfig.WeightsEntry
 }
 var file_exam_proto_depIdxs = []int32{
 	0,   // 0: exam.CreateTopicResponse.topic:type_name -> exam.Topic
@@ -7781,140 +20595,360 @@ var file_exam_proto_depIdxs = []int32{
 	1,   // 2: exam.CreateSectionResponse.section:type_name -> exam.Section
 	1,   // 3: exam.GetSectionsResponse.sections:type_name -> exam.Section
 	10,  // 4: exam.CreateQuestionRequest.choices:type_name -> exam.ChoiceInput
-	11,  // 5: exam.CreateBulkQuestionsRequest.questions:type_name -> exam.CreateQuestionRequest
-	20,  // 6: exam.CreateExamRequest.questions:type_name -> exam.QuestionAssignment
-	19,  // 7: exam.CreateExamRequest.settings:type_name -> exam.ExamSettings
-	19,  // 8: exam.GenerateExamRequest.settings:type_name -> exam.ExamSettings
-	22,  // 9: exam.GenerateExamRequest.section_configs:type_name -> exam.SectionConfig
-	20,  // 10: exam.GenerateExamRequest.fixed_questions:type_name -> exam.QuestionAssignment
-	25,  // 11: exam.QuestionDetails.choices:type_name -> exam.ChoiceDetails
-	19,  // 12: exam.GetExamDetailsResponse.settings:type_name -> exam.ExamSettings
-	26,  // 13: exam.GetExamDetailsResponse.questions:type_name -> exam.QuestionDetails
-	26,  // 14: exam.GetQuestionResponse.question:type_name -> exam.QuestionDetails
-	10,  // 15: exam.UpdateQuestionRequest.choices:type_name -> exam.ChoiceInput
-	43,  // 16: exam.GetExamsResponse.exams:type_name -> exam.ExamListItem
-	19,  // 17: exam.UpdateExamRequest.settings:type_name -> exam.ExamSettings
-	20,  // 18: exam.UpdateExamRequest.questions:type_name -> exam.QuestionAssignment
-	52,  // 19: exam.SubmitExamRequest.answers:type_name -> exam.UserAnswer
-	57,  // 20: exam.SubmissionDetail.choices:type_name -> exam.ChoiceReview
-	56,  // 21: exam.GetSubmissionResponse.details:type_name -> exam.SubmissionDetail
-	116, // 22: exam.GetExamStatsDetailedResponse.score_distribution:type_name -> exam.GetExamStatsDetailedResponse.ScoreDistributionEntry
-	71,  // 23: exam.GetExamSubmissionsResponse.submissions:type_name -> exam.SubmissionSummary
-	77,  // 24: exam.GetQuestionsResponse.questions:type_name -> exam.QuestionListItem
-	79,  // 25: exam.GetExamViolationsResponse.violations:type_name -> exam.ExamViolation
-	26,  // 26: exam.StartExamResponse.questions:type_name -> exam.QuestionDetails
-	85,  // 27: exam.StartExamResponse.current_answers:type_name -> exam.AnswerDetail
-	89,  // 28: exam.GetAccessRequestsResponse.requests:type_name -> exam.AccessRequestItem
-	105, // 29: exam.GetExamsByClassResponse.exams:type_name -> exam.Exam
-	105, // 30: exam.GetInstructorExamsResponse.exams:type_name -> exam.Exam
-	108, // 31: exam.GetRecentSubmissionsResponse.submissions:type_name -> exam.RecentSubmissionItem
-	71,  // 32: exam.GetMySubmissionsResponse.submissions:type_name -> exam.SubmissionSummary
-	113, // 33: exam.StudentGrade.scores:type_name -> exam.ExamScore
-	105, // 34: exam.GetClassGradebookResponse.exams:type_name -> exam.Exam
-	114, // 35: exam.GetClassGradebookResponse.grades:type_name -> exam.StudentGrade
-	2,   // 36: exam.ExamService.CreateTopic:input_type -> exam.CreateTopicRequest
-	4,   // 37: exam.ExamService.GetTopics:input_type -> exam.GetTopicsRequest
-	6,   // 38: exam.ExamService.CreateSection:input_type -> exam.CreateSectionRequest
-	8,   // 39: exam.ExamService.GetSections:input_type -> exam.GetSectionsRequest
-	91,  // 40: exam.ExamService.UpdateTopic:input_type -> exam.UpdateTopicRequest
-	93,  // 41: exam.ExamService.DeleteTopic:input_type -> exam.DeleteTopicRequest
-	95,  // 42: exam.ExamService.UpdateSection:input_type -> exam.UpdateSectionRequest
-	97,  // 43: exam.ExamService.DeleteSection:input_type -> exam.DeleteSectionRequest
-	76,  // 44: exam.ExamService.GetQuestions:input_type -> exam.GetQuestionsRequest
-	11,  // 45: exam.ExamService.CreateQuestion:input_type -> exam.CreateQuestionRequest
-	13,  // 46: exam.ExamService.CreateBulkQuestions:input_type -> exam.CreateBulkQuestionsRequest
-	35,  // 47: exam.ExamService.GetQuestion:input_type -> exam.GetQuestionRequest
-	17,  // 48: exam.ExamService.ImportQuestions:input_type -> exam.ImportQuestionsRequest
-	37,  // 49: exam.ExamService.UpdateQuestion:input_type -> exam.UpdateQuestionRequest
-	39,  // 50: exam.ExamService.DeleteQuestion:input_type -> exam.DeleteQuestionRequest
-	41,  // 51: exam.ExamService.DeleteBulkQuestions:input_type -> exam.DeleteBulkQuestionsRequest
-	15,  // 52: exam.ExamService.GetUploadURL:input_type -> exam.GetUploadURLRequest
-	21,  // 53: exam.ExamService.CreateExam:input_type -> exam.CreateExamRequest
-	23,  // 54: exam.ExamService.GenerateExam:input_type -> exam.GenerateExamRequest
-	27,  // 55: exam.ExamService.GetExamDetails:input_type -> exam.GetExamDetailsRequest
-	44,  // 56: exam.ExamService.GetExams:input_type -> exam.GetExamsRequest
-	46,  // 57: exam.ExamService.UpdateExam:input_type -> exam.UpdateExamRequest
-	48,  // 58: exam.ExamService.DeleteExam:input_type -> exam.DeleteExamRequest
-	50,  // 59: exam.ExamService.PublishExam:input_type -> exam.PublishExamRequest
-	29,  // 60: exam.ExamService.RequestExamAccess:input_type -> exam.RequestExamAccessRequest
-	31,  // 61: exam.ExamService.ApproveExamAccess:input_type -> exam.ApproveExamAccessRequest
-	33,  // 62: exam.ExamService.CheckExamAccess:input_type -> exam.CheckExamAccessRequest
-	88,  // 63: exam.ExamService.GetAccessRequests:input_type -> exam.GetAccessRequestsRequest
-	53,  // 64: exam.ExamService.SubmitExam:input_type -> exam.SubmitExamRequest
-	55,  // 65: exam.ExamService.GetSubmission:input_type -> exam.GetSubmissionRequest
-	59,  // 66: exam.ExamService.GetUserExamStats:input_type -> exam.GetUserExamStatsRequest
-	61,  // 67: exam.ExamService.GetExamCount:input_type -> exam.GetExamCountRequest
-	63,  // 68: exam.ExamService.SaveAnswer:input_type -> exam.SaveAnswerRequest
-	65,  // 69: exam.ExamService.LogViolation:input_type -> exam.LogViolationRequest
-	69,  // 70: exam.ExamService.GetExamStatsDetailed:input_type -> exam.GetExamStatsDetailedRequest
-	72,  // 71: exam.ExamService.GetExamSubmissions:input_type -> exam.GetExamSubmissionsRequest
-	74,  // 72: exam.ExamService.ExportExamResults:input_type -> exam.ExportExamResultsRequest
-	80,  // 73: exam.ExamService.GetExamViolations:input_type -> exam.GetExamViolationsRequest
-	82,  // 74: exam.ExamService.ExportQuestions:input_type -> exam.ExportQuestionsRequest
-	84,  // 75: exam.ExamService.StartExam:input_type -> exam.StartExamRequest
-	99,  // 76: exam.ExamService.GetExamsByClass:input_type -> exam.GetExamsByClassRequest
-	101, // 77: exam.ExamService.AssignExamToClass:input_type -> exam.AssignExamToClassRequest
-	101, // 78: exam.ExamService.UnassignExamFromClass:input_type -> exam.AssignExamToClassRequest
-	103, // 79: exam.ExamService.GetInstructorExams:input_type -> exam.GetInstructorExamsRequest
-	106, // 80: exam.ExamService.GetExamPreview:input_type -> exam.GetExamPreviewRequest
-	107, // 81: exam.ExamService.GetRecentSubmissions:input_type -> exam.GetRecentSubmissionsRequest
-	110, // 82: exam.ExamService.GetMySubmissions:input_type -> exam.GetMySubmissionsRequest
-	67,  // 83: exam.ExamService.GradeEssay:input_type -> exam.GradeEssayRequest
-	112, // 84: exam.ExamService.GetClassGradebook:input_type -> exam.GetClassGradebookRequest
-	3,   // 85: exam.ExamService.CreateTopic:output_type -> exam.CreateTopicResponse
-	5,   // 86: exam.ExamService.GetTopics:output_type -> exam.GetTopicsResponse
-	7,   // 87: exam.ExamService.CreateSection:output_type -> exam.CreateSectionResponse
-	9,   // 88: exam.ExamService.GetSections:output_type -> exam.GetSectionsResponse
-	92,  // 89: exam.ExamService.UpdateTopic:output_type -> exam.UpdateTopicResponse
-	94,  // 90: exam.ExamService.DeleteTopic:output_type -> exam.DeleteTopicResponse
-	96,  // 91: exam.ExamService.UpdateSection:output_type -> exam.UpdateSectionResponse
-	98,  // 92: exam.ExamService.DeleteSection:output_type -> exam.DeleteSectionResponse
-	78,  // 93: exam.ExamService.GetQuestions:output_type -> exam.GetQuestionsResponse
-	12,  // 94: exam.ExamService.CreateQuestion:output_type -> exam.CreateQuestionResponse
-	14,  // 95: exam.ExamService.CreateBulkQuestions:output_type -> exam.CreateBulkQuestionsResponse
-	36,  // 96: exam.ExamService.GetQuestion:output_type -> exam.GetQuestionResponse
-	18,  // 97: exam.ExamService.ImportQuestions:output_type -> exam.ImportQuestionsResponse
-	38,  // 98: exam.ExamService.UpdateQuestion:output_type -> exam.UpdateQuestionResponse
-	40,  // 99: exam.ExamService.DeleteQuestion:output_type -> exam.DeleteQuestionResponse
-	42,  // 100: exam.ExamService.DeleteBulkQuestions:output_type -> exam.DeleteBulkQuestionsResponse
-	16,  // 101: exam.ExamService.GetUploadURL:output_type -> exam.GetUploadURLResponse
-	24,  // 102: exam.ExamService.CreateExam:output_type -> exam.CreateExamResponse
-	24,  // 103: exam.ExamService.GenerateExam:output_type -> exam.CreateExamResponse
-	28,  // 104: exam.ExamService.GetExamDetails:output_type -> exam.GetExamDetailsResponse
-	45,  // 105: exam.ExamService.GetExams:output_type -> exam.GetExamsResponse
-	47,  // 106: exam.ExamService.UpdateExam:output_type -> exam.UpdateExamResponse
-	49,  // 107: exam.ExamService.DeleteExam:output_type -> exam.DeleteExamResponse
-	51,  // 108: exam.ExamService.PublishExam:output_type -> exam.PublishExamResponse
-	30,  // 109: exam.ExamService.RequestExamAccess:output_type -> exam.RequestExamAccessResponse
-	32,  // 110: exam.ExamService.ApproveExamAccess:output_type -> exam.ApproveExamAccessResponse
-	34,  // 111: exam.ExamService.CheckExamAccess:output_type -> exam.CheckExamAccessResponse
-	90,  // 112: exam.ExamService.GetAccessRequests:output_type -> exam.GetAccessRequestsResponse
-	54,  // 113: exam.ExamService.SubmitExam:output_type -> exam.SubmitExamResponse
-	58,  // 114: exam.ExamService.GetSubmission:output_type -> exam.GetSubmissionResponse
-	60,  // 115: exam.ExamService.GetUserExamStats:output_type -> exam.GetUserExamStatsResponse
-	62,  // 116: exam.ExamService.GetExamCount:output_type -> exam.GetExamCountResponse
-	64,  // 117: exam.ExamService.SaveAnswer:output_type -> exam.SaveAnswerResponse
-	66,  // 118: exam.ExamService.LogViolation:output_type -> exam.LogViolationResponse
-	70,  // 119: exam.ExamService.GetExamStatsDetailed:output_type -> exam.GetExamStatsDetailedResponse
-	73,  // 120: exam.ExamService.GetExamSubmissions:output_type -> exam.GetExamSubmissionsResponse
-	75,  // 121: exam.ExamService.ExportExamResults:output_type -> exam.ExportExamResultsResponse
-	81,  // 122: exam.ExamService.GetExamViolations:output_type -> exam.GetExamViolationsResponse
-	83,  // 123: exam.ExamService.ExportQuestions:output_type -> exam.ExportQuestionsResponse
-	86,  // 124: exam.ExamService.StartExam:output_type -> exam.StartExamResponse
-	100, // 125: exam.ExamService.GetExamsByClass:output_type -> exam.GetExamsByClassResponse
-	102, // 126: exam.ExamService.AssignExamToClass:output_type -> exam.AssignExamToClassResponse
-	102, // 127: exam.ExamService.UnassignExamFromClass:output_type -> exam.AssignExamToClassResponse
-	104, // 128: exam.ExamService.GetInstructorExams:output_type -> exam.GetInstructorExamsResponse
-	28,  // 129: exam.ExamService.GetExamPreview:output_type -> exam.GetExamDetailsResponse
-	109, // 130: exam.ExamService.GetRecentSubmissions:output_type -> exam.GetRecentSubmissionsResponse
-	111, // 131: exam.ExamService.GetMySubmissions:output_type -> exam.GetMySubmissionsResponse
-	68,  // 132: exam.ExamService.GradeEssay:output_type -> exam.GradeEssayResponse
-	115, // 133: exam.ExamService.GetClassGradebook:output_type -> exam.GetClassGradebookResponse
-	85,  // [85:134] is the sub-list for method output_type
-	36,  // [36:85] is the sub-list for method input_type
-	36,  // [36:36] is the sub-list for extension type_name
-	36,  // [36:36] is the sub-list for extension extendee
-	0,   // [0:36] is the sub-list for field type_name
+	122, // 5: exam.CreateQuestionRequest.numeric:type_name -> exam.NumericAnswerConfig
+	123, // 6: exam.CreateQuestionRequest.blanks:type_name -> exam.ClozeBlank
+	11,  // 7: exam.CreateBulkQuestionsRequest.questions:type_name -> exam.CreateQuestionRequest
+	125, // 8: exam.ImportQuestionsResponse.errors:type_name -> exam.QuestionBankError
+	20,  // 9: exam.CreateExamRequest.questions:type_name -> exam.QuestionAssignment
+	19,  // 10: exam.CreateExamRequest.settings:type_name -> exam.ExamSettings
+	19,  // 11: exam.GenerateExamRequest.settings:type_name -> exam.ExamSettings
+	22,  // 12: exam.GenerateExamRequest.section_configs:type_name -> exam.SectionConfig
+	20,  // 13: exam.GenerateExamRequest.fixed_questions:type_name -> exam.QuestionAssignment
+	25,  // 14: exam.QuestionDetails.choices:type_name -> exam.ChoiceDetails
+	122, // 15: exam.QuestionDetails.numeric:type_name -> exam.NumericAnswerConfig
+	123, // 16: exam.QuestionDetails.blanks:type_name -> exam.ClozeBlank
+	19,  // 17: exam.GetExamDetailsResponse.settings:type_name -> exam.ExamSettings
+	26,  // 18: exam.GetExamDetailsResponse.questions:type_name -> exam.QuestionDetails
+	190, // 19: exam.CheckExamAccessResponse.accommodation:type_name -> exam.AccommodationTerms
+	26,  // 20: exam.GetQuestionResponse.question:type_name -> exam.QuestionDetails
+	10,  // 21: exam.UpdateQuestionRequest.choices:type_name -> exam.ChoiceInput
+	122, // 22: exam.UpdateQuestionRequest.numeric:type_name -> exam.NumericAnswerConfig
+	123, // 23: exam.UpdateQuestionRequest.blanks:type_name -> exam.ClozeBlank
+	43,  // 24: exam.GetExamsResponse.exams:type_name -> exam.ExamListItem
+	19,  // 25: exam.UpdateExamRequest.settings:type_name -> exam.ExamSettings
+	20,  // 26: exam.UpdateExamRequest.questions:type_name -> exam.QuestionAssignment
+	124, // 27: exam.UserAnswer.matches:type_name -> exam.MatchAnswer
+	52,  // 28: exam.SubmitExamRequest.answers:type_name -> exam.UserAnswer
+	57,  // 29: exam.SubmissionDetail.choices:type_name -> exam.ChoiceReview
+	124, // 30: exam.SubmissionDetail.matches:type_name -> exam.MatchAnswer
+	122, // 31: exam.SubmissionDetail.numeric:type_name -> exam.NumericAnswerConfig
+	123, // 32: exam.SubmissionDetail.correct_blanks:type_name -> exam.ClozeBlank
+	171, // 33: exam.SubmissionDetail.rubric:type_name -> exam.RubricResult
+	225, // 34: exam.SubmissionDetail.similarity_matches:type_name -> exam.SimilarityMatch
+	56,  // 35: exam.GetSubmissionResponse.details:type_name -> exam.SubmissionDetail
+	124, // 36: exam.SaveAnswerRequest.matches:type_name -> exam.MatchAnswer
+	234, // 37: exam.SaveAnswerResponse.feedback:type_name -> exam.PracticeFeedback
+	169, // 38: exam.GradeEssayRequest.rubric_scores:type_name -> exam.RubricSelection
+	276, // 39: exam.GetExamStatsDetailedResponse.score_distribution:type_name -> exam.GetExamStatsDetailedResponse.ScoreDistributionEntry
+	71,  // 40: exam.GetExamSubmissionsResponse.submissions:type_name -> exam.SubmissionSummary
+	77,  // 41: exam.GetQuestionsResponse.questions:type_name -> exam.QuestionListItem
+	79,  // 42: exam.GetExamViolationsResponse.violations:type_name -> exam.ExamViolation
+	125, // 43: exam.ExportQuestionsResponse.errors:type_name -> exam.QuestionBankError
+	124, // 44: exam.AnswerDetail.matches:type_name -> exam.MatchAnswer
+	26,  // 45: exam.StartExamResponse.questions:type_name -> exam.QuestionDetails
+	85,  // 46: exam.StartExamResponse.current_answers:type_name -> exam.AnswerDetail
+	89,  // 47: exam.GetAccessRequestsResponse.requests:type_name -> exam.AccessRequestItem
+	105, // 48: exam.GetExamsByClassResponse.exams:type_name -> exam.Exam
+	105, // 49: exam.GetInstructorExamsResponse.exams:type_name -> exam.Exam
+	108, // 50: exam.GetRecentSubmissionsResponse.submissions:type_name -> exam.RecentSubmissionItem
+	71,  // 51: exam.GetMySubmissionsResponse.submissions:type_name -> exam.SubmissionSummary
+	113, // 52: exam.StudentGrade.scores:type_name -> exam.ExamScore
+	105, // 53: exam.GetClassGradebookResponse.exams:type_name -> exam.Exam
+	114, // 54: exam.GetClassGradebookResponse.grades:type_name -> exam.StudentGrade
+	117, // 55: exam.ItemStat.distractors:type_name -> exam.DistractorStat
+	118, // 56: exam.GetItemAnalysisResponse.items:type_name -> exam.ItemStat
+	124, // 57: exam.GetNextAdaptiveQuestionRequest.matches:type_name -> exam.MatchAnswer
+	26,  // 58: exam.GetNextAdaptiveQuestionResponse.question:type_name -> exam.QuestionDetails
+	125, // 59: exam.ImportQTIPackageResponse.errors:type_name -> exam.QuestionBankError
+	125, // 60: exam.ExportQTIPackageResponse.errors:type_name -> exam.QuestionBankError
+	130, // 61: exam.GetQuestionHistoryResponse.versions:type_name -> exam.QuestionVersionSummary
+	26,  // 62: exam.GetQuestionVersionResponse.question:type_name -> exam.QuestionDetails
+	135, // 63: exam.DiffQuestionVersionsResponse.changes:type_name -> exam.QuestionFieldChange
+	136, // 64: exam.DiffQuestionVersionsResponse.choice_changes:type_name -> exam.ChoiceChange
+	140, // 65: exam.RegradeExamResponse.changes:type_name -> exam.RegradeScoreChange
+	140, // 66: exam.RegradeRecord.changes:type_name -> exam.RegradeScoreChange
+	142, // 67: exam.GetRegradeHistoryResponse.regrades:type_name -> exam.RegradeRecord
+	145, // 68: exam.CreateAppealResponse.appeal:type_name -> exam.ScoreAppeal
+	145, // 69: exam.GetAppealQueueResponse.appeals:type_name -> exam.ScoreAppeal
+	145, // 70: exam.GetMyAppealsResponse.appeals:type_name -> exam.ScoreAppeal
+	145, // 71: exam.ResolveAppealResponse.appeal:type_name -> exam.ScoreAppeal
+	154, // 72: exam.RubricCriterion.levels:type_name -> exam.RubricLevel
+	155, // 73: exam.Rubric.criteria:type_name -> exam.RubricCriterion
+	155, // 74: exam.CreateRubricRequest.criteria:type_name -> exam.RubricCriterion
+	156, // 75: exam.CreateRubricResponse.rubric:type_name -> exam.Rubric
+	155, // 76: exam.UpdateRubricRequest.criteria:type_name -> exam.RubricCriterion
+	156, // 77: exam.UpdateRubricResponse.rubric:type_name -> exam.Rubric
+	156, // 78: exam.GetRubricResponse.rubric:type_name -> exam.Rubric
+	156, // 79: exam.GetRubricsResponse.rubrics:type_name -> exam.Rubric
+	170, // 80: exam.RubricResult.criteria:type_name -> exam.RubricCriterionScore
+	172, // 81: exam.ConfigureMarkingResponse.config:type_name -> exam.MarkingConfig
+	177, // 82: exam.GetMarkingTasksResponse.tasks:type_name -> exam.MarkingTask
+	225, // 83: exam.MarkingEssay.similarity_matches:type_name -> exam.SimilarityMatch
+	177, // 84: exam.GetMarkingTaskResponse.task:type_name -> exam.MarkingTask
+	180, // 85: exam.GetMarkingTaskResponse.essays:type_name -> exam.MarkingEssay
+	169, // 86: exam.EssayMarkInput.rubric_scores:type_name -> exam.RubricSelection
+	183, // 87: exam.SubmitMarksRequest.marks:type_name -> exam.EssayMarkInput
+	186, // 88: exam.SubmissionMarking.assignments:type_name -> exam.MarkerAssignmentSummary
+	172, // 89: exam.GetMarkingOverviewResponse.config:type_name -> exam.MarkingConfig
+	187, // 90: exam.GetMarkingOverviewResponse.submissions:type_name -> exam.SubmissionMarking
+	190, // 91: exam.Accommodation.terms:type_name -> exam.AccommodationTerms
+	190, // 92: exam.SaveAccommodationRequest.terms:type_name -> exam.AccommodationTerms
+	191, // 93: exam.SaveAccommodationResponse.accommodation:type_name -> exam.Accommodation
+	191, // 94: exam.GetAccommodationsResponse.accommodations:type_name -> exam.Accommodation
+	190, // 95: exam.SaveExamAccommodationRequest.terms:type_name -> exam.AccommodationTerms
+	191, // 96: exam.SaveExamAccommodationResponse.accommodation:type_name -> exam.Accommodation
+	191, // 97: exam.GetExamAccommodationsResponse.accommodations:type_name -> exam.Accommodation
+	204, // 98: exam.ControlExamSessionResponse.action:type_name -> exam.ExamSessionAction
+	204, // 99: exam.GetExamSessionActionsResponse.actions:type_name -> exam.ExamSessionAction
+	277, // 100: exam.SuspicionConfig.weights:type_name -> exam.SuspicionConfig.WeightsEntry
+	211, // 101: exam.GetSuspicionConfigResponse.config:type_name -> exam.SuspicionConfig
+	211, // 102: exam.UpdateSuspicionConfigRequest.config:type_name -> exam.SuspicionConfig
+	211, // 103: exam.UpdateSuspicionConfigResponse.config:type_name -> exam.SuspicionConfig
+	216, // 104: exam.CollusionPair.evidence:type_name -> exam.CollusionEvidence
+	217, // 105: exam.CollusionReport.pairs:type_name -> exam.CollusionPair
+	218, // 106: exam.AnalyzeCollusionResponse.report:type_name -> exam.CollusionReport
+	218, // 107: exam.GetCollusionReportResponse.report:type_name -> exam.CollusionReport
+	224, // 108: exam.SimilarityMatch.spans:type_name -> exam.SimilaritySpan
+	223, // 109: exam.SaveSimilaritySourcesRequest.sources:type_name -> exam.SimilaritySource
+	223, // 110: exam.SaveSimilaritySourcesResponse.sources:type_name -> exam.SimilaritySource
+	223, // 111: exam.GetSimilaritySourcesResponse.sources:type_name -> exam.SimilaritySource
+	225, // 112: exam.CheckEssaySimilarityResponse.matches:type_name -> exam.SimilarityMatch
+	225, // 113: exam.GetEssaySimilarityResponse.matches:type_name -> exam.SimilarityMatch
+	57,  // 114: exam.PracticeFeedback.choices:type_name -> exam.ChoiceReview
+	122, // 115: exam.PracticeFeedback.numeric:type_name -> exam.NumericAnswerConfig
+	123, // 116: exam.PracticeFeedback.correct_blanks:type_name -> exam.ClozeBlank
+	235, // 117: exam.GetPracticeReportResponse.sections:type_name -> exam.PracticeArea
+	235, // 118: exam.GetPracticeReportResponse.topics:type_name -> exam.PracticeArea
+	57,  // 119: exam.ReviewAnswer.choices:type_name -> exam.ChoiceReview
+	122, // 120: exam.ReviewAnswer.numeric:type_name -> exam.NumericAnswerConfig
+	123, // 121: exam.ReviewAnswer.correct_blanks:type_name -> exam.ClozeBlank
+	26,  // 122: exam.ReviewCard.question:type_name -> exam.QuestionDetails
+	238, // 123: exam.ReviewCard.answer:type_name -> exam.ReviewAnswer
+	239, // 124: exam.GetDueReviewsResponse.cards:type_name -> exam.ReviewCard
+	239, // 125: exam.RecordReviewResponse.card:type_name -> exam.ReviewCard
+	245, // 126: exam.GeneratePaperExamResponse.versions:type_name -> exam.PaperExamVersion
+	248, // 127: exam.GenerateAnswerSheetsResponse.sheets:type_name -> exam.AnswerSheetInfo
+	251, // 128: exam.AnswerSheetScan.marks:type_name -> exam.ScanMark
+	250, // 129: exam.GradeScannedSheetsRequest.files:type_name -> exam.ScannedFile
+	252, // 130: exam.GradeScannedSheetsResponse.scans:type_name -> exam.AnswerSheetScan
+	252, // 131: exam.GetAnswerSheetScansResponse.scans:type_name -> exam.AnswerSheetScan
+	257, // 132: exam.ResolveAnswerSheetScanRequest.corrections:type_name -> exam.ScanCorrection
+	252, // 133: exam.ResolveAnswerSheetScanResponse.scan:type_name -> exam.AnswerSheetScan
+	19,  // 134: exam.ExamTemplate.settings:type_name -> exam.ExamSettings
+	22,  // 135: exam.ExamTemplate.section_configs:type_name -> exam.SectionConfig
+	19,  // 136: exam.CreateExamTemplateRequest.settings:type_name -> exam.ExamSettings
+	22,  // 137: exam.CreateExamTemplateRequest.section_configs:type_name -> exam.SectionConfig
+	262, // 138: exam.CreateExamTemplateResponse.template:type_name -> exam.ExamTemplate
+	262, // 139: exam.GetExamTemplatesResponse.templates:type_name -> exam.ExamTemplate
+	22,  // 140: exam.ValidateExamBlueprintRequest.section_configs:type_name -> exam.SectionConfig
+	271, // 141: exam.ValidateExamBlueprintResponse.rules:type_name -> exam.BlueprintRuleReport
+	272, // 142: exam.ValidateExamBlueprintResponse.overlaps:type_name -> exam.BlueprintOverlap
+	273, // 143: exam.ValidateExamBlueprintResponse.issues:type_name -> exam.BlueprintIssue
+	2,   // 144: exam.ExamService.CreateTopic:input_type -> exam.CreateTopicRequest
+	4,   // 145: exam.ExamService.GetTopics:input_type -> exam.GetTopicsRequest
+	6,   // 146: exam.ExamService.CreateSection:input_type -> exam.CreateSectionRequest
+	8,   // 147: exam.ExamService.GetSections:input_type -> exam.GetSectionsRequest
+	91,  // 148: exam.ExamService.UpdateTopic:input_type -> exam.UpdateTopicRequest
+	93,  // 149: exam.ExamService.DeleteTopic:input_type -> exam.DeleteTopicRequest
+	95,  // 150: exam.ExamService.UpdateSection:input_type -> exam.UpdateSectionRequest
+	97,  // 151: exam.ExamService.DeleteSection:input_type -> exam.DeleteSectionRequest
+	76,  // 152: exam.ExamService.GetQuestions:input_type -> exam.GetQuestionsRequest
+	11,  // 153: exam.ExamService.CreateQuestion:input_type -> exam.CreateQuestionRequest
+	13,  // 154: exam.ExamService.CreateBulkQuestions:input_type -> exam.CreateBulkQuestionsRequest
+	35,  // 155: exam.ExamService.GetQuestion:input_type -> exam.GetQuestionRequest
+	17,  // 156: exam.ExamService.ImportQuestions:input_type -> exam.ImportQuestionsRequest
+	37,  // 157: exam.ExamService.UpdateQuestion:input_type -> exam.UpdateQuestionRequest
+	39,  // 158: exam.ExamService.DeleteQuestion:input_type -> exam.DeleteQuestionRequest
+	41,  // 159: exam.ExamService.DeleteBulkQuestions:input_type -> exam.DeleteBulkQuestionsRequest
+	15,  // 160: exam.ExamService.GetUploadURL:input_type -> exam.GetUploadURLRequest
+	21,  // 161: exam.ExamService.CreateExam:input_type -> exam.CreateExamRequest
+	23,  // 162: exam.ExamService.GenerateExam:input_type -> exam.GenerateExamRequest
+	27,  // 163: exam.ExamService.GetExamDetails:input_type -> exam.GetExamDetailsRequest
+	44,  // 164: exam.ExamService.GetExams:input_type -> exam.GetExamsRequest
+	46,  // 165: exam.ExamService.UpdateExam:input_type -> exam.UpdateExamRequest
+	48,  // 166: exam.ExamService.DeleteExam:input_type -> exam.DeleteExamRequest
+	50,  // 167: exam.ExamService.PublishExam:input_type -> exam.PublishExamRequest
+	29,  // 168: exam.ExamService.RequestExamAccess:input_type -> exam.RequestExamAccessRequest
+	31,  // 169: exam.ExamService.ApproveExamAccess:input_type -> exam.ApproveExamAccessRequest
+	33,  // 170: exam.ExamService.CheckExamAccess:input_type -> exam.CheckExamAccessRequest
+	88,  // 171: exam.ExamService.GetAccessRequests:input_type -> exam.GetAccessRequestsRequest
+	53,  // 172: exam.ExamService.SubmitExam:input_type -> exam.SubmitExamRequest
+	55,  // 173: exam.ExamService.GetSubmission:input_type -> exam.GetSubmissionRequest
+	59,  // 174: exam.ExamService.GetUserExamStats:input_type -> exam.GetUserExamStatsRequest
+	61,  // 175: exam.ExamService.GetExamCount:input_type -> exam.GetExamCountRequest
+	63,  // 176: exam.ExamService.SaveAnswer:input_type -> exam.SaveAnswerRequest
+	65,  // 177: exam.ExamService.LogViolation:input_type -> exam.LogViolationRequest
+	69,  // 178: exam.ExamService.GetExamStatsDetailed:input_type -> exam.GetExamStatsDetailedRequest
+	72,  // 179: exam.ExamService.GetExamSubmissions:input_type -> exam.GetExamSubmissionsRequest
+	74,  // 180: exam.ExamService.ExportExamResults:input_type -> exam.ExportExamResultsRequest
+	80,  // 181: exam.ExamService.GetExamViolations:input_type -> exam.GetExamViolationsRequest
+	82,  // 182: exam.ExamService.ExportQuestions:input_type -> exam.ExportQuestionsRequest
+	84,  // 183: exam.ExamService.StartExam:input_type -> exam.StartExamRequest
+	99,  // 184: exam.ExamService.GetExamsByClass:input_type -> exam.GetExamsByClassRequest
+	101, // 185: exam.ExamService.AssignExamToClass:input_type -> exam.AssignExamToClassRequest
+	101, // 186: exam.ExamService.UnassignExamFromClass:input_type -> exam.AssignExamToClassRequest
+	103, // 187: exam.ExamService.GetInstructorExams:input_type -> exam.GetInstructorExamsRequest
+	106, // 188: exam.ExamService.GetExamPreview:input_type -> exam.GetExamPreviewRequest
+	107, // 189: exam.ExamService.GetRecentSubmissions:input_type -> exam.GetRecentSubmissionsRequest
+	110, // 190: exam.ExamService.GetMySubmissions:input_type -> exam.GetMySubmissionsRequest
+	67,  // 191: exam.ExamService.GradeEssay:input_type -> exam.GradeEssayRequest
+	112, // 192: exam.ExamService.GetClassGradebook:input_type -> exam.GetClassGradebookRequest
+	116, // 193: exam.ExamService.GetItemAnalysis:input_type -> exam.GetItemAnalysisRequest
+	120, // 194: exam.ExamService.GetNextAdaptiveQuestion:input_type -> exam.GetNextAdaptiveQuestionRequest
+	126, // 195: exam.ExamService.ImportQTIPackage:input_type -> exam.ImportQTIPackageRequest
+	128, // 196: exam.ExamService.ExportQTIPackage:input_type -> exam.ExportQTIPackageRequest
+	131, // 197: exam.ExamService.GetQuestionHistory:input_type -> exam.GetQuestionHistoryRequest
+	133, // 198: exam.ExamService.GetQuestionVersion:input_type -> exam.GetQuestionVersionRequest
+	137, // 199: exam.ExamService.DiffQuestionVersions:input_type -> exam.DiffQuestionVersionsRequest
+	139, // 200: exam.ExamService.RegradeExam:input_type -> exam.RegradeExamRequest
+	143, // 201: exam.ExamService.GetRegradeHistory:input_type -> exam.GetRegradeHistoryRequest
+	146, // 202: exam.ExamService.CreateAppeal:input_type -> exam.CreateAppealRequest
+	148, // 203: exam.ExamService.GetAppealQueue:input_type -> exam.GetAppealQueueRequest
+	150, // 204: exam.ExamService.GetMyAppeals:input_type -> exam.GetMyAppealsRequest
+	152, // 205: exam.ExamService.ResolveAppeal:input_type -> exam.ResolveAppealRequest
+	157, // 206: exam.ExamService.CreateRubric:input_type -> exam.CreateRubricRequest
+	159, // 207: exam.ExamService.UpdateRubric:input_type -> exam.UpdateRubricRequest
+	161, // 208: exam.ExamService.GetRubric:input_type -> exam.GetRubricRequest
+	163, // 209: exam.ExamService.GetRubrics:input_type -> exam.GetRubricsRequest
+	165, // 210: exam.ExamService.DeleteRubric:input_type -> exam.DeleteRubricRequest
+	167, // 211: exam.ExamService.SetQuestionRubric:input_type -> exam.SetQuestionRubricRequest
+	173, // 212: exam.ExamService.ConfigureMarking:input_type -> exam.ConfigureMarkingRequest
+	175, // 213: exam.ExamService.AssignMarkers:input_type -> exam.AssignMarkersRequest
+	178, // 214: exam.ExamService.GetMarkingTasks:input_type -> exam.GetMarkingTasksRequest
+	181, // 215: exam.ExamService.GetMarkingTask:input_type -> exam.GetMarkingTaskRequest
+	184, // 216: exam.ExamService.SubmitMarks:input_type -> exam.SubmitMarksRequest
+	188, // 217: exam.ExamService.GetMarkingOverview:input_type -> exam.GetMarkingOverviewRequest
+	192, // 218: exam.ExamService.SaveAccommodation:input_type -> exam.SaveAccommodationRequest
+	194, // 219: exam.ExamService.GetAccommodations:input_type -> exam.GetAccommodationsRequest
+	196, // 220: exam.ExamService.DeleteAccommodation:input_type -> exam.DeleteAccommodationRequest
+	198, // 221: exam.ExamService.SaveExamAccommodation:input_type -> exam.SaveExamAccommodationRequest
+	200, // 222: exam.ExamService.GetExamAccommodations:input_type -> exam.GetExamAccommodationsRequest
+	202, // 223: exam.ExamService.DeleteExamAccommodation:input_type -> exam.DeleteExamAccommodationRequest
+	205, // 224: exam.ExamService.ControlExamSession:input_type -> exam.ControlExamSessionRequest
+	207, // 225: exam.ExamService.GetExamSessionActions:input_type -> exam.GetExamSessionActionsRequest
+	209, // 226: exam.ExamService.GetExamSessionState:input_type -> exam.GetExamSessionStateRequest
+	212, // 227: exam.ExamService.GetSuspicionConfig:input_type -> exam.GetSuspicionConfigRequest
+	214, // 228: exam.ExamService.UpdateSuspicionConfig:input_type -> exam.UpdateSuspicionConfigRequest
+	219, // 229: exam.ExamService.AnalyzeCollusion:input_type -> exam.AnalyzeCollusionRequest
+	221, // 230: exam.ExamService.GetCollusionReport:input_type -> exam.GetCollusionReportRequest
+	226, // 231: exam.ExamService.SaveSimilaritySources:input_type -> exam.SaveSimilaritySourcesRequest
+	228, // 232: exam.ExamService.GetSimilaritySources:input_type -> exam.GetSimilaritySourcesRequest
+	230, // 233: exam.ExamService.CheckEssaySimilarity:input_type -> exam.CheckEssaySimilarityRequest
+	232, // 234: exam.ExamService.GetEssaySimilarity:input_type -> exam.GetEssaySimilarityRequest
+	236, // 235: exam.ExamService.GetPracticeReport:input_type -> exam.GetPracticeReportRequest
+	240, // 236: exam.ExamService.GetDueReviews:input_type -> exam.GetDueReviewsRequest
+	242, // 237: exam.ExamService.RecordReview:input_type -> exam.RecordReviewRequest
+	244, // 238: exam.ExamService.GeneratePaperExam:input_type -> exam.GeneratePaperExamRequest
+	247, // 239: exam.ExamService.GenerateAnswerSheets:input_type -> exam.GenerateAnswerSheetsRequest
+	253, // 240: exam.ExamService.GradeScannedSheets:input_type -> exam.GradeScannedSheetsRequest
+	255, // 241: exam.ExamService.GetAnswerSheetScans:input_type -> exam.GetAnswerSheetScansRequest
+	258, // 242: exam.ExamService.ResolveAnswerSheetScan:input_type -> exam.ResolveAnswerSheetScanRequest
+	260, // 243: exam.ExamService.CloneExam:input_type -> exam.CloneExamRequest
+	263, // 244: exam.ExamService.CreateExamTemplate:input_type -> exam.CreateExamTemplateRequest
+	265, // 245: exam.ExamService.GetExamTemplates:input_type -> exam.GetExamTemplatesRequest
+	267, // 246: exam.ExamService.DeleteExamTemplate:input_type -> exam.DeleteExamTemplateRequest
+	269, // 247: exam.ExamService.InstantiateExamTemplate:input_type -> exam.InstantiateExamTemplateRequest
+	274, // 248: exam.ExamService.ValidateExamBlueprint:input_type -> exam.ValidateExamBlueprintRequest
+	3,   // 249: exam.ExamService.CreateTopic:output_type -> exam.CreateTopicResponse
+	5,   // 250: exam.ExamService.GetTopics:output_type -> exam.GetTopicsResponse
+	7,   // 251: exam.ExamService.CreateSection:output_type -> exam.CreateSectionResponse
+	9,   // 252: exam.ExamService.GetSections:output_type -> exam.GetSectionsResponse
+	92,  // 253: exam.ExamService.UpdateTopic:output_type -> exam.UpdateTopicResponse
+	94,  // 254: exam.ExamService.DeleteTopic:output_type -> exam.DeleteTopicResponse
+	96,  // 255: exam.ExamService.UpdateSection:output_type -> exam.UpdateSectionResponse
+	98,  // 256: exam.ExamService.DeleteSection:output_type -> exam.DeleteSectionResponse
+	78,  // 257: exam.ExamService.GetQuestions:output_type -> exam.GetQuestionsResponse
+	12,  // 258: exam.ExamService.CreateQuestion:output_type -> exam.CreateQuestionResponse
+	14,  // 259: exam.ExamService.CreateBulkQuestions:output_type -> exam.CreateBulkQuestionsResponse
+	36,  // 260: exam.ExamService.GetQuestion:output_type -> exam.GetQuestionResponse
+	18,  // 261: exam.ExamService.ImportQuestions:output_type -> exam.ImportQuestionsResponse
+	38,  // 262: exam.ExamService.UpdateQuestion:output_type -> exam.UpdateQuestionResponse
+	40,  // 263: exam.ExamService.DeleteQuestion:output_type -> exam.DeleteQuestionResponse
+	42,  // 264: exam.ExamService.DeleteBulkQuestions:output_type -> exam.DeleteBulkQuestionsResponse
+	16,  // 265: exam.ExamService.GetUploadURL:output_type -> exam.GetUploadURLResponse
+	24,  // 266: exam.ExamService.CreateExam:output_type -> exam.CreateExamResponse
+	24,  // 267: exam.ExamService.GenerateExam:output_type -> exam.CreateExamResponse
+	28,  // 268: exam.ExamService.GetExamDetails:output_type -> exam.GetExamDetailsResponse
+	45,  // 269: exam.ExamService.GetExams:output_type -> exam.GetExamsResponse
+	47,  // 270: exam.ExamService.UpdateExam:output_type -> exam.UpdateExamResponse
+	49,  // 271: exam.ExamService.DeleteExam:output_type -> exam.DeleteExamResponse
+	51,  // 272: exam.ExamService.PublishExam:output_type -> exam.PublishExamResponse
+	30,  // 273: exam.ExamService.RequestExamAccess:output_type -> exam.RequestExamAccessResponse
+	32,  // 274: exam.ExamService.ApproveExamAccess:output_type -> exam.ApproveExamAccessResponse
+	34,  // 275: exam.ExamService.CheckExamAccess:output_type -> exam.CheckExamAccessResponse
+	90,  // 276: exam.ExamService.GetAccessRequests:output_type -> exam.GetAccessRequestsResponse
+	54,  // 277: exam.ExamService.SubmitExam:output_type -> exam.SubmitExamResponse
+	58,  // 278: exam.ExamService.GetSubmission:output_type -> exam.GetSubmissionResponse
+	60,  // 279: exam.ExamService.GetUserExamStats:output_type -> exam.GetUserExamStatsResponse
+	62,  // 280: exam.ExamService.GetExamCount:output_type -> exam.GetExamCountResponse
+	64,  // 281: exam.ExamService.SaveAnswer:output_type -> exam.SaveAnswerResponse
+	66,  // 282: exam.ExamService.LogViolation:output_type -> exam.LogViolationResponse
+	70,  // 283: exam.ExamService.GetExamStatsDetailed:output_type -> exam.GetExamStatsDetailedResponse
+	73,  // 284: exam.ExamService.GetExamSubmissions:output_type -> exam.GetExamSubmissionsResponse
+	75,  // 285: exam.ExamService.ExportExamResults:output_type -> exam.ExportExamResultsResponse
+	81,  // 286: exam.ExamService.GetExamViolations:output_type -> exam.GetExamViolationsResponse
+	83,  // 287: exam.ExamService.ExportQuestions:output_type -> exam.ExportQuestionsResponse
+	86,  // 288: exam.ExamService.StartExam:output_type -> exam.StartExamResponse
+	100, // 289: exam.ExamService.GetExamsByClass:output_type -> exam.GetExamsByClassResponse
+	102, // 290: exam.ExamService.AssignExamToClass:output_type -> exam.AssignExamToClassResponse
+	102, // 291: exam.ExamService.UnassignExamFromClass:output_type -> exam.AssignExamToClassResponse
+	104, // 292: exam.ExamService.GetInstructorExams:output_type -> exam.GetInstructorExamsResponse
+	28,  // 293: exam.ExamService.GetExamPreview:output_type -> exam.GetExamDetailsResponse
+	109, // 294: exam.ExamService.GetRecentSubmissions:output_type -> exam.GetRecentSubmissionsResponse
+	111, // 295: exam.ExamService.GetMySubmissions:output_type -> exam.GetMySubmissionsResponse
+	68,  // 296: exam.ExamService.GradeEssay:output_type -> exam.GradeEssayResponse
+	115, // 297: exam.ExamService.GetClassGradebook:output_type -> exam.GetClassGradebookResponse
+	119, // 298: exam.ExamService.GetItemAnalysis:output_type -> exam.GetItemAnalysisResponse
+	121, // 299: exam.ExamService.GetNextAdaptiveQuestion:output_type -> exam.GetNextAdaptiveQuestionResponse
+	127, // 300: exam.ExamService.ImportQTIPackage:output_type -> exam.ImportQTIPackageResponse
+	129, // 301: exam.ExamService.ExportQTIPackage:output_type -> exam.ExportQTIPackageResponse
+	132, // 302: exam.ExamService.GetQuestionHistory:output_type -> exam.GetQuestionHistoryResponse
+	134, // 303: exam.ExamService.GetQuestionVersion:output_type -> exam.GetQuestionVersionResponse
+	138, // 304: exam.ExamService.DiffQuestionVersions:output_type -> exam.DiffQuestionVersionsResponse
+	141, // 305: exam.ExamService.RegradeExam:output_type -> exam.RegradeExamResponse
+	144, // 306: exam.ExamService.GetRegradeHistory:output_type -> exam.GetRegradeHistoryResponse
+	147, // 307: exam.ExamService.CreateAppeal:output_type -> exam.CreateAppealResponse
+	149, // 308: exam.ExamService.GetAppealQueue:output_type -> exam.GetAppealQueueResponse
+	151, // 309: exam.ExamService.GetMyAppeals:output_type -> exam.GetMyAppealsResponse
+	153, // 310: exam.ExamService.ResolveAppeal:output_type -> exam.ResolveAppealResponse
+	158, // 311: exam.ExamService.CreateRubric:output_type -> exam.CreateRubricResponse
+	160, // 312: exam.ExamService.UpdateRubric:output_type -> exam.UpdateRubricResponse
+	162, // 313: exam.ExamService.GetRubric:output_type -> exam.GetRubricResponse
+	164, // 314: exam.ExamService.GetRubrics:output_type -> exam.GetRubricsResponse
+	166, // 315: exam.ExamService.DeleteRubric:output_type -> exam.DeleteRubricResponse
+	168, // 316: exam.ExamService.SetQuestionRubric:output_type -> exam.SetQuestionRubricResponse
+	174, // 317: exam.ExamService.ConfigureMarking:output_type -> exam.ConfigureMarkingResponse
+	176, // 318: exam.ExamService.AssignMarkers:output_type -> exam.AssignMarkersResponse
+	179, // 319: exam.ExamService.GetMarkingTasks:output_type -> exam.GetMarkingTasksResponse
+	182, // 320: exam.ExamService.GetMarkingTask:output_type -> exam.GetMarkingTaskResponse
+	185, // 321: exam.ExamService.SubmitMarks:output_type -> exam.SubmitMarksResponse
+	189, // 322: exam.ExamService.GetMarkingOverview:output_type -> exam.GetMarkingOverviewResponse
+	193, // 323: exam.ExamService.SaveAccommodation:output_type -> exam.SaveAccommodationResponse
+	195, // 324: exam.ExamService.GetAccommodations:output_type -> exam.GetAccommodationsResponse
+	197, // 325: exam.ExamService.DeleteAccommodation:output_type -> exam.DeleteAccommodationResponse
+	199, // 326: exam.ExamService.SaveExamAccommodation:output_type -> exam.SaveExamAccommodationResponse
+	201, // 327: exam.ExamService.GetExamAccommodations:output_type -> exam.GetExamAccommodationsResponse
+	203, // 328: exam.ExamService.DeleteExamAccommodation:output_type -> exam.DeleteExamAccommodationResponse
+	206, // 329: exam.ExamService.ControlExamSession:output_type -> exam.ControlExamSessionResponse
+	208, // 330: exam.ExamService.GetExamSessionActions:output_type -> exam.GetExamSessionActionsResponse
+	210, // 331: exam.ExamService.GetExamSessionState:output_type -> exam.GetExamSessionStateResponse
+	213, // 332: exam.ExamService.GetSuspicionConfig:output_type -> exam.GetSuspicionConfigResponse
+	215, // 333: exam.ExamService.UpdateSuspicionConfig:output_type -> exam.UpdateSuspicionConfigResponse
+	220, // 334: exam.ExamService.AnalyzeCollusion:output_type -> exam.AnalyzeCollusionResponse
+	222, // 335: exam.ExamService.GetCollusionReport:output_type -> exam.GetCollusionReportResponse
+	227, // 336: exam.ExamService.SaveSimilaritySources:output_type -> exam.SaveSimilaritySourcesResponse
+	229, // 337: exam.ExamService.GetSimilaritySources:output_type -> exam.GetSimilaritySourcesResponse
+	231, // 338: exam.ExamService.CheckEssaySimilarity:output_type -> exam.CheckEssaySimilarityResponse
+	233, // 339: exam.ExamService.GetEssaySimilarity:output_type -> exam.GetEssaySimilarityResponse
+	237, // 340: exam.ExamService.GetPracticeReport:output_type -> exam.GetPracticeReportResponse
+	241, // 341: exam.ExamService.GetDueReviews:output_type -> exam.GetDueReviewsResponse
+	243, // 342: exam.ExamService.RecordReview:output_type -> exam.RecordReviewResponse
+	246, // 343: exam.ExamService.GeneratePaperExam:output_type -> exam.GeneratePaperExamResponse
+	249, // 344: exam.ExamService.GenerateAnswerSheets:output_type -> exam.GenerateAnswerSheetsResponse
+	254, // 345: exam.ExamService.GradeScannedSheets:output_type -> exam.GradeScannedSheetsResponse
+	256, // 346: exam.ExamService.GetAnswerSheetScans:output_type -> exam.GetAnswerSheetScansResponse
+	259, // 347: exam.ExamService.ResolveAnswerSheetScan:output_type -> exam.ResolveAnswerSheetScanResponse
+	261, // 348: exam.ExamService.CloneExam:output_type -> exam.CloneExamResponse
+	264, // 349: exam.ExamService.CreateExamTemplate:output_type -> exam.CreateExamTemplateResponse
+	266, // 350: exam.ExamService.GetExamTemplates:output_type -> exam.GetExamTemplatesResponse
+	268, // 351: exam.ExamService.DeleteExamTemplate:output_type -> exam.DeleteExamTemplateResponse
+	270, // 352: exam.ExamService.InstantiateExamTemplate:output_type -> exam.InstantiateExamTemplateResponse
+	275, // 353: exam.ExamService.ValidateExamBlueprint:output_type -> exam.ValidateExamBlueprintResponse
+	249, // [249:354] is the sub-list for method output_type
+	144, // [144:249] is the sub-list for method input_type
+	144, // [144:144] is the sub-list for extension type_name
+	144, // [144:144] is the sub-list for extension extendee
+	0,   // [0:144] is the sub-list for field type_name
 }
 
 func init() { file_exam_proto_init() }
@@ -7926,13 +20960,17 @@ func file_exam_proto_init() {
 	file_exam_proto_msgTypes[46].OneofWrappers = []any{}
 	file_exam_proto_msgTypes[91].OneofWrappers = []any{}
 	file_exam_proto_msgTypes[95].OneofWrappers = []any{}
+	file_exam_proto_msgTypes[145].OneofWrappers = []any{}
+	file_exam_proto_msgTypes[152].OneofWrappers = []any{}
+	file_exam_proto_msgTypes[180].OneofWrappers = []any{}
+	file_exam_proto_msgTypes[187].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_exam_proto_rawDesc), len(file_exam_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   117,
+			NumMessages:   278,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
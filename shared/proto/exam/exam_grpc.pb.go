@@ -19,55 +19,111 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExamService_CreateTopic_FullMethodName           = "/exam.ExamService/CreateTopic"
-	ExamService_GetTopics_FullMethodName             = "/exam.ExamService/GetTopics"
-	ExamService_CreateSection_FullMethodName         = "/exam.ExamService/CreateSection"
-	ExamService_GetSections_FullMethodName           = "/exam.ExamService/GetSections"
-	ExamService_UpdateTopic_FullMethodName           = "/exam.ExamService/UpdateTopic"
-	ExamService_DeleteTopic_FullMethodName           = "/exam.ExamService/DeleteTopic"
-	ExamService_UpdateSection_FullMethodName         = "/exam.ExamService/UpdateSection"
-	ExamService_DeleteSection_FullMethodName         = "/exam.ExamService/DeleteSection"
-	ExamService_GetQuestions_FullMethodName          = "/exam.ExamService/GetQuestions"
-	ExamService_CreateQuestion_FullMethodName        = "/exam.ExamService/CreateQuestion"
-	ExamService_CreateBulkQuestions_FullMethodName   = "/exam.ExamService/CreateBulkQuestions"
-	ExamService_GetQuestion_FullMethodName           = "/exam.ExamService/GetQuestion"
-	ExamService_ImportQuestions_FullMethodName       = "/exam.ExamService/ImportQuestions"
-	ExamService_UpdateQuestion_FullMethodName        = "/exam.ExamService/UpdateQuestion"
-	ExamService_DeleteQuestion_FullMethodName        = "/exam.ExamService/DeleteQuestion"
-	ExamService_DeleteBulkQuestions_FullMethodName   = "/exam.ExamService/DeleteBulkQuestions"
-	ExamService_GetUploadURL_FullMethodName          = "/exam.ExamService/GetUploadURL"
-	ExamService_CreateExam_FullMethodName            = "/exam.ExamService/CreateExam"
-	ExamService_GenerateExam_FullMethodName          = "/exam.ExamService/GenerateExam"
-	ExamService_GetExamDetails_FullMethodName        = "/exam.ExamService/GetExamDetails"
-	ExamService_GetExams_FullMethodName              = "/exam.ExamService/GetExams"
-	ExamService_UpdateExam_FullMethodName            = "/exam.ExamService/UpdateExam"
-	ExamService_DeleteExam_FullMethodName            = "/exam.ExamService/DeleteExam"
-	ExamService_PublishExam_FullMethodName           = "/exam.ExamService/PublishExam"
-	ExamService_RequestExamAccess_FullMethodName     = "/exam.ExamService/RequestExamAccess"
-	ExamService_ApproveExamAccess_FullMethodName     = "/exam.ExamService/ApproveExamAccess"
-	ExamService_CheckExamAccess_FullMethodName       = "/exam.ExamService/CheckExamAccess"
-	ExamService_GetAccessRequests_FullMethodName     = "/exam.ExamService/GetAccessRequests"
-	ExamService_SubmitExam_FullMethodName            = "/exam.ExamService/SubmitExam"
-	ExamService_GetSubmission_FullMethodName         = "/exam.ExamService/GetSubmission"
-	ExamService_GetUserExamStats_FullMethodName      = "/exam.ExamService/GetUserExamStats"
-	ExamService_GetExamCount_FullMethodName          = "/exam.ExamService/GetExamCount"
-	ExamService_SaveAnswer_FullMethodName            = "/exam.ExamService/SaveAnswer"
-	ExamService_LogViolation_FullMethodName          = "/exam.ExamService/LogViolation"
-	ExamService_GetExamStatsDetailed_FullMethodName  = "/exam.ExamService/GetExamStatsDetailed"
-	ExamService_GetExamSubmissions_FullMethodName    = "/exam.ExamService/GetExamSubmissions"
-	ExamService_ExportExamResults_FullMethodName     = "/exam.ExamService/ExportExamResults"
-	ExamService_GetExamViolations_FullMethodName     = "/exam.ExamService/GetExamViolations"
-	ExamService_ExportQuestions_FullMethodName       = "/exam.ExamService/ExportQuestions"
-	ExamService_StartExam_FullMethodName             = "/exam.ExamService/StartExam"
-	ExamService_GetExamsByClass_FullMethodName       = "/exam.ExamService/GetExamsByClass"
-	ExamService_AssignExamToClass_FullMethodName     = "/exam.ExamService/AssignExamToClass"
-	ExamService_UnassignExamFromClass_FullMethodName = "/exam.ExamService/UnassignExamFromClass"
-	ExamService_GetInstructorExams_FullMethodName    = "/exam.ExamService/GetInstructorExams"
-	ExamService_GetExamPreview_FullMethodName        = "/exam.ExamService/GetExamPreview"
-	ExamService_GetRecentSubmissions_FullMethodName  = "/exam.ExamService/GetRecentSubmissions"
-	ExamService_GetMySubmissions_FullMethodName      = "/exam.ExamService/GetMySubmissions"
-	ExamService_GradeEssay_FullMethodName            = "/exam.ExamService/GradeEssay"
-	ExamService_GetClassGradebook_FullMethodName     = "/exam.ExamService/GetClassGradebook"
+	ExamService_CreateTopic_FullMethodName             = "/exam.ExamService/CreateTopic"
+	ExamService_GetTopics_FullMethodName               = "/exam.ExamService/GetTopics"
+	ExamService_CreateSection_FullMethodName           = "/exam.ExamService/CreateSection"
+	ExamService_GetSections_FullMethodName             = "/exam.ExamService/GetSections"
+	ExamService_UpdateTopic_FullMethodName             = "/exam.ExamService/UpdateTopic"
+	ExamService_DeleteTopic_FullMethodName             = "/exam.ExamService/DeleteTopic"
+	ExamService_UpdateSection_FullMethodName           = "/exam.ExamService/UpdateSection"
+	ExamService_DeleteSection_FullMethodName           = "/exam.ExamService/DeleteSection"
+	ExamService_GetQuestions_FullMethodName            = "/exam.ExamService/GetQuestions"
+	ExamService_CreateQuestion_FullMethodName          = "/exam.ExamService/CreateQuestion"
+	ExamService_CreateBulkQuestions_FullMethodName     = "/exam.ExamService/CreateBulkQuestions"
+	ExamService_GetQuestion_FullMethodName             = "/exam.ExamService/GetQuestion"
+	ExamService_ImportQuestions_FullMethodName         = "/exam.ExamService/ImportQuestions"
+	ExamService_UpdateQuestion_FullMethodName          = "/exam.ExamService/UpdateQuestion"
+	ExamService_DeleteQuestion_FullMethodName          = "/exam.ExamService/DeleteQuestion"
+	ExamService_DeleteBulkQuestions_FullMethodName     = "/exam.ExamService/DeleteBulkQuestions"
+	ExamService_GetUploadURL_FullMethodName            = "/exam.ExamService/GetUploadURL"
+	ExamService_CreateExam_FullMethodName              = "/exam.ExamService/CreateExam"
+	ExamService_GenerateExam_FullMethodName            = "/exam.ExamService/GenerateExam"
+	ExamService_GetExamDetails_FullMethodName          = "/exam.ExamService/GetExamDetails"
+	ExamService_GetExams_FullMethodName                = "/exam.ExamService/GetExams"
+	ExamService_UpdateExam_FullMethodName              = "/exam.ExamService/UpdateExam"
+	ExamService_DeleteExam_FullMethodName              = "/exam.ExamService/DeleteExam"
+	ExamService_PublishExam_FullMethodName             = "/exam.ExamService/PublishExam"
+	ExamService_RequestExamAccess_FullMethodName       = "/exam.ExamService/RequestExamAccess"
+	ExamService_ApproveExamAccess_FullMethodName       = "/exam.ExamService/ApproveExamAccess"
+	ExamService_CheckExamAccess_FullMethodName         = "/exam.ExamService/CheckExamAccess"
+	ExamService_GetAccessRequests_FullMethodName       = "/exam.ExamService/GetAccessRequests"
+	ExamService_SubmitExam_FullMethodName              = "/exam.ExamService/SubmitExam"
+	ExamService_GetSubmission_FullMethodName           = "/exam.ExamService/GetSubmission"
+	ExamService_GetUserExamStats_FullMethodName        = "/exam.ExamService/GetUserExamStats"
+	ExamService_GetExamCount_FullMethodName            = "/exam.ExamService/GetExamCount"
+	ExamService_SaveAnswer_FullMethodName              = "/exam.ExamService/SaveAnswer"
+	ExamService_LogViolation_FullMethodName            = "/exam.ExamService/LogViolation"
+	ExamService_GetExamStatsDetailed_FullMethodName    = "/exam.ExamService/GetExamStatsDetailed"
+	ExamService_GetExamSubmissions_FullMethodName      = "/exam.ExamService/GetExamSubmissions"
+	ExamService_ExportExamResults_FullMethodName       = "/exam.ExamService/ExportExamResults"
+	ExamService_GetExamViolations_FullMethodName       = "/exam.ExamService/GetExamViolations"
+	ExamService_ExportQuestions_FullMethodName         = "/exam.ExamService/ExportQuestions"
+	ExamService_StartExam_FullMethodName               = "/exam.ExamService/StartExam"
+	ExamService_GetExamsByClass_FullMethodName         = "/exam.ExamService/GetExamsByClass"
+	ExamService_AssignExamToClass_FullMethodName       = "/exam.ExamService/AssignExamToClass"
+	ExamService_UnassignExamFromClass_FullMethodName   = "/exam.ExamService/UnassignExamFromClass"
+	ExamService_GetInstructorExams_FullMethodName      = "/exam.ExamService/GetInstructorExams"
+	ExamService_GetExamPreview_FullMethodName          = "/exam.ExamService/GetExamPreview"
+	ExamService_GetRecentSubmissions_FullMethodName    = "/exam.ExamService/GetRecentSubmissions"
+	ExamService_GetMySubmissions_FullMethodName        = "/exam.ExamService/GetMySubmissions"
+	ExamService_GradeEssay_FullMethodName              = "/exam.ExamService/GradeEssay"
+	ExamService_GetClassGradebook_FullMethodName       = "/exam.ExamService/GetClassGradebook"
+	ExamService_GetItemAnalysis_FullMethodName         = "/exam.ExamService/GetItemAnalysis"
+	ExamService_GetNextAdaptiveQuestion_FullMethodName = "/exam.ExamService/GetNextAdaptiveQuestion"
+	ExamService_ImportQTIPackage_FullMethodName        = "/exam.ExamService/ImportQTIPackage"
+	ExamService_ExportQTIPackage_FullMethodName        = "/exam.ExamService/ExportQTIPackage"
+	ExamService_GetQuestionHistory_FullMethodName      = "/exam.ExamService/GetQuestionHistory"
+	ExamService_GetQuestionVersion_FullMethodName      = "/exam.ExamService/GetQuestionVersion"
+	ExamService_DiffQuestionVersions_FullMethodName    = "/exam.ExamService/DiffQuestionVersions"
+	ExamService_RegradeExam_FullMethodName             = "/exam.ExamService/RegradeExam"
+	ExamService_GetRegradeHistory_FullMethodName       = "/exam.ExamService/GetRegradeHistory"
+	ExamService_CreateAppeal_FullMethodName            = "/exam.ExamService/CreateAppeal"
+	ExamService_GetAppealQueue_FullMethodName          = "/exam.ExamService/GetAppealQueue"
+	ExamService_GetMyAppeals_FullMethodName            = "/exam.ExamService/GetMyAppeals"
+	ExamService_ResolveAppeal_FullMethodName           = "/exam.ExamService/ResolveAppeal"
+	ExamService_CreateRubric_FullMethodName            = "/exam.ExamService/CreateRubric"
+	ExamService_UpdateRubric_FullMethodName            = "/exam.ExamService/UpdateRubric"
+	ExamService_GetRubric_FullMethodName               = "/exam.ExamService/GetRubric"
+	ExamService_GetRubrics_FullMethodName              = "/exam.ExamService/GetRubrics"
+	ExamService_DeleteRubric_FullMethodName            = "/exam.ExamService/DeleteRubric"
+	ExamService_SetQuestionRubric_FullMethodName       = "/exam.ExamService/SetQuestionRubric"
+	ExamService_ConfigureMarking_FullMethodName        = "/exam.ExamService/ConfigureMarking"
+	ExamService_AssignMarkers_FullMethodName           = "/exam.ExamService/AssignMarkers"
+	ExamService_GetMarkingTasks_FullMethodName         = "/exam.ExamService/GetMarkingTasks"
+	ExamService_GetMarkingTask_FullMethodName          = "/exam.ExamService/GetMarkingTask"
+	ExamService_SubmitMarks_FullMethodName             = "/exam.ExamService/SubmitMarks"
+	ExamService_GetMarkingOverview_FullMethodName      = "/exam.ExamService/GetMarkingOverview"
+	ExamService_SaveAccommodation_FullMethodName       = "/exam.ExamService/SaveAccommodation"
+	ExamService_GetAccommodations_FullMethodName       = "/exam.ExamService/GetAccommodations"
+	ExamService_DeleteAccommodation_FullMethodName     = "/exam.ExamService/DeleteAccommodation"
+	ExamService_SaveExamAccommodation_FullMethodName   = "/exam.ExamService/SaveExamAccommodation"
+	ExamService_GetExamAccommodations_FullMethodName   = "/exam.ExamService/GetExamAccommodations"
+	ExamService_DeleteExamAccommodation_FullMethodName = "/exam.ExamService/DeleteExamAccommodation"
+	ExamService_ControlExamSession_FullMethodName      = "/exam.ExamService/ControlExamSession"
+	ExamService_GetExamSessionActions_FullMethodName   = "/exam.ExamService/GetExamSessionActions"
+	ExamService_GetExamSessionState_FullMethodName     = "/exam.ExamService/GetExamSessionState"
+	ExamService_GetSuspicionConfig_FullMethodName      = "/exam.ExamService/GetSuspicionConfig"
+	ExamService_UpdateSuspicionConfig_FullMethodName   = "/exam.ExamService/UpdateSuspicionConfig"
+	ExamService_AnalyzeCollusion_FullMethodName        = "/exam.ExamService/AnalyzeCollusion"
+	ExamService_GetCollusionReport_FullMethodName      = "/exam.ExamService/GetCollusionReport"
+	ExamService_SaveSimilaritySources_FullMethodName   = "/exam.ExamService/SaveSimilaritySources"
+	ExamService_GetSimilaritySources_FullMethodName    = "/exam.ExamService/GetSimilaritySources"
+	ExamService_CheckEssaySimilarity_FullMethodName    = "/exam.ExamService/CheckEssaySimilarity"
+	ExamService_GetEssaySimilarity_FullMethodName      = "/exam.ExamService/GetEssaySimilarity"
+	ExamService_GetPracticeReport_FullMethodName       = "/exam.ExamService/GetPracticeReport"
+	ExamService_GetDueReviews_FullMethodName           = "/exam.ExamService/GetDueReviews"
+	ExamService_RecordReview_FullMethodName            = "/exam.ExamService/RecordReview"
+	ExamService_GeneratePaperExam_FullMethodName       = "/exam.ExamService/GeneratePaperExam"
+	ExamService_GenerateAnswerSheets_FullMethodName    = "/exam.ExamService/GenerateAnswerSheets"
+	ExamService_GradeScannedSheets_FullMethodName      = "/exam.ExamService/GradeScannedSheets"
+	ExamService_GetAnswerSheetScans_FullMethodName     = "/exam.ExamService/GetAnswerSheetScans"
+	ExamService_ResolveAnswerSheetScan_FullMethodName  = "/exam.ExamService/ResolveAnswerSheetScan"
+	ExamService_CloneExam_FullMethodName               = "/exam.ExamService/CloneExam"
+	ExamService_CreateExamTemplate_FullMethodName      = "/exam.ExamService/CreateExamTemplate"
+	ExamService_GetExamTemplates_FullMethodName        = "/exam.ExamService/GetExamTemplates"
+	ExamService_DeleteExamTemplate_FullMethodName      = "/exam.ExamService/DeleteExamTemplate"
+	ExamService_InstantiateExamTemplate_FullMethodName = "/exam.ExamService/InstantiateExamTemplate"
+	ExamService_ValidateExamBlueprint_FullMethodName   = "/exam.ExamService/ValidateExamBlueprint"
 )
 
 // ExamServiceClient is the client API for ExamService service.
@@ -123,6 +179,62 @@ type ExamServiceClient interface {
 	GetMySubmissions(ctx context.Context, in *GetMySubmissionsRequest, opts ...grpc.CallOption) (*GetMySubmissionsResponse, error)
 	GradeEssay(ctx context.Context, in *GradeEssayRequest, opts ...grpc.CallOption) (*GradeEssayResponse, error)
 	GetClassGradebook(ctx context.Context, in *GetClassGradebookRequest, opts ...grpc.CallOption) (*GetClassGradebookResponse, error)
+	GetItemAnalysis(ctx context.Context, in *GetItemAnalysisRequest, opts ...grpc.CallOption) (*GetItemAnalysisResponse, error)
+	GetNextAdaptiveQuestion(ctx context.Context, in *GetNextAdaptiveQuestionRequest, opts ...grpc.CallOption) (*GetNextAdaptiveQuestionResponse, error)
+	ImportQTIPackage(ctx context.Context, in *ImportQTIPackageRequest, opts ...grpc.CallOption) (*ImportQTIPackageResponse, error)
+	ExportQTIPackage(ctx context.Context, in *ExportQTIPackageRequest, opts ...grpc.CallOption) (*ExportQTIPackageResponse, error)
+	GetQuestionHistory(ctx context.Context, in *GetQuestionHistoryRequest, opts ...grpc.CallOption) (*GetQuestionHistoryResponse, error)
+	GetQuestionVersion(ctx context.Context, in *GetQuestionVersionRequest, opts ...grpc.CallOption) (*GetQuestionVersionResponse, error)
+	DiffQuestionVersions(ctx context.Context, in *DiffQuestionVersionsRequest, opts ...grpc.CallOption) (*DiffQuestionVersionsResponse, error)
+	RegradeExam(ctx context.Context, in *RegradeExamRequest, opts ...grpc.CallOption) (*RegradeExamResponse, error)
+	GetRegradeHistory(ctx context.Context, in *GetRegradeHistoryRequest, opts ...grpc.CallOption) (*GetRegradeHistoryResponse, error)
+	CreateAppeal(ctx context.Context, in *CreateAppealRequest, opts ...grpc.CallOption) (*CreateAppealResponse, error)
+	GetAppealQueue(ctx context.Context, in *GetAppealQueueRequest, opts ...grpc.CallOption) (*GetAppealQueueResponse, error)
+	GetMyAppeals(ctx context.Context, in *GetMyAppealsRequest, opts ...grpc.CallOption) (*GetMyAppealsResponse, error)
+	ResolveAppeal(ctx context.Context, in *ResolveAppealRequest, opts ...grpc.CallOption) (*ResolveAppealResponse, error)
+	CreateRubric(ctx context.Context, in *CreateRubricRequest, opts ...grpc.CallOption) (*CreateRubricResponse, error)
+	UpdateRubric(ctx context.Context, in *UpdateRubricRequest, opts ...grpc.CallOption) (*UpdateRubricResponse, error)
+	GetRubric(ctx context.Context, in *GetRubricRequest, opts ...grpc.CallOption) (*GetRubricResponse, error)
+	GetRubrics(ctx context.Context, in *GetRubricsRequest, opts ...grpc.CallOption) (*GetRubricsResponse, error)
+	DeleteRubric(ctx context.Context, in *DeleteRubricRequest, opts ...grpc.CallOption) (*DeleteRubricResponse, error)
+	SetQuestionRubric(ctx context.Context, in *SetQuestionRubricRequest, opts ...grpc.CallOption) (*SetQuestionRubricResponse, error)
+	ConfigureMarking(ctx context.Context, in *ConfigureMarkingRequest, opts ...grpc.CallOption) (*ConfigureMarkingResponse, error)
+	AssignMarkers(ctx context.Context, in *AssignMarkersRequest, opts ...grpc.CallOption) (*AssignMarkersResponse, error)
+	GetMarkingTasks(ctx context.Context, in *GetMarkingTasksRequest, opts ...grpc.CallOption) (*GetMarkingTasksResponse, error)
+	GetMarkingTask(ctx context.Context, in *GetMarkingTaskRequest, opts ...grpc.CallOption) (*GetMarkingTaskResponse, error)
+	SubmitMarks(ctx context.Context, in *SubmitMarksRequest, opts ...grpc.CallOption) (*SubmitMarksResponse, error)
+	GetMarkingOverview(ctx context.Context, in *GetMarkingOverviewRequest, opts ...grpc.CallOption) (*GetMarkingOverviewResponse, error)
+	SaveAccommodation(ctx context.Context, in *SaveAccommodationRequest, opts ...grpc.CallOption) (*SaveAccommodationResponse, error)
+	GetAccommodations(ctx context.Context, in *GetAccommodationsRequest, opts ...grpc.CallOption) (*GetAccommodationsResponse, error)
+	DeleteAccommodation(ctx context.Context, in *DeleteAccommodationRequest, opts ...grpc.CallOption) (*DeleteAccommodationResponse, error)
+	SaveExamAccommodation(ctx context.Context, in *SaveExamAccommodationRequest, opts ...grpc.CallOption) (*SaveExamAccommodationResponse, error)
+	GetExamAccommodations(ctx context.Context, in *GetExamAccommodationsRequest, opts ...grpc.CallOption) (*GetExamAccommodationsResponse, error)
+	DeleteExamAccommodation(ctx context.Context, in *DeleteExamAccommodationRequest, opts ...grpc.CallOption) (*DeleteExamAccommodationResponse, error)
+	ControlExamSession(ctx context.Context, in *ControlExamSessionRequest, opts ...grpc.CallOption) (*ControlExamSessionResponse, error)
+	GetExamSessionActions(ctx context.Context, in *GetExamSessionActionsRequest, opts ...grpc.CallOption) (*GetExamSessionActionsResponse, error)
+	GetExamSessionState(ctx context.Context, in *GetExamSessionStateRequest, opts ...grpc.CallOption) (*GetExamSessionStateResponse, error)
+	GetSuspicionConfig(ctx context.Context, in *GetSuspicionConfigRequest, opts ...grpc.CallOption) (*GetSuspicionConfigResponse, error)
+	UpdateSuspicionConfig(ctx context.Context, in *UpdateSuspicionConfigRequest, opts ...grpc.CallOption) (*UpdateSuspicionConfigResponse, error)
+	AnalyzeCollusion(ctx context.Context, in *AnalyzeCollusionRequest, opts ...grpc.CallOption) (*AnalyzeCollusionResponse, error)
+	GetCollusionReport(ctx context.Context, in *GetCollusionReportRequest, opts ...grpc.CallOption) (*GetCollusionReportResponse, error)
+	SaveSimilaritySources(ctx context.Context, in *SaveSimilaritySourcesRequest, opts ...grpc.CallOption) (*SaveSimilaritySourcesResponse, error)
+	GetSimilaritySources(ctx context.Context, in *GetSimilaritySourcesRequest, opts ...grpc.CallOption) (*GetSimilaritySourcesResponse, error)
+	CheckEssaySimilarity(ctx context.Context, in *CheckEssaySimilarityRequest, opts ...grpc.CallOption) (*CheckEssaySimilarityResponse, error)
+	GetEssaySimilarity(ctx context.Context, in *GetEssaySimilarityRequest, opts ...grpc.CallOption) (*GetEssaySimilarityResponse, error)
+	GetPracticeReport(ctx context.Context, in *GetPracticeReportRequest, opts ...grpc.CallOption) (*GetPracticeReportResponse, error)
+	GetDueReviews(ctx context.Context, in *GetDueReviewsRequest, opts ...grpc.CallOption) (*GetDueReviewsResponse, error)
+	RecordReview(ctx context.Context, in *RecordReviewRequest, opts ...grpc.CallOption) (*RecordReviewResponse, error)
+	GeneratePaperExam(ctx context.Context, in *GeneratePaperExamRequest, opts ...grpc.CallOption) (*GeneratePaperExamResponse, error)
+	GenerateAnswerSheets(ctx context.Context, in *GenerateAnswerSheetsRequest, opts ...grpc.CallOption) (*GenerateAnswerSheetsResponse, error)
+	GradeScannedSheets(ctx context.Context, in *GradeScannedSheetsRequest, opts ...grpc.CallOption) (*GradeScannedSheetsResponse, error)
+	GetAnswerSheetScans(ctx context.Context, in *GetAnswerSheetScansRequest, opts ...grpc.CallOption) (*GetAnswerSheetScansResponse, error)
+	ResolveAnswerSheetScan(ctx context.Context, in *ResolveAnswerSheetScanRequest, opts ...grpc.CallOption) (*ResolveAnswerSheetScanResponse, error)
+	CloneExam(ctx context.Context, in *CloneExamRequest, opts ...grpc.CallOption) (*CloneExamResponse, error)
+	CreateExamTemplate(ctx context.Context, in *CreateExamTemplateRequest, opts ...grpc.CallOption) (*CreateExamTemplateResponse, error)
+	GetExamTemplates(ctx context.Context, in *GetExamTemplatesRequest, opts ...grpc.CallOption) (*GetExamTemplatesResponse, error)
+	DeleteExamTemplate(ctx context.Context, in *DeleteExamTemplateRequest, opts ...grpc.CallOption) (*DeleteExamTemplateResponse, error)
+	InstantiateExamTemplate(ctx context.Context, in *InstantiateExamTemplateRequest, opts ...grpc.CallOption) (*InstantiateExamTemplateResponse, error)
+	ValidateExamBlueprint(ctx context.Context, in *ValidateExamBlueprintRequest, opts ...grpc.CallOption) (*ValidateExamBlueprintResponse, error)
 }
 
 type examServiceClient struct {
@@ -623,1115 +735,2907 @@ func (c *examServiceClient) GetClassGradebook(ctx context.Context, in *GetClassG
 	return out, nil
 }
 
-// ExamServiceServer is the server API for ExamService service.
-// All implementations must embed UnimplementedExamServiceServer
-// for forward compatibility.
-type ExamServiceServer interface {
-	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error)
-	GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error)
-	CreateSection(context.Context, *CreateSectionRequest) (*CreateSectionResponse, error)
-	GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error)
-	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
-	DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error)
-	UpdateSection(context.Context, *UpdateSectionRequest) (*UpdateSectionResponse, error)
-	DeleteSection(context.Context, *DeleteSectionRequest) (*DeleteSectionResponse, error)
-	GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error)
-	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
-	CreateBulkQuestions(context.Context, *CreateBulkQuestionsRequest) (*CreateBulkQuestionsResponse, error)
-	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
-	ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error)
-	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
-	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
-	DeleteBulkQuestions(context.Context, *DeleteBulkQuestionsRequest) (*DeleteBulkQuestionsResponse, error)
-	GetUploadURL(context.Context, *GetUploadURLRequest) (*GetUploadURLResponse, error)
-	CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error)
-	GenerateExam(context.Context, *GenerateExamRequest) (*CreateExamResponse, error)
-	GetExamDetails(context.Context, *GetExamDetailsRequest) (*GetExamDetailsResponse, error)
-	GetExams(context.Context, *GetExamsRequest) (*GetExamsResponse, error)
-	UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error)
-	DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error)
-	PublishExam(context.Context, *PublishExamRequest) (*PublishExamResponse, error)
-	RequestExamAccess(context.Context, *RequestExamAccessRequest) (*RequestExamAccessResponse, error)
-	ApproveExamAccess(context.Context, *ApproveExamAccessRequest) (*ApproveExamAccessResponse, error)
-	CheckExamAccess(context.Context, *CheckExamAccessRequest) (*CheckExamAccessResponse, error)
-	GetAccessRequests(context.Context, *GetAccessRequestsRequest) (*GetAccessRequestsResponse, error)
-	SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error)
-	GetSubmission(context.Context, *GetSubmissionRequest) (*GetSubmissionResponse, error)
-	GetUserExamStats(context.Context, *GetUserExamStatsRequest) (*GetUserExamStatsResponse, error)
-	GetExamCount(context.Context, *GetExamCountRequest) (*GetExamCountResponse, error)
-	SaveAnswer(context.Context, *SaveAnswerRequest) (*SaveAnswerResponse, error)
-	LogViolation(context.Context, *LogViolationRequest) (*LogViolationResponse, error)
-	GetExamStatsDetailed(context.Context, *GetExamStatsDetailedRequest) (*GetExamStatsDetailedResponse, error)
-	GetExamSubmissions(context.Context, *GetExamSubmissionsRequest) (*GetExamSubmissionsResponse, error)
-	ExportExamResults(context.Context, *ExportExamResultsRequest) (*ExportExamResultsResponse, error)
-	GetExamViolations(context.Context, *GetExamViolationsRequest) (*GetExamViolationsResponse, error)
-	ExportQuestions(context.Context, *ExportQuestionsRequest) (*ExportQuestionsResponse, error)
-	StartExam(context.Context, *StartExamRequest) (*StartExamResponse, error)
-	GetExamsByClass(context.Context, *GetExamsByClassRequest) (*GetExamsByClassResponse, error)
-	AssignExamToClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error)
-	UnassignExamFromClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error)
-	GetInstructorExams(context.Context, *GetInstructorExamsRequest) (*GetInstructorExamsResponse, error)
-	GetExamPreview(context.Context, *GetExamPreviewRequest) (*GetExamDetailsResponse, error)
-	GetRecentSubmissions(context.Context, *GetRecentSubmissionsRequest) (*GetRecentSubmissionsResponse, error)
-	GetMySubmissions(context.Context, *GetMySubmissionsRequest) (*GetMySubmissionsResponse, error)
-	GradeEssay(context.Context, *GradeEssayRequest) (*GradeEssayResponse, error)
-	GetClassGradebook(context.Context, *GetClassGradebookRequest) (*GetClassGradebookResponse, error)
-	mustEmbedUnimplementedExamServiceServer()
+func (c *examServiceClient) GetItemAnalysis(ctx context.Context, in *GetItemAnalysisRequest, opts ...grpc.CallOption) (*GetItemAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetItemAnalysisResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetItemAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedExamServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedExamServiceServer struct{}
+func (c *examServiceClient) GetNextAdaptiveQuestion(ctx context.Context, in *GetNextAdaptiveQuestionRequest, opts ...grpc.CallOption) (*GetNextAdaptiveQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNextAdaptiveQuestionResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetNextAdaptiveQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedExamServiceServer) CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTopic not implemented")
+func (c *examServiceClient) ImportQTIPackage(ctx context.Context, in *ImportQTIPackageRequest, opts ...grpc.CallOption) (*ImportQTIPackageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportQTIPackageResponse)
+	err := c.cc.Invoke(ctx, ExamService_ImportQTIPackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTopics not implemented")
+
+func (c *examServiceClient) ExportQTIPackage(ctx context.Context, in *ExportQTIPackageRequest, opts ...grpc.CallOption) (*ExportQTIPackageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportQTIPackageResponse)
+	err := c.cc.Invoke(ctx, ExamService_ExportQTIPackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) CreateSection(context.Context, *CreateSectionRequest) (*CreateSectionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateSection not implemented")
+
+func (c *examServiceClient) GetQuestionHistory(ctx context.Context, in *GetQuestionHistoryRequest, opts ...grpc.CallOption) (*GetQuestionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionHistoryResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetQuestionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSections not implemented")
+
+func (c *examServiceClient) GetQuestionVersion(ctx context.Context, in *GetQuestionVersionRequest, opts ...grpc.CallOption) (*GetQuestionVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionVersionResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetQuestionVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateTopic not implemented")
+
+func (c *examServiceClient) DiffQuestionVersions(ctx context.Context, in *DiffQuestionVersionsRequest, opts ...grpc.CallOption) (*DiffQuestionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffQuestionVersionsResponse)
+	err := c.cc.Invoke(ctx, ExamService_DiffQuestionVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteTopic not implemented")
+
+func (c *examServiceClient) RegradeExam(ctx context.Context, in *RegradeExamRequest, opts ...grpc.CallOption) (*RegradeExamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegradeExamResponse)
+	err := c.cc.Invoke(ctx, ExamService_RegradeExam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) UpdateSection(context.Context, *UpdateSectionRequest) (*UpdateSectionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateSection not implemented")
+
+func (c *examServiceClient) GetRegradeHistory(ctx context.Context, in *GetRegradeHistoryRequest, opts ...grpc.CallOption) (*GetRegradeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegradeHistoryResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetRegradeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) DeleteSection(context.Context, *DeleteSectionRequest) (*DeleteSectionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteSection not implemented")
+
+func (c *examServiceClient) CreateAppeal(ctx context.Context, in *CreateAppealRequest, opts ...grpc.CallOption) (*CreateAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAppealResponse)
+	err := c.cc.Invoke(ctx, ExamService_CreateAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetQuestions not implemented")
+
+func (c *examServiceClient) GetAppealQueue(ctx context.Context, in *GetAppealQueueRequest, opts ...grpc.CallOption) (*GetAppealQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppealQueueResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetAppealQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateQuestion not implemented")
+
+func (c *examServiceClient) GetMyAppeals(ctx context.Context, in *GetMyAppealsRequest, opts ...grpc.CallOption) (*GetMyAppealsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyAppealsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetMyAppeals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) CreateBulkQuestions(context.Context, *CreateBulkQuestionsRequest) (*CreateBulkQuestionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateBulkQuestions not implemented")
+
+func (c *examServiceClient) ResolveAppeal(ctx context.Context, in *ResolveAppealRequest, opts ...grpc.CallOption) (*ResolveAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveAppealResponse)
+	err := c.cc.Invoke(ctx, ExamService_ResolveAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetQuestion not implemented")
+
+func (c *examServiceClient) CreateRubric(ctx context.Context, in *CreateRubricRequest, opts ...grpc.CallOption) (*CreateRubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRubricResponse)
+	err := c.cc.Invoke(ctx, ExamService_CreateRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ImportQuestions not implemented")
+
+func (c *examServiceClient) UpdateRubric(ctx context.Context, in *UpdateRubricRequest, opts ...grpc.CallOption) (*UpdateRubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRubricResponse)
+	err := c.cc.Invoke(ctx, ExamService_UpdateRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateQuestion not implemented")
+
+func (c *examServiceClient) GetRubric(ctx context.Context, in *GetRubricRequest, opts ...grpc.CallOption) (*GetRubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRubricResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteQuestion not implemented")
+
+func (c *examServiceClient) GetRubrics(ctx context.Context, in *GetRubricsRequest, opts ...grpc.CallOption) (*GetRubricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRubricsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetRubrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) DeleteBulkQuestions(context.Context, *DeleteBulkQuestionsRequest) (*DeleteBulkQuestionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteBulkQuestions not implemented")
+
+func (c *examServiceClient) DeleteRubric(ctx context.Context, in *DeleteRubricRequest, opts ...grpc.CallOption) (*DeleteRubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRubricResponse)
+	err := c.cc.Invoke(ctx, ExamService_DeleteRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetUploadURL(context.Context, *GetUploadURLRequest) (*GetUploadURLResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetUploadURL not implemented")
+
+func (c *examServiceClient) SetQuestionRubric(ctx context.Context, in *SetQuestionRubricRequest, opts ...grpc.CallOption) (*SetQuestionRubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQuestionRubricResponse)
+	err := c.cc.Invoke(ctx, ExamService_SetQuestionRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateExam not implemented")
+
+func (c *examServiceClient) ConfigureMarking(ctx context.Context, in *ConfigureMarkingRequest, opts ...grpc.CallOption) (*ConfigureMarkingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigureMarkingResponse)
+	err := c.cc.Invoke(ctx, ExamService_ConfigureMarking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GenerateExam(context.Context, *GenerateExamRequest) (*CreateExamResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GenerateExam not implemented")
+
+func (c *examServiceClient) AssignMarkers(ctx context.Context, in *AssignMarkersRequest, opts ...grpc.CallOption) (*AssignMarkersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignMarkersResponse)
+	err := c.cc.Invoke(ctx, ExamService_AssignMarkers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetExamDetails(context.Context, *GetExamDetailsRequest) (*GetExamDetailsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExamDetails not implemented")
+
+func (c *examServiceClient) GetMarkingTasks(ctx context.Context, in *GetMarkingTasksRequest, opts ...grpc.CallOption) (*GetMarkingTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarkingTasksResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetMarkingTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetExams(context.Context, *GetExamsRequest) (*GetExamsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExams not implemented")
+
+func (c *examServiceClient) GetMarkingTask(ctx context.Context, in *GetMarkingTaskRequest, opts ...grpc.CallOption) (*GetMarkingTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarkingTaskResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetMarkingTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateExam not implemented")
+
+func (c *examServiceClient) SubmitMarks(ctx context.Context, in *SubmitMarksRequest, opts ...grpc.CallOption) (*SubmitMarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitMarksResponse)
+	err := c.cc.Invoke(ctx, ExamService_SubmitMarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteExam not implemented")
+
+func (c *examServiceClient) GetMarkingOverview(ctx context.Context, in *GetMarkingOverviewRequest, opts ...grpc.CallOption) (*GetMarkingOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarkingOverviewResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetMarkingOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) PublishExam(context.Context, *PublishExamRequest) (*PublishExamResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method PublishExam not implemented")
+
+func (c *examServiceClient) SaveAccommodation(ctx context.Context, in *SaveAccommodationRequest, opts ...grpc.CallOption) (*SaveAccommodationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveAccommodationResponse)
+	err := c.cc.Invoke(ctx, ExamService_SaveAccommodation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) RequestExamAccess(context.Context, *RequestExamAccessRequest) (*RequestExamAccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RequestExamAccess not implemented")
+
+func (c *examServiceClient) GetAccommodations(ctx context.Context, in *GetAccommodationsRequest, opts ...grpc.CallOption) (*GetAccommodationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccommodationsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetAccommodations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) ApproveExamAccess(context.Context, *ApproveExamAccessRequest) (*ApproveExamAccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ApproveExamAccess not implemented")
+
+func (c *examServiceClient) DeleteAccommodation(ctx context.Context, in *DeleteAccommodationRequest, opts ...grpc.CallOption) (*DeleteAccommodationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccommodationResponse)
+	err := c.cc.Invoke(ctx, ExamService_DeleteAccommodation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) CheckExamAccess(context.Context, *CheckExamAccessRequest) (*CheckExamAccessResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CheckExamAccess not implemented")
+
+func (c *examServiceClient) SaveExamAccommodation(ctx context.Context, in *SaveExamAccommodationRequest, opts ...grpc.CallOption) (*SaveExamAccommodationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveExamAccommodationResponse)
+	err := c.cc.Invoke(ctx, ExamService_SaveExamAccommodation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetAccessRequests(context.Context, *GetAccessRequestsRequest) (*GetAccessRequestsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAccessRequests not implemented")
+
+func (c *examServiceClient) GetExamAccommodations(ctx context.Context, in *GetExamAccommodationsRequest, opts ...grpc.CallOption) (*GetExamAccommodationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExamAccommodationsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetExamAccommodations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SubmitExam not implemented")
+
+func (c *examServiceClient) DeleteExamAccommodation(ctx context.Context, in *DeleteExamAccommodationRequest, opts ...grpc.CallOption) (*DeleteExamAccommodationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExamAccommodationResponse)
+	err := c.cc.Invoke(ctx, ExamService_DeleteExamAccommodation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetSubmission(context.Context, *GetSubmissionRequest) (*GetSubmissionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSubmission not implemented")
+
+func (c *examServiceClient) ControlExamSession(ctx context.Context, in *ControlExamSessionRequest, opts ...grpc.CallOption) (*ControlExamSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlExamSessionResponse)
+	err := c.cc.Invoke(ctx, ExamService_ControlExamSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetUserExamStats(context.Context, *GetUserExamStatsRequest) (*GetUserExamStatsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetUserExamStats not implemented")
+
+func (c *examServiceClient) GetExamSessionActions(ctx context.Context, in *GetExamSessionActionsRequest, opts ...grpc.CallOption) (*GetExamSessionActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExamSessionActionsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetExamSessionActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetExamCount(context.Context, *GetExamCountRequest) (*GetExamCountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExamCount not implemented")
+
+func (c *examServiceClient) GetExamSessionState(ctx context.Context, in *GetExamSessionStateRequest, opts ...grpc.CallOption) (*GetExamSessionStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExamSessionStateResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetExamSessionState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) SaveAnswer(context.Context, *SaveAnswerRequest) (*SaveAnswerResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveAnswer not implemented")
+
+func (c *examServiceClient) GetSuspicionConfig(ctx context.Context, in *GetSuspicionConfigRequest, opts ...grpc.CallOption) (*GetSuspicionConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSuspicionConfigResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetSuspicionConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) LogViolation(context.Context, *LogViolationRequest) (*LogViolationResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method LogViolation not implemented")
+
+func (c *examServiceClient) UpdateSuspicionConfig(ctx context.Context, in *UpdateSuspicionConfigRequest, opts ...grpc.CallOption) (*UpdateSuspicionConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSuspicionConfigResponse)
+	err := c.cc.Invoke(ctx, ExamService_UpdateSuspicionConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetExamStatsDetailed(context.Context, *GetExamStatsDetailedRequest) (*GetExamStatsDetailedResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExamStatsDetailed not implemented")
+
+func (c *examServiceClient) AnalyzeCollusion(ctx context.Context, in *AnalyzeCollusionRequest, opts ...grpc.CallOption) (*AnalyzeCollusionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeCollusionResponse)
+	err := c.cc.Invoke(ctx, ExamService_AnalyzeCollusion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetExamSubmissions(context.Context, *GetExamSubmissionsRequest) (*GetExamSubmissionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExamSubmissions not implemented")
+
+func (c *examServiceClient) GetCollusionReport(ctx context.Context, in *GetCollusionReportRequest, opts ...grpc.CallOption) (*GetCollusionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollusionReportResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetCollusionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) ExportExamResults(context.Context, *ExportExamResultsRequest) (*ExportExamResultsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ExportExamResults not implemented")
+
+func (c *examServiceClient) SaveSimilaritySources(ctx context.Context, in *SaveSimilaritySourcesRequest, opts ...grpc.CallOption) (*SaveSimilaritySourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSimilaritySourcesResponse)
+	err := c.cc.Invoke(ctx, ExamService_SaveSimilaritySources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetExamViolations(context.Context, *GetExamViolationsRequest) (*GetExamViolationsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExamViolations not implemented")
+
+func (c *examServiceClient) GetSimilaritySources(ctx context.Context, in *GetSimilaritySourcesRequest, opts ...grpc.CallOption) (*GetSimilaritySourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimilaritySourcesResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetSimilaritySources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) ExportQuestions(context.Context, *ExportQuestionsRequest) (*ExportQuestionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ExportQuestions not implemented")
+
+func (c *examServiceClient) CheckEssaySimilarity(ctx context.Context, in *CheckEssaySimilarityRequest, opts ...grpc.CallOption) (*CheckEssaySimilarityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckEssaySimilarityResponse)
+	err := c.cc.Invoke(ctx, ExamService_CheckEssaySimilarity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) StartExam(context.Context, *StartExamRequest) (*StartExamResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method StartExam not implemented")
+
+func (c *examServiceClient) GetEssaySimilarity(ctx context.Context, in *GetEssaySimilarityRequest, opts ...grpc.CallOption) (*GetEssaySimilarityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEssaySimilarityResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetEssaySimilarity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) GetExamsByClass(context.Context, *GetExamsByClassRequest) (*GetExamsByClassResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExamsByClass not implemented")
+
+func (c *examServiceClient) GetPracticeReport(ctx context.Context, in *GetPracticeReportRequest, opts ...grpc.CallOption) (*GetPracticeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPracticeReportResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetPracticeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) AssignExamToClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AssignExamToClass not implemented")
+
+func (c *examServiceClient) GetDueReviews(ctx context.Context, in *GetDueReviewsRequest, opts ...grpc.CallOption) (*GetDueReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDueReviewsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetDueReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedExamServiceServer) UnassignExamFromClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UnassignExamFromClass not implemented")
+
+func (c *examServiceClient) RecordReview(ctx context.Context, in *RecordReviewRequest, opts ...grpc.CallOption) (*RecordReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordReviewResponse)
+	err := c.cc.Invoke(ctx, ExamService_RecordReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GeneratePaperExam(ctx context.Context, in *GeneratePaperExamRequest, opts ...grpc.CallOption) (*GeneratePaperExamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePaperExamResponse)
+	err := c.cc.Invoke(ctx, ExamService_GeneratePaperExam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GenerateAnswerSheets(ctx context.Context, in *GenerateAnswerSheetsRequest, opts ...grpc.CallOption) (*GenerateAnswerSheetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateAnswerSheetsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GenerateAnswerSheets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GradeScannedSheets(ctx context.Context, in *GradeScannedSheetsRequest, opts ...grpc.CallOption) (*GradeScannedSheetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeScannedSheetsResponse)
+	err := c.cc.Invoke(ctx, ExamService_GradeScannedSheets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GetAnswerSheetScans(ctx context.Context, in *GetAnswerSheetScansRequest, opts ...grpc.CallOption) (*GetAnswerSheetScansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnswerSheetScansResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetAnswerSheetScans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ResolveAnswerSheetScan(ctx context.Context, in *ResolveAnswerSheetScanRequest, opts ...grpc.CallOption) (*ResolveAnswerSheetScanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveAnswerSheetScanResponse)
+	err := c.cc.Invoke(ctx, ExamService_ResolveAnswerSheetScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) CloneExam(ctx context.Context, in *CloneExamRequest, opts ...grpc.CallOption) (*CloneExamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneExamResponse)
+	err := c.cc.Invoke(ctx, ExamService_CloneExam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) CreateExamTemplate(ctx context.Context, in *CreateExamTemplateRequest, opts ...grpc.CallOption) (*CreateExamTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExamTemplateResponse)
+	err := c.cc.Invoke(ctx, ExamService_CreateExamTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) GetExamTemplates(ctx context.Context, in *GetExamTemplatesRequest, opts ...grpc.CallOption) (*GetExamTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExamTemplatesResponse)
+	err := c.cc.Invoke(ctx, ExamService_GetExamTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) DeleteExamTemplate(ctx context.Context, in *DeleteExamTemplateRequest, opts ...grpc.CallOption) (*DeleteExamTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExamTemplateResponse)
+	err := c.cc.Invoke(ctx, ExamService_DeleteExamTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) InstantiateExamTemplate(ctx context.Context, in *InstantiateExamTemplateRequest, opts ...grpc.CallOption) (*InstantiateExamTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstantiateExamTemplateResponse)
+	err := c.cc.Invoke(ctx, ExamService_InstantiateExamTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ValidateExamBlueprint(ctx context.Context, in *ValidateExamBlueprintRequest, opts ...grpc.CallOption) (*ValidateExamBlueprintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateExamBlueprintResponse)
+	err := c.cc.Invoke(ctx, ExamService_ValidateExamBlueprint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExamServiceServer is the server API for ExamService service.
+// All implementations must embed UnimplementedExamServiceServer
+// for forward compatibility.
+type ExamServiceServer interface {
+	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error)
+	GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error)
+	CreateSection(context.Context, *CreateSectionRequest) (*CreateSectionResponse, error)
+	GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error)
+	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
+	DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error)
+	UpdateSection(context.Context, *UpdateSectionRequest) (*UpdateSectionResponse, error)
+	DeleteSection(context.Context, *DeleteSectionRequest) (*DeleteSectionResponse, error)
+	GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error)
+	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
+	CreateBulkQuestions(context.Context, *CreateBulkQuestionsRequest) (*CreateBulkQuestionsResponse, error)
+	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
+	ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error)
+	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
+	DeleteBulkQuestions(context.Context, *DeleteBulkQuestionsRequest) (*DeleteBulkQuestionsResponse, error)
+	GetUploadURL(context.Context, *GetUploadURLRequest) (*GetUploadURLResponse, error)
+	CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error)
+	GenerateExam(context.Context, *GenerateExamRequest) (*CreateExamResponse, error)
+	GetExamDetails(context.Context, *GetExamDetailsRequest) (*GetExamDetailsResponse, error)
+	GetExams(context.Context, *GetExamsRequest) (*GetExamsResponse, error)
+	UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error)
+	DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error)
+	PublishExam(context.Context, *PublishExamRequest) (*PublishExamResponse, error)
+	RequestExamAccess(context.Context, *RequestExamAccessRequest) (*RequestExamAccessResponse, error)
+	ApproveExamAccess(context.Context, *ApproveExamAccessRequest) (*ApproveExamAccessResponse, error)
+	CheckExamAccess(context.Context, *CheckExamAccessRequest) (*CheckExamAccessResponse, error)
+	GetAccessRequests(context.Context, *GetAccessRequestsRequest) (*GetAccessRequestsResponse, error)
+	SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error)
+	GetSubmission(context.Context, *GetSubmissionRequest) (*GetSubmissionResponse, error)
+	GetUserExamStats(context.Context, *GetUserExamStatsRequest) (*GetUserExamStatsResponse, error)
+	GetExamCount(context.Context, *GetExamCountRequest) (*GetExamCountResponse, error)
+	SaveAnswer(context.Context, *SaveAnswerRequest) (*SaveAnswerResponse, error)
+	LogViolation(context.Context, *LogViolationRequest) (*LogViolationResponse, error)
+	GetExamStatsDetailed(context.Context, *GetExamStatsDetailedRequest) (*GetExamStatsDetailedResponse, error)
+	GetExamSubmissions(context.Context, *GetExamSubmissionsRequest) (*GetExamSubmissionsResponse, error)
+	ExportExamResults(context.Context, *ExportExamResultsRequest) (*ExportExamResultsResponse, error)
+	GetExamViolations(context.Context, *GetExamViolationsRequest) (*GetExamViolationsResponse, error)
+	ExportQuestions(context.Context, *ExportQuestionsRequest) (*ExportQuestionsResponse, error)
+	StartExam(context.Context, *StartExamRequest) (*StartExamResponse, error)
+	GetExamsByClass(context.Context, *GetExamsByClassRequest) (*GetExamsByClassResponse, error)
+	AssignExamToClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error)
+	UnassignExamFromClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error)
+	GetInstructorExams(context.Context, *GetInstructorExamsRequest) (*GetInstructorExamsResponse, error)
+	GetExamPreview(context.Context, *GetExamPreviewRequest) (*GetExamDetailsResponse, error)
+	GetRecentSubmissions(context.Context, *GetRecentSubmissionsRequest) (*GetRecentSubmissionsResponse, error)
+	GetMySubmissions(context.Context, *GetMySubmissionsRequest) (*GetMySubmissionsResponse, error)
+	GradeEssay(context.Context, *GradeEssayRequest) (*GradeEssayResponse, error)
+	GetClassGradebook(context.Context, *GetClassGradebookRequest) (*GetClassGradebookResponse, error)
+	GetItemAnalysis(context.Context, *GetItemAnalysisRequest) (*GetItemAnalysisResponse, error)
+	GetNextAdaptiveQuestion(context.Context, *GetNextAdaptiveQuestionRequest) (*GetNextAdaptiveQuestionResponse, error)
+	ImportQTIPackage(context.Context, *ImportQTIPackageRequest) (*ImportQTIPackageResponse, error)
+	ExportQTIPackage(context.Context, *ExportQTIPackageRequest) (*ExportQTIPackageResponse, error)
+	GetQuestionHistory(context.Context, *GetQuestionHistoryRequest) (*GetQuestionHistoryResponse, error)
+	GetQuestionVersion(context.Context, *GetQuestionVersionRequest) (*GetQuestionVersionResponse, error)
+	DiffQuestionVersions(context.Context, *DiffQuestionVersionsRequest) (*DiffQuestionVersionsResponse, error)
+	RegradeExam(context.Context, *RegradeExamRequest) (*RegradeExamResponse, error)
+	GetRegradeHistory(context.Context, *GetRegradeHistoryRequest) (*GetRegradeHistoryResponse, error)
+	CreateAppeal(context.Context, *CreateAppealRequest) (*CreateAppealResponse, error)
+	GetAppealQueue(context.Context, *GetAppealQueueRequest) (*GetAppealQueueResponse, error)
+	GetMyAppeals(context.Context, *GetMyAppealsRequest) (*GetMyAppealsResponse, error)
+	ResolveAppeal(context.Context, *ResolveAppealRequest) (*ResolveAppealResponse, error)
+	CreateRubric(context.Context, *CreateRubricRequest) (*CreateRubricResponse, error)
+	UpdateRubric(context.Context, *UpdateRubricRequest) (*UpdateRubricResponse, error)
+	GetRubric(context.Context, *GetRubricRequest) (*GetRubricResponse, error)
+	GetRubrics(context.Context, *GetRubricsRequest) (*GetRubricsResponse, error)
+	DeleteRubric(context.Context, *DeleteRubricRequest) (*DeleteRubricResponse, error)
+	SetQuestionRubric(context.Context, *SetQuestionRubricRequest) (*SetQuestionRubricResponse, error)
+	ConfigureMarking(context.Context, *ConfigureMarkingRequest) (*ConfigureMarkingResponse, error)
+	AssignMarkers(context.Context, *AssignMarkersRequest) (*AssignMarkersResponse, error)
+	GetMarkingTasks(context.Context, *GetMarkingTasksRequest) (*GetMarkingTasksResponse, error)
+	GetMarkingTask(context.Context, *GetMarkingTaskRequest) (*GetMarkingTaskResponse, error)
+	SubmitMarks(context.Context, *SubmitMarksRequest) (*SubmitMarksResponse, error)
+	GetMarkingOverview(context.Context, *GetMarkingOverviewRequest) (*GetMarkingOverviewResponse, error)
+	SaveAccommodation(context.Context, *SaveAccommodationRequest) (*SaveAccommodationResponse, error)
+	GetAccommodations(context.Context, *GetAccommodationsRequest) (*GetAccommodationsResponse, error)
+	DeleteAccommodation(context.Context, *DeleteAccommodationRequest) (*DeleteAccommodationResponse, error)
+	SaveExamAccommodation(context.Context, *SaveExamAccommodationRequest) (*SaveExamAccommodationResponse, error)
+	GetExamAccommodations(context.Context, *GetExamAccommodationsRequest) (*GetExamAccommodationsResponse, error)
+	DeleteExamAccommodation(context.Context, *DeleteExamAccommodationRequest) (*DeleteExamAccommodationResponse, error)
+	ControlExamSession(context.Context, *ControlExamSessionRequest) (*ControlExamSessionResponse, error)
+	GetExamSessionActions(context.Context, *GetExamSessionActionsRequest) (*GetExamSessionActionsResponse, error)
+	GetExamSessionState(context.Context, *GetExamSessionStateRequest) (*GetExamSessionStateResponse, error)
+	GetSuspicionConfig(context.Context, *GetSuspicionConfigRequest) (*GetSuspicionConfigResponse, error)
+	UpdateSuspicionConfig(context.Context, *UpdateSuspicionConfigRequest) (*UpdateSuspicionConfigResponse, error)
+	AnalyzeCollusion(context.Context, *AnalyzeCollusionRequest) (*AnalyzeCollusionResponse, error)
+	GetCollusionReport(context.Context, *GetCollusionReportRequest) (*GetCollusionReportResponse, error)
+	SaveSimilaritySources(context.Context, *SaveSimilaritySourcesRequest) (*SaveSimilaritySourcesResponse, error)
+	GetSimilaritySources(context.Context, *GetSimilaritySourcesRequest) (*GetSimilaritySourcesResponse, error)
+	CheckEssaySimilarity(context.Context, *CheckEssaySimilarityRequest) (*CheckEssaySimilarityResponse, error)
+	GetEssaySimilarity(context.Context, *GetEssaySimilarityRequest) (*GetEssaySimilarityResponse, error)
+	GetPracticeReport(context.Context, *GetPracticeReportRequest) (*GetPracticeReportResponse, error)
+	GetDueReviews(context.Context, *GetDueReviewsRequest) (*GetDueReviewsResponse, error)
+	RecordReview(context.Context, *RecordReviewRequest) (*RecordReviewResponse, error)
+	GeneratePaperExam(context.Context, *GeneratePaperExamRequest) (*GeneratePaperExamResponse, error)
+	GenerateAnswerSheets(context.Context, *GenerateAnswerSheetsRequest) (*GenerateAnswerSheetsResponse, error)
+	GradeScannedSheets(context.Context, *GradeScannedSheetsRequest) (*GradeScannedSheetsResponse, error)
+	GetAnswerSheetScans(context.Context, *GetAnswerSheetScansRequest) (*GetAnswerSheetScansResponse, error)
+	ResolveAnswerSheetScan(context.Context, *ResolveAnswerSheetScanRequest) (*ResolveAnswerSheetScanResponse, error)
+	CloneExam(context.Context, *CloneExamRequest) (*CloneExamResponse, error)
+	CreateExamTemplate(context.Context, *CreateExamTemplateRequest) (*CreateExamTemplateResponse, error)
+	GetExamTemplates(context.Context, *GetExamTemplatesRequest) (*GetExamTemplatesResponse, error)
+	DeleteExamTemplate(context.Context, *DeleteExamTemplateRequest) (*DeleteExamTemplateResponse, error)
+	InstantiateExamTemplate(context.Context, *InstantiateExamTemplateRequest) (*InstantiateExamTemplateResponse, error)
+	ValidateExamBlueprint(context.Context, *ValidateExamBlueprintRequest) (*ValidateExamBlueprintResponse, error)
+	mustEmbedUnimplementedExamServiceServer()
+}
+
+// UnimplementedExamServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExamServiceServer struct{}
+
+func (UnimplementedExamServiceServer) CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTopic not implemented")
+}
+func (UnimplementedExamServiceServer) GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTopics not implemented")
+}
+func (UnimplementedExamServiceServer) CreateSection(context.Context, *CreateSectionRequest) (*CreateSectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSection not implemented")
+}
+func (UnimplementedExamServiceServer) GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSections not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTopic not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTopic not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateSection(context.Context, *UpdateSectionRequest) (*UpdateSectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSection not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteSection(context.Context, *DeleteSectionRequest) (*DeleteSectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSection not implemented")
+}
+func (UnimplementedExamServiceServer) GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) CreateBulkQuestions(context.Context, *CreateBulkQuestionsRequest) (*CreateBulkQuestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBulkQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) ImportQuestions(context.Context, *ImportQuestionsRequest) (*ImportQuestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteBulkQuestions(context.Context, *DeleteBulkQuestionsRequest) (*DeleteBulkQuestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBulkQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) GetUploadURL(context.Context, *GetUploadURLRequest) (*GetUploadURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUploadURL not implemented")
+}
+func (UnimplementedExamServiceServer) CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExam not implemented")
+}
+func (UnimplementedExamServiceServer) GenerateExam(context.Context, *GenerateExamRequest) (*CreateExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateExam not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamDetails(context.Context, *GetExamDetailsRequest) (*GetExamDetailsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamDetails not implemented")
+}
+func (UnimplementedExamServiceServer) GetExams(context.Context, *GetExamsRequest) (*GetExamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExams not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateExam not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExam not implemented")
+}
+func (UnimplementedExamServiceServer) PublishExam(context.Context, *PublishExamRequest) (*PublishExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishExam not implemented")
+}
+func (UnimplementedExamServiceServer) RequestExamAccess(context.Context, *RequestExamAccessRequest) (*RequestExamAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestExamAccess not implemented")
+}
+func (UnimplementedExamServiceServer) ApproveExamAccess(context.Context, *ApproveExamAccessRequest) (*ApproveExamAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveExamAccess not implemented")
+}
+func (UnimplementedExamServiceServer) CheckExamAccess(context.Context, *CheckExamAccessRequest) (*CheckExamAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckExamAccess not implemented")
+}
+func (UnimplementedExamServiceServer) GetAccessRequests(context.Context, *GetAccessRequestsRequest) (*GetAccessRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccessRequests not implemented")
+}
+func (UnimplementedExamServiceServer) SubmitExam(context.Context, *SubmitExamRequest) (*SubmitExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitExam not implemented")
+}
+func (UnimplementedExamServiceServer) GetSubmission(context.Context, *GetSubmissionRequest) (*GetSubmissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubmission not implemented")
+}
+func (UnimplementedExamServiceServer) GetUserExamStats(context.Context, *GetUserExamStatsRequest) (*GetUserExamStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserExamStats not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamCount(context.Context, *GetExamCountRequest) (*GetExamCountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamCount not implemented")
+}
+func (UnimplementedExamServiceServer) SaveAnswer(context.Context, *SaveAnswerRequest) (*SaveAnswerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveAnswer not implemented")
+}
+func (UnimplementedExamServiceServer) LogViolation(context.Context, *LogViolationRequest) (*LogViolationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogViolation not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamStatsDetailed(context.Context, *GetExamStatsDetailedRequest) (*GetExamStatsDetailedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamStatsDetailed not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamSubmissions(context.Context, *GetExamSubmissionsRequest) (*GetExamSubmissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamSubmissions not implemented")
+}
+func (UnimplementedExamServiceServer) ExportExamResults(context.Context, *ExportExamResultsRequest) (*ExportExamResultsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportExamResults not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamViolations(context.Context, *GetExamViolationsRequest) (*GetExamViolationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamViolations not implemented")
+}
+func (UnimplementedExamServiceServer) ExportQuestions(context.Context, *ExportQuestionsRequest) (*ExportQuestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) StartExam(context.Context, *StartExamRequest) (*StartExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartExam not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamsByClass(context.Context, *GetExamsByClassRequest) (*GetExamsByClassResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamsByClass not implemented")
+}
+func (UnimplementedExamServiceServer) AssignExamToClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignExamToClass not implemented")
+}
+func (UnimplementedExamServiceServer) UnassignExamFromClass(context.Context, *AssignExamToClassRequest) (*AssignExamToClassResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnassignExamFromClass not implemented")
+}
+func (UnimplementedExamServiceServer) GetInstructorExams(context.Context, *GetInstructorExamsRequest) (*GetInstructorExamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInstructorExams not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamPreview(context.Context, *GetExamPreviewRequest) (*GetExamDetailsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamPreview not implemented")
+}
+func (UnimplementedExamServiceServer) GetRecentSubmissions(context.Context, *GetRecentSubmissionsRequest) (*GetRecentSubmissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecentSubmissions not implemented")
+}
+func (UnimplementedExamServiceServer) GetMySubmissions(context.Context, *GetMySubmissionsRequest) (*GetMySubmissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMySubmissions not implemented")
+}
+func (UnimplementedExamServiceServer) GradeEssay(context.Context, *GradeEssayRequest) (*GradeEssayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GradeEssay not implemented")
+}
+func (UnimplementedExamServiceServer) GetClassGradebook(context.Context, *GetClassGradebookRequest) (*GetClassGradebookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClassGradebook not implemented")
+}
+func (UnimplementedExamServiceServer) GetItemAnalysis(context.Context, *GetItemAnalysisRequest) (*GetItemAnalysisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetItemAnalysis not implemented")
+}
+func (UnimplementedExamServiceServer) GetNextAdaptiveQuestion(context.Context, *GetNextAdaptiveQuestionRequest) (*GetNextAdaptiveQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNextAdaptiveQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) ImportQTIPackage(context.Context, *ImportQTIPackageRequest) (*ImportQTIPackageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportQTIPackage not implemented")
+}
+func (UnimplementedExamServiceServer) ExportQTIPackage(context.Context, *ExportQTIPackageRequest) (*ExportQTIPackageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportQTIPackage not implemented")
+}
+func (UnimplementedExamServiceServer) GetQuestionHistory(context.Context, *GetQuestionHistoryRequest) (*GetQuestionHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuestionHistory not implemented")
+}
+func (UnimplementedExamServiceServer) GetQuestionVersion(context.Context, *GetQuestionVersionRequest) (*GetQuestionVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuestionVersion not implemented")
+}
+func (UnimplementedExamServiceServer) DiffQuestionVersions(context.Context, *DiffQuestionVersionsRequest) (*DiffQuestionVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffQuestionVersions not implemented")
+}
+func (UnimplementedExamServiceServer) RegradeExam(context.Context, *RegradeExamRequest) (*RegradeExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegradeExam not implemented")
+}
+func (UnimplementedExamServiceServer) GetRegradeHistory(context.Context, *GetRegradeHistoryRequest) (*GetRegradeHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRegradeHistory not implemented")
+}
+func (UnimplementedExamServiceServer) CreateAppeal(context.Context, *CreateAppealRequest) (*CreateAppealResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAppeal not implemented")
+}
+func (UnimplementedExamServiceServer) GetAppealQueue(context.Context, *GetAppealQueueRequest) (*GetAppealQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAppealQueue not implemented")
+}
+func (UnimplementedExamServiceServer) GetMyAppeals(context.Context, *GetMyAppealsRequest) (*GetMyAppealsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyAppeals not implemented")
+}
+func (UnimplementedExamServiceServer) ResolveAppeal(context.Context, *ResolveAppealRequest) (*ResolveAppealResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveAppeal not implemented")
+}
+func (UnimplementedExamServiceServer) CreateRubric(context.Context, *CreateRubricRequest) (*CreateRubricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRubric not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateRubric(context.Context, *UpdateRubricRequest) (*UpdateRubricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRubric not implemented")
+}
+func (UnimplementedExamServiceServer) GetRubric(context.Context, *GetRubricRequest) (*GetRubricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRubric not implemented")
+}
+func (UnimplementedExamServiceServer) GetRubrics(context.Context, *GetRubricsRequest) (*GetRubricsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRubrics not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteRubric(context.Context, *DeleteRubricRequest) (*DeleteRubricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRubric not implemented")
+}
+func (UnimplementedExamServiceServer) SetQuestionRubric(context.Context, *SetQuestionRubricRequest) (*SetQuestionRubricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetQuestionRubric not implemented")
+}
+func (UnimplementedExamServiceServer) ConfigureMarking(context.Context, *ConfigureMarkingRequest) (*ConfigureMarkingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfigureMarking not implemented")
+}
+func (UnimplementedExamServiceServer) AssignMarkers(context.Context, *AssignMarkersRequest) (*AssignMarkersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignMarkers not implemented")
+}
+func (UnimplementedExamServiceServer) GetMarkingTasks(context.Context, *GetMarkingTasksRequest) (*GetMarkingTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarkingTasks not implemented")
+}
+func (UnimplementedExamServiceServer) GetMarkingTask(context.Context, *GetMarkingTaskRequest) (*GetMarkingTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarkingTask not implemented")
+}
+func (UnimplementedExamServiceServer) SubmitMarks(context.Context, *SubmitMarksRequest) (*SubmitMarksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitMarks not implemented")
+}
+func (UnimplementedExamServiceServer) GetMarkingOverview(context.Context, *GetMarkingOverviewRequest) (*GetMarkingOverviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarkingOverview not implemented")
+}
+func (UnimplementedExamServiceServer) SaveAccommodation(context.Context, *SaveAccommodationRequest) (*SaveAccommodationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveAccommodation not implemented")
+}
+func (UnimplementedExamServiceServer) GetAccommodations(context.Context, *GetAccommodationsRequest) (*GetAccommodationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccommodations not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteAccommodation(context.Context, *DeleteAccommodationRequest) (*DeleteAccommodationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAccommodation not implemented")
+}
+func (UnimplementedExamServiceServer) SaveExamAccommodation(context.Context, *SaveExamAccommodationRequest) (*SaveExamAccommodationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveExamAccommodation not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamAccommodations(context.Context, *GetExamAccommodationsRequest) (*GetExamAccommodationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamAccommodations not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteExamAccommodation(context.Context, *DeleteExamAccommodationRequest) (*DeleteExamAccommodationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExamAccommodation not implemented")
+}
+func (UnimplementedExamServiceServer) ControlExamSession(context.Context, *ControlExamSessionRequest) (*ControlExamSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ControlExamSession not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamSessionActions(context.Context, *GetExamSessionActionsRequest) (*GetExamSessionActionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamSessionActions not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamSessionState(context.Context, *GetExamSessionStateRequest) (*GetExamSessionStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamSessionState not implemented")
+}
+func (UnimplementedExamServiceServer) GetSuspicionConfig(context.Context, *GetSuspicionConfigRequest) (*GetSuspicionConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSuspicionConfig not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateSuspicionConfig(context.Context, *UpdateSuspicionConfigRequest) (*UpdateSuspicionConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSuspicionConfig not implemented")
+}
+func (UnimplementedExamServiceServer) AnalyzeCollusion(context.Context, *AnalyzeCollusionRequest) (*AnalyzeCollusionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnalyzeCollusion not implemented")
+}
+func (UnimplementedExamServiceServer) GetCollusionReport(context.Context, *GetCollusionReportRequest) (*GetCollusionReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollusionReport not implemented")
+}
+func (UnimplementedExamServiceServer) SaveSimilaritySources(context.Context, *SaveSimilaritySourcesRequest) (*SaveSimilaritySourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveSimilaritySources not implemented")
+}
+func (UnimplementedExamServiceServer) GetSimilaritySources(context.Context, *GetSimilaritySourcesRequest) (*GetSimilaritySourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSimilaritySources not implemented")
+}
+func (UnimplementedExamServiceServer) CheckEssaySimilarity(context.Context, *CheckEssaySimilarityRequest) (*CheckEssaySimilarityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckEssaySimilarity not implemented")
+}
+func (UnimplementedExamServiceServer) GetEssaySimilarity(context.Context, *GetEssaySimilarityRequest) (*GetEssaySimilarityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEssaySimilarity not implemented")
+}
+func (UnimplementedExamServiceServer) GetPracticeReport(context.Context, *GetPracticeReportRequest) (*GetPracticeReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPracticeReport not implemented")
+}
+func (UnimplementedExamServiceServer) GetDueReviews(context.Context, *GetDueReviewsRequest) (*GetDueReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDueReviews not implemented")
+}
+func (UnimplementedExamServiceServer) RecordReview(context.Context, *RecordReviewRequest) (*RecordReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordReview not implemented")
+}
+func (UnimplementedExamServiceServer) GeneratePaperExam(context.Context, *GeneratePaperExamRequest) (*GeneratePaperExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GeneratePaperExam not implemented")
+}
+func (UnimplementedExamServiceServer) GenerateAnswerSheets(context.Context, *GenerateAnswerSheetsRequest) (*GenerateAnswerSheetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateAnswerSheets not implemented")
+}
+func (UnimplementedExamServiceServer) GradeScannedSheets(context.Context, *GradeScannedSheetsRequest) (*GradeScannedSheetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GradeScannedSheets not implemented")
+}
+func (UnimplementedExamServiceServer) GetAnswerSheetScans(context.Context, *GetAnswerSheetScansRequest) (*GetAnswerSheetScansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnswerSheetScans not implemented")
+}
+func (UnimplementedExamServiceServer) ResolveAnswerSheetScan(context.Context, *ResolveAnswerSheetScanRequest) (*ResolveAnswerSheetScanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveAnswerSheetScan not implemented")
+}
+func (UnimplementedExamServiceServer) CloneExam(context.Context, *CloneExamRequest) (*CloneExamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneExam not implemented")
+}
+func (UnimplementedExamServiceServer) CreateExamTemplate(context.Context, *CreateExamTemplateRequest) (*CreateExamTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExamTemplate not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamTemplates(context.Context, *GetExamTemplatesRequest) (*GetExamTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExamTemplates not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteExamTemplate(context.Context, *DeleteExamTemplateRequest) (*DeleteExamTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExamTemplate not implemented")
+}
+func (UnimplementedExamServiceServer) InstantiateExamTemplate(context.Context, *InstantiateExamTemplateRequest) (*InstantiateExamTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InstantiateExamTemplate not implemented")
+}
+func (UnimplementedExamServiceServer) ValidateExamBlueprint(context.Context, *ValidateExamBlueprintRequest) (*ValidateExamBlueprintResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateExamBlueprint not implemented")
+}
+func (UnimplementedExamServiceServer) mustEmbedUnimplementedExamServiceServer() {}
+func (UnimplementedExamServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeExamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExamServiceServer will
+// result in compilation errors.
+type UnsafeExamServiceServer interface {
+	mustEmbedUnimplementedExamServiceServer()
+}
+
+func RegisterExamServiceServer(s grpc.ServiceRegistrar, srv ExamServiceServer) {
+	// If the following call panics, it indicates UnimplementedExamServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExamService_ServiceDesc, srv)
+}
+
+func _ExamService_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_CreateTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateTopic(ctx, req.(*CreateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetTopics(ctx, req.(*GetTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_CreateSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateSection(ctx, req.(*CreateSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetSections(ctx, req.(*GetSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_UpdateTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_DeleteTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteTopic(ctx, req.(*DeleteTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_UpdateSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateSection(ctx, req.(*UpdateSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_DeleteSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteSection(ctx, req.(*DeleteSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetQuestions(ctx, req.(*GetQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_CreateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreateBulkQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBulkQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateBulkQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_CreateBulkQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateBulkQuestions(ctx, req.(*CreateBulkQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetQuestion(ctx, req.(*GetQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ImportQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ImportQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_ImportQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ImportQuestions(ctx, req.(*ImportQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_UpdateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_DeleteQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteBulkQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBulkQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteBulkQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_DeleteBulkQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteBulkQuestions(ctx, req.(*DeleteBulkQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetUploadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetUploadURL(ctx, req.(*GetUploadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_CreateExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateExam(ctx, req.(*CreateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GenerateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GenerateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GenerateExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GenerateExam(ctx, req.(*GenerateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExamDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamDetails(ctx, req.(*GetExamDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExams(ctx, req.(*GetExamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_UpdateExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateExam(ctx, req.(*UpdateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_DeleteExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteExam(ctx, req.(*DeleteExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_PublishExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).PublishExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_PublishExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).PublishExam(ctx, req.(*PublishExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_RequestExamAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestExamAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).RequestExamAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_RequestExamAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).RequestExamAccess(ctx, req.(*RequestExamAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ApproveExamAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveExamAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ApproveExamAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_ApproveExamAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ApproveExamAccess(ctx, req.(*ApproveExamAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CheckExamAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckExamAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CheckExamAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_CheckExamAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CheckExamAccess(ctx, req.(*CheckExamAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetAccessRequests(ctx, req.(*GetAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_SubmitExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).SubmitExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_SubmitExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).SubmitExam(ctx, req.(*SubmitExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetSubmission(ctx, req.(*GetSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetUserExamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserExamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetUserExamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetUserExamStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetUserExamStats(ctx, req.(*GetUserExamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExamCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamCount(ctx, req.(*GetExamCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_SaveAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).SaveAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_SaveAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).SaveAnswer(ctx, req.(*SaveAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_LogViolation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogViolationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).LogViolation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_LogViolation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).LogViolation(ctx, req.(*LogViolationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamStatsDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamStatsDetailedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamStatsDetailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExamStatsDetailed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamStatsDetailed(ctx, req.(*GetExamStatsDetailedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExamSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamSubmissions(ctx, req.(*GetExamSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ExportExamResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportExamResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ExportExamResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_ExportExamResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ExportExamResults(ctx, req.(*ExportExamResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExamViolations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamViolations(ctx, req.(*GetExamViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ExportQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ExportQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_ExportQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ExportQuestions(ctx, req.(*ExportQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_StartExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).StartExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_StartExam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).StartExam(ctx, req.(*StartExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamsByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExamsByClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamsByClass(ctx, req.(*GetExamsByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_AssignExamToClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignExamToClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).AssignExamToClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_AssignExamToClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).AssignExamToClass(ctx, req.(*AssignExamToClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UnassignExamFromClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignExamToClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UnassignExamFromClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_UnassignExamFromClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UnassignExamFromClass(ctx, req.(*AssignExamToClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetInstructorExams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstructorExamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetInstructorExams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetInstructorExams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetInstructorExams(ctx, req.(*GetInstructorExamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExamPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetExamPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamPreview(ctx, req.(*GetExamPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetRecentSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetRecentSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetRecentSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetRecentSubmissions(ctx, req.(*GetRecentSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetMySubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMySubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetMySubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetMySubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetMySubmissions(ctx, req.(*GetMySubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExamServiceServer) GetInstructorExams(context.Context, *GetInstructorExamsRequest) (*GetInstructorExamsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetInstructorExams not implemented")
+
+func _ExamService_GradeEssay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeEssayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GradeEssay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GradeEssay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GradeEssay(ctx, req.(*GradeEssayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExamServiceServer) GetExamPreview(context.Context, *GetExamPreviewRequest) (*GetExamDetailsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExamPreview not implemented")
+
+func _ExamService_GetClassGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassGradebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetClassGradebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetClassGradebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetClassGradebook(ctx, req.(*GetClassGradebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExamServiceServer) GetRecentSubmissions(context.Context, *GetRecentSubmissionsRequest) (*GetRecentSubmissionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetRecentSubmissions not implemented")
+
+func _ExamService_GetItemAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetItemAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetItemAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetItemAnalysis(ctx, req.(*GetItemAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExamServiceServer) GetMySubmissions(context.Context, *GetMySubmissionsRequest) (*GetMySubmissionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetMySubmissions not implemented")
+
+func _ExamService_GetNextAdaptiveQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextAdaptiveQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetNextAdaptiveQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetNextAdaptiveQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetNextAdaptiveQuestion(ctx, req.(*GetNextAdaptiveQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExamServiceServer) GradeEssay(context.Context, *GradeEssayRequest) (*GradeEssayResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GradeEssay not implemented")
+
+func _ExamService_ImportQTIPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQTIPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ImportQTIPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_ImportQTIPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ImportQTIPackage(ctx, req.(*ImportQTIPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExamServiceServer) GetClassGradebook(context.Context, *GetClassGradebookRequest) (*GetClassGradebookResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetClassGradebook not implemented")
+
+func _ExamService_ExportQTIPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportQTIPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ExportQTIPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_ExportQTIPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ExportQTIPackage(ctx, req.(*ExportQTIPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExamServiceServer) mustEmbedUnimplementedExamServiceServer() {}
-func (UnimplementedExamServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeExamServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ExamServiceServer will
-// result in compilation errors.
-type UnsafeExamServiceServer interface {
-	mustEmbedUnimplementedExamServiceServer()
+func _ExamService_GetQuestionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetQuestionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetQuestionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetQuestionHistory(ctx, req.(*GetQuestionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterExamServiceServer(s grpc.ServiceRegistrar, srv ExamServiceServer) {
-	// If the following call panics, it indicates UnimplementedExamServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ExamService_GetQuestionVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ExamService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetQuestionVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetQuestionVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetQuestionVersion(ctx, req.(*GetQuestionVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTopicRequest)
+func _ExamService_DiffQuestionVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffQuestionVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateTopic(ctx, in)
+		return srv.(ExamServiceServer).DiffQuestionVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_CreateTopic_FullMethodName,
+		FullMethod: ExamService_DiffQuestionVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateTopic(ctx, req.(*CreateTopicRequest))
+		return srv.(ExamServiceServer).DiffQuestionVersions(ctx, req.(*DiffQuestionVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicsRequest)
+func _ExamService_RegradeExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegradeExamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetTopics(ctx, in)
+		return srv.(ExamServiceServer).RegradeExam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetTopics_FullMethodName,
+		FullMethod: ExamService_RegradeExam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetTopics(ctx, req.(*GetTopicsRequest))
+		return srv.(ExamServiceServer).RegradeExam(ctx, req.(*RegradeExamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSectionRequest)
+func _ExamService_GetRegradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegradeHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateSection(ctx, in)
+		return srv.(ExamServiceServer).GetRegradeHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_CreateSection_FullMethodName,
+		FullMethod: ExamService_GetRegradeHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateSection(ctx, req.(*CreateSectionRequest))
+		return srv.(ExamServiceServer).GetRegradeHistory(ctx, req.(*GetRegradeHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSectionsRequest)
+func _ExamService_CreateAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetSections(ctx, in)
+		return srv.(ExamServiceServer).CreateAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetSections_FullMethodName,
+		FullMethod: ExamService_CreateAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetSections(ctx, req.(*GetSectionsRequest))
+		return srv.(ExamServiceServer).CreateAppeal(ctx, req.(*CreateAppealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTopicRequest)
+func _ExamService_GetAppealQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealQueueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).UpdateTopic(ctx, in)
+		return srv.(ExamServiceServer).GetAppealQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_UpdateTopic_FullMethodName,
+		FullMethod: ExamService_GetAppealQueue_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+		return srv.(ExamServiceServer).GetAppealQueue(ctx, req.(*GetAppealQueueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTopicRequest)
+func _ExamService_GetMyAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyAppealsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).DeleteTopic(ctx, in)
+		return srv.(ExamServiceServer).GetMyAppeals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_DeleteTopic_FullMethodName,
+		FullMethod: ExamService_GetMyAppeals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).DeleteTopic(ctx, req.(*DeleteTopicRequest))
+		return srv.(ExamServiceServer).GetMyAppeals(ctx, req.(*GetMyAppealsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_UpdateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSectionRequest)
+func _ExamService_ResolveAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAppealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).UpdateSection(ctx, in)
+		return srv.(ExamServiceServer).ResolveAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_UpdateSection_FullMethodName,
+		FullMethod: ExamService_ResolveAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).UpdateSection(ctx, req.(*UpdateSectionRequest))
+		return srv.(ExamServiceServer).ResolveAppeal(ctx, req.(*ResolveAppealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_DeleteSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSectionRequest)
+func _ExamService_CreateRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRubricRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).DeleteSection(ctx, in)
+		return srv.(ExamServiceServer).CreateRubric(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_DeleteSection_FullMethodName,
+		FullMethod: ExamService_CreateRubric_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).DeleteSection(ctx, req.(*DeleteSectionRequest))
+		return srv.(ExamServiceServer).CreateRubric(ctx, req.(*CreateRubricRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQuestionsRequest)
+func _ExamService_UpdateRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRubricRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetQuestions(ctx, in)
+		return srv.(ExamServiceServer).UpdateRubric(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetQuestions_FullMethodName,
+		FullMethod: ExamService_UpdateRubric_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetQuestions(ctx, req.(*GetQuestionsRequest))
+		return srv.(ExamServiceServer).UpdateRubric(ctx, req.(*UpdateRubricRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateQuestionRequest)
+func _ExamService_GetRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRubricRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateQuestion(ctx, in)
+		return srv.(ExamServiceServer).GetRubric(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_CreateQuestion_FullMethodName,
+		FullMethod: ExamService_GetRubric_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
+		return srv.(ExamServiceServer).GetRubric(ctx, req.(*GetRubricRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateBulkQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBulkQuestionsRequest)
+func _ExamService_GetRubrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRubricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateBulkQuestions(ctx, in)
+		return srv.(ExamServiceServer).GetRubrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_CreateBulkQuestions_FullMethodName,
+		FullMethod: ExamService_GetRubrics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateBulkQuestions(ctx, req.(*CreateBulkQuestionsRequest))
+		return srv.(ExamServiceServer).GetRubrics(ctx, req.(*GetRubricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQuestionRequest)
+func _ExamService_DeleteRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRubricRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetQuestion(ctx, in)
+		return srv.(ExamServiceServer).DeleteRubric(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetQuestion_FullMethodName,
+		FullMethod: ExamService_DeleteRubric_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetQuestion(ctx, req.(*GetQuestionRequest))
+		return srv.(ExamServiceServer).DeleteRubric(ctx, req.(*DeleteRubricRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_ImportQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportQuestionsRequest)
+func _ExamService_SetQuestionRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuestionRubricRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).ImportQuestions(ctx, in)
+		return srv.(ExamServiceServer).SetQuestionRubric(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_ImportQuestions_FullMethodName,
+		FullMethod: ExamService_SetQuestionRubric_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).ImportQuestions(ctx, req.(*ImportQuestionsRequest))
+		return srv.(ExamServiceServer).SetQuestionRubric(ctx, req.(*SetQuestionRubricRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateQuestionRequest)
+func _ExamService_ConfigureMarking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureMarkingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).UpdateQuestion(ctx, in)
+		return srv.(ExamServiceServer).ConfigureMarking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_UpdateQuestion_FullMethodName,
+		FullMethod: ExamService_ConfigureMarking_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+		return srv.(ExamServiceServer).ConfigureMarking(ctx, req.(*ConfigureMarkingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteQuestionRequest)
+func _ExamService_AssignMarkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignMarkersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).DeleteQuestion(ctx, in)
+		return srv.(ExamServiceServer).AssignMarkers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_DeleteQuestion_FullMethodName,
+		FullMethod: ExamService_AssignMarkers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+		return srv.(ExamServiceServer).AssignMarkers(ctx, req.(*AssignMarkersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_DeleteBulkQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBulkQuestionsRequest)
+func _ExamService_GetMarkingTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarkingTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).DeleteBulkQuestions(ctx, in)
+		return srv.(ExamServiceServer).GetMarkingTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_DeleteBulkQuestions_FullMethodName,
+		FullMethod: ExamService_GetMarkingTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).DeleteBulkQuestions(ctx, req.(*DeleteBulkQuestionsRequest))
+		return srv.(ExamServiceServer).GetMarkingTasks(ctx, req.(*GetMarkingTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUploadURLRequest)
+func _ExamService_GetMarkingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarkingTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetUploadURL(ctx, in)
+		return srv.(ExamServiceServer).GetMarkingTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetUploadURL_FullMethodName,
+		FullMethod: ExamService_GetMarkingTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetUploadURL(ctx, req.(*GetUploadURLRequest))
+		return srv.(ExamServiceServer).GetMarkingTask(ctx, req.(*GetMarkingTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateExamRequest)
+func _ExamService_SubmitMarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitMarksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateExam(ctx, in)
+		return srv.(ExamServiceServer).SubmitMarks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_CreateExam_FullMethodName,
+		FullMethod: ExamService_SubmitMarks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateExam(ctx, req.(*CreateExamRequest))
+		return srv.(ExamServiceServer).SubmitMarks(ctx, req.(*SubmitMarksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GenerateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateExamRequest)
+func _ExamService_GetMarkingOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarkingOverviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GenerateExam(ctx, in)
+		return srv.(ExamServiceServer).GetMarkingOverview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GenerateExam_FullMethodName,
+		FullMethod: ExamService_GetMarkingOverview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GenerateExam(ctx, req.(*GenerateExamRequest))
+		return srv.(ExamServiceServer).GetMarkingOverview(ctx, req.(*GetMarkingOverviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExamDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamDetailsRequest)
+func _ExamService_SaveAccommodation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAccommodationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExamDetails(ctx, in)
+		return srv.(ExamServiceServer).SaveAccommodation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExamDetails_FullMethodName,
+		FullMethod: ExamService_SaveAccommodation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExamDetails(ctx, req.(*GetExamDetailsRequest))
+		return srv.(ExamServiceServer).SaveAccommodation(ctx, req.(*SaveAccommodationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamsRequest)
+func _ExamService_GetAccommodations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccommodationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetAccommodations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamService_GetAccommodations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetAccommodations(ctx, req.(*GetAccommodationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteAccommodation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccommodationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExams(ctx, in)
+		return srv.(ExamServiceServer).DeleteAccommodation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExams_FullMethodName,
+		FullMethod: ExamService_DeleteAccommodation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExams(ctx, req.(*GetExamsRequest))
+		return srv.(ExamServiceServer).DeleteAccommodation(ctx, req.(*DeleteAccommodationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_UpdateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExamRequest)
+func _ExamService_SaveExamAccommodation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveExamAccommodationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).UpdateExam(ctx, in)
+		return srv.(ExamServiceServer).SaveExamAccommodation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_UpdateExam_FullMethodName,
+		FullMethod: ExamService_SaveExamAccommodation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).UpdateExam(ctx, req.(*UpdateExamRequest))
+		return srv.(ExamServiceServer).SaveExamAccommodation(ctx, req.(*SaveExamAccommodationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_DeleteExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteExamRequest)
+func _ExamService_GetExamAccommodations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamAccommodationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).DeleteExam(ctx, in)
+		return srv.(ExamServiceServer).GetExamAccommodations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_DeleteExam_FullMethodName,
+		FullMethod: ExamService_GetExamAccommodations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).DeleteExam(ctx, req.(*DeleteExamRequest))
+		return srv.(ExamServiceServer).GetExamAccommodations(ctx, req.(*GetExamAccommodationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_PublishExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishExamRequest)
+func _ExamService_DeleteExamAccommodation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExamAccommodationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).PublishExam(ctx, in)
+		return srv.(ExamServiceServer).DeleteExamAccommodation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_PublishExam_FullMethodName,
+		FullMethod: ExamService_DeleteExamAccommodation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).PublishExam(ctx, req.(*PublishExamRequest))
+		return srv.(ExamServiceServer).DeleteExamAccommodation(ctx, req.(*DeleteExamAccommodationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_RequestExamAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestExamAccessRequest)
+func _ExamService_ControlExamSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlExamSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).RequestExamAccess(ctx, in)
+		return srv.(ExamServiceServer).ControlExamSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_RequestExamAccess_FullMethodName,
+		FullMethod: ExamService_ControlExamSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).RequestExamAccess(ctx, req.(*RequestExamAccessRequest))
+		return srv.(ExamServiceServer).ControlExamSession(ctx, req.(*ControlExamSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_ApproveExamAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApproveExamAccessRequest)
+func _ExamService_GetExamSessionActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamSessionActionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).ApproveExamAccess(ctx, in)
+		return srv.(ExamServiceServer).GetExamSessionActions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_ApproveExamAccess_FullMethodName,
+		FullMethod: ExamService_GetExamSessionActions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).ApproveExamAccess(ctx, req.(*ApproveExamAccessRequest))
+		return srv.(ExamServiceServer).GetExamSessionActions(ctx, req.(*GetExamSessionActionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CheckExamAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckExamAccessRequest)
+func _ExamService_GetExamSessionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamSessionStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CheckExamAccess(ctx, in)
+		return srv.(ExamServiceServer).GetExamSessionState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_CheckExamAccess_FullMethodName,
+		FullMethod: ExamService_GetExamSessionState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CheckExamAccess(ctx, req.(*CheckExamAccessRequest))
+		return srv.(ExamServiceServer).GetExamSessionState(ctx, req.(*GetExamSessionStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccessRequestsRequest)
+func _ExamService_GetSuspicionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSuspicionConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetAccessRequests(ctx, in)
+		return srv.(ExamServiceServer).GetSuspicionConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetAccessRequests_FullMethodName,
+		FullMethod: ExamService_GetSuspicionConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetAccessRequests(ctx, req.(*GetAccessRequestsRequest))
+		return srv.(ExamServiceServer).GetSuspicionConfig(ctx, req.(*GetSuspicionConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_SubmitExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitExamRequest)
+func _ExamService_UpdateSuspicionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSuspicionConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).SubmitExam(ctx, in)
+		return srv.(ExamServiceServer).UpdateSuspicionConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_SubmitExam_FullMethodName,
+		FullMethod: ExamService_UpdateSuspicionConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).SubmitExam(ctx, req.(*SubmitExamRequest))
+		return srv.(ExamServiceServer).UpdateSuspicionConfig(ctx, req.(*UpdateSuspicionConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubmissionRequest)
+func _ExamService_AnalyzeCollusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeCollusionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetSubmission(ctx, in)
+		return srv.(ExamServiceServer).AnalyzeCollusion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetSubmission_FullMethodName,
+		FullMethod: ExamService_AnalyzeCollusion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetSubmission(ctx, req.(*GetSubmissionRequest))
+		return srv.(ExamServiceServer).AnalyzeCollusion(ctx, req.(*AnalyzeCollusionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetUserExamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserExamStatsRequest)
+func _ExamService_GetCollusionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollusionReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetUserExamStats(ctx, in)
+		return srv.(ExamServiceServer).GetCollusionReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetUserExamStats_FullMethodName,
+		FullMethod: ExamService_GetCollusionReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetUserExamStats(ctx, req.(*GetUserExamStatsRequest))
+		return srv.(ExamServiceServer).GetCollusionReport(ctx, req.(*GetCollusionReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExamCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamCountRequest)
+func _ExamService_SaveSimilaritySources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSimilaritySourcesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExamCount(ctx, in)
+		return srv.(ExamServiceServer).SaveSimilaritySources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExamCount_FullMethodName,
+		FullMethod: ExamService_SaveSimilaritySources_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExamCount(ctx, req.(*GetExamCountRequest))
+		return srv.(ExamServiceServer).SaveSimilaritySources(ctx, req.(*SaveSimilaritySourcesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_SaveAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveAnswerRequest)
+func _ExamService_GetSimilaritySources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilaritySourcesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).SaveAnswer(ctx, in)
+		return srv.(ExamServiceServer).GetSimilaritySources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_SaveAnswer_FullMethodName,
+		FullMethod: ExamService_GetSimilaritySources_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).SaveAnswer(ctx, req.(*SaveAnswerRequest))
+		return srv.(ExamServiceServer).GetSimilaritySources(ctx, req.(*GetSimilaritySourcesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_LogViolation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogViolationRequest)
+func _ExamService_CheckEssaySimilarity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEssaySimilarityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).LogViolation(ctx, in)
+		return srv.(ExamServiceServer).CheckEssaySimilarity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_LogViolation_FullMethodName,
+		FullMethod: ExamService_CheckEssaySimilarity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).LogViolation(ctx, req.(*LogViolationRequest))
+		return srv.(ExamServiceServer).CheckEssaySimilarity(ctx, req.(*CheckEssaySimilarityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExamStatsDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamStatsDetailedRequest)
+func _ExamService_GetEssaySimilarity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEssaySimilarityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExamStatsDetailed(ctx, in)
+		return srv.(ExamServiceServer).GetEssaySimilarity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExamStatsDetailed_FullMethodName,
+		FullMethod: ExamService_GetEssaySimilarity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExamStatsDetailed(ctx, req.(*GetExamStatsDetailedRequest))
+		return srv.(ExamServiceServer).GetEssaySimilarity(ctx, req.(*GetEssaySimilarityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExamSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamSubmissionsRequest)
+func _ExamService_GetPracticeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPracticeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExamSubmissions(ctx, in)
+		return srv.(ExamServiceServer).GetPracticeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExamSubmissions_FullMethodName,
+		FullMethod: ExamService_GetPracticeReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExamSubmissions(ctx, req.(*GetExamSubmissionsRequest))
+		return srv.(ExamServiceServer).GetPracticeReport(ctx, req.(*GetPracticeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_ExportExamResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportExamResultsRequest)
+func _ExamService_GetDueReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDueReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).ExportExamResults(ctx, in)
+		return srv.(ExamServiceServer).GetDueReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_ExportExamResults_FullMethodName,
+		FullMethod: ExamService_GetDueReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).ExportExamResults(ctx, req.(*ExportExamResultsRequest))
+		return srv.(ExamServiceServer).GetDueReviews(ctx, req.(*GetDueReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExamViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamViolationsRequest)
+func _ExamService_RecordReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExamViolations(ctx, in)
+		return srv.(ExamServiceServer).RecordReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExamViolations_FullMethodName,
+		FullMethod: ExamService_RecordReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExamViolations(ctx, req.(*GetExamViolationsRequest))
+		return srv.(ExamServiceServer).RecordReview(ctx, req.(*RecordReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_ExportQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportQuestionsRequest)
+func _ExamService_GeneratePaperExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePaperExamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).ExportQuestions(ctx, in)
+		return srv.(ExamServiceServer).GeneratePaperExam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_ExportQuestions_FullMethodName,
+		FullMethod: ExamService_GeneratePaperExam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).ExportQuestions(ctx, req.(*ExportQuestionsRequest))
+		return srv.(ExamServiceServer).GeneratePaperExam(ctx, req.(*GeneratePaperExamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_StartExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartExamRequest)
+func _ExamService_GenerateAnswerSheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateAnswerSheetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).StartExam(ctx, in)
+		return srv.(ExamServiceServer).GenerateAnswerSheets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_StartExam_FullMethodName,
+		FullMethod: ExamService_GenerateAnswerSheets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).StartExam(ctx, req.(*StartExamRequest))
+		return srv.(ExamServiceServer).GenerateAnswerSheets(ctx, req.(*GenerateAnswerSheetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExamsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamsByClassRequest)
+func _ExamService_GradeScannedSheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeScannedSheetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExamsByClass(ctx, in)
+		return srv.(ExamServiceServer).GradeScannedSheets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExamsByClass_FullMethodName,
+		FullMethod: ExamService_GradeScannedSheets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExamsByClass(ctx, req.(*GetExamsByClassRequest))
+		return srv.(ExamServiceServer).GradeScannedSheets(ctx, req.(*GradeScannedSheetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_AssignExamToClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignExamToClassRequest)
+func _ExamService_GetAnswerSheetScans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnswerSheetScansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).AssignExamToClass(ctx, in)
+		return srv.(ExamServiceServer).GetAnswerSheetScans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_AssignExamToClass_FullMethodName,
+		FullMethod: ExamService_GetAnswerSheetScans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).AssignExamToClass(ctx, req.(*AssignExamToClassRequest))
+		return srv.(ExamServiceServer).GetAnswerSheetScans(ctx, req.(*GetAnswerSheetScansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_UnassignExamFromClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignExamToClassRequest)
+func _ExamService_ResolveAnswerSheetScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAnswerSheetScanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).UnassignExamFromClass(ctx, in)
+		return srv.(ExamServiceServer).ResolveAnswerSheetScan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_UnassignExamFromClass_FullMethodName,
+		FullMethod: ExamService_ResolveAnswerSheetScan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).UnassignExamFromClass(ctx, req.(*AssignExamToClassRequest))
+		return srv.(ExamServiceServer).ResolveAnswerSheetScan(ctx, req.(*ResolveAnswerSheetScanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetInstructorExams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInstructorExamsRequest)
+func _ExamService_CloneExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneExamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetInstructorExams(ctx, in)
+		return srv.(ExamServiceServer).CloneExam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetInstructorExams_FullMethodName,
+		FullMethod: ExamService_CloneExam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetInstructorExams(ctx, req.(*GetInstructorExamsRequest))
+		return srv.(ExamServiceServer).CloneExam(ctx, req.(*CloneExamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetExamPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExamPreviewRequest)
+func _ExamService_CreateExamTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExamTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetExamPreview(ctx, in)
+		return srv.(ExamServiceServer).CreateExamTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetExamPreview_FullMethodName,
+		FullMethod: ExamService_CreateExamTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetExamPreview(ctx, req.(*GetExamPreviewRequest))
+		return srv.(ExamServiceServer).CreateExamTemplate(ctx, req.(*CreateExamTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetRecentSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecentSubmissionsRequest)
+func _ExamService_GetExamTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetRecentSubmissions(ctx, in)
+		return srv.(ExamServiceServer).GetExamTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetRecentSubmissions_FullMethodName,
+		FullMethod: ExamService_GetExamTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetRecentSubmissions(ctx, req.(*GetRecentSubmissionsRequest))
+		return srv.(ExamServiceServer).GetExamTemplates(ctx, req.(*GetExamTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetMySubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMySubmissionsRequest)
+func _ExamService_DeleteExamTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExamTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetMySubmissions(ctx, in)
+		return srv.(ExamServiceServer).DeleteExamTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetMySubmissions_FullMethodName,
+		FullMethod: ExamService_DeleteExamTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetMySubmissions(ctx, req.(*GetMySubmissionsRequest))
+		return srv.(ExamServiceServer).DeleteExamTemplate(ctx, req.(*DeleteExamTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GradeEssay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GradeEssayRequest)
+func _ExamService_InstantiateExamTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateExamTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GradeEssay(ctx, in)
+		return srv.(ExamServiceServer).InstantiateExamTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GradeEssay_FullMethodName,
+		FullMethod: ExamService_InstantiateExamTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GradeEssay(ctx, req.(*GradeEssayRequest))
+		return srv.(ExamServiceServer).InstantiateExamTemplate(ctx, req.(*InstantiateExamTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_GetClassGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClassGradebookRequest)
+func _ExamService_ValidateExamBlueprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateExamBlueprintRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).GetClassGradebook(ctx, in)
+		return srv.(ExamServiceServer).ValidateExamBlueprint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExamService_GetClassGradebook_FullMethodName,
+		FullMethod: ExamService_ValidateExamBlueprint_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).GetClassGradebook(ctx, req.(*GetClassGradebookRequest))
+		return srv.(ExamServiceServer).ValidateExamBlueprint(ctx, req.(*ValidateExamBlueprintRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1939,6 +3843,230 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetClassGradebook",
 			Handler:    _ExamService_GetClassGradebook_Handler,
 		},
+		{
+			MethodName: "GetItemAnalysis",
+			Handler:    _ExamService_GetItemAnalysis_Handler,
+		},
+		{
+			MethodName: "GetNextAdaptiveQuestion",
+			Handler:    _ExamService_GetNextAdaptiveQuestion_Handler,
+		},
+		{
+			MethodName: "ImportQTIPackage",
+			Handler:    _ExamService_ImportQTIPackage_Handler,
+		},
+		{
+			MethodName: "ExportQTIPackage",
+			Handler:    _ExamService_ExportQTIPackage_Handler,
+		},
+		{
+			MethodName: "GetQuestionHistory",
+			Handler:    _ExamService_GetQuestionHistory_Handler,
+		},
+		{
+			MethodName: "GetQuestionVersion",
+			Handler:    _ExamService_GetQuestionVersion_Handler,
+		},
+		{
+			MethodName: "DiffQuestionVersions",
+			Handler:    _ExamService_DiffQuestionVersions_Handler,
+		},
+		{
+			MethodName: "RegradeExam",
+			Handler:    _ExamService_RegradeExam_Handler,
+		},
+		{
+			MethodName: "GetRegradeHistory",
+			Handler:    _ExamService_GetRegradeHistory_Handler,
+		},
+		{
+			MethodName: "CreateAppeal",
+			Handler:    _ExamService_CreateAppeal_Handler,
+		},
+		{
+			MethodName: "GetAppealQueue",
+			Handler:    _ExamService_GetAppealQueue_Handler,
+		},
+		{
+			MethodName: "GetMyAppeals",
+			Handler:    _ExamService_GetMyAppeals_Handler,
+		},
+		{
+			MethodName: "ResolveAppeal",
+			Handler:    _ExamService_ResolveAppeal_Handler,
+		},
+		{
+			MethodName: "CreateRubric",
+			Handler:    _ExamService_CreateRubric_Handler,
+		},
+		{
+			MethodName: "UpdateRubric",
+			Handler:    _ExamService_UpdateRubric_Handler,
+		},
+		{
+			MethodName: "GetRubric",
+			Handler:    _ExamService_GetRubric_Handler,
+		},
+		{
+			MethodName: "GetRubrics",
+			Handler:    _ExamService_GetRubrics_Handler,
+		},
+		{
+			MethodName: "DeleteRubric",
+			Handler:    _ExamService_DeleteRubric_Handler,
+		},
+		{
+			MethodName: "SetQuestionRubric",
+			Handler:    _ExamService_SetQuestionRubric_Handler,
+		},
+		{
+			MethodName: "ConfigureMarking",
+			Handler:    _ExamService_ConfigureMarking_Handler,
+		},
+		{
+			MethodName: "AssignMarkers",
+			Handler:    _ExamService_AssignMarkers_Handler,
+		},
+		{
+			MethodName: "GetMarkingTasks",
+			Handler:    _ExamService_GetMarkingTasks_Handler,
+		},
+		{
+			MethodName: "GetMarkingTask",
+			Handler:    _ExamService_GetMarkingTask_Handler,
+		},
+		{
+			MethodName: "SubmitMarks",
+			Handler:    _ExamService_SubmitMarks_Handler,
+		},
+		{
+			MethodName: "GetMarkingOverview",
+			Handler:    _ExamService_GetMarkingOverview_Handler,
+		},
+		{
+			MethodName: "SaveAccommodation",
+			Handler:    _ExamService_SaveAccommodation_Handler,
+		},
+		{
+			MethodName: "GetAccommodations",
+			Handler:    _ExamService_GetAccommodations_Handler,
+		},
+		{
+			MethodName: "DeleteAccommodation",
+			Handler:    _ExamService_DeleteAccommodation_Handler,
+		},
+		{
+			MethodName: "SaveExamAccommodation",
+			Handler:    _ExamService_SaveExamAccommodation_Handler,
+		},
+		{
+			MethodName: "GetExamAccommodations",
+			Handler:    _ExamService_GetExamAccommodations_Handler,
+		},
+		{
+			MethodName: "DeleteExamAccommodation",
+			Handler:    _ExamService_DeleteExamAccommodation_Handler,
+		},
+		{
+			MethodName: "ControlExamSession",
+			Handler:    _ExamService_ControlExamSession_Handler,
+		},
+		{
+			MethodName: "GetExamSessionActions",
+			Handler:    _ExamService_GetExamSessionActions_Handler,
+		},
+		{
+			MethodName: "GetExamSessionState",
+			Handler:    _ExamService_GetExamSessionState_Handler,
+		},
+		{
+			MethodName: "GetSuspicionConfig",
+			Handler:    _ExamService_GetSuspicionConfig_Handler,
+		},
+		{
+			MethodName: "UpdateSuspicionConfig",
+			Handler:    _ExamService_UpdateSuspicionConfig_Handler,
+		},
+		{
+			MethodName: "AnalyzeCollusion",
+			Handler:    _ExamService_AnalyzeCollusion_Handler,
+		},
+		{
+			MethodName: "GetCollusionReport",
+			Handler:    _ExamService_GetCollusionReport_Handler,
+		},
+		{
+			MethodName: "SaveSimilaritySources",
+			Handler:    _ExamService_SaveSimilaritySources_Handler,
+		},
+		{
+			MethodName: "GetSimilaritySources",
+			Handler:    _ExamService_GetSimilaritySources_Handler,
+		},
+		{
+			MethodName: "CheckEssaySimilarity",
+			Handler:    _ExamService_CheckEssaySimilarity_Handler,
+		},
+		{
+			MethodName: "GetEssaySimilarity",
+			Handler:    _ExamService_GetEssaySimilarity_Handler,
+		},
+		{
+			MethodName: "GetPracticeReport",
+			Handler:    _ExamService_GetPracticeReport_Handler,
+		},
+		{
+			MethodName: "GetDueReviews",
+			Handler:    _ExamService_GetDueReviews_Handler,
+		},
+		{
+			MethodName: "RecordReview",
+			Handler:    _ExamService_RecordReview_Handler,
+		},
+		{
+			MethodName: "GeneratePaperExam",
+			Handler:    _ExamService_GeneratePaperExam_Handler,
+		},
+		{
+			MethodName: "GenerateAnswerSheets",
+			Handler:    _ExamService_GenerateAnswerSheets_Handler,
+		},
+		{
+			MethodName: "GradeScannedSheets",
+			Handler:    _ExamService_GradeScannedSheets_Handler,
+		},
+		{
+			MethodName: "GetAnswerSheetScans",
+			Handler:    _ExamService_GetAnswerSheetScans_Handler,
+		},
+		{
+			MethodName: "ResolveAnswerSheetScan",
+			Handler:    _ExamService_ResolveAnswerSheetScan_Handler,
+		},
+		{
+			MethodName: "CloneExam",
+			Handler:    _ExamService_CloneExam_Handler,
+		},
+		{
+			MethodName: "CreateExamTemplate",
+			Handler:    _ExamService_CreateExamTemplate_Handler,
+		},
+		{
+			MethodName: "GetExamTemplates",
+			Handler:    _ExamService_GetExamTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteExamTemplate",
+			Handler:    _ExamService_DeleteExamTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateExamTemplate",
+			Handler:    _ExamService_InstantiateExamTemplate_Handler,
+		},
+		{
+			MethodName: "ValidateExamBlueprint",
+			Handler:    _ExamService_ValidateExamBlueprint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exam.proto",